@@ -6,6 +6,8 @@ package main
 const (
 	envDatabaseName          = "DATABASE_NAME"
 	envDatabaseAccountName   = "DATABASE_ACCOUNT_NAME"
+	envDatabaseBackend       = "DATABASE_BACKEND"
+	envDatabaseEmbeddedPath  = "DATABASE_EMBEDDED_PATH"
 	envKeyVaultPrefix        = "KEYVAULT_PREFIX"
 	envDBTokenUrl            = "DBTOKEN_URL"
 	envOpenShiftVersions     = "OPENSHIFT_VERSIONS"
@@ -0,0 +1,56 @@
+package main
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm/policy"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/database"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+	"github.com/Azure/ARO-RP/pkg/env"
+	"github.com/Azure/ARO-RP/pkg/metrics"
+	"github.com/Azure/ARO-RP/pkg/util/encryption"
+)
+
+// newDatabaseClient returns a client for the RP's Cosmos DB account. In
+// development mode, setting DATABASE_BACKEND=embedded uses the local database
+// file at DATABASE_EMBEDDED_PATH instead.
+func newDatabaseClient(ctx context.Context, log *logrus.Entry, _env env.Core, msiToken azcore.TokenCredential, m metrics.Emitter, aead encryption.AEAD) (cosmosdb.DatabaseClient, error) {
+	switch backend := os.Getenv(envDatabaseBackend); backend {
+	case "", "cosmosdb":
+	case "embedded":
+		if !_env.IsLocalDevelopmentMode() {
+			return nil, fmt.Errorf("%s=%s is only supported in development mode", envDatabaseBackend, backend)
+		}
+
+		if err := env.ValidateVars(envDatabaseEmbeddedPath); err != nil {
+			return nil, err
+		}
+
+		return database.NewEmbeddedDatabaseClient(log, os.Getenv(envDatabaseEmbeddedPath), aead)
+	default:
+		return nil, fmt.Errorf("invalid %s %q", envDatabaseBackend, backend)
+	}
+
+	if err := env.ValidateVars(envDatabaseAccountName); err != nil {
+		return nil, err
+	}
+
+	dbAccountName := os.Getenv(envDatabaseAccountName)
+	clientOptions := &policy.ClientOptions{
+		ClientOptions: _env.Environment().ManagedIdentityCredentialOptions().ClientOptions,
+	}
+	dbAuthorizer, err := database.NewMasterKeyAuthorizer(ctx, msiToken, clientOptions, _env.SubscriptionID(), _env.ResourceGroup(), dbAccountName)
+	if err != nil {
+		return nil, err
+	}
+
+	return database.NewDatabaseClient(log, _env, dbAuthorizer, m, aead, dbAccountName)
+}
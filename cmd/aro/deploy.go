@@ -19,7 +19,8 @@ import (
 	"github.com/Azure/ARO-RP/pkg/util/version"
 )
 
-func deploy(ctx context.Context, log *logrus.Entry) error {
+// deployEnv returns the environment and credential the deployer runs with
+func deployEnv(ctx context.Context, log *logrus.Entry) (env.Core, azcore.TokenCredential, error) {
 	// TODO(mjudeikis): Remove this hack in public once we moved to EV2
 	// We are not able to use MSI in public cloud CI as we would need
 	// to have dedicated node pool with MSI where we can controll which jobs are running
@@ -34,12 +35,12 @@ func deploy(ctx context.Context, log *logrus.Entry) error {
 		var err error
 		_env, err = env.NewCore(ctx, log, env.COMPONENT_DEPLOY)
 		if err != nil {
-			return err
+			return nil, nil, err
 		}
 		options := _env.Environment().ManagedIdentityCredentialOptions()
 		tokenCredential, err = azidentity.NewManagedIdentityCredential(options)
 		if err != nil {
-			return err
+			return nil, nil, err
 		}
 	} else { // running in CI node/Public - Use SP from Env
 		err := env.ValidateVars(
@@ -49,20 +50,28 @@ func deploy(ctx context.Context, log *logrus.Entry) error {
 			"AZURE_TENANT_ID")
 
 		if err != nil {
-			return err
+			return nil, nil, err
 		}
 
 		_env, err = env.NewCoreForCI(ctx, log)
 		if err != nil {
-			return err
+			return nil, nil, err
 		}
 		options := _env.Environment().EnvironmentCredentialOptions()
 		tokenCredential, err = azidentity.NewEnvironmentCredential(options)
 		if err != nil {
-			return err
+			return nil, nil, err
 		}
 	}
-	env := _env
+
+	return _env, tokenCredential, nil
+}
+
+func deploy(ctx context.Context, log *logrus.Entry) error {
+	env, tokenCredential, err := deployEnv(ctx, log)
+	if err != nil {
+		return err
+	}
 
 	deployVersion, location := version.GitCommit, flag.Arg(2)
 
@@ -145,3 +154,117 @@ func deploy(ctx context.Context, log *logrus.Entry) error {
 	// still serving
 	return deployer.SaveVersion(ctx)
 }
+
+// deployValidate validates the config file for every location and shows, for
+// each location given, how the effective parameters differ from the deployed
+// ones
+func deployValidate(ctx context.Context, log *logrus.Entry) error {
+	path, locations := flag.Arg(2), flag.Args()[3:]
+
+	validation, err := pkgdeploy.ValidateConfig(path)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range validation.UnknownKeys {
+		log.Errorf("unknown key %s", key)
+	}
+	for _, key := range validation.IgnoredKeys {
+		log.Warnf("ignored key %s", key)
+	}
+	for location, errs := range validation.Errors {
+		for _, err := range errs {
+			log.Errorf("%s: %s", location, err)
+		}
+	}
+
+	if len(locations) > 0 {
+		env, tokenCredential, err := deployEnv(ctx, log)
+		if err != nil {
+			return err
+		}
+
+		for _, location := range locations {
+			config, err := pkgdeploy.GetConfig(path, location)
+			if err != nil {
+				return err
+			}
+
+			deployer, err := pkgdeploy.New(ctx, log, env, config, version.GitCommit, tokenCredential)
+			if err != nil {
+				return err
+			}
+
+			diffs, err := deployer.DiffParameters(ctx)
+			if err != nil {
+				return err
+			}
+
+			log.Printf("%s: %d parameter(s) differ from the deployed parameters", location, len(diffs))
+			for _, diff := range diffs {
+				log.Printf("%s: %s", location, diff)
+			}
+		}
+	}
+
+	if !validation.Valid() {
+		return fmt.Errorf("config %s is invalid", path)
+	}
+
+	return nil
+}
+
+// deployPreview shows the changes the predeploy, rp-production and
+// gateway-production deployments of this version would make to the location,
+// using ARM what-if
+func deployPreview(ctx context.Context, log *logrus.Entry) error {
+	path, location := flag.Arg(2), flag.Arg(3)
+
+	config, err := pkgdeploy.GetConfig(path, location)
+	if err != nil {
+		return err
+	}
+
+	env, tokenCredential, err := deployEnv(ctx, log)
+	if err != nil {
+		return err
+	}
+
+	deployer, err := pkgdeploy.New(ctx, log, env, config, version.GitCommit, tokenCredential)
+	if err != nil {
+		return err
+	}
+
+	summaries, err := deployer.Preview(ctx)
+	if err != nil {
+		return err
+	}
+
+	var unexpected []string
+	for _, summary := range summaries {
+		log.Print(summary)
+		for _, id := range summary.Create {
+			log.Printf("%s: create %s", summary.Deployment, id)
+		}
+		for _, id := range summary.Modify {
+			log.Printf("%s: modify %s", summary.Deployment, id)
+		}
+		for _, id := range summary.Delete {
+			log.Printf("%s: delete %s", summary.Deployment, id)
+		}
+		for _, p := range summary.DeleteProperties {
+			log.Printf("%s: delete property %s", summary.Deployment, p)
+		}
+		for _, id := range summary.Ignore {
+			log.Printf("%s: ignore %s", summary.Deployment, id)
+		}
+
+		unexpected = append(unexpected, summary.UnexpectedDeletes(config.Configuration.WhatIfAllowedDeletes)...)
+	}
+
+	if len(unexpected) > 0 {
+		return fmt.Errorf("deployments would delete %s; add them to whatIfAllowedDeletes to proceed", strings.Join(unexpected, ", "))
+	}
+
+	return nil
+}
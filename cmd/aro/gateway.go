@@ -5,8 +5,10 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"os/signal"
+	"strconv"
 	"strings"
 	"syscall"
 	"time"
@@ -109,9 +111,14 @@ func gateway(ctx context.Context, log *logrus.Entry) error {
 		return err
 	}
 
+	flowLogConfig, err := getFlowLogConfig()
+	if err != nil {
+		return err
+	}
+
 	log.Print("listening")
 
-	p, err := pkggateway.NewGateway(ctx, _env, log.WithField("component", "gateway"), log.WithField("component", "gateway-access"), dbGateway, httpsl, httpl, healthListener, os.Getenv("ACR_RESOURCE_ID"), os.Getenv("GATEWAY_DOMAINS"), m)
+	p, err := pkggateway.NewGateway(ctx, _env, log.WithField("component", "gateway"), log.WithField("component", "gateway-access"), dbGateway, httpsl, httpl, healthListener, os.Getenv("ACR_RESOURCE_ID"), os.Getenv("GATEWAY_DOMAINS"), m, flowLogConfig)
 	if err != nil {
 		return err
 	}
@@ -131,6 +138,28 @@ func gateway(ctx context.Context, log *logrus.Entry) error {
 	return nil
 }
 
+// getFlowLogConfig returns the flow log configuration.  If
+// GATEWAY_FLOW_LOG_PATH is unset, flow records are not written but denied
+// hosts are still reported.
+func getFlowLogConfig() (pkggateway.FlowLogConfig, error) {
+	config := pkggateway.FlowLogConfig{
+		Path:          os.Getenv("GATEWAY_FLOW_LOG_PATH"),
+		SamplePercent: 10,
+		MaxSizeBytes:  100 * 1024 * 1024,
+		MaxBackups:    5,
+	}
+
+	if v := os.Getenv("GATEWAY_FLOW_LOG_SAMPLE_PERCENT"); v != "" {
+		samplePercent, err := strconv.Atoi(v)
+		if err != nil || samplePercent < 0 || samplePercent > 100 {
+			return pkggateway.FlowLogConfig{}, fmt.Errorf("invalid GATEWAY_FLOW_LOG_SAMPLE_PERCENT %q", v)
+		}
+		config.SamplePercent = samplePercent
+	}
+
+	return config, nil
+}
+
 func getURL(isLocalDevelopmentMode bool) (string, error) {
 	if isLocalDevelopmentMode {
 		return "https://localhost:8445", nil
@@ -24,6 +24,8 @@ func usage() {
 	fmt.Fprint(flag.CommandLine.Output(), "usage:\n")
 	fmt.Fprintf(flag.CommandLine.Output(), "  %s dbtoken\n", os.Args[0])
 	fmt.Fprintf(flag.CommandLine.Output(), "  %s deploy config.yaml location\n", os.Args[0])
+	fmt.Fprintf(flag.CommandLine.Output(), "  %s deploy preview config.yaml location\n", os.Args[0])
+	fmt.Fprintf(flag.CommandLine.Output(), "  %s deploy validate config.yaml [location...]\n", os.Args[0])
 	fmt.Fprintf(flag.CommandLine.Output(), "  %s gateway\n", os.Args[0])
 	fmt.Fprintf(flag.CommandLine.Output(), "  %s mirror [release_image...]\n", os.Args[0])
 	fmt.Fprintf(flag.CommandLine.Output(), "  %s monitor\n", os.Args[0])
@@ -56,8 +58,17 @@ func main() {
 		checkArgs(1)
 		err = dbtoken(ctx, log)
 	case "deploy":
-		checkArgs(3)
-		err = deploy(ctx, log)
+		switch strings.ToLower(flag.Arg(1)) {
+		case "preview":
+			checkArgs(4)
+			err = deployPreview(ctx, log)
+		case "validate":
+			checkMinArgs(3)
+			err = deployValidate(ctx, log)
+		default:
+			checkArgs(3)
+			err = deploy(ctx, log)
+		}
 	case "gateway":
 		checkArgs(1)
 		err = gateway(ctx, log)
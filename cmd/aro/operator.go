@@ -16,8 +16,10 @@ import (
 	"github.com/Azure/ARO-RP/pkg/env"
 	pkgoperator "github.com/Azure/ARO-RP/pkg/operator"
 	"github.com/Azure/ARO-RP/pkg/operator/controllers/alertwebhook"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/autoscaler"
 	"github.com/Azure/ARO-RP/pkg/operator/controllers/autosizednodes"
 	"github.com/Azure/ARO-RP/pkg/operator/controllers/banner"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/certificates"
 	"github.com/Azure/ARO-RP/pkg/operator/controllers/checkers/clusterdnschecker"
 	"github.com/Azure/ARO-RP/pkg/operator/controllers/checkers/ingresscertificatechecker"
 	"github.com/Azure/ARO-RP/pkg/operator/controllers/checkers/internetchecker"
@@ -25,6 +27,7 @@ import (
 	"github.com/Azure/ARO-RP/pkg/operator/controllers/cloudproviderconfig"
 	"github.com/Azure/ARO-RP/pkg/operator/controllers/clusteroperatoraro"
 	"github.com/Azure/ARO-RP/pkg/operator/controllers/dnsmasq"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/drift"
 	"github.com/Azure/ARO-RP/pkg/operator/controllers/genevalogging"
 	"github.com/Azure/ARO-RP/pkg/operator/controllers/guardrails"
 	"github.com/Azure/ARO-RP/pkg/operator/controllers/imageconfig"
@@ -42,6 +45,7 @@ import (
 	"github.com/Azure/ARO-RP/pkg/operator/controllers/storageaccounts"
 	"github.com/Azure/ARO-RP/pkg/operator/controllers/subnets"
 	"github.com/Azure/ARO-RP/pkg/operator/controllers/workaround"
+	"github.com/Azure/ARO-RP/pkg/operator/diagnostics"
 	"github.com/Azure/ARO-RP/pkg/util/dynamichelper"
 	utillog "github.com/Azure/ARO-RP/pkg/util/log"
 	// +kubebuilder:scaffold:imports
@@ -68,7 +72,7 @@ func operator(ctx context.Context, log *logrus.Entry) error {
 
 	mgr, err := ctrl.NewManager(restConfig, ctrl.Options{
 		HealthProbeBindAddress: ":8080",
-		MetricsBindAddress:     "0", // disabled
+		MetricsBindAddress:     fmt.Sprintf(":%d", diagnostics.Port),
 		Port:                   8443,
 	})
 	if err != nil {
@@ -77,11 +81,17 @@ func operator(ctx context.Context, log *logrus.Entry) error {
 
 	client := mgr.GetClient()
 
+	err = mgr.AddMetricsExtraHandler(diagnostics.Path, diagnostics.NewHandler(log.WithField("component", "diagnostics"), client, role))
+	if err != nil {
+		return err
+	}
+
 	kubernetescli, err := kubernetes.NewForConfig(restConfig)
 	if err != nil {
 		return err
 	}
-	dh, err := dynamichelper.New(log, restConfig)
+	driftRecorder := drift.NewRecorder()
+	dh, err := dynamichelper.NewWithDriftRecorder(log, restConfig, driftRecorder)
 	if err != nil {
 		return err
 	}
@@ -178,7 +188,7 @@ func operator(ctx context.Context, log *logrus.Entry) error {
 		}
 		if err = (storageaccounts.NewReconciler(
 			log.WithField("controller", storageaccounts.ControllerName),
-			client)).SetupWithManager(mgr); err != nil {
+			client, mgr.GetEventRecorderFor(storageaccounts.ControllerName))).SetupWithManager(mgr); err != nil {
 			return fmt.Errorf("unable to create controller %s: %v", storageaccounts.ControllerName, err)
 		}
 		if err = (muo.NewReconciler(
@@ -191,6 +201,16 @@ func operator(ctx context.Context, log *logrus.Entry) error {
 			client)).SetupWithManager(mgr); err != nil {
 			return fmt.Errorf("unable to create controller %s: %v", autosizednodes.ControllerName, err)
 		}
+		if err = (autoscaler.NewReconciler(
+			log.WithField("controller", autoscaler.ControllerName),
+			client)).SetupWithManager(mgr); err != nil {
+			return fmt.Errorf("unable to create controller %s: %v", autoscaler.ControllerName, err)
+		}
+		if err = (certificates.NewReconciler(
+			log.WithField("controller", certificates.ControllerName),
+			client)).SetupWithManager(mgr); err != nil {
+			return fmt.Errorf("unable to create controller %s: %v", certificates.ControllerName, err)
+		}
 		if err = (machinehealthcheck.NewReconciler(
 			log.WithField("controller", machinehealthcheck.ControllerName),
 			client, dh)).SetupWithManager(mgr); err != nil {
@@ -226,6 +246,11 @@ func operator(ctx context.Context, log *logrus.Entry) error {
 			client)).SetupWithManager(mgr); err != nil {
 			return fmt.Errorf("unable to create controller %s: %v", cloudproviderconfig.ControllerName, err)
 		}
+		if err = (drift.NewReconciler(
+			log.WithField("controller", drift.ControllerName),
+			client, driftRecorder)).SetupWithManager(mgr); err != nil {
+			return fmt.Errorf("unable to create controller %s: %v", drift.ControllerName, err)
+		}
 	}
 
 	if err = (internetchecker.NewReconciler(
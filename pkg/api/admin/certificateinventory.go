@@ -0,0 +1,52 @@
+package admin
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"time"
+)
+
+// CertificateInventory represents the certificates of a cluster and how each
+// of them is renewed.
+type CertificateInventory struct {
+	Certificates []Certificate `json:"certificates"`
+}
+
+// Certificate represents a certificate held in a cluster secret.
+type Certificate struct {
+	Kind          CertificateKind          `json:"kind,omitempty"`
+	Namespace     string                   `json:"namespace,omitempty"`
+	SecretName    string                   `json:"secretName,omitempty"`
+	Subject       string                   `json:"subject,omitempty"`
+	NotAfter      time.Time                `json:"notAfter,omitempty"`
+	RenewalPolicy CertificateRenewalPolicy `json:"renewalPolicy,omitempty"`
+
+	// RenewAfter is when the certificate is due for renewal.  It is only set
+	// for the RP and Manual renewal policies.
+	RenewAfter *time.Time `json:"renewAfter,omitempty"`
+}
+
+// CertificateKind represents the purpose of a certificate.
+type CertificateKind string
+
+// CertificateKind constants.
+const (
+	CertificateKindAPIServer           CertificateKind = "APIServer"
+	CertificateKindEtcd                CertificateKind = "Etcd"
+	CertificateKindIngress             CertificateKind = "Ingress"
+	CertificateKindKubeletServing      CertificateKind = "KubeletServing"
+	CertificateKindMachineConfigServer CertificateKind = "MachineConfigServer"
+	CertificateKindMDSD                CertificateKind = "MDSD"
+)
+
+// CertificateRenewalPolicy represents who renews a certificate.
+type CertificateRenewalPolicy string
+
+// CertificateRenewalPolicy constants.
+const (
+	CertificateRenewalPolicyRP       CertificateRenewalPolicy = "RP"
+	CertificateRenewalPolicyCluster  CertificateRenewalPolicy = "Cluster"
+	CertificateRenewalPolicyCustomer CertificateRenewalPolicy = "Customer"
+	CertificateRenewalPolicyManual   CertificateRenewalPolicy = "Manual"
+)
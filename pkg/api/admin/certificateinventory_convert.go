@@ -0,0 +1,38 @@
+package admin
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"github.com/Azure/ARO-RP/pkg/api"
+)
+
+type certificateInventoryConverter struct{}
+
+// certificateInventoryConverter.ToExternal returns a new external
+// representation of the internal object.  ToExternal does not modify its
+// argument; there is no pointer aliasing between the passed and returned
+// objects.
+func (certificateInventoryConverter) ToExternal(inv *api.CertificateInventory) interface{} {
+	out := &CertificateInventory{
+		Certificates: make([]Certificate, 0, len(inv.Certificates)),
+	}
+
+	for _, c := range inv.Certificates {
+		cert := Certificate{
+			Kind:          CertificateKind(c.Kind),
+			Namespace:     c.Namespace,
+			SecretName:    c.SecretName,
+			Subject:       c.Subject,
+			NotAfter:      c.NotAfter,
+			RenewalPolicy: CertificateRenewalPolicy(c.RenewalPolicy),
+		}
+		if c.RenewAfter != nil {
+			renewAfter := *c.RenewAfter
+			cert.RenewAfter = &renewAfter
+		}
+		out.Certificates = append(out.Certificates, cert)
+	}
+
+	return out
+}
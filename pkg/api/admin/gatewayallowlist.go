@@ -0,0 +1,22 @@
+package admin
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+// GatewayAllowList represents the destinations which a gateway-enabled
+// cluster may reach through the gateway in addition to those allowed for all
+// clusters.
+type GatewayAllowList struct {
+	Entries []GatewayAllowListEntry `json:"entries"`
+}
+
+// GatewayAllowListEntry represents a destination allowed through the gateway.
+type GatewayAllowListEntry struct {
+	// Host is an exact host name (e.g. "api.example.com") or, if prefixed with
+	// "*.", a domain whose subdomains are allowed (e.g. "*.example.com").
+	Host string `json:"host,omitempty"`
+
+	// Port is the allowed destination port.  Defaults to 443.  Ports other
+	// than 443 can only be reached through the gateway's HTTP CONNECT proxy.
+	Port int `json:"port,omitempty"`
+}
@@ -0,0 +1,46 @@
+package admin
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"strings"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+)
+
+type gatewayAllowListConverter struct{}
+
+// gatewayAllowListConverter.ToExternal returns a new external representation
+// of the allow list of the internal object.  ToExternal does not modify its
+// argument; there is no pointer aliasing between the passed and returned
+// objects.
+func (gatewayAllowListConverter) ToExternal(g *api.Gateway) interface{} {
+	out := &GatewayAllowList{
+		Entries: make([]GatewayAllowListEntry, 0, len(g.AllowList)),
+	}
+
+	for _, e := range g.AllowList {
+		out.Entries = append(out.Entries, GatewayAllowListEntry{
+			Host: e.Host,
+			Port: e.Port,
+		})
+	}
+
+	return out
+}
+
+// ToInternal overwrites in place the allow list of a pre-existing internal
+// object.  Host names are lowercased.  ToInternal modifies its argument; there
+// is no pointer aliasing between the passed and returned objects.
+func (gatewayAllowListConverter) ToInternal(_new interface{}, out *api.Gateway) {
+	new := _new.(*GatewayAllowList)
+
+	out.AllowList = nil
+	for _, e := range new.Entries {
+		out.AllowList = append(out.AllowList, api.GatewayAllowListEntry{
+			Host: strings.ToLower(e.Host),
+			Port: e.Port,
+		})
+	}
+}
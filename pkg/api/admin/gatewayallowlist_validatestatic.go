@@ -0,0 +1,57 @@
+package admin
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/api/validate"
+)
+
+// maxGatewayAllowListEntries bounds the per-cluster allow list, which the
+// gateway checks on every connection
+const maxGatewayAllowListEntries = 50
+
+type gatewayAllowListStaticValidator struct{}
+
+// Static validates a gateway allow list.  The allow list is replaced as a
+// whole through PUT, so there is no delta to validate.
+func (sv gatewayAllowListStaticValidator) Static(_new interface{}) error {
+	new := _new.(*GatewayAllowList)
+
+	if len(new.Entries) > maxGatewayAllowListEntries {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "entries", "The allow list may contain at most %d entries.", maxGatewayAllowListEntries)
+	}
+
+	seen := map[GatewayAllowListEntry]bool{}
+	for i, e := range new.Entries {
+		path := fmt.Sprintf("entries[%d]", i)
+
+		host := strings.ToLower(e.Host)
+		domain := strings.TrimPrefix(host, "*.")
+
+		if !validate.RxDomainNameRFC1123.MatchString(domain) ||
+			(domain != host && !strings.ContainsRune(domain, '.')) {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".host", "The provided host '%s' is invalid: it must be a host name or a '*.'-prefixed domain with at least two labels.", e.Host)
+		}
+
+		if e.Port < 0 || e.Port > 65535 {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".port", "The provided port '%d' is invalid.", e.Port)
+		}
+
+		key := GatewayAllowListEntry{Host: host, Port: e.Port}
+		if key.Port == 0 {
+			key.Port = 443
+		}
+		if seen[key] {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path, "The entry for host '%s' and port '%d' is duplicated.", e.Host, key.Port)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package admin
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"testing"
+
+	utilerror "github.com/Azure/ARO-RP/test/util/error"
+)
+
+func TestGatewayAllowListStaticValidate(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		modify  func(*GatewayAllowList)
+		wantErr string
+	}{
+		{
+			name: "valid",
+		},
+		{
+			name: "valid empty",
+			modify: func(l *GatewayAllowList) {
+				l.Entries = nil
+			},
+		},
+		{
+			name: "too many entries",
+			modify: func(l *GatewayAllowList) {
+				l.Entries = make([]GatewayAllowListEntry, maxGatewayAllowListEntries+1)
+			},
+			wantErr: "400: InvalidParameter: entries: The allow list may contain at most 50 entries.",
+		},
+		{
+			name: "empty host",
+			modify: func(l *GatewayAllowList) {
+				l.Entries[0].Host = ""
+			},
+			wantErr: "400: InvalidParameter: entries[0].host: The provided host '' is invalid: it must be a host name or a '*.'-prefixed domain with at least two labels.",
+		},
+		{
+			name: "invalid host",
+			modify: func(l *GatewayAllowList) {
+				l.Entries[0].Host = "api.example.com:443"
+			},
+			wantErr: "400: InvalidParameter: entries[0].host: The provided host 'api.example.com:443' is invalid: it must be a host name or a '*.'-prefixed domain with at least two labels.",
+		},
+		{
+			name: "wildcard top level domain",
+			modify: func(l *GatewayAllowList) {
+				l.Entries[1].Host = "*.com"
+			},
+			wantErr: "400: InvalidParameter: entries[1].host: The provided host '*.com' is invalid: it must be a host name or a '*.'-prefixed domain with at least two labels.",
+		},
+		{
+			name: "wildcard in the middle",
+			modify: func(l *GatewayAllowList) {
+				l.Entries[1].Host = "api.*.example.com"
+			},
+			wantErr: "400: InvalidParameter: entries[1].host: The provided host 'api.*.example.com' is invalid: it must be a host name or a '*.'-prefixed domain with at least two labels.",
+		},
+		{
+			name: "invalid port",
+			modify: func(l *GatewayAllowList) {
+				l.Entries[2].Port = 65536
+			},
+			wantErr: "400: InvalidParameter: entries[2].port: The provided port '65536' is invalid.",
+		},
+		{
+			name: "duplicate entry with default port",
+			modify: func(l *GatewayAllowList) {
+				l.Entries = append(l.Entries, GatewayAllowListEntry{Host: "API.example.com", Port: 443})
+			},
+			wantErr: "400: InvalidParameter: entries[3]: The entry for host 'API.example.com' and port '443' is duplicated.",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			l := &GatewayAllowList{
+				Entries: []GatewayAllowListEntry{
+					{Host: "api.example.com"},
+					{Host: "*.example.org"},
+					{Host: "registry.example.net", Port: 5000},
+				},
+			}
+			if tt.modify != nil {
+				tt.modify(l)
+			}
+
+			err := (&gatewayAllowListStaticValidator{}).Static(l)
+			utilerror.AssertErrorMessage(t, err, tt.wantErr)
+		})
+	}
+}
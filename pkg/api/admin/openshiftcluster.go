@@ -48,16 +48,19 @@ type OpenShiftClusterProperties struct {
 	// WorkerProfiles is used to store the worker profile data that was sent in the api request
 	WorkerProfiles []WorkerProfile `json:"workerProfiles,omitempty"`
 	// WorkerProfilesStatus is used to store the enriched worker profile data
-	WorkerProfilesStatus            []WorkerProfile   `json:"workerProfilesStatus,omitempty" swagger:"readOnly"`
-	APIServerProfile                APIServerProfile  `json:"apiserverProfile,omitempty"`
-	IngressProfiles                 []IngressProfile  `json:"ingressProfiles,omitempty"`
-	Install                         *Install          `json:"install,omitempty"`
-	StorageSuffix                   string            `json:"storageSuffix,omitempty"`
-	RegistryProfiles                []RegistryProfile `json:"registryProfiles,omitempty"`
-	ImageRegistryStorageAccountName string            `json:"imageRegistryStorageAccountName,omitempty"`
-	InfraID                         string            `json:"infraId,omitempty"`
-	HiveProfile                     HiveProfile       `json:"hiveProfile,omitempty"`
-	MaintenanceState                MaintenanceState  `json:"maintenanceState,omitempty"`
+	WorkerProfilesStatus            []WorkerProfile         `json:"workerProfilesStatus,omitempty" swagger:"readOnly"`
+	APIServerProfile                APIServerProfile        `json:"apiserverProfile,omitempty"`
+	IngressProfiles                 []IngressProfile        `json:"ingressProfiles,omitempty"`
+	Install                         *Install                `json:"install,omitempty"`
+	Upgrade                         *Upgrade                `json:"upgrade,omitempty"`
+	SubscriptionSuspension          *SubscriptionSuspension `json:"subscriptionSuspension,omitempty"`
+	CertificateRenewal              *CertificateRenewal     `json:"certificateRenewal,omitempty"`
+	StorageSuffix                   string                  `json:"storageSuffix,omitempty"`
+	RegistryProfiles                []RegistryProfile       `json:"registryProfiles,omitempty"`
+	ImageRegistryStorageAccountName string                  `json:"imageRegistryStorageAccountName,omitempty"`
+	InfraID                         string                  `json:"infraId,omitempty"`
+	HiveProfile                     HiveProfile             `json:"hiveProfile,omitempty"`
+	MaintenanceState                MaintenanceState        `json:"maintenanceState,omitempty"`
 }
 
 // ProvisioningState represents a provisioning state.
@@ -105,6 +108,19 @@ const (
 	MaintenanceTaskOperator   MaintenanceTask = "OperatorUpdate"
 	MaintenanceTaskRenewCerts MaintenanceTask = "CertificatesRenewal"
 
+	// Upgrade is set by the upgrade admin action and can't be requested
+	// through PATCH, as it needs a desired version
+	MaintenanceTaskUpgrade MaintenanceTask = "Upgrade"
+
+	// SubscriptionSuspend and SubscriptionResume are set by the subscription
+	// backend and can't be requested through PATCH
+	MaintenanceTaskSubscriptionSuspend MaintenanceTask = "SubscriptionSuspend"
+	MaintenanceTaskSubscriptionResume  MaintenanceTask = "SubscriptionResume"
+
+	// HiveShardMove is set by the hiveshard admin action and can't be
+	// requested through PATCH, as it needs a target shard
+	MaintenanceTaskHiveShardMove MaintenanceTask = "HiveShardMove"
+
 	//
 	// Maintenance tasks for updating customer maintenance signals
 	//
@@ -415,6 +431,57 @@ type Install struct {
 	Phase InstallPhase `json:"phase"`
 }
 
+// Upgrade represents an admin-driven OpenShift upgrade of the cluster.
+type Upgrade struct {
+	DesiredVersion string       `json:"desiredVersion,omitempty"`
+	Phase          UpgradePhase `json:"phase,omitempty"`
+	Message        string       `json:"message,omitempty"`
+	StartedAt      time.Time    `json:"startedAt,omitempty"`
+}
+
+// UpgradePhase represents an upgrade phase.
+type UpgradePhase string
+
+// UpgradePhase constants.
+const (
+	UpgradePhasePending   UpgradePhase = "Pending"
+	UpgradePhaseUpgrading UpgradePhase = "Upgrading"
+	UpgradePhasePaused    UpgradePhase = "Paused"
+	UpgradePhaseCompleted UpgradePhase = "Completed"
+)
+
+// SubscriptionSuspension records what was stopped when the cluster's
+// subscription was suspended.
+type SubscriptionSuspension struct {
+	State          SubscriptionSuspensionState `json:"state,omitempty"`
+	DeallocatedVMs []string                    `json:"deallocatedVMs,omitempty"`
+	BillingStopped bool                        `json:"billingStopped,omitempty"`
+	SuspendedAt    *time.Time                  `json:"suspendedAt,omitempty"`
+	ResumedAt      *time.Time                  `json:"resumedAt,omitempty"`
+}
+
+// CertificateRenewal records when the certificates which the RP renews are
+// next due for renewal.
+type CertificateRenewal struct {
+	InventoryTime    *time.Time `json:"inventoryTime,omitempty"`
+	DueTime          *time.Time `json:"dueTime,omitempty"`
+	LastStartTime    *time.Time `json:"lastStartTime,omitempty"`
+	LastStartDueTime *time.Time `json:"lastStartDueTime,omitempty"`
+	Attempts         int        `json:"attempts,omitempty"`
+}
+
+// SubscriptionSuspensionState represents the state of a subscription
+// suspension.
+type SubscriptionSuspensionState string
+
+// SubscriptionSuspensionState constants.
+const (
+	SubscriptionSuspensionStateSuspending SubscriptionSuspensionState = "Suspending"
+	SubscriptionSuspensionStateSuspended  SubscriptionSuspensionState = "Suspended"
+	SubscriptionSuspensionStateResuming   SubscriptionSuspensionState = "Resuming"
+	SubscriptionSuspensionStateResumed    SubscriptionSuspensionState = "Resumed"
+)
+
 // InstallPhase represents an install phase.
 type InstallPhase int
 
@@ -466,4 +533,12 @@ type HiveProfile struct {
 	// of clusters that were created by Hive to avoid deleting existing
 	// ClusterDeployments.
 	CreatedByHive bool `json:"createdByHive,omitempty"`
+
+	// ShardIndex is the Hive (AKS) shard the cluster is assigned to. It is
+	// read-only here; use the hiveshard admin action to move a cluster.
+	ShardIndex int `json:"shardIndex,omitempty"`
+
+	// TargetShardIndex is the Hive shard the cluster is being moved to. It
+	// is read-only.
+	TargetShardIndex int `json:"targetShardIndex,omitempty"`
 }
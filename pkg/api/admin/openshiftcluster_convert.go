@@ -165,6 +165,35 @@ func (c openShiftClusterConverter) ToExternal(oc *api.OpenShiftCluster) interfac
 		}
 	}
 
+	if oc.Properties.Upgrade != nil {
+		out.Properties.Upgrade = &Upgrade{
+			DesiredVersion: oc.Properties.Upgrade.DesiredVersion,
+			Phase:          UpgradePhase(oc.Properties.Upgrade.Phase),
+			Message:        oc.Properties.Upgrade.Message,
+			StartedAt:      oc.Properties.Upgrade.StartedAt,
+		}
+	}
+
+	if oc.Properties.SubscriptionSuspension != nil {
+		out.Properties.SubscriptionSuspension = &SubscriptionSuspension{
+			State:          SubscriptionSuspensionState(oc.Properties.SubscriptionSuspension.State),
+			DeallocatedVMs: append([]string(nil), oc.Properties.SubscriptionSuspension.DeallocatedVMs...),
+			BillingStopped: oc.Properties.SubscriptionSuspension.BillingStopped,
+			SuspendedAt:    oc.Properties.SubscriptionSuspension.SuspendedAt,
+			ResumedAt:      oc.Properties.SubscriptionSuspension.ResumedAt,
+		}
+	}
+
+	if oc.Properties.CertificateRenewal != nil {
+		out.Properties.CertificateRenewal = &CertificateRenewal{
+			InventoryTime:    oc.Properties.CertificateRenewal.InventoryTime,
+			DueTime:          oc.Properties.CertificateRenewal.DueTime,
+			LastStartTime:    oc.Properties.CertificateRenewal.LastStartTime,
+			LastStartDueTime: oc.Properties.CertificateRenewal.LastStartDueTime,
+			Attempts:         oc.Properties.CertificateRenewal.Attempts,
+		}
+	}
+
 	if oc.Tags != nil {
 		out.Tags = make(map[string]string, len(oc.Tags))
 		for k, v := range oc.Tags {
@@ -181,8 +210,10 @@ func (c openShiftClusterConverter) ToExternal(oc *api.OpenShiftCluster) interfac
 	}
 
 	out.Properties.HiveProfile = HiveProfile{
-		Namespace:     oc.Properties.HiveProfile.Namespace,
-		CreatedByHive: oc.Properties.HiveProfile.CreatedByHive,
+		Namespace:        oc.Properties.HiveProfile.Namespace,
+		CreatedByHive:    oc.Properties.HiveProfile.CreatedByHive,
+		ShardIndex:       oc.Properties.HiveProfile.ShardIndex,
+		TargetShardIndex: oc.Properties.HiveProfile.TargetShardIndex,
 	}
 
 	return out
@@ -346,6 +377,38 @@ func (c openShiftClusterConverter) ToInternal(_oc interface{}, out *api.OpenShif
 		}
 	}
 
+	out.Properties.Upgrade = nil
+	if oc.Properties.Upgrade != nil {
+		out.Properties.Upgrade = &api.Upgrade{
+			DesiredVersion: oc.Properties.Upgrade.DesiredVersion,
+			Phase:          api.UpgradePhase(oc.Properties.Upgrade.Phase),
+			Message:        oc.Properties.Upgrade.Message,
+			StartedAt:      oc.Properties.Upgrade.StartedAt,
+		}
+	}
+
+	out.Properties.SubscriptionSuspension = nil
+	if oc.Properties.SubscriptionSuspension != nil {
+		out.Properties.SubscriptionSuspension = &api.SubscriptionSuspension{
+			State:          api.SubscriptionSuspensionState(oc.Properties.SubscriptionSuspension.State),
+			DeallocatedVMs: append([]string(nil), oc.Properties.SubscriptionSuspension.DeallocatedVMs...),
+			BillingStopped: oc.Properties.SubscriptionSuspension.BillingStopped,
+			SuspendedAt:    oc.Properties.SubscriptionSuspension.SuspendedAt,
+			ResumedAt:      oc.Properties.SubscriptionSuspension.ResumedAt,
+		}
+	}
+
+	out.Properties.CertificateRenewal = nil
+	if oc.Properties.CertificateRenewal != nil {
+		out.Properties.CertificateRenewal = &api.CertificateRenewal{
+			InventoryTime:    oc.Properties.CertificateRenewal.InventoryTime,
+			DueTime:          oc.Properties.CertificateRenewal.DueTime,
+			LastStartTime:    oc.Properties.CertificateRenewal.LastStartTime,
+			LastStartDueTime: oc.Properties.CertificateRenewal.LastStartDueTime,
+			Attempts:         oc.Properties.CertificateRenewal.Attempts,
+		}
+	}
+
 	// out.Properties.RegistryProfiles is not converted. The field is immutable and does not have to be converted.
 	// Other fields are converted and this breaks the pattern, however this converting this field creates an issue
 	// with filling the out.Properties.RegistryProfiles[i].Password as default is "" which erases the original value.
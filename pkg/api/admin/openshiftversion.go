@@ -3,6 +3,10 @@ package admin
 // Copyright (c) Microsoft Corporation.
 // Licensed under the Apache License 2.0.
 
+import (
+	"time"
+)
+
 // OpenShiftVersionList represents a list of OpenShift versions that can be
 // installed.
 type OpenShiftVersionList struct {
@@ -27,4 +31,35 @@ type OpenShiftVersionProperties struct {
 	OpenShiftPullspec string `json:"openShiftPullspec,omitempty" mutable:"true"`
 	InstallerPullspec string `json:"installerPullspec,omitempty" mutable:"true"`
 	Enabled           bool   `json:"enabled" mutable:"true"`
+
+	// Channel is the update channel the version was released on, e.g.
+	// stable-4.13.
+	Channel          string                         `json:"channel,omitempty" mutable:"true"`
+	GADate           *time.Time                     `json:"gaDate,omitempty" mutable:"true"`
+	EndOfSupportDate *time.Time                     `json:"endOfSupportDate,omitempty" mutable:"true"`
+	LifecycleState   OpenShiftVersionLifecycleState `json:"lifecycleState,omitempty" mutable:"true"`
+
+	// UpgradeOnly versions may be upgraded to but not installed.
+	UpgradeOnly bool `json:"upgradeOnly,omitempty" mutable:"true"`
+}
+
+// OpenShiftVersionLifecycleState represents the lifecycle state of an
+// OpenShift version.
+type OpenShiftVersionLifecycleState string
+
+// OpenShiftVersionLifecycleState constants.
+const (
+	OpenShiftVersionLifecycleStateSupported  OpenShiftVersionLifecycleState = "Supported"
+	OpenShiftVersionLifecycleStateDeprecated OpenShiftVersionLifecycleState = "Deprecated"
+)
+
+// OpenShiftVersionBundle is a signed export of the OpenShift version
+// catalogue, used to sync the catalogue between regions.
+type OpenShiftVersionBundle struct {
+	ExportedAt        time.Time           `json:"exportedAt"`
+	OpenShiftVersions []*OpenShiftVersion `json:"value"`
+
+	// Signature is the base64 encoded HMAC-SHA256 of the bundle with an empty
+	// signature.
+	Signature string `json:"signature,omitempty"`
 }
@@ -21,9 +21,21 @@ func (openShiftVersionConverter) ToExternal(v *api.OpenShiftVersion) interface{}
 			OpenShiftPullspec: v.Properties.OpenShiftPullspec,
 			InstallerPullspec: v.Properties.InstallerPullspec,
 			Enabled:           v.Properties.Enabled,
+			Channel:           v.Properties.Channel,
+			LifecycleState:    OpenShiftVersionLifecycleState(v.Properties.LifecycleState),
+			UpgradeOnly:       v.Properties.UpgradeOnly,
 		},
 	}
 
+	if v.Properties.GADate != nil {
+		t := *v.Properties.GADate
+		out.Properties.GADate = &t
+	}
+	if v.Properties.EndOfSupportDate != nil {
+		t := *v.Properties.EndOfSupportDate
+		out.Properties.EndOfSupportDate = &t
+	}
+
 	return out
 }
 
@@ -52,4 +64,18 @@ func (c openShiftVersionConverter) ToInternal(_new interface{}, out *api.OpenShi
 	out.Properties.InstallerPullspec = new.Properties.InstallerPullspec
 	out.Properties.OpenShiftPullspec = new.Properties.OpenShiftPullspec
 	out.Properties.Version = new.Properties.Version
+	out.Properties.Channel = new.Properties.Channel
+	out.Properties.LifecycleState = api.OpenShiftVersionLifecycleState(new.Properties.LifecycleState)
+	out.Properties.UpgradeOnly = new.Properties.UpgradeOnly
+
+	out.Properties.GADate = nil
+	if new.Properties.GADate != nil {
+		t := *new.Properties.GADate
+		out.Properties.GADate = &t
+	}
+	out.Properties.EndOfSupportDate = nil
+	if new.Properties.EndOfSupportDate != nil {
+		t := *new.Properties.EndOfSupportDate
+		out.Properties.EndOfSupportDate = &t
+	}
 }
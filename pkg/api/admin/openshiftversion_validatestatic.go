@@ -5,11 +5,14 @@ package admin
 
 import (
 	"net/http"
+	"regexp"
 
 	"github.com/Azure/ARO-RP/pkg/api"
 	"github.com/Azure/ARO-RP/pkg/api/util/immutable"
 )
 
+var rxChannel = regexp.MustCompile(`^(stable|fast|candidate|eus)-[0-9]+\.[0-9]+$`)
+
 type openShiftVersionStaticValidator struct{}
 
 // Validate validates an OpenShift cluster
@@ -45,6 +48,22 @@ func (sv openShiftVersionStaticValidator) validate(new *OpenShiftVersion, isCrea
 	if new.Properties.OpenShiftPullspec == "" {
 		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "properties.openShiftPullspec", "Must be provided")
 	}
+
+	if new.Properties.Channel != "" && !rxChannel.MatchString(new.Properties.Channel) {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "properties.channel", "The provided channel '%s' is invalid.", new.Properties.Channel)
+	}
+
+	switch new.Properties.LifecycleState {
+	case "", OpenShiftVersionLifecycleStateSupported, OpenShiftVersionLifecycleStateDeprecated:
+	default:
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "properties.lifecycleState", "The provided lifecycle state '%s' is invalid.", new.Properties.LifecycleState)
+	}
+
+	if new.Properties.GADate != nil && new.Properties.EndOfSupportDate != nil &&
+		!new.Properties.EndOfSupportDate.After(*new.Properties.GADate) {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "properties.endOfSupportDate", "The end of support date must be after the GA date.")
+	}
+
 	return nil
 }
 
@@ -0,0 +1,91 @@
+package admin
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	utilerror "github.com/Azure/ARO-RP/test/util/error"
+)
+
+func TestOpenShiftVersionStaticValidate(t *testing.T) {
+	gaDate := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	for _, tt := range []struct {
+		name    string
+		modify  func(*OpenShiftVersion)
+		current *api.OpenShiftVersion
+		wantErr string
+	}{
+		{
+			name: "valid",
+		},
+		{
+			name: "valid lifecycle",
+			modify: func(v *OpenShiftVersion) {
+				eos := gaDate.AddDate(1, 0, 0)
+				v.Properties.Channel = "eus-4.12"
+				v.Properties.GADate = &gaDate
+				v.Properties.EndOfSupportDate = &eos
+				v.Properties.LifecycleState = OpenShiftVersionLifecycleStateDeprecated
+				v.Properties.UpgradeOnly = true
+			},
+		},
+		{
+			name: "invalid channel",
+			modify: func(v *OpenShiftVersion) {
+				v.Properties.Channel = "latest"
+			},
+			wantErr: "400: InvalidParameter: properties.channel: The provided channel 'latest' is invalid.",
+		},
+		{
+			name: "invalid lifecycle state",
+			modify: func(v *OpenShiftVersion) {
+				v.Properties.LifecycleState = "Retired"
+			},
+			wantErr: "400: InvalidParameter: properties.lifecycleState: The provided lifecycle state 'Retired' is invalid.",
+		},
+		{
+			name: "end of support before GA",
+			modify: func(v *OpenShiftVersion) {
+				eos := gaDate.AddDate(0, -1, 0)
+				v.Properties.GADate = &gaDate
+				v.Properties.EndOfSupportDate = &eos
+			},
+			wantErr: "400: InvalidParameter: properties.endOfSupportDate: The end of support date must be after the GA date.",
+		},
+		{
+			name: "lifecycle is mutable",
+			modify: func(v *OpenShiftVersion) {
+				v.Properties.LifecycleState = OpenShiftVersionLifecycleStateDeprecated
+			},
+			current: &api.OpenShiftVersion{
+				Properties: api.OpenShiftVersionProperties{
+					Version:           "4.12.25",
+					OpenShiftPullspec: "a:a/b",
+					InstallerPullspec: "b:b/c",
+					GADate:            &gaDate,
+				},
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			v := &OpenShiftVersion{
+				Properties: OpenShiftVersionProperties{
+					Version:           "4.12.25",
+					OpenShiftPullspec: "a:a/b",
+					InstallerPullspec: "b:b/c",
+				},
+			}
+			if tt.modify != nil {
+				tt.modify(v)
+			}
+
+			err := (&openShiftVersionStaticValidator{}).Static(v, tt.current)
+			utilerror.AssertErrorMessage(t, err, tt.wantErr)
+		})
+	}
+}
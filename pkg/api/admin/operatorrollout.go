@@ -0,0 +1,98 @@
+package admin
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"time"
+)
+
+// OperatorRolloutList represents a list of operator rollouts.
+type OperatorRolloutList struct {
+	OperatorRollouts []*OperatorRollout `json:"value"`
+}
+
+// OperatorRollout represents a staged rollout of the ARO operator to the
+// clusters of the region.
+type OperatorRollout struct {
+	// The ID for the resource.
+	ID string `json:"id,omitempty"`
+
+	// The properties for the OperatorRollout resource.
+	Properties OperatorRolloutProperties `json:"properties,omitempty"`
+}
+
+// OperatorRolloutProperties represents the properties of an OperatorRollout.
+type OperatorRolloutProperties struct {
+	// Version is the operator version rolled out.  It is set to the version
+	// of the RP which the rollout is started on.
+	Version string `json:"version,omitempty"`
+
+	State      OperatorRolloutState `json:"state,omitempty"`
+	HaltReason string               `json:"haltReason,omitempty"`
+
+	// MaxDegradedPercent is the percentage of updated clusters which may be
+	// degraded by the update before the rollout is halted.  Defaults to 10.
+	MaxDegradedPercent *int `json:"maxDegradedPercent,omitempty"`
+
+	CurrentWave int                   `json:"currentWave"`
+	Waves       []OperatorRolloutWave `json:"waves,omitempty"`
+
+	CreatedAt      time.Time `json:"createdAt,omitempty"`
+	LastUpdateTime time.Time `json:"lastUpdateTime,omitempty"`
+}
+
+// OperatorRolloutState represents the state of an operator rollout.
+type OperatorRolloutState string
+
+// OperatorRolloutState constants.
+const (
+	OperatorRolloutStateProgressing OperatorRolloutState = "Progressing"
+	OperatorRolloutStateHalted      OperatorRolloutState = "Halted"
+	OperatorRolloutStateCompleted   OperatorRolloutState = "Completed"
+	OperatorRolloutStateCancelled   OperatorRolloutState = "Cancelled"
+)
+
+// OperatorRolloutWave represents a set of clusters updated together.  A wave
+// sets either Subscriptions, to update the clusters of canary subscriptions,
+// or Percentage, to update further clusters until that percentage of the
+// clusters of the region has been updated.
+type OperatorRolloutWave struct {
+	Name          string   `json:"name,omitempty"`
+	Subscriptions []string `json:"subscriptions,omitempty"`
+	Percentage    int      `json:"percentage,omitempty"`
+
+	State    OperatorRolloutWaveState `json:"state,omitempty"`
+	Clusters []OperatorRolloutCluster `json:"clusters,omitempty"`
+}
+
+// OperatorRolloutWaveState represents the state of an operator rollout wave.
+type OperatorRolloutWaveState string
+
+// OperatorRolloutWaveState constants.
+const (
+	OperatorRolloutWaveStatePending     OperatorRolloutWaveState = "Pending"
+	OperatorRolloutWaveStateProgressing OperatorRolloutWaveState = "Progressing"
+	OperatorRolloutWaveStateSucceeded   OperatorRolloutWaveState = "Succeeded"
+)
+
+// OperatorRolloutCluster represents the operator update of a cluster.
+type OperatorRolloutCluster struct {
+	ID    string                      `json:"id,omitempty"`
+	State OperatorRolloutClusterState `json:"state,omitempty"`
+	Error string                      `json:"error,omitempty"`
+}
+
+// OperatorRolloutClusterState represents the state of the operator update of
+// a cluster.
+type OperatorRolloutClusterState string
+
+// OperatorRolloutClusterState constants.
+const (
+	OperatorRolloutClusterStatePending   OperatorRolloutClusterState = "Pending"
+	OperatorRolloutClusterStateStarting  OperatorRolloutClusterState = "Starting"
+	OperatorRolloutClusterStateUpdating  OperatorRolloutClusterState = "Updating"
+	OperatorRolloutClusterStateSucceeded OperatorRolloutClusterState = "Succeeded"
+	OperatorRolloutClusterStateDegraded  OperatorRolloutClusterState = "Degraded"
+	OperatorRolloutClusterStateSkipped   OperatorRolloutClusterState = "Skipped"
+)
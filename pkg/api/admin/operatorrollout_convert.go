@@ -0,0 +1,104 @@
+package admin
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"github.com/Azure/ARO-RP/pkg/api"
+)
+
+const defaultMaxDegradedPercent = 10
+
+type operatorRolloutConverter struct{}
+
+// operatorRolloutConverter.ToExternal returns a new external representation
+// of the internal object, reading from the subset of the internal object's
+// fields that appear in the external representation.  ToExternal does not
+// modify its argument; there is no pointer aliasing between the passed and
+// returned objects.
+func (operatorRolloutConverter) ToExternal(r *api.OperatorRollout) interface{} {
+	maxDegradedPercent := r.Properties.MaxDegradedPercent
+
+	out := &OperatorRollout{
+		ID: r.ID,
+		Properties: OperatorRolloutProperties{
+			Version:            r.Properties.Version,
+			State:              OperatorRolloutState(r.Properties.State),
+			HaltReason:         r.Properties.HaltReason,
+			MaxDegradedPercent: &maxDegradedPercent,
+			CurrentWave:        r.Properties.CurrentWave,
+			Waves:              make([]OperatorRolloutWave, 0, len(r.Properties.Waves)),
+			CreatedAt:          r.Properties.CreatedAt,
+			LastUpdateTime:     r.Properties.LastUpdateTime,
+		},
+	}
+
+	for _, w := range r.Properties.Waves {
+		wave := OperatorRolloutWave{
+			Name:       w.Name,
+			Percentage: w.Percentage,
+			State:      OperatorRolloutWaveState(w.State),
+		}
+
+		if w.Subscriptions != nil {
+			wave.Subscriptions = append([]string{}, w.Subscriptions...)
+		}
+
+		if w.Clusters != nil {
+			wave.Clusters = make([]OperatorRolloutCluster, 0, len(w.Clusters))
+			for _, c := range w.Clusters {
+				wave.Clusters = append(wave.Clusters, OperatorRolloutCluster{
+					ID:    c.Key,
+					State: OperatorRolloutClusterState(c.State),
+					Error: c.Error,
+				})
+			}
+		}
+
+		out.Properties.Waves = append(out.Properties.Waves, wave)
+	}
+
+	return out
+}
+
+// ToExternalList returns a slice of external representations of the internal
+// objects
+func (c operatorRolloutConverter) ToExternalList(rollouts []*api.OperatorRollout) interface{} {
+	l := &OperatorRolloutList{
+		OperatorRollouts: make([]*OperatorRollout, 0, len(rollouts)),
+	}
+
+	for _, rollout := range rollouts {
+		l.OperatorRollouts = append(l.OperatorRollouts, c.ToExternal(rollout).(*OperatorRollout))
+	}
+
+	return l
+}
+
+// ToInternal overwrites in place a pre-existing internal object, setting (only)
+// the fields which may be set when a rollout is started.  ToInternal modifies
+// its argument; there is no pointer aliasing between the passed and returned
+// objects
+func (c operatorRolloutConverter) ToInternal(_new interface{}, out *api.OperatorRollout) {
+	new := _new.(*OperatorRollout)
+
+	out.Properties.MaxDegradedPercent = defaultMaxDegradedPercent
+	if new.Properties.MaxDegradedPercent != nil {
+		out.Properties.MaxDegradedPercent = *new.Properties.MaxDegradedPercent
+	}
+
+	out.Properties.Waves = make([]api.OperatorRolloutWave, 0, len(new.Properties.Waves))
+	for _, w := range new.Properties.Waves {
+		wave := api.OperatorRolloutWave{
+			Name:       w.Name,
+			Percentage: w.Percentage,
+			State:      api.OperatorRolloutWaveStatePending,
+		}
+
+		if w.Subscriptions != nil {
+			wave.Subscriptions = append([]string{}, w.Subscriptions...)
+		}
+
+		out.Properties.Waves = append(out.Properties.Waves, wave)
+	}
+}
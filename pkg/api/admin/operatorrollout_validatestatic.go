@@ -0,0 +1,58 @@
+package admin
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/util/uuid"
+)
+
+type operatorRolloutStaticValidator struct{}
+
+// Static validates a new operator rollout.  Rollouts are not updated through
+// PUT, so there is no delta to validate.
+func (sv operatorRolloutStaticValidator) Static(_new interface{}) error {
+	new := _new.(*OperatorRollout)
+
+	if new.Properties.MaxDegradedPercent != nil &&
+		(*new.Properties.MaxDegradedPercent < 0 || *new.Properties.MaxDegradedPercent > 100) {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "properties.maxDegradedPercent", "The provided maximum degraded percentage '%d' is invalid: it must be between 0 and 100.", *new.Properties.MaxDegradedPercent)
+	}
+
+	if len(new.Properties.Waves) == 0 {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "properties.waves", "Must be provided")
+	}
+
+	var percentage int
+	for i, w := range new.Properties.Waves {
+		path := fmt.Sprintf("properties.waves[%d]", i)
+
+		switch {
+		case len(w.Subscriptions) > 0 && w.Percentage != 0:
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path, "A wave must set either subscriptions or percentage, not both.")
+
+		case len(w.Subscriptions) > 0:
+			for j, subscriptionID := range w.Subscriptions {
+				if !uuid.IsValid(subscriptionID) {
+					return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, fmt.Sprintf("%s.subscriptions[%d]", path, j), "The provided subscription '%s' is invalid.", subscriptionID)
+				}
+			}
+
+		case w.Percentage <= percentage || w.Percentage > 100:
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".percentage", "The provided percentage '%d' is invalid: percentages must increase from wave to wave and be at most 100.", w.Percentage)
+
+		default:
+			percentage = w.Percentage
+		}
+	}
+
+	if percentage != 100 {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "properties.waves", "The last percentage wave must update 100%% of the clusters.")
+	}
+
+	return nil
+}
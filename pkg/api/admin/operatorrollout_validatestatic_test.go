@@ -0,0 +1,90 @@
+package admin
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest/to"
+
+	utilerror "github.com/Azure/ARO-RP/test/util/error"
+)
+
+func TestOperatorRolloutStaticValidate(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		modify  func(*OperatorRollout)
+		wantErr string
+	}{
+		{
+			name: "valid",
+		},
+		{
+			name: "valid without canary",
+			modify: func(r *OperatorRollout) {
+				r.Properties.Waves = []OperatorRolloutWave{{Percentage: 100}}
+			},
+		},
+		{
+			name: "invalid maxDegradedPercent",
+			modify: func(r *OperatorRollout) {
+				r.Properties.MaxDegradedPercent = to.IntPtr(101)
+			},
+			wantErr: "400: InvalidParameter: properties.maxDegradedPercent: The provided maximum degraded percentage '101' is invalid: it must be between 0 and 100.",
+		},
+		{
+			name: "no waves",
+			modify: func(r *OperatorRollout) {
+				r.Properties.Waves = nil
+			},
+			wantErr: "400: InvalidParameter: properties.waves: Must be provided",
+		},
+		{
+			name: "subscriptions and percentage",
+			modify: func(r *OperatorRollout) {
+				r.Properties.Waves[0].Percentage = 5
+			},
+			wantErr: "400: InvalidParameter: properties.waves[0]: A wave must set either subscriptions or percentage, not both.",
+		},
+		{
+			name: "invalid subscription",
+			modify: func(r *OperatorRollout) {
+				r.Properties.Waves[0].Subscriptions = []string{"invalid"}
+			},
+			wantErr: "400: InvalidParameter: properties.waves[0].subscriptions[0]: The provided subscription 'invalid' is invalid.",
+		},
+		{
+			name: "decreasing percentage",
+			modify: func(r *OperatorRollout) {
+				r.Properties.Waves[2].Percentage = 10
+			},
+			wantErr: "400: InvalidParameter: properties.waves[2].percentage: The provided percentage '10' is invalid: percentages must increase from wave to wave and be at most 100.",
+		},
+		{
+			name: "incomplete rollout",
+			modify: func(r *OperatorRollout) {
+				r.Properties.Waves = r.Properties.Waves[:2]
+			},
+			wantErr: "400: InvalidParameter: properties.waves: The last percentage wave must update 100% of the clusters.",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &OperatorRollout{
+				Properties: OperatorRolloutProperties{
+					Waves: []OperatorRolloutWave{
+						{Name: "canary", Subscriptions: []string{"00000000-0000-0000-0000-000000000000"}},
+						{Name: "early", Percentage: 10},
+						{Name: "rest", Percentage: 100},
+					},
+				},
+			}
+			if tt.modify != nil {
+				tt.modify(r)
+			}
+
+			err := (&operatorRolloutStaticValidator{}).Static(r)
+			utilerror.AssertErrorMessage(t, err, tt.wantErr)
+		})
+	}
+}
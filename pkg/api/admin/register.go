@@ -16,5 +16,10 @@ func init() {
 		OpenShiftClusterStaticValidator: openShiftClusterStaticValidator{},
 		OpenShiftVersionConverter:       openShiftVersionConverter{},
 		OpenShiftVersionStaticValidator: openShiftVersionStaticValidator{},
+		OperatorRolloutConverter:        operatorRolloutConverter{},
+		OperatorRolloutStaticValidator:  operatorRolloutStaticValidator{},
+		GatewayAllowListConverter:       gatewayAllowListConverter{},
+		GatewayAllowListStaticValidator: gatewayAllowListStaticValidator{},
+		CertificateInventoryConverter:   certificateInventoryConverter{},
 	}
 }
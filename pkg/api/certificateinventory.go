@@ -0,0 +1,61 @@
+package api
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"time"
+)
+
+// CertificateInventory lists the certificates of a cluster and how each of
+// them is renewed
+type CertificateInventory struct {
+	Certificates []Certificate
+}
+
+// Certificate represents a certificate held in a cluster secret
+type Certificate struct {
+	Kind CertificateKind
+
+	Namespace  string
+	SecretName string
+	Subject    string
+	NotAfter   time.Time
+
+	RenewalPolicy CertificateRenewalPolicy
+
+	// RenewAfter is when the certificate is due for renewal; it is only set
+	// for the RP and Manual renewal policies
+	RenewAfter *time.Time
+}
+
+// CertificateKind represents the purpose of a certificate
+type CertificateKind string
+
+// CertificateKind constants
+const (
+	CertificateKindAPIServer           CertificateKind = "APIServer"
+	CertificateKindEtcd                CertificateKind = "Etcd"
+	CertificateKindIngress             CertificateKind = "Ingress"
+	CertificateKindKubeletServing      CertificateKind = "KubeletServing"
+	CertificateKindMachineConfigServer CertificateKind = "MachineConfigServer"
+	CertificateKindMDSD                CertificateKind = "MDSD"
+)
+
+// CertificateRenewalPolicy represents who renews a certificate
+type CertificateRenewalPolicy string
+
+// CertificateRenewalPolicy constants
+const (
+	// CertificateRenewalPolicyRP: renewed by the CertificatesRenewal admin
+	// update, which the backend starts once RenewAfter has passed
+	CertificateRenewalPolicyRP CertificateRenewalPolicy = "RP"
+	// CertificateRenewalPolicyCluster: rotated in the cluster by OpenShift or
+	// the ARO operator
+	CertificateRenewalPolicyCluster CertificateRenewalPolicy = "Cluster"
+	// CertificateRenewalPolicyCustomer: provided and renewed by the customer
+	CertificateRenewalPolicyCustomer CertificateRenewalPolicy = "Customer"
+	// CertificateRenewalPolicyManual: renewed by an SRE through an admin
+	// action once RenewAfter has passed
+	CertificateRenewalPolicyManual CertificateRenewalPolicy = "Manual"
+)
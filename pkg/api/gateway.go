@@ -12,4 +12,18 @@ type Gateway struct {
 
 	StorageSuffix                   string `json:"storageSuffix,omitempty"`
 	ImageRegistryStorageAccountName string `json:"imageRegistryStorageAccountName,omitempty"`
+
+	// AllowList holds the destinations which the cluster may reach through the
+	// gateway in addition to those allowed for all clusters
+	AllowList []GatewayAllowListEntry `json:"allowList,omitempty"`
+}
+
+// GatewayAllowListEntry represents a destination allowed through the gateway.
+// Host is either an exact host name or, if prefixed with "*.", matches any
+// subdomain of the given domain.  Port defaults to 443 when unset.
+type GatewayAllowListEntry struct {
+	MissingFields
+
+	Host string `json:"host,omitempty"`
+	Port int    `json:"port,omitempty"`
 }
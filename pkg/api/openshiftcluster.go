@@ -132,6 +132,23 @@ type OpenShiftClusterProperties struct {
 	// WorkerProfilesStatus is used to store the enriched worker profile data
 	WorkerProfilesStatus []WorkerProfile `json:"workerProfilesStatus,omitempty" swagger:"readOnly"`
 
+	// WorkerProfilesUpdating is set when a customer update changes the size
+	// or count of WorkerProfiles and cleared by the backend once the worker
+	// machinesets have been scaled or resized to match
+	WorkerProfilesUpdating bool `json:"workerProfilesUpdating,omitempty"`
+
+	// AutoscalerProfile is the customer requested cluster autoscaler
+	// configuration, reconciled on the cluster by the ARO operator
+	AutoscalerProfile *AutoscalerProfile `json:"autoscalerProfile,omitempty"`
+
+	// AutoscalerProfileStatus is used to store the enriched autoscaler status
+	AutoscalerProfileStatus *AutoscalerProfileStatus `json:"autoscalerProfileStatus,omitempty" swagger:"readOnly"`
+
+	// CertificateProfile is the customer provided API server and ingress
+	// certificate configuration, kept installed on the cluster by the ARO
+	// operator
+	CertificateProfile *CertificateProfile `json:"certificateProfile,omitempty"`
+
 	APIServerProfile APIServerProfile `json:"apiserverProfile,omitempty"`
 
 	IngressProfiles []IngressProfile `json:"ingressProfiles,omitempty"`
@@ -139,6 +156,16 @@ type OpenShiftClusterProperties struct {
 	// Install is non-nil only when an install is in progress
 	Install *Install `json:"install,omitempty"`
 
+	Upgrade *Upgrade `json:"upgrade,omitempty"`
+
+	// SubscriptionSuspension is non-nil once the cluster has been suspended
+	// because its subscription was suspended
+	SubscriptionSuspension *SubscriptionSuspension `json:"subscriptionSuspension,omitempty"`
+
+	// CertificateRenewal is set by the certificate inventory taken at the
+	// end of installs and admin updates
+	CertificateRenewal *CertificateRenewal `json:"certificateRenewal,omitempty"`
+
 	StorageSuffix                   string `json:"storageSuffix,omitempty"`
 	ImageRegistryStorageAccountName string `json:"imageRegistryStorageAccountName,omitempty"`
 
@@ -203,6 +230,21 @@ const (
 	MaintenanceTaskOperator   MaintenanceTask = "OperatorUpdate"
 	MaintenanceTaskRenewCerts MaintenanceTask = "CertificatesRenewal"
 
+	// Upgrade drives an OpenShift upgrade to properties.upgrade.desiredVersion.
+	// It is only set through the admin upgrade action.
+	MaintenanceTaskUpgrade MaintenanceTask = "Upgrade"
+
+	// SubscriptionSuspend and SubscriptionResume stop and restart the cluster
+	// when its subscription is suspended and registered again.  They are
+	// only set by the subscription backend.
+	MaintenanceTaskSubscriptionSuspend MaintenanceTask = "SubscriptionSuspend"
+	MaintenanceTaskSubscriptionResume  MaintenanceTask = "SubscriptionResume"
+
+	// HiveShardMove moves the Hive resources of the cluster to
+	// properties.hiveProfile.targetShardIndex.  It is only set through the
+	// admin hiveshard action.
+	MaintenanceTaskHiveShardMove MaintenanceTask = "HiveShardMove"
+
 	//
 	// Maintenance tasks for updating customer maintenance signals
 	//
@@ -223,6 +265,10 @@ func (t MaintenanceTask) IsMaintenanceOngoingTask() bool {
 	result := (t == MaintenanceTaskEverything) ||
 		(t == MaintenanceTaskOperator) ||
 		(t == MaintenanceTaskRenewCerts) ||
+		(t == MaintenanceTaskUpgrade) ||
+		(t == MaintenanceTaskSubscriptionSuspend) ||
+		(t == MaintenanceTaskSubscriptionResume) ||
+		(t == MaintenanceTaskHiveShardMove) ||
 		(t == "")
 	return result
 }
@@ -685,6 +731,107 @@ func GetEnrichedWorkerProfiles(ocp OpenShiftClusterProperties) ([]WorkerProfile,
 	return ocp.WorkerProfiles, "workerProfiles"
 }
 
+// AutoscalerProfile represents the cluster autoscaler configuration
+type AutoscalerProfile struct {
+	MissingFields
+
+	ScaleDown      *AutoscalerScaleDown      `json:"scaleDown,omitempty"`
+	WorkerProfiles []AutoscalerWorkerProfile `json:"workerProfiles,omitempty"`
+}
+
+// ScaleDownState represents whether the cluster autoscaler removes unneeded
+// nodes
+type ScaleDownState string
+
+// ScaleDownState constants
+const (
+	ScaleDownStateEnabled  ScaleDownState = "Enabled"
+	ScaleDownStateDisabled ScaleDownState = "Disabled"
+)
+
+// AutoscalerScaleDown represents the scale down settings of the cluster
+// autoscaler.  Durations are Go duration strings, e.g. 10m
+type AutoscalerScaleDown struct {
+	MissingFields
+
+	State                ScaleDownState `json:"state,omitempty"`
+	DelayAfterAdd        string         `json:"delayAfterAdd,omitempty"`
+	UnneededTime         string         `json:"unneededTime,omitempty"`
+	UtilizationThreshold string         `json:"utilizationThreshold,omitempty"`
+}
+
+// AutoscalerWorkerProfile represents the autoscaling limits of a worker
+// profile.  Name refers to a worker profile in WorkerProfilesStatus, i.e. a
+// worker machineset
+type AutoscalerWorkerProfile struct {
+	MissingFields
+
+	Name     string `json:"name,omitempty"`
+	MinCount int    `json:"minCount,omitempty"`
+	MaxCount int    `json:"maxCount,omitempty"`
+}
+
+// AutoscalerState represents the state of the autoscaler configuration on the
+// cluster
+type AutoscalerState string
+
+// AutoscalerState constants
+const (
+	AutoscalerStateSucceeded AutoscalerState = "Succeeded"
+	AutoscalerStateFailed    AutoscalerState = "Failed"
+)
+
+// AutoscalerProfileStatus represents the status of the autoscaler
+// configuration as reported by the ARO operator
+type AutoscalerProfileStatus struct {
+	MissingFields
+
+	State   AutoscalerState `json:"state,omitempty"`
+	Message string          `json:"message,omitempty"`
+}
+
+// CertificateProfile represents the customer provided certificates of a
+// cluster with a custom domain.  Certificates which are not set are not
+// managed by ARO.
+type CertificateProfile struct {
+	MissingFields
+
+	APIServer *CertificateSource `json:"apiServer,omitempty"`
+	Ingress   *CertificateSource `json:"ingress,omitempty"`
+}
+
+// CertificateSourceType represents where a customer provided certificate
+// comes from
+type CertificateSourceType string
+
+// CertificateSourceType constants
+const (
+	CertificateSourceTypeKeyVault CertificateSourceType = "KeyVault"
+	CertificateSourceTypeACME     CertificateSourceType = "ACME"
+)
+
+// CertificateSource represents a customer provided certificate.  For the
+// KeyVault type, KeyVaultSecretID references a secret in the customer's key
+// vault holding the certificate and key; for the ACME type, the certificate is
+// issued by ACME using DNS-01 challenges in the customer's DNS zone.
+type CertificateSource struct {
+	MissingFields
+
+	Type             CertificateSourceType `json:"type,omitempty"`
+	KeyVaultSecretID string                `json:"keyVaultSecretId,omitempty"`
+	ACME             *ACMEIssuer           `json:"acme,omitempty"`
+}
+
+// ACMEIssuer represents an ACME issuer.  DNSZoneID is the resource ID of the
+// Azure DNS zone of the cluster domain.
+type ACMEIssuer struct {
+	MissingFields
+
+	DirectoryURL string `json:"directoryUrl,omitempty"`
+	Email        string `json:"email,omitempty"`
+	DNSZoneID    string `json:"dnsZoneId,omitempty"`
+}
+
 // APIServerProfile represents an API server profile
 type APIServerProfile struct {
 	MissingFields
@@ -730,6 +877,110 @@ type Install struct {
 	Phase InstallPhase `json:"phase"`
 }
 
+// Upgrade represents an admin-driven OpenShift upgrade of the cluster
+type Upgrade struct {
+	MissingFields
+
+	DesiredVersion string       `json:"desiredVersion,omitempty"`
+	Phase          UpgradePhase `json:"phase,omitempty"`
+
+	// Message describes the progress of the upgrade, or why it was paused
+	Message string `json:"message,omitempty"`
+
+	StartedAt time.Time `json:"startedAt,omitempty"`
+}
+
+// UpgradePhase represents an upgrade phase
+type UpgradePhase string
+
+// UpgradePhase constants
+const (
+	// UpgradePhasePending: the upgrade was requested and is waiting for the
+	// backend to run the pre-upgrade health gates
+	UpgradePhasePending UpgradePhase = "Pending"
+	// UpgradePhaseUpgrading: the ClusterVersion desired update has been set
+	UpgradePhaseUpgrading UpgradePhase = "Upgrading"
+	// UpgradePhasePaused: a health gate failed and the upgrade was not
+	// started or progressed; a new upgrade request resumes it
+	UpgradePhasePaused UpgradePhase = "Paused"
+	// UpgradePhaseCompleted: the cluster reports the desired version as
+	// completed
+	UpgradePhaseCompleted UpgradePhase = "Completed"
+)
+
+// SubscriptionSuspension records what the RP stopped when the cluster's
+// subscription was suspended, so that it can be restored when the
+// subscription is registered again
+type SubscriptionSuspension struct {
+	MissingFields
+
+	State SubscriptionSuspensionState `json:"state,omitempty"`
+
+	// DeallocatedVMs are the VMs which were running and were deallocated
+	// by the suspension; only these are started again on resume
+	DeallocatedVMs []string `json:"deallocatedVMs,omitempty"`
+
+	// BillingStopped is true if the billing record was marked as deleted by
+	// the suspension
+	BillingStopped bool `json:"billingStopped,omitempty"`
+
+	SuspendedAt *time.Time `json:"suspendedAt,omitempty"`
+	ResumedAt   *time.Time `json:"resumedAt,omitempty"`
+}
+
+// IsSuspended returns true if the cluster VMs are, or are being, deallocated
+// by a suspension which has not been resumed
+func (s *SubscriptionSuspension) IsSuspended() bool {
+	return s != nil && (s.State == SubscriptionSuspensionStateSuspending || s.State == SubscriptionSuspensionStateSuspended)
+}
+
+// CertificateRenewal records when the certificates which the RP renews are
+// next due for renewal.  The backend starts a CertificatesRenewal admin update
+// once DueTime has passed.
+type CertificateRenewal struct {
+	MissingFields
+
+	// InventoryTime is when the certificates of the cluster were last
+	// inventoried
+	InventoryTime *time.Time `json:"inventoryTime,omitempty"`
+
+	// DueTime is the earliest RenewAfter of the certificates with the RP
+	// renewal policy, or nil if there are none
+	DueTime *time.Time `json:"dueTime,omitempty"`
+
+	// LastStartTime is when the backend last started a CertificatesRenewal
+	// admin update
+	LastStartTime *time.Time `json:"lastStartTime,omitempty"`
+
+	// LastStartDueTime is the DueTime when the backend last started a
+	// CertificatesRenewal admin update.  A successful renewal moves DueTime.
+	LastStartDueTime *time.Time `json:"lastStartDueTime,omitempty"`
+
+	// Attempts is the number of CertificatesRenewal admin updates started
+	// for LastStartDueTime
+	Attempts int `json:"attempts,omitempty"`
+}
+
+// SubscriptionSuspensionState represents the state of a subscription
+// suspension
+type SubscriptionSuspensionState string
+
+// SubscriptionSuspensionState constants
+const (
+	// SubscriptionSuspensionStateSuspending: VMs are being deallocated and
+	// billing stopped
+	SubscriptionSuspensionStateSuspending SubscriptionSuspensionState = "Suspending"
+	// SubscriptionSuspensionStateSuspended: the VMs are deallocated and
+	// billing is stopped
+	SubscriptionSuspensionStateSuspended SubscriptionSuspensionState = "Suspended"
+	// SubscriptionSuspensionStateResuming: the deallocated VMs are being
+	// started and the cluster is waited on to become healthy
+	SubscriptionSuspensionStateResuming SubscriptionSuspensionState = "Resuming"
+	// SubscriptionSuspensionStateResumed: the cluster is healthy again and
+	// billing has resumed
+	SubscriptionSuspensionStateResumed SubscriptionSuspensionState = "Resumed"
+)
+
 // InstallPhase represents an install phase
 type InstallPhase int
 
@@ -760,4 +1011,13 @@ type HiveProfile struct {
 	// of clusters that were created by Hive to avoid deleting existing
 	// ClusterDeployments.
 	CreatedByHive bool `json:"createdByHive,omitempty"`
+
+	// ShardIndex is the Hive (AKS) shard the cluster is assigned to. Clusters
+	// which were placed before sharding was introduced have a zero value and
+	// are treated as being on the default shard.
+	ShardIndex int `json:"shardIndex,omitempty"`
+
+	// TargetShardIndex is the Hive shard the cluster is being moved to by a
+	// HiveShardMove admin update
+	TargetShardIndex int `json:"targetShardIndex,omitempty"`
 }
@@ -42,6 +42,15 @@ type OpenShiftClusterDocument struct {
 
 	AsyncOperationID string `json:"asyncOperationId,omitempty" deep:"-"`
 
+	// BillingID is the ID of the current billing document of the cluster
+	// once it was resumed after a subscription suspension; until then the
+	// billing document has the ID of the cluster document
+	BillingID string `json:"billingId,omitempty"`
+
+	// OperatorRolloutID is the ID of the operator rollout which last started
+	// an operator update of the cluster
+	OperatorRolloutID string `json:"operatorRolloutId,omitempty"`
+
 	OpenShiftCluster *OpenShiftCluster `json:"openShiftCluster,omitempty"`
 
 	CorrelationData *CorrelationData `json:"correlationData,omitempty" deep:"-"`
@@ -50,3 +59,12 @@ type OpenShiftClusterDocument struct {
 func (c *OpenShiftClusterDocument) String() string {
 	return encodeJSON(c)
 }
+
+// BillingDocumentID returns the ID of the current billing document of the
+// cluster
+func (c *OpenShiftClusterDocument) BillingDocumentID() string {
+	if c.BillingID != "" {
+		return c.BillingID
+	}
+	return c.ID
+}
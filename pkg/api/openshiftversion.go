@@ -3,6 +3,10 @@ package api
 // Copyright (c) Microsoft Corporation.
 // Licensed under the Apache License 2.0.
 
+import (
+	"time"
+)
+
 // OpenShiftVersion represents an OpenShift version that can be installed
 type OpenShiftVersion struct {
 	MissingFields
@@ -16,6 +20,17 @@ type OpenShiftVersion struct {
 	Properties OpenShiftVersionProperties `json:"properties,omitempty"`
 }
 
+// OpenShiftVersionLifecycleState represents the lifecycle state of an
+// OpenShift version.
+type OpenShiftVersionLifecycleState string
+
+// OpenShiftVersionLifecycleState constants.  An empty lifecycle state is
+// treated as OpenShiftVersionLifecycleStateSupported.
+const (
+	OpenShiftVersionLifecycleStateSupported  OpenShiftVersionLifecycleState = "Supported"
+	OpenShiftVersionLifecycleStateDeprecated OpenShiftVersionLifecycleState = "Deprecated"
+)
+
 // OpenShiftVersionProperties represents the properties of an OpenShiftVersion.
 type OpenShiftVersionProperties struct {
 	MissingFields
@@ -26,4 +41,34 @@ type OpenShiftVersionProperties struct {
 	InstallerPullspec string `json:"installerPullspec,omitempty"`
 	Enabled           bool   `json:"enabled,omitempty"`
 	Default           bool   `json:"default,omitempty"`
+
+	// Channel is the update channel the version was released on, e.g.
+	// stable-4.13.
+	Channel          string                         `json:"channel,omitempty"`
+	GADate           *time.Time                     `json:"gaDate,omitempty"`
+	EndOfSupportDate *time.Time                     `json:"endOfSupportDate,omitempty"`
+	LifecycleState   OpenShiftVersionLifecycleState `json:"lifecycleState,omitempty"`
+
+	// UpgradeOnly versions may be upgraded to but not installed.
+	UpgradeOnly bool `json:"upgradeOnly,omitempty"`
+}
+
+// IsInstallable returns true if new clusters may be installed at the version
+// at the given time.  It does not consider whether the version is enabled.
+func (v *OpenShiftVersion) IsInstallable(now time.Time) bool {
+	p := &v.Properties
+
+	if p.UpgradeOnly || p.LifecycleState == OpenShiftVersionLifecycleStateDeprecated {
+		return false
+	}
+
+	if p.GADate != nil && now.Before(*p.GADate) {
+		return false
+	}
+
+	if p.EndOfSupportDate != nil && !now.Before(*p.EndOfSupportDate) {
+		return false
+	}
+
+	return true
 }
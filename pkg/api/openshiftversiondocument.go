@@ -3,6 +3,10 @@ package api
 // Copyright (c) Microsoft Corporation.
 // Licensed under the Apache License 2.0.
 
+import (
+	"time"
+)
+
 // OpenShiftVersionDocuments represents OpenShift version specification documents.
 // pkg/database/cosmosdb requires its definition.
 type OpenShiftVersionDocuments struct {
@@ -30,6 +34,10 @@ type OpenShiftVersionDocument struct {
 	LSN         int                    `json:"_lsn,omitempty"`
 	Metadata    map[string]interface{} `json:"_metadata,omitempty"`
 
+	// BundleExportedAt is the export time of the bundle which last imported
+	// this version, and is used to reject bundles older than the catalogue
+	BundleExportedAt *time.Time `json:"bundleExportedAt,omitempty"`
+
 	OpenShiftVersion *OpenShiftVersion `json:"openShiftVersion,omitempty"`
 }
 
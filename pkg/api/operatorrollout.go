@@ -0,0 +1,121 @@
+package api
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"time"
+)
+
+// OperatorRollout represents a staged rollout of the ARO operator to the
+// clusters of the region.  The rollout updates the operator in waves, each
+// wave waiting for the clusters of the previous one to be updated and healthy.
+type OperatorRollout struct {
+	MissingFields
+
+	ID string `json:"id,omitempty"`
+
+	// The properties for the OperatorRollout resource.
+	Properties OperatorRolloutProperties `json:"properties,omitempty"`
+}
+
+// OperatorRolloutState represents the state of an operator rollout.
+type OperatorRolloutState string
+
+// OperatorRolloutState constants.
+const (
+	OperatorRolloutStateProgressing OperatorRolloutState = "Progressing"
+	OperatorRolloutStateHalted      OperatorRolloutState = "Halted"
+	OperatorRolloutStateCompleted   OperatorRolloutState = "Completed"
+	OperatorRolloutStateCancelled   OperatorRolloutState = "Cancelled"
+)
+
+// IsTerminal returns true if the rollout will make no further progress.
+func (s OperatorRolloutState) IsTerminal() bool {
+	return s == OperatorRolloutStateCompleted || s == OperatorRolloutStateCancelled
+}
+
+// OperatorRolloutProperties represents the properties of an OperatorRollout.
+type OperatorRolloutProperties struct {
+	MissingFields
+
+	// Version is the operator version rolled out.  It is the version of the RP
+	// which the rollout was started on.
+	Version string `json:"version,omitempty"`
+
+	State OperatorRolloutState `json:"state,omitempty"`
+
+	// HaltReason explains why a halted rollout was halted.
+	HaltReason string `json:"haltReason,omitempty"`
+
+	// MaxDegradedPercent is the percentage of updated clusters which may be
+	// degraded by the update before the rollout is halted.
+	MaxDegradedPercent int `json:"maxDegradedPercent,omitempty"`
+
+	// CurrentWave is the index of the wave being rolled out.
+	CurrentWave int `json:"currentWave,omitempty"`
+
+	Waves []OperatorRolloutWave `json:"waves,omitempty"`
+
+	CreatedAt      time.Time `json:"createdAt,omitempty"`
+	LastUpdateTime time.Time `json:"lastUpdateTime,omitempty"`
+}
+
+// OperatorRolloutWaveState represents the state of an operator rollout wave.
+type OperatorRolloutWaveState string
+
+// OperatorRolloutWaveState constants.
+const (
+	OperatorRolloutWaveStatePending     OperatorRolloutWaveState = "Pending"
+	OperatorRolloutWaveStateProgressing OperatorRolloutWaveState = "Progressing"
+	OperatorRolloutWaveStateSucceeded   OperatorRolloutWaveState = "Succeeded"
+)
+
+// OperatorRolloutWave represents a set of clusters updated together.  A wave
+// selects either the clusters of its canary subscriptions, or enough further
+// clusters for Percentage percent of the clusters of the region to have been
+// selected by the rollout.
+type OperatorRolloutWave struct {
+	MissingFields
+
+	Name          string   `json:"name,omitempty"`
+	Subscriptions []string `json:"subscriptions,omitempty"`
+	Percentage    int      `json:"percentage,omitempty"`
+
+	State    OperatorRolloutWaveState `json:"state,omitempty"`
+	Clusters []OperatorRolloutCluster `json:"clusters,omitempty"`
+}
+
+// OperatorRolloutClusterState represents the state of the operator update of
+// a cluster.
+type OperatorRolloutClusterState string
+
+// OperatorRolloutClusterState constants.
+const (
+	OperatorRolloutClusterStatePending   OperatorRolloutClusterState = "Pending"
+	OperatorRolloutClusterStateStarting  OperatorRolloutClusterState = "Starting"
+	OperatorRolloutClusterStateUpdating  OperatorRolloutClusterState = "Updating"
+	OperatorRolloutClusterStateSucceeded OperatorRolloutClusterState = "Succeeded"
+	OperatorRolloutClusterStateDegraded  OperatorRolloutClusterState = "Degraded"
+	OperatorRolloutClusterStateSkipped   OperatorRolloutClusterState = "Skipped"
+)
+
+// IsTerminal returns true if the operator update of the cluster is over.
+func (s OperatorRolloutClusterState) IsTerminal() bool {
+	return s == OperatorRolloutClusterStateSucceeded ||
+		s == OperatorRolloutClusterStateDegraded ||
+		s == OperatorRolloutClusterStateSkipped
+}
+
+// OperatorRolloutCluster represents the operator update of a cluster.
+type OperatorRolloutCluster struct {
+	MissingFields
+
+	// Key is the key of the cluster document.
+	Key   string                      `json:"key,omitempty"`
+	State OperatorRolloutClusterState `json:"state,omitempty"`
+	Error string                      `json:"error,omitempty"`
+
+	// StartTime is when the cluster was selected to start its update.
+	StartTime *time.Time `json:"startTime,omitempty"`
+}
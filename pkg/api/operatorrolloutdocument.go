@@ -0,0 +1,38 @@
+package api
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+// OperatorRolloutDocuments represents operator rollout documents.
+// pkg/database/cosmosdb requires its definition.
+type OperatorRolloutDocuments struct {
+	Count                    int                        `json:"_count,omitempty"`
+	ResourceID               string                     `json:"_rid,omitempty"`
+	OperatorRolloutDocuments []*OperatorRolloutDocument `json:"Documents,omitempty"`
+}
+
+func (c *OperatorRolloutDocuments) String() string {
+	return encodeJSON(c)
+}
+
+// OperatorRolloutDocument represents an operator rollout document.
+// pkg/database/cosmosdb requires its definition.
+type OperatorRolloutDocument struct {
+	MissingFields
+
+	ID          string                 `json:"id,omitempty"`
+	ResourceID  string                 `json:"_rid,omitempty"`
+	Timestamp   int                    `json:"_ts,omitempty"`
+	Self        string                 `json:"_self,omitempty"`
+	ETag        string                 `json:"_etag,omitempty" deep:"-"`
+	Attachments string                 `json:"_attachments,omitempty"`
+	TTL         int                    `json:"ttl,omitempty"`
+	LSN         int                    `json:"_lsn,omitempty"`
+	Metadata    map[string]interface{} `json:"_metadata,omitempty"`
+
+	OperatorRollout *OperatorRollout `json:"operatorRollout,omitempty"`
+}
+
+func (c *OperatorRolloutDocument) String() string {
+	return encodeJSON(c)
+}
@@ -3,6 +3,8 @@ package api
 // Copyright (c) Microsoft Corporation.
 // Licensed under the Apache License 2.0.
 
+import "time"
+
 // Portal represents a portal
 type Portal struct {
 	MissingFields
@@ -12,8 +14,9 @@ type Portal struct {
 	// ID is the resourceID of the cluster being accessed by the SRE
 	ID string `json:"id,omitempty"`
 
-	SSH        *SSH        `json:"ssh,omitempty"`
-	Kubeconfig *Kubeconfig `json:"kubeconfig,omitempty"`
+	SSH            *SSH            `json:"ssh,omitempty"`
+	Kubeconfig     *Kubeconfig     `json:"kubeconfig,omitempty"`
+	ElevatedAccess *ElevatedAccess `json:"elevatedAccess,omitempty"`
 }
 
 type SSH struct {
@@ -21,10 +24,57 @@ type SSH struct {
 
 	Master        int  `json:"master"`
 	Authenticated bool `json:"authenticated,omitempty"`
+
+	// ElevatedAccessID is the ID of the elevated access grant under which the
+	// SSH session was created
+	ElevatedAccessID string `json:"elevatedAccessId,omitempty"`
 }
 
 type Kubeconfig struct {
 	MissingFields
 
 	Elevated bool `json:"elevated,omitempty"`
+
+	// ElevatedAccessID is the ID of the elevated access grant under which the
+	// elevated kubeconfig was created
+	ElevatedAccessID string `json:"elevatedAccessId,omitempty"`
+}
+
+// ElevatedAccessState represents the state of an elevated access grant
+type ElevatedAccessState string
+
+// ElevatedAccessState constants
+const (
+	ElevatedAccessStateRequested ElevatedAccessState = "Requested"
+	ElevatedAccessStateApproved  ElevatedAccessState = "Approved"
+	ElevatedAccessStateDenied    ElevatedAccessState = "Denied"
+	ElevatedAccessStateRevoked   ElevatedAccessState = "Revoked"
+)
+
+// ElevatedAccess is a just-in-time grant of elevated access to a cluster.  It
+// is requested by Portal.Username and must be approved by somebody else.
+type ElevatedAccess struct {
+	MissingFields
+
+	Justification string              `json:"justification,omitempty"`
+	IncidentID    string              `json:"incidentId,omitempty"`
+	Duration      int                 `json:"duration,omitempty"` // minutes
+	State         ElevatedAccessState `json:"state,omitempty"`
+
+	RequestedAt time.Time `json:"requestedAt,omitempty"`
+
+	// DecidedBy and DecidedAt record who approved or denied the request
+	DecidedBy string     `json:"decidedBy,omitempty"`
+	DecidedAt *time.Time `json:"decidedAt,omitempty"`
+
+	// ExpiresAt is set when the request is approved
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	RevokedBy string     `json:"revokedBy,omitempty"`
+	RevokedAt *time.Time `json:"revokedAt,omitempty"`
+}
+
+// IsActive returns true if the grant is approved and has not expired
+func (e *ElevatedAccess) IsActive(now time.Time) bool {
+	return e.State == ElevatedAccessStateApproved && e.ExpiresAt != nil && now.Before(*e.ExpiresAt)
 }
@@ -37,6 +37,29 @@ type OpenShiftVersionStaticValidator interface {
 	Static(interface{}, *OpenShiftVersion) error
 }
 
+type OperatorRolloutConverter interface {
+	ToExternal(*OperatorRollout) interface{}
+	ToExternalList([]*OperatorRollout) interface{}
+	ToInternal(interface{}, *OperatorRollout)
+}
+
+type OperatorRolloutStaticValidator interface {
+	Static(interface{}) error
+}
+
+type GatewayAllowListConverter interface {
+	ToExternal(*Gateway) interface{}
+	ToInternal(interface{}, *Gateway)
+}
+
+type GatewayAllowListStaticValidator interface {
+	Static(interface{}) error
+}
+
+type CertificateInventoryConverter interface {
+	ToExternal(*CertificateInventory) interface{}
+}
+
 type SyncSetConverter interface {
 	ToExternal(*SyncSet) interface{}
 	ToExternalList([]*SyncSet) interface{}
@@ -69,6 +92,11 @@ type Version struct {
 	OpenShiftClusterAdminKubeconfigConverter OpenShiftClusterAdminKubeconfigConverter
 	OpenShiftVersionConverter                OpenShiftVersionConverter
 	OpenShiftVersionStaticValidator          OpenShiftVersionStaticValidator
+	OperatorRolloutConverter                 OperatorRolloutConverter
+	OperatorRolloutStaticValidator           OperatorRolloutStaticValidator
+	GatewayAllowListConverter                GatewayAllowListConverter
+	GatewayAllowListStaticValidator          GatewayAllowListStaticValidator
+	CertificateInventoryConverter            CertificateInventoryConverter
 	OperationList                            OperationList
 	SyncSetConverter                         SyncSetConverter
 	MachinePoolConverter                     MachinePoolConverter
@@ -35,6 +35,11 @@ type SubscriptionDocument struct {
 
 	Deleting bool `json:"deleting,omitempty"`
 
+	// LifecyclePending is set when the subscription is suspended or
+	// registered again, until the backend has suspended or resumed its
+	// clusters
+	LifecyclePending bool `json:"lifecyclePending,omitempty"`
+
 	Subscription *Subscription `json:"subscription,omitempty"`
 }
 
@@ -225,7 +225,7 @@ type WorkerProfile struct {
 	Name string `json:"name,omitempty"`
 
 	// The size of the worker VMs.
-	VMSize VMSize `json:"vmSize,omitempty"`
+	VMSize VMSize `json:"vmSize,omitempty" mutable:"true"`
 
 	// The disk size of the worker VMs.
 	DiskSizeGB int `json:"diskSizeGB,omitempty"`
@@ -234,7 +234,7 @@ type WorkerProfile struct {
 	SubnetID string `json:"subnetId,omitempty"`
 
 	// The number of worker VMs.
-	Count int `json:"count,omitempty"`
+	Count int `json:"count,omitempty" mutable:"true"`
 
 	// Whether master virtual machines are encrypted at host.
 	EncryptionAtHost EncryptionAtHost `json:"encryptionAtHost,omitempty"`
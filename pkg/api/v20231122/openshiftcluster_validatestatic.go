@@ -406,5 +406,19 @@ func (sv openShiftClusterStaticValidator) validateDelta(oc, current *OpenShiftCl
 		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodePropertyChangeNotAllowed, err.Target, err.Message)
 	}
 
+	// worker profiles can be scaled and resized after install; immutable has
+	// already checked that the profiles themselves haven't changed
+	for i, wp := range oc.Properties.WorkerProfiles {
+		path := "properties.workerProfiles['" + wp.Name + "']"
+		cwp := current.Properties.WorkerProfiles[i]
+
+		if wp.VMSize != cwp.VMSize && !validate.VMSizeIsValid(api.VMSize(wp.VMSize), sv.requireD2sV3Workers, false) {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".vmSize", "The provided worker VM size '%s' is invalid.", wp.VMSize)
+		}
+		if wp.Count != cwp.Count && (wp.Count < 2 || wp.Count > 50) {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, path+".count", "The provided worker count '%d' is invalid.", wp.Count)
+		}
+	}
+
 	return nil
 }
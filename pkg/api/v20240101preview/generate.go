@@ -0,0 +1,6 @@
+package v20240101preview
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+//go:generate go run ../../../hack/swagger github.com/Azure/ARO-RP/pkg/api/v20240101preview ../../../swagger/redhatopenshift/resource-manager/Microsoft.RedHatOpenShift/preview/2024-01-01-preview
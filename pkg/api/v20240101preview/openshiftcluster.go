@@ -0,0 +1,417 @@
+package v20240101preview
+
+import "time"
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+// OpenShiftClusterList represents a list of OpenShift clusters.
+type OpenShiftClusterList struct {
+	// The list of OpenShift clusters.
+	OpenShiftClusters []*OpenShiftCluster `json:"value"`
+
+	// The link used to get the next page of operations.
+	NextLink string `json:"nextLink,omitempty"`
+}
+
+// OpenShiftCluster represents an Azure Red Hat OpenShift cluster.
+type OpenShiftCluster struct {
+	// The resource ID.
+	ID string `json:"id,omitempty" mutable:"case"`
+
+	// The resource name.
+	Name string `json:"name,omitempty" mutable:"case"`
+
+	// The resource type.
+	Type string `json:"type,omitempty" mutable:"case"`
+
+	// The resource location.
+	Location string `json:"location,omitempty"`
+
+	// SystemData - The system metadata relating to this resource
+	SystemData *SystemData `json:"systemData,omitempty" swagger:"readOnly"`
+
+	// The resource tags.
+	Tags Tags `json:"tags,omitempty" mutable:"true"`
+
+	// The cluster properties.
+	Properties OpenShiftClusterProperties `json:"properties,omitempty"`
+}
+
+// Tags represents an OpenShift cluster's tags.
+type Tags map[string]string
+
+// OpenShiftClusterProperties represents an OpenShift cluster's properties.
+type OpenShiftClusterProperties struct {
+	// The cluster provisioning state.
+	ProvisioningState ProvisioningState `json:"provisioningState,omitempty"`
+
+	// The cluster profile.
+	ClusterProfile ClusterProfile `json:"clusterProfile,omitempty"`
+
+	// The console profile.
+	ConsoleProfile ConsoleProfile `json:"consoleProfile,omitempty"`
+
+	// The cluster service principal profile.
+	ServicePrincipalProfile ServicePrincipalProfile `json:"servicePrincipalProfile,omitempty"`
+
+	// The cluster network profile.
+	NetworkProfile NetworkProfile `json:"networkProfile,omitempty"`
+
+	// The cluster master profile.
+	MasterProfile MasterProfile `json:"masterProfile,omitempty"`
+
+	// The cluster worker profiles.
+	WorkerProfiles []WorkerProfile `json:"workerProfiles,omitempty"`
+
+	// The cluster worker profiles status.
+	WorkerProfilesStatus []WorkerProfile `json:"workerProfilesStatus,omitempty" swagger:"readOnly"`
+
+	// The cluster autoscaler profile.
+	AutoscalerProfile *AutoscalerProfile `json:"autoscalerProfile,omitempty" mutable:"true"`
+
+	// The cluster autoscaler profile status.
+	AutoscalerProfileStatus *AutoscalerProfileStatus `json:"autoscalerProfileStatus,omitempty" swagger:"readOnly"`
+
+	// The cluster certificate profile.
+	CertificateProfile *CertificateProfile `json:"certificateProfile,omitempty" mutable:"true"`
+
+	// The cluster API server profile.
+	APIServerProfile APIServerProfile `json:"apiserverProfile,omitempty"`
+
+	// The cluster ingress profiles.
+	IngressProfiles []IngressProfile `json:"ingressProfiles,omitempty"`
+}
+
+// ProvisioningState represents a provisioning state.
+type ProvisioningState string
+
+// ProvisioningState constants.
+// TODO: ProvisioningStateCancelled is included to pass upstream CI. It is currently unused in ARO.
+const (
+	ProvisioningStateCreating      ProvisioningState = "Creating"
+	ProvisioningStateUpdating      ProvisioningState = "Updating"
+	ProvisioningStateCancelled     ProvisioningState = "Cancelled"
+	ProvisioningStateAdminUpdating ProvisioningState = "AdminUpdating"
+	ProvisioningStateDeleting      ProvisioningState = "Deleting"
+	ProvisioningStateSucceeded     ProvisioningState = "Succeeded"
+	ProvisioningStateFailed        ProvisioningState = "Failed"
+)
+
+// FipsValidatedModules determines if FIPS is used.
+type FipsValidatedModules string
+
+// FipsValidatedModules constants.
+const (
+	FipsValidatedModulesEnabled  FipsValidatedModules = "Enabled"
+	FipsValidatedModulesDisabled FipsValidatedModules = "Disabled"
+)
+
+// ClusterProfile represents a cluster profile.
+type ClusterProfile struct {
+	// The pull secret for the cluster.
+	PullSecret string `json:"pullSecret,omitempty"`
+
+	// The domain for the cluster.
+	Domain string `json:"domain,omitempty"`
+
+	// The version of the cluster.
+	Version string `json:"version,omitempty"`
+
+	// The ID of the cluster resource group.
+	ResourceGroupID string `json:"resourceGroupId,omitempty"`
+
+	// If FIPS validated crypto modules are used
+	FipsValidatedModules FipsValidatedModules `json:"fipsValidatedModules,omitempty"`
+}
+
+// ConsoleProfile represents a console profile.
+type ConsoleProfile struct {
+	// The URL to access the cluster console.
+	URL string `json:"url,omitempty" swagger:"readOnly"`
+}
+
+// ServicePrincipalProfile represents a service principal profile.
+type ServicePrincipalProfile struct {
+	// The client ID used for the cluster.
+	ClientID string `json:"clientId,omitempty" mutable:"true"`
+
+	// The client secret used for the cluster.
+	ClientSecret string `json:"clientSecret,omitempty" mutable:"true"`
+}
+
+// The outbound routing strategy used to provide your cluster egress to the internet.
+type OutboundType string
+
+// OutboundType constants.
+const (
+	OutboundTypeUserDefinedRouting OutboundType = "UserDefinedRouting"
+	OutboundTypeLoadbalancer       OutboundType = "Loadbalancer"
+)
+
+// ResourceReference represents a reference to an Azure resource.
+type ResourceReference struct {
+	// The fully qualified Azure resource id of an IP address resource.
+	ID string `json:"id,omitempty"`
+}
+
+// LoadBalancerProfile represents the profile of the cluster public load balancer.
+type LoadBalancerProfile struct {
+	// The desired managed outbound IPs for the cluster public load balancer.
+	ManagedOutboundIPs *ManagedOutboundIPs `json:"managedOutboundIps,omitempty" mutable:"true"`
+	// The list of effective outbound IP addresses of the public load balancer.
+	EffectiveOutboundIPs []EffectiveOutboundIP `json:"effectiveOutboundIps,omitempty" swagger:"readOnly"`
+}
+
+// EffectiveOutboundIP represents an effective outbound IP resource of the cluster public load balancer.
+type EffectiveOutboundIP ResourceReference
+
+// ManagedOutboundIPs represents the desired managed outbound IPs for the cluster public load balancer.
+type ManagedOutboundIPs struct {
+	// Count represents the desired number of IPv4 outbound IPs created and managed by Azure for the cluster public load balancer.  Allowed values are in the range of 1 - 20.  The default value is 1.
+	Count int `json:"count,omitempty"`
+}
+
+// NetworkProfile represents a network profile.
+type NetworkProfile struct {
+	// The CIDR used for OpenShift/Kubernetes Pods.
+	PodCIDR string `json:"podCidr,omitempty"`
+
+	// The CIDR used for OpenShift/Kubernetes Services.
+	ServiceCIDR string `json:"serviceCidr,omitempty"`
+
+	// The OutboundType used for egress traffic.
+	OutboundType OutboundType `json:"outboundType,omitempty"`
+
+	// The cluster load balancer profile.
+	LoadBalancerProfile *LoadBalancerProfile `json:"loadBalancerProfile,omitempty"`
+
+	// Specifies whether subnets are pre-attached with an NSG
+	PreconfiguredNSG PreconfiguredNSG `json:"preconfiguredNSG,omitempty"`
+}
+
+// PreconfiguredNSG represents whether customers want to use their own NSG attached to the subnets
+type PreconfiguredNSG string
+
+// PreconfiguredNSG constants
+const (
+	PreconfiguredNSGEnabled  PreconfiguredNSG = "Enabled"
+	PreconfiguredNSGDisabled PreconfiguredNSG = "Disabled"
+)
+
+// EncryptionAtHost represents encryption at host state
+type EncryptionAtHost string
+
+// EncryptionAtHost constants
+const (
+	EncryptionAtHostEnabled  EncryptionAtHost = "Enabled"
+	EncryptionAtHostDisabled EncryptionAtHost = "Disabled"
+)
+
+// MasterProfile represents a master profile.
+type MasterProfile struct {
+	// The size of the master VMs.
+	VMSize VMSize `json:"vmSize,omitempty"`
+
+	// The Azure resource ID of the master subnet.
+	SubnetID string `json:"subnetId,omitempty"`
+
+	// Whether master virtual machines are encrypted at host.
+	EncryptionAtHost EncryptionAtHost `json:"encryptionAtHost,omitempty"`
+
+	// The resource ID of an associated DiskEncryptionSet, if applicable.
+	DiskEncryptionSetID string `json:"diskEncryptionSetId,omitempty"`
+}
+
+// VM size availability varies by region.
+// If a node contains insufficient compute resources (memory, cpu, etc.), pods might fail to run correctly.
+// For more details on restricted VM sizes, see: https://docs.microsoft.com/en-us/azure/openshift/support-policies-v4#supported-virtual-machine-sizes
+type VMSize string
+
+// WorkerProfile represents a worker profile.
+type WorkerProfile struct {
+	// The worker profile name.
+	Name string `json:"name,omitempty"`
+
+	// The size of the worker VMs.
+	VMSize VMSize `json:"vmSize,omitempty" mutable:"true"`
+
+	// The disk size of the worker VMs.
+	DiskSizeGB int `json:"diskSizeGB,omitempty"`
+
+	// The Azure resource ID of the worker subnet.
+	SubnetID string `json:"subnetId,omitempty"`
+
+	// The number of worker VMs.
+	Count int `json:"count,omitempty" mutable:"true"`
+
+	// Whether master virtual machines are encrypted at host.
+	EncryptionAtHost EncryptionAtHost `json:"encryptionAtHost,omitempty"`
+
+	// The resource ID of an associated DiskEncryptionSet, if applicable.
+	DiskEncryptionSetID string `json:"diskEncryptionSetId,omitempty"`
+}
+
+// AutoscalerProfile represents the cluster autoscaler configuration.
+type AutoscalerProfile struct {
+	// The scale down settings of the cluster autoscaler.
+	ScaleDown *AutoscalerScaleDown `json:"scaleDown,omitempty"`
+
+	// The worker profiles to autoscale.
+	WorkerProfiles []AutoscalerWorkerProfile `json:"workerProfiles,omitempty"`
+}
+
+// ScaleDownState represents whether the cluster autoscaler removes unneeded nodes.
+type ScaleDownState string
+
+// ScaleDownState constants.
+const (
+	ScaleDownStateEnabled  ScaleDownState = "Enabled"
+	ScaleDownStateDisabled ScaleDownState = "Disabled"
+)
+
+// AutoscalerScaleDown represents the scale down settings of the cluster autoscaler.
+type AutoscalerScaleDown struct {
+	// Whether the cluster autoscaler removes unneeded nodes.  The default value is Enabled.
+	State ScaleDownState `json:"state,omitempty"`
+
+	// How long after a scale up scale down evaluation resumes, e.g. 10m.
+	DelayAfterAdd string `json:"delayAfterAdd,omitempty"`
+
+	// How long a node must be unneeded before it is removed, e.g. 10m.
+	UnneededTime string `json:"unneededTime,omitempty"`
+
+	// The node utilization level, between 0 and 1, below which a node may be removed, e.g. 0.5.
+	UtilizationThreshold string `json:"utilizationThreshold,omitempty"`
+}
+
+// AutoscalerWorkerProfile represents the autoscaling limits of a worker profile.
+type AutoscalerWorkerProfile struct {
+	// The name of the worker profile, as listed in workerProfilesStatus.
+	Name string `json:"name,omitempty"`
+
+	// The minimum number of worker VMs.
+	MinCount int `json:"minCount,omitempty"`
+
+	// The maximum number of worker VMs.  Allowed values are in the range of 1 - 50.
+	MaxCount int `json:"maxCount,omitempty"`
+}
+
+// AutoscalerState represents the state of the autoscaler configuration on the cluster.
+type AutoscalerState string
+
+// AutoscalerState constants.
+const (
+	AutoscalerStateSucceeded AutoscalerState = "Succeeded"
+	AutoscalerStateFailed    AutoscalerState = "Failed"
+)
+
+// AutoscalerProfileStatus represents the status of the autoscaler configuration on the cluster.
+type AutoscalerProfileStatus struct {
+	// The state of the autoscaler configuration.
+	State AutoscalerState `json:"state,omitempty"`
+
+	// Details of the failure to apply the autoscaler configuration, if any.
+	Message string `json:"message,omitempty"`
+}
+
+// CertificateProfile represents the customer provided certificates of a cluster with a custom domain.
+type CertificateProfile struct {
+	// The certificate served by the API server.
+	APIServer *CertificateSource `json:"apiServer,omitempty"`
+
+	// The default certificate served by the ingress controller.
+	Ingress *CertificateSource `json:"ingress,omitempty"`
+}
+
+// CertificateSourceType represents where a customer provided certificate comes from.
+type CertificateSourceType string
+
+// CertificateSourceType constants.
+const (
+	CertificateSourceTypeKeyVault CertificateSourceType = "KeyVault"
+	CertificateSourceTypeACME     CertificateSourceType = "ACME"
+)
+
+// CertificateSource represents a customer provided certificate.
+type CertificateSource struct {
+	// Where the certificate comes from.
+	Type CertificateSourceType `json:"type,omitempty"`
+
+	// The ID of the key vault secret holding the certificate and private key, for the KeyVault type.  The secret may be versionless to follow renewals in the key vault.
+	KeyVaultSecretID string `json:"keyVaultSecretId,omitempty"`
+
+	// The ACME issuer, for the ACME type.
+	ACME *ACMEIssuer `json:"acme,omitempty"`
+}
+
+// ACMEIssuer represents an ACME issuer which validates the cluster domain with DNS-01 challenges.
+type ACMEIssuer struct {
+	// The ACME directory URL, e.g. https://acme-v02.api.letsencrypt.org/directory.
+	DirectoryURL string `json:"directoryUrl,omitempty"`
+
+	// The contact email address of the ACME account.
+	Email string `json:"email,omitempty"`
+
+	// The resource ID of the Azure DNS zone of the cluster domain, in which the challenge records are created.
+	DNSZoneID string `json:"dnsZoneId,omitempty"`
+}
+
+// APIServerProfile represents an API server profile.
+type APIServerProfile struct {
+	// API server visibility.
+	Visibility Visibility `json:"visibility,omitempty"`
+
+	// The URL to access the cluster API server.
+	URL string `json:"url,omitempty" swagger:"readOnly"`
+
+	// The IP of the cluster API server.
+	IP string `json:"ip,omitempty" swagger:"readOnly"`
+}
+
+// Visibility represents visibility.
+type Visibility string
+
+// Visibility constants
+const (
+	VisibilityPublic  Visibility = "Public"
+	VisibilityPrivate Visibility = "Private"
+)
+
+// IngressProfile represents an ingress profile.
+type IngressProfile struct {
+	// The ingress profile name.
+	Name string `json:"name,omitempty"`
+
+	// Ingress visibility.
+	Visibility Visibility `json:"visibility,omitempty"`
+
+	// The IP of the ingress.
+	IP string `json:"ip,omitempty" swagger:"readOnly"`
+}
+
+// CreatedByType by defines user type, which executed the request
+type CreatedByType string
+
+const (
+	CreatedByTypeApplication     CreatedByType = "Application"
+	CreatedByTypeKey             CreatedByType = "Key"
+	CreatedByTypeManagedIdentity CreatedByType = "ManagedIdentity"
+	CreatedByTypeUser            CreatedByType = "User"
+)
+
+// SystemData metadata pertaining to creation and last modification of the resource.
+type SystemData struct {
+	// The identity that created the resource.
+	CreatedBy string `json:"createdBy,omitempty"`
+	// The type of identity that created the resource. Possible values include: 'User', 'Application', 'ManagedIdentity', 'Key'
+	CreatedByType CreatedByType `json:"createdByType,omitempty"`
+	// The timestamp of resource creation (UTC).
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	// The identity that last modified the resource.
+	LastModifiedBy string `json:"lastModifiedBy,omitempty"`
+	// The type of identity that last modified the resource. Possible values include: 'User', 'Application', 'ManagedIdentity', 'Key'
+	LastModifiedByType CreatedByType `json:"lastModifiedByType,omitempty"`
+	// The type of identity that last modified the resource.
+	LastModifiedAt *time.Time `json:"lastModifiedAt,omitempty"`
+}
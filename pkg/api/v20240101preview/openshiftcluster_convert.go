@@ -0,0 +1,393 @@
+package v20240101preview
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"github.com/Azure/ARO-RP/pkg/api"
+)
+
+type openShiftClusterConverter struct{}
+
+// ToExternal returns a new external representation of the internal object,
+// reading from the subset of the internal object's fields that appear in the
+// external representation.  ToExternal does not modify its argument; there is
+// no pointer aliasing between the passed and returned objects
+func (c openShiftClusterConverter) ToExternal(oc *api.OpenShiftCluster) interface{} {
+	out := &OpenShiftCluster{
+		ID:       oc.ID,
+		Name:     oc.Name,
+		Type:     oc.Type,
+		Location: oc.Location,
+		Properties: OpenShiftClusterProperties{
+			ProvisioningState: ProvisioningState(oc.Properties.ProvisioningState),
+			ClusterProfile: ClusterProfile{
+				PullSecret:           string(oc.Properties.ClusterProfile.PullSecret),
+				Domain:               oc.Properties.ClusterProfile.Domain,
+				Version:              oc.Properties.ClusterProfile.Version,
+				ResourceGroupID:      oc.Properties.ClusterProfile.ResourceGroupID,
+				FipsValidatedModules: FipsValidatedModules(oc.Properties.ClusterProfile.FipsValidatedModules),
+			},
+			ConsoleProfile: ConsoleProfile{
+				URL: oc.Properties.ConsoleProfile.URL,
+			},
+			ServicePrincipalProfile: ServicePrincipalProfile{
+				ClientID:     oc.Properties.ServicePrincipalProfile.ClientID,
+				ClientSecret: string(oc.Properties.ServicePrincipalProfile.ClientSecret),
+			},
+			NetworkProfile: NetworkProfile{
+				PodCIDR:          oc.Properties.NetworkProfile.PodCIDR,
+				ServiceCIDR:      oc.Properties.NetworkProfile.ServiceCIDR,
+				OutboundType:     OutboundType(oc.Properties.NetworkProfile.OutboundType),
+				PreconfiguredNSG: PreconfiguredNSG(oc.Properties.NetworkProfile.PreconfiguredNSG),
+			},
+			MasterProfile: MasterProfile{
+				VMSize:              VMSize(oc.Properties.MasterProfile.VMSize),
+				SubnetID:            oc.Properties.MasterProfile.SubnetID,
+				EncryptionAtHost:    EncryptionAtHost(oc.Properties.MasterProfile.EncryptionAtHost),
+				DiskEncryptionSetID: oc.Properties.MasterProfile.DiskEncryptionSetID,
+			},
+			APIServerProfile: APIServerProfile{
+				Visibility: Visibility(oc.Properties.APIServerProfile.Visibility),
+				URL:        oc.Properties.APIServerProfile.URL,
+				IP:         oc.Properties.APIServerProfile.IP,
+			},
+		},
+	}
+
+	if oc.Properties.NetworkProfile.LoadBalancerProfile != nil {
+		out.Properties.NetworkProfile.LoadBalancerProfile = &LoadBalancerProfile{}
+
+		if oc.Properties.NetworkProfile.LoadBalancerProfile.ManagedOutboundIPs != nil {
+			out.Properties.NetworkProfile.LoadBalancerProfile.ManagedOutboundIPs = &ManagedOutboundIPs{
+				Count: oc.Properties.NetworkProfile.LoadBalancerProfile.ManagedOutboundIPs.Count,
+			}
+		}
+
+		if oc.Properties.NetworkProfile.LoadBalancerProfile.EffectiveOutboundIPs != nil {
+			out.Properties.NetworkProfile.LoadBalancerProfile.EffectiveOutboundIPs = make([]EffectiveOutboundIP, 0, len(oc.Properties.NetworkProfile.LoadBalancerProfile.EffectiveOutboundIPs))
+			for _, effectiveOutboundIP := range oc.Properties.NetworkProfile.LoadBalancerProfile.EffectiveOutboundIPs {
+				out.Properties.NetworkProfile.LoadBalancerProfile.EffectiveOutboundIPs = append(out.Properties.NetworkProfile.LoadBalancerProfile.EffectiveOutboundIPs, EffectiveOutboundIP{
+					ID: effectiveOutboundIP.ID,
+				})
+			}
+		}
+	}
+
+	if oc.Properties.WorkerProfiles != nil {
+		workerProfiles := oc.Properties.WorkerProfiles
+		out.Properties.WorkerProfiles = make([]WorkerProfile, 0, len(workerProfiles))
+		for _, p := range workerProfiles {
+			out.Properties.WorkerProfiles = append(out.Properties.WorkerProfiles, WorkerProfile{
+				Name:                p.Name,
+				VMSize:              VMSize(p.VMSize),
+				DiskSizeGB:          p.DiskSizeGB,
+				SubnetID:            p.SubnetID,
+				Count:               p.Count,
+				EncryptionAtHost:    EncryptionAtHost(p.EncryptionAtHost),
+				DiskEncryptionSetID: p.DiskEncryptionSetID,
+			})
+		}
+	}
+
+	if oc.Properties.WorkerProfilesStatus != nil {
+		workerProfiles := oc.Properties.WorkerProfilesStatus
+		out.Properties.WorkerProfilesStatus = make([]WorkerProfile, 0, len(workerProfiles))
+		for _, p := range workerProfiles {
+			out.Properties.WorkerProfilesStatus = append(out.Properties.WorkerProfilesStatus, WorkerProfile{
+				Name:                p.Name,
+				VMSize:              VMSize(p.VMSize),
+				DiskSizeGB:          p.DiskSizeGB,
+				SubnetID:            p.SubnetID,
+				Count:               p.Count,
+				EncryptionAtHost:    EncryptionAtHost(p.EncryptionAtHost),
+				DiskEncryptionSetID: p.DiskEncryptionSetID,
+			})
+		}
+	}
+
+	if oc.Properties.AutoscalerProfile != nil {
+		out.Properties.AutoscalerProfile = &AutoscalerProfile{}
+
+		if oc.Properties.AutoscalerProfile.ScaleDown != nil {
+			out.Properties.AutoscalerProfile.ScaleDown = &AutoscalerScaleDown{
+				State:                ScaleDownState(oc.Properties.AutoscalerProfile.ScaleDown.State),
+				DelayAfterAdd:        oc.Properties.AutoscalerProfile.ScaleDown.DelayAfterAdd,
+				UnneededTime:         oc.Properties.AutoscalerProfile.ScaleDown.UnneededTime,
+				UtilizationThreshold: oc.Properties.AutoscalerProfile.ScaleDown.UtilizationThreshold,
+			}
+		}
+
+		if oc.Properties.AutoscalerProfile.WorkerProfiles != nil {
+			out.Properties.AutoscalerProfile.WorkerProfiles = make([]AutoscalerWorkerProfile, 0, len(oc.Properties.AutoscalerProfile.WorkerProfiles))
+			for _, p := range oc.Properties.AutoscalerProfile.WorkerProfiles {
+				out.Properties.AutoscalerProfile.WorkerProfiles = append(out.Properties.AutoscalerProfile.WorkerProfiles, AutoscalerWorkerProfile{
+					Name:     p.Name,
+					MinCount: p.MinCount,
+					MaxCount: p.MaxCount,
+				})
+			}
+		}
+	}
+
+	if oc.Properties.AutoscalerProfileStatus != nil {
+		out.Properties.AutoscalerProfileStatus = &AutoscalerProfileStatus{
+			State:   AutoscalerState(oc.Properties.AutoscalerProfileStatus.State),
+			Message: oc.Properties.AutoscalerProfileStatus.Message,
+		}
+	}
+
+	if oc.Properties.CertificateProfile != nil {
+		out.Properties.CertificateProfile = &CertificateProfile{
+			APIServer: certificateSourceToExternal(oc.Properties.CertificateProfile.APIServer),
+			Ingress:   certificateSourceToExternal(oc.Properties.CertificateProfile.Ingress),
+		}
+	}
+
+	if oc.Properties.IngressProfiles != nil {
+		out.Properties.IngressProfiles = make([]IngressProfile, 0, len(oc.Properties.IngressProfiles))
+		for _, p := range oc.Properties.IngressProfiles {
+			out.Properties.IngressProfiles = append(out.Properties.IngressProfiles, IngressProfile{
+				Name:       p.Name,
+				Visibility: Visibility(p.Visibility),
+				IP:         p.IP,
+			})
+		}
+	}
+
+	if oc.Tags != nil {
+		out.Tags = make(map[string]string, len(oc.Tags))
+		for k, v := range oc.Tags {
+			out.Tags[k] = v
+		}
+	}
+
+	out.SystemData = &SystemData{
+		CreatedBy:          oc.SystemData.CreatedBy,
+		CreatedAt:          oc.SystemData.CreatedAt,
+		CreatedByType:      CreatedByType(oc.SystemData.CreatedByType),
+		LastModifiedBy:     oc.SystemData.LastModifiedBy,
+		LastModifiedAt:     oc.SystemData.LastModifiedAt,
+		LastModifiedByType: CreatedByType(oc.SystemData.LastModifiedByType),
+	}
+
+	return out
+}
+
+// ToExternalList returns a slice of external representations of the internal
+// objects
+func (c openShiftClusterConverter) ToExternalList(ocs []*api.OpenShiftCluster, nextLink string) interface{} {
+	l := &OpenShiftClusterList{
+		OpenShiftClusters: make([]*OpenShiftCluster, 0, len(ocs)),
+		NextLink:          nextLink,
+	}
+
+	for _, oc := range ocs {
+		l.OpenShiftClusters = append(l.OpenShiftClusters, c.ToExternal(oc).(*OpenShiftCluster))
+	}
+
+	return l
+}
+
+// ToInternal overwrites in place a pre-existing internal object, setting (only)
+// all mapped fields from the external representation. ToInternal modifies its
+// argument; there is no pointer aliasing between the passed and returned
+// objects
+func (c openShiftClusterConverter) ToInternal(_oc interface{}, out *api.OpenShiftCluster) {
+	oc := _oc.(*OpenShiftCluster)
+
+	out.ID = oc.ID
+	out.Name = oc.Name
+	out.Type = oc.Type
+	out.Location = oc.Location
+	out.Tags = nil
+	if oc.Tags != nil {
+		out.Tags = make(map[string]string, len(oc.Tags))
+		for k, v := range oc.Tags {
+			out.Tags[k] = v
+		}
+	}
+	out.Properties.ProvisioningState = api.ProvisioningState(oc.Properties.ProvisioningState)
+	out.Properties.ClusterProfile.PullSecret = api.SecureString(oc.Properties.ClusterProfile.PullSecret)
+	out.Properties.ClusterProfile.Domain = oc.Properties.ClusterProfile.Domain
+	out.Properties.ClusterProfile.Version = oc.Properties.ClusterProfile.Version
+	out.Properties.ClusterProfile.ResourceGroupID = oc.Properties.ClusterProfile.ResourceGroupID
+	if oc.Properties.ConsoleProfile.URL != "" {
+		out.Properties.ConsoleProfile.URL = oc.Properties.ConsoleProfile.URL
+	}
+	out.Properties.ClusterProfile.FipsValidatedModules = api.FipsValidatedModules(oc.Properties.ClusterProfile.FipsValidatedModules)
+	out.Properties.ServicePrincipalProfile.ClientID = oc.Properties.ServicePrincipalProfile.ClientID
+	out.Properties.ServicePrincipalProfile.ClientSecret = api.SecureString(oc.Properties.ServicePrincipalProfile.ClientSecret)
+	out.Properties.NetworkProfile.PodCIDR = oc.Properties.NetworkProfile.PodCIDR
+	out.Properties.NetworkProfile.ServiceCIDR = oc.Properties.NetworkProfile.ServiceCIDR
+	out.Properties.NetworkProfile.OutboundType = api.OutboundType(oc.Properties.NetworkProfile.OutboundType)
+
+	if oc.Properties.NetworkProfile.LoadBalancerProfile != nil {
+		loadBalancerProfile := api.LoadBalancerProfile{}
+
+		// EffectiveOutboundIPs is a read-only field, so it will never be present in requests.
+		// Preserve the slice from the pre-existing internal object.
+		if out.Properties.NetworkProfile.LoadBalancerProfile != nil {
+			loadBalancerProfile.EffectiveOutboundIPs = make([]api.EffectiveOutboundIP, len(out.Properties.NetworkProfile.LoadBalancerProfile.EffectiveOutboundIPs))
+			copy(loadBalancerProfile.EffectiveOutboundIPs, out.Properties.NetworkProfile.LoadBalancerProfile.EffectiveOutboundIPs)
+		}
+
+		out.Properties.NetworkProfile.LoadBalancerProfile = &loadBalancerProfile
+
+		if oc.Properties.NetworkProfile.LoadBalancerProfile.ManagedOutboundIPs != nil {
+			out.Properties.NetworkProfile.LoadBalancerProfile.ManagedOutboundIPs = &api.ManagedOutboundIPs{
+				Count: oc.Properties.NetworkProfile.LoadBalancerProfile.ManagedOutboundIPs.Count,
+			}
+		}
+		if oc.Properties.NetworkProfile.LoadBalancerProfile.EffectiveOutboundIPs != nil {
+			out.Properties.NetworkProfile.LoadBalancerProfile.EffectiveOutboundIPs = make([]api.EffectiveOutboundIP, len(oc.Properties.NetworkProfile.LoadBalancerProfile.EffectiveOutboundIPs))
+			for i := range oc.Properties.NetworkProfile.LoadBalancerProfile.EffectiveOutboundIPs {
+				out.Properties.NetworkProfile.LoadBalancerProfile.EffectiveOutboundIPs[i].ID = oc.Properties.NetworkProfile.LoadBalancerProfile.EffectiveOutboundIPs[i].ID
+			}
+		}
+	}
+
+	out.Properties.MasterProfile.VMSize = api.VMSize(oc.Properties.MasterProfile.VMSize)
+	out.Properties.MasterProfile.SubnetID = oc.Properties.MasterProfile.SubnetID
+	out.Properties.MasterProfile.EncryptionAtHost = api.EncryptionAtHost(oc.Properties.MasterProfile.EncryptionAtHost)
+	out.Properties.MasterProfile.DiskEncryptionSetID = oc.Properties.MasterProfile.DiskEncryptionSetID
+	out.Properties.WorkerProfiles = nil
+	if oc.Properties.WorkerProfiles != nil {
+		out.Properties.WorkerProfiles = make([]api.WorkerProfile, len(oc.Properties.WorkerProfiles))
+		for i := range oc.Properties.WorkerProfiles {
+			out.Properties.WorkerProfiles[i].Name = oc.Properties.WorkerProfiles[i].Name
+			out.Properties.WorkerProfiles[i].VMSize = api.VMSize(oc.Properties.WorkerProfiles[i].VMSize)
+			out.Properties.WorkerProfiles[i].DiskSizeGB = oc.Properties.WorkerProfiles[i].DiskSizeGB
+			out.Properties.WorkerProfiles[i].SubnetID = oc.Properties.WorkerProfiles[i].SubnetID
+			out.Properties.WorkerProfiles[i].Count = oc.Properties.WorkerProfiles[i].Count
+			out.Properties.WorkerProfiles[i].EncryptionAtHost = api.EncryptionAtHost(oc.Properties.WorkerProfiles[i].EncryptionAtHost)
+			out.Properties.WorkerProfiles[i].DiskEncryptionSetID = oc.Properties.WorkerProfiles[i].DiskEncryptionSetID
+		}
+	}
+	out.Properties.WorkerProfilesStatus = nil
+	if oc.Properties.WorkerProfilesStatus != nil {
+		out.Properties.WorkerProfilesStatus = make([]api.WorkerProfile, len(oc.Properties.WorkerProfilesStatus))
+		for i := range oc.Properties.WorkerProfilesStatus {
+			out.Properties.WorkerProfilesStatus[i].Name = oc.Properties.WorkerProfilesStatus[i].Name
+			out.Properties.WorkerProfilesStatus[i].VMSize = api.VMSize(oc.Properties.WorkerProfilesStatus[i].VMSize)
+			out.Properties.WorkerProfilesStatus[i].DiskSizeGB = oc.Properties.WorkerProfilesStatus[i].DiskSizeGB
+			out.Properties.WorkerProfilesStatus[i].SubnetID = oc.Properties.WorkerProfilesStatus[i].SubnetID
+			out.Properties.WorkerProfilesStatus[i].Count = oc.Properties.WorkerProfilesStatus[i].Count
+			out.Properties.WorkerProfilesStatus[i].EncryptionAtHost = api.EncryptionAtHost(oc.Properties.WorkerProfilesStatus[i].EncryptionAtHost)
+			out.Properties.WorkerProfilesStatus[i].DiskEncryptionSetID = oc.Properties.WorkerProfilesStatus[i].DiskEncryptionSetID
+		}
+	}
+	out.Properties.AutoscalerProfile = nil
+	if oc.Properties.AutoscalerProfile != nil {
+		out.Properties.AutoscalerProfile = &api.AutoscalerProfile{}
+		if oc.Properties.AutoscalerProfile.ScaleDown != nil {
+			out.Properties.AutoscalerProfile.ScaleDown = &api.AutoscalerScaleDown{
+				State:                api.ScaleDownState(oc.Properties.AutoscalerProfile.ScaleDown.State),
+				DelayAfterAdd:        oc.Properties.AutoscalerProfile.ScaleDown.DelayAfterAdd,
+				UnneededTime:         oc.Properties.AutoscalerProfile.ScaleDown.UnneededTime,
+				UtilizationThreshold: oc.Properties.AutoscalerProfile.ScaleDown.UtilizationThreshold,
+			}
+		}
+		if oc.Properties.AutoscalerProfile.WorkerProfiles != nil {
+			out.Properties.AutoscalerProfile.WorkerProfiles = make([]api.AutoscalerWorkerProfile, len(oc.Properties.AutoscalerProfile.WorkerProfiles))
+			for i := range oc.Properties.AutoscalerProfile.WorkerProfiles {
+				out.Properties.AutoscalerProfile.WorkerProfiles[i].Name = oc.Properties.AutoscalerProfile.WorkerProfiles[i].Name
+				out.Properties.AutoscalerProfile.WorkerProfiles[i].MinCount = oc.Properties.AutoscalerProfile.WorkerProfiles[i].MinCount
+				out.Properties.AutoscalerProfile.WorkerProfiles[i].MaxCount = oc.Properties.AutoscalerProfile.WorkerProfiles[i].MaxCount
+			}
+		}
+	}
+	out.Properties.AutoscalerProfileStatus = nil
+	if oc.Properties.AutoscalerProfileStatus != nil {
+		out.Properties.AutoscalerProfileStatus = &api.AutoscalerProfileStatus{
+			State:   api.AutoscalerState(oc.Properties.AutoscalerProfileStatus.State),
+			Message: oc.Properties.AutoscalerProfileStatus.Message,
+		}
+	}
+	out.Properties.CertificateProfile = nil
+	if oc.Properties.CertificateProfile != nil {
+		out.Properties.CertificateProfile = &api.CertificateProfile{
+			APIServer: certificateSourceToInternal(oc.Properties.CertificateProfile.APIServer),
+			Ingress:   certificateSourceToInternal(oc.Properties.CertificateProfile.Ingress),
+		}
+	}
+	out.Properties.APIServerProfile.Visibility = api.Visibility(oc.Properties.APIServerProfile.Visibility)
+	if oc.Properties.APIServerProfile.URL != "" {
+		out.Properties.APIServerProfile.URL = oc.Properties.APIServerProfile.URL
+	}
+	if oc.Properties.APIServerProfile.IP != "" {
+		out.Properties.APIServerProfile.IP = oc.Properties.APIServerProfile.IP
+	}
+	out.Properties.IngressProfiles = nil
+	if oc.Properties.IngressProfiles != nil {
+		out.Properties.IngressProfiles = make([]api.IngressProfile, len(oc.Properties.IngressProfiles))
+		for i := range oc.Properties.IngressProfiles {
+			out.Properties.IngressProfiles[i].Name = oc.Properties.IngressProfiles[i].Name
+			out.Properties.IngressProfiles[i].Visibility = api.Visibility(oc.Properties.IngressProfiles[i].Visibility)
+			if oc.Properties.IngressProfiles[i].IP != "" {
+				out.Properties.IngressProfiles[i].IP = oc.Properties.IngressProfiles[i].IP
+			}
+		}
+	}
+
+	if oc.SystemData != nil {
+		out.SystemData = api.SystemData{
+			CreatedBy:          oc.SystemData.CreatedBy,
+			CreatedAt:          oc.SystemData.CreatedAt,
+			CreatedByType:      api.CreatedByType(oc.SystemData.CreatedByType),
+			LastModifiedBy:     oc.SystemData.LastModifiedBy,
+			LastModifiedAt:     oc.SystemData.LastModifiedAt,
+			LastModifiedByType: api.CreatedByType(oc.SystemData.CreatedByType),
+		}
+	}
+}
+
+// ExternalNoReadOnly removes all read-only fields from the external representation.
+func (c openShiftClusterConverter) ExternalNoReadOnly(_oc interface{}) {
+	oc := _oc.(*OpenShiftCluster)
+	oc.Properties.WorkerProfilesStatus = nil
+	oc.Properties.AutoscalerProfileStatus = nil
+	if oc.Properties.NetworkProfile.LoadBalancerProfile != nil {
+		oc.Properties.NetworkProfile.LoadBalancerProfile.EffectiveOutboundIPs = nil
+	}
+}
+
+func certificateSourceToExternal(cs *api.CertificateSource) *CertificateSource {
+	if cs == nil {
+		return nil
+	}
+
+	out := &CertificateSource{
+		Type:             CertificateSourceType(cs.Type),
+		KeyVaultSecretID: cs.KeyVaultSecretID,
+	}
+	if cs.ACME != nil {
+		out.ACME = &ACMEIssuer{
+			DirectoryURL: cs.ACME.DirectoryURL,
+			Email:        cs.ACME.Email,
+			DNSZoneID:    cs.ACME.DNSZoneID,
+		}
+	}
+
+	return out
+}
+
+func certificateSourceToInternal(cs *CertificateSource) *api.CertificateSource {
+	if cs == nil {
+		return nil
+	}
+
+	out := &api.CertificateSource{
+		Type:             api.CertificateSourceType(cs.Type),
+		KeyVaultSecretID: cs.KeyVaultSecretID,
+	}
+	if cs.ACME != nil {
+		out.ACME = &api.ACMEIssuer{
+			DirectoryURL: cs.ACME.DirectoryURL,
+			Email:        cs.ACME.Email,
+			DNSZoneID:    cs.ACME.DNSZoneID,
+		}
+	}
+
+	return out
+}
@@ -72,3 +72,37 @@ func TestRxDomainNameRFC1123(t *testing.T) {
 		})
 	}
 }
+
+func TestRxKeyVaultSecretID(t *testing.T) {
+	for _, tt := range []struct {
+		value string
+		want  bool
+	}{
+		{
+			value: "https://myvault.vault.azure.net/secrets/mycert",
+			want:  true,
+		},
+		{
+			value: "https://myvault.vault.usgovcloudapi.net/secrets/mycert/0123456789abcdef0123456789abcdef",
+			want:  true,
+		},
+		{
+			value: "http://myvault.vault.azure.net/secrets/mycert",
+			want:  false,
+		},
+		{
+			value: "https://myvault.vault.azure.net/certificates/mycert",
+			want:  false,
+		},
+		{
+			value: "https://myvault.vault.azure.net/secrets/mycert/latest",
+			want:  false,
+		},
+	} {
+		t.Run(tt.value, func(t *testing.T) {
+			if RxKeyVaultSecretID.MatchString(tt.value) != tt.want {
+				t.Fatalf("%s didn't match %s", tt.value, RxKeyVaultSecretID)
+			}
+		})
+	}
+}
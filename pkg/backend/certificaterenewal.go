@@ -0,0 +1,149 @@
+package backend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+)
+
+const (
+	certificateRenewalInterval = time.Hour
+	// certificateRenewalRetryInterval is how long to wait before starting the
+	// renewal of a cluster again whose certificates are still due, e.g.
+	// because its previous renewal failed
+	certificateRenewalRetryInterval = 24 * time.Hour
+	certificateRenewalMaxStarted    = 20
+	// certificateRenewalMaxAttempts is how many renewals are started for the
+	// same due time before giving up on the cluster
+	certificateRenewalMaxAttempts = 3
+)
+
+type certificateRenewalBackend struct {
+	*backend
+
+	lastTick time.Time
+	now      func() time.Time
+}
+
+func newCertificateRenewalBackend(b *backend) *certificateRenewalBackend {
+	return &certificateRenewalBackend{
+		backend: b,
+		now:     time.Now,
+	}
+}
+
+// try starts a CertificatesRenewal admin update on the clusters whose
+// certificates renewed by the RP are due, as recorded by the last certificate
+// inventory of the cluster.  It runs at most once per
+// certificateRenewalInterval and starts at most certificateRenewalMaxStarted
+// renewals per run.  Clusters whose renewals don't move the due time are
+// given up on and reported as stuck.
+func (cb *certificateRenewalBackend) try(ctx context.Context) error {
+	if cb.now().Sub(cb.lastTick) < certificateRenewalInterval {
+		return nil
+	}
+	cb.lastTick = cb.now()
+
+	docs, err := cb.dbOpenShiftClusters.ListByCertificateRenewalDue(ctx, cb.now())
+	if err != nil {
+		return err
+	}
+
+	var due, started, stuck int64
+	for _, doc := range docs.OpenShiftClusterDocuments {
+		if !cb.renewalDue(doc) {
+			continue
+		}
+
+		cr := doc.OpenShiftCluster.Properties.CertificateRenewal
+		if renewalStuck(cr) {
+			cb.baseLog.WithField("resource", doc.OpenShiftCluster.ID).Errorf("certificate renewal stopped after %d attempts: certificates still due at %s", cr.Attempts, cr.DueTime.Format(time.RFC3339))
+			stuck++
+			continue
+		}
+
+		if cr.LastStartTime != nil && cb.now().Sub(*cr.LastStartTime) < certificateRenewalRetryInterval {
+			continue
+		}
+		due++
+
+		if started >= certificateRenewalMaxStarted {
+			continue
+		}
+
+		log := cb.baseLog.WithField("resource", doc.OpenShiftCluster.ID)
+
+		_, err := cb.dbOpenShiftClusters.Patch(ctx, doc.Key, func(doc *api.OpenShiftClusterDocument) error {
+			if doc.OpenShiftCluster.Properties.ProvisioningState != api.ProvisioningStateSucceeded {
+				return errClusterNotSucceeded
+			}
+
+			now := cb.now().UTC()
+
+			doc.OpenShiftCluster.Properties.LastProvisioningState = doc.OpenShiftCluster.Properties.ProvisioningState
+			doc.OpenShiftCluster.Properties.ProvisioningState = api.ProvisioningStateAdminUpdating
+			doc.OpenShiftCluster.Properties.MaintenanceTask = api.MaintenanceTaskRenewCerts
+			if doc.OpenShiftCluster.Properties.MaintenanceState == api.MaintenanceStatePending {
+				doc.OpenShiftCluster.Properties.MaintenanceState = api.MaintenanceStatePlanned
+			} else {
+				doc.OpenShiftCluster.Properties.MaintenanceState = api.MaintenanceStateUnplanned
+			}
+			doc.OpenShiftCluster.Properties.LastAdminUpdateError = ""
+
+			cr := doc.OpenShiftCluster.Properties.CertificateRenewal
+			if cr.LastStartDueTime != nil && cr.DueTime != nil && cr.LastStartDueTime.Equal(*cr.DueTime) {
+				cr.Attempts++
+			} else {
+				cr.Attempts = 1
+			}
+			cr.LastStartDueTime = cr.DueTime
+			cr.LastStartTime = &now
+			doc.Dequeues = 0
+			return nil
+		})
+		switch {
+		case cosmosdb.IsErrorStatusCode(err, http.StatusNotFound), err == errClusterNotSucceeded:
+			// the cluster was deleted or became busy since it was listed
+		case err != nil:
+			log.Error(err)
+		default:
+			log.Print("started certificate renewal")
+			started++
+		}
+	}
+
+	cb.m.EmitGauge("backend.certificaterenewal.due", due, nil)
+	cb.m.EmitGauge("backend.certificaterenewal.started", started, nil)
+	cb.m.EmitGauge("backend.certificaterenewal.stuck", stuck, nil)
+
+	return nil
+}
+
+// renewalDue returns true if the certificates of a cluster are due for
+// renewal by the RP.  Suspended clusters are renewed once they are resumed.
+func (cb *certificateRenewalBackend) renewalDue(doc *api.OpenShiftClusterDocument) bool {
+	cr := doc.OpenShiftCluster.Properties.CertificateRenewal
+	return doc.OpenShiftCluster.Properties.ProvisioningState == api.ProvisioningStateSucceeded &&
+		!doc.OpenShiftCluster.Properties.SubscriptionSuspension.IsSuspended() &&
+		cr != nil && cr.DueTime != nil && !cb.now().Before(*cr.DueTime)
+}
+
+// renewalStuck returns true if renewing the certificates of a cluster again
+// is pointless: a renewal started for the current due time completed, as
+// a later inventory shows, without moving it, or certificateRenewalMaxAttempts
+// renewals were started for it.  A renewal which moves the due time, e.g. by
+// an SRE, unsticks the cluster.
+func renewalStuck(cr *api.CertificateRenewal) bool {
+	if cr.LastStartDueTime == nil || !cr.LastStartDueTime.Equal(*cr.DueTime) {
+		return false
+	}
+
+	return cr.Attempts >= certificateRenewalMaxAttempts ||
+		cr.InventoryTime != nil && cr.LastStartTime != nil && cr.InventoryTime.After(*cr.LastStartTime)
+}
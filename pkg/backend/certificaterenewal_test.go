@@ -0,0 +1,149 @@
+package backend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/metrics/noop"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+)
+
+func TestCertificateRenewalTry(t *testing.T) {
+	ctx := context.Background()
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+	yesterday := now.Add(-certificateRenewalRetryInterval)
+	earlier := yesterday.Add(-time.Hour)
+
+	clusterDoc := func(name string, state api.ProvisioningState, renewal *api.CertificateRenewal) *api.OpenShiftClusterDocument {
+		key := fmt.Sprintf("/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/resourcegroup/providers/microsoft.redhatopenshift/openshiftclusters/%s", name)
+		return &api.OpenShiftClusterDocument{
+			Key: key,
+			OpenShiftCluster: &api.OpenShiftCluster{
+				ID: key,
+				Properties: api.OpenShiftClusterProperties{
+					ProvisioningState:  state,
+					CertificateRenewal: renewal,
+				},
+			},
+		}
+	}
+
+	suspendedClusterDoc := func(name string, renewal *api.CertificateRenewal) *api.OpenShiftClusterDocument {
+		doc := clusterDoc(name, api.ProvisioningStateSucceeded, renewal)
+		doc.OpenShiftCluster.Properties.SubscriptionSuspension = &api.SubscriptionSuspension{
+			State: api.SubscriptionSuspensionStateSuspended,
+		}
+		return doc
+	}
+
+	for _, tt := range []struct {
+		name         string
+		cluster      *api.OpenShiftClusterDocument
+		wantStarted  bool
+		wantAttempts int
+	}{
+		{
+			name:         "renewal is started once due",
+			cluster:      clusterDoc("due", api.ProvisioningStateSucceeded, &api.CertificateRenewal{DueTime: &past}),
+			wantStarted:  true,
+			wantAttempts: 1,
+		},
+		{
+			name:         "renewal is retried a day after the last start",
+			cluster:      clusterDoc("retried", api.ProvisioningStateSucceeded, &api.CertificateRenewal{DueTime: &past, LastStartTime: &yesterday}),
+			wantStarted:  true,
+			wantAttempts: 1,
+		},
+		{
+			name:         "failed renewal is retried",
+			cluster:      clusterDoc("failed", api.ProvisioningStateSucceeded, &api.CertificateRenewal{InventoryTime: &earlier, DueTime: &past, LastStartTime: &yesterday, LastStartDueTime: &past, Attempts: 1}),
+			wantStarted:  true,
+			wantAttempts: 2,
+		},
+		{
+			name:    "renewal is given up after the maximum attempts",
+			cluster: clusterDoc("maxattempts", api.ProvisioningStateSucceeded, &api.CertificateRenewal{InventoryTime: &earlier, DueTime: &past, LastStartTime: &yesterday, LastStartDueTime: &past, Attempts: certificateRenewalMaxAttempts}),
+		},
+		{
+			name:    "renewal which didn't move the due time is given up",
+			cluster: clusterDoc("notmoved", api.ProvisioningStateSucceeded, &api.CertificateRenewal{InventoryTime: &past, DueTime: &past, LastStartTime: &yesterday, LastStartDueTime: &past, Attempts: 1}),
+		},
+		{
+			name:         "renewal which moved the due time resets the attempts",
+			cluster:      clusterDoc("moved", api.ProvisioningStateSucceeded, &api.CertificateRenewal{InventoryTime: &past, DueTime: &past, LastStartTime: &yesterday, LastStartDueTime: &earlier, Attempts: certificateRenewalMaxAttempts}),
+			wantStarted:  true,
+			wantAttempts: 1,
+		},
+		{
+			name:    "renewal is not started before due",
+			cluster: clusterDoc("notdue", api.ProvisioningStateSucceeded, &api.CertificateRenewal{DueTime: &future}),
+		},
+		{
+			name:    "renewal is not restarted within a day",
+			cluster: clusterDoc("recent", api.ProvisioningStateSucceeded, &api.CertificateRenewal{DueTime: &past, LastStartTime: &past}),
+		},
+		{
+			name:    "cluster without inventory",
+			cluster: clusterDoc("noinventory", api.ProvisioningStateSucceeded, nil),
+		},
+		{
+			name:    "busy cluster",
+			cluster: clusterDoc("busy", api.ProvisioningStateUpdating, &api.CertificateRenewal{DueTime: &past}),
+		},
+		{
+			name:    "suspended cluster",
+			cluster: suspendedClusterDoc("suspended", &api.CertificateRenewal{DueTime: &past}),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			dbOpenShiftClusters, _ := testdatabase.NewFakeOpenShiftClusters()
+
+			f := testdatabase.NewFixture().WithOpenShiftClusters(dbOpenShiftClusters)
+			f.AddOpenShiftClusterDocuments(tt.cluster)
+			err := f.Create()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			cb := newCertificateRenewalBackend(&backend{
+				baseLog:             logrus.NewEntry(logrus.StandardLogger()),
+				dbOpenShiftClusters: dbOpenShiftClusters,
+				m:                   &noop.Noop{},
+			})
+			cb.now = func() time.Time { return now }
+
+			err = cb.try(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			doc, err := dbOpenShiftClusters.Get(ctx, tt.cluster.Key)
+			if err != nil {
+				t.Fatal(err)
+			}
+			p := doc.OpenShiftCluster.Properties
+
+			started := p.ProvisioningState == api.ProvisioningStateAdminUpdating
+			if started != tt.wantStarted {
+				t.Fatalf("got started %t, want %t", started, tt.wantStarted)
+			}
+			if started && (p.MaintenanceTask != api.MaintenanceTaskRenewCerts || !p.CertificateRenewal.LastStartTime.Equal(now)) {
+				t.Errorf("got %s, last start time %v", p.MaintenanceTask, p.CertificateRenewal.LastStartTime)
+			}
+			if started && (p.CertificateRenewal.Attempts != tt.wantAttempts || !p.CertificateRenewal.LastStartDueTime.Equal(past)) {
+				t.Errorf("got %d attempts, last start due time %v", p.CertificateRenewal.Attempts, p.CertificateRenewal.LastStartDueTime)
+			}
+		})
+	}
+}
@@ -118,7 +118,15 @@ func (ocb *openShiftClusterBackend) handle(ctx context.Context, log *logrus.Entr
 
 	var hr hive.ClusterManager
 	if installViaHive || adoptViaHive {
-		hiveShard := 1
+		if doc.OpenShiftCluster.Properties.ProvisioningState == api.ProvisioningStateCreating &&
+			doc.OpenShiftCluster.Properties.HiveProfile.ShardIndex == 0 {
+			doc, err = ocb.placeHiveShard(ctx, log, doc)
+			if err != nil {
+				return ocb.endLease(ctx, log, stop, doc, api.ProvisioningStateFailed, err)
+			}
+		}
+
+		hiveShard := hive.ShardIndex(doc)
 		hiveRestConfig, err := ocb.env.LiveConfig().HiveRestConfig(ctx, hiveShard)
 		if err != nil {
 			return fmt.Errorf("failed getting RESTConfig for Hive shard %d: %w", hiveShard, err)
@@ -206,6 +214,31 @@ func (ocb *openShiftClusterBackend) handle(ctx context.Context, log *logrus.Entr
 	return fmt.Errorf("unexpected provisioningState %q", doc.OpenShiftCluster.Properties.ProvisioningState)
 }
 
+// placeHiveShard assigns a new cluster to the least loaded Hive shard and
+// persists the choice on the cluster document
+func (ocb *openShiftClusterBackend) placeHiveShard(ctx context.Context, log *logrus.Entry, doc *api.OpenShiftClusterDocument) (*api.OpenShiftClusterDocument, error) {
+	shardCount, err := ocb.env.LiveConfig().HiveShardCount(ctx)
+	if err != nil {
+		return doc, err
+	}
+
+	_, err = hive.PlaceShard(ctx, ocb.dbOpenShiftClusters, shardCount, func(shard int) error {
+		log.Printf("placing cluster on Hive shard %d", shard)
+		placed, err := ocb.dbOpenShiftClusters.PatchWithLease(ctx, doc.Key, func(doc *api.OpenShiftClusterDocument) error {
+			doc.OpenShiftCluster.Properties.HiveProfile.ShardIndex = shard
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		doc = placed
+		return nil
+	})
+
+	return doc, err
+}
+
 func (ocb *openShiftClusterBackend) heartbeat(ctx context.Context, cancel context.CancelFunc, log *logrus.Entry, doc *api.OpenShiftClusterDocument) func() {
 	var stopped bool
 	stop, done := make(chan struct{}), make(chan struct{})
@@ -0,0 +1,443 @@
+package backend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+	"github.com/Azure/ARO-RP/pkg/util/version"
+)
+
+const (
+	operatorRolloutInterval    = time.Minute
+	operatorRolloutMaxUpdating = 20
+	// operatorRolloutStartTimeout is how long a cluster whose document doesn't
+	// record the start of its update may remain Starting before it is
+	// considered not started, e.g. because the RP starting it stopped
+	operatorRolloutStartTimeout = 10 * time.Minute
+)
+
+var (
+	errClusterNotSucceeded = errors.New("cluster is not in Succeeded state")
+	errClusterSuspended    = errors.New("cluster is suspended")
+)
+
+type operatorRolloutBackend struct {
+	*backend
+
+	lastTick time.Time
+	now      func() time.Time
+}
+
+func newOperatorRolloutBackend(b *backend) *operatorRolloutBackend {
+	return &operatorRolloutBackend{
+		backend: b,
+		now:     time.Now,
+	}
+}
+
+// try progresses the operator rollouts started with the version of this RP.
+// It runs at most once per operatorRolloutInterval.  Rollouts started with a
+// different version are left to the RPs running that version, so that an RP
+// never rolls out an operator other than its own.
+func (rb *operatorRolloutBackend) try(ctx context.Context) error {
+	if rb.dbOperatorRollouts == nil || rb.now().Sub(rb.lastTick) < operatorRolloutInterval {
+		return nil
+	}
+	rb.lastTick = rb.now()
+
+	docs, err := rb.dbOperatorRollouts.ListAll(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, doc := range docs.OperatorRolloutDocuments {
+		if doc.OperatorRollout.Properties.State != api.OperatorRolloutStateProgressing ||
+			doc.OperatorRollout.Properties.Version != version.GitCommit {
+			continue
+		}
+
+		log := rb.baseLog.WithField("operatorRollout", doc.ID)
+
+		err = rb.tick(ctx, log, doc.ID)
+		if err != nil {
+			log.Error(err)
+		}
+	}
+
+	return nil
+}
+
+// tick moves a rollout forward by one step: it selects the clusters of the
+// current wave, starts their operator updates, records the outcome of the
+// updates and moves on to the next wave once all clusters are done.
+func (rb *operatorRolloutBackend) tick(ctx context.Context, log *logrus.Entry, id string) error {
+	// the mutator may be retried on conflicts with other RPs, so it only
+	// decides which clusters to start: their updates are started once the
+	// rollout document has been patched
+	var starting []string
+	doc, err := rb.dbOperatorRollouts.Patch(ctx, id, func(doc *api.OperatorRolloutDocument) error {
+		starting = nil
+
+		p := &doc.OperatorRollout.Properties
+		if p.State != api.OperatorRolloutStateProgressing {
+			return nil
+		}
+
+		wave := &p.Waves[p.CurrentWave]
+
+		if wave.State == api.OperatorRolloutWaveStatePending {
+			err := rb.selectClusters(ctx, doc.ID, p)
+			if err != nil {
+				return err
+			}
+			wave.State = api.OperatorRolloutWaveStateProgressing
+		}
+
+		var err error
+		starting, err = rb.updateClusters(ctx, doc.ID, wave)
+		if err != nil {
+			return err
+		}
+
+		rb.advance(p)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(starting) > 0 {
+		doc, err = rb.startClusters(ctx, log, id, starting)
+		if err != nil {
+			return err
+		}
+	}
+
+	rb.emitMetrics(doc)
+
+	if doc.OperatorRollout.Properties.State == api.OperatorRolloutStateHalted {
+		log.Warnf("halted: %s", doc.OperatorRollout.Properties.HaltReason)
+	}
+
+	return nil
+}
+
+// selectClusters selects the clusters of the current wave.  A canary wave
+// selects the clusters of its subscriptions.  A percentage wave selects
+// further clusters until the given percentage of the clusters of the region
+// has been selected by the rollout; clusters are taken in an order which is
+// pseudo-random but stable for the rollout.
+func (rb *operatorRolloutBackend) selectClusters(ctx context.Context, id string, p *api.OperatorRolloutProperties) error {
+	docs, err := rb.dbOpenShiftClusters.ListAll(ctx)
+	if err != nil {
+		return err
+	}
+
+	selected := map[string]struct{}{}
+	for _, wave := range p.Waves[:p.CurrentWave] {
+		for _, c := range wave.Clusters {
+			selected[c.Key] = struct{}{}
+		}
+	}
+
+	var candidates []string
+	var total int
+	for _, doc := range docs.OpenShiftClusterDocuments {
+		switch doc.OpenShiftCluster.Properties.ProvisioningState {
+		case api.ProvisioningStateCreating, api.ProvisioningStateDeleting:
+			continue
+		}
+
+		total++
+		if _, ok := selected[doc.Key]; !ok {
+			candidates = append(candidates, doc.Key)
+		}
+	}
+
+	wave := &p.Waves[p.CurrentWave]
+
+	var keys []string
+	if len(wave.Subscriptions) > 0 {
+		for _, key := range candidates {
+			for _, subscriptionID := range wave.Subscriptions {
+				if strings.HasPrefix(key, "/subscriptions/"+strings.ToLower(subscriptionID)+"/") {
+					keys = append(keys, key)
+					break
+				}
+			}
+		}
+	} else {
+		sort.Slice(candidates, func(i, j int) bool {
+			return rolloutOrder(id, candidates[i]) < rolloutOrder(id, candidates[j])
+		})
+
+		want := (wave.Percentage*total+99)/100 - len(selected)
+		if want > len(candidates) {
+			want = len(candidates)
+		}
+		if want > 0 {
+			keys = candidates[:want]
+		}
+	}
+
+	sort.Strings(keys)
+
+	wave.Clusters = make([]api.OperatorRolloutCluster, 0, len(keys))
+	for _, key := range keys {
+		wave.Clusters = append(wave.Clusters, api.OperatorRolloutCluster{
+			Key:   key,
+			State: api.OperatorRolloutClusterStatePending,
+		})
+	}
+
+	return nil
+}
+
+// updateClusters records the outcome of the finished operator updates of the
+// wave and selects the pending clusters to start, keeping at most
+// operatorRolloutMaxUpdating updates running at once.  The selected clusters
+// are marked Starting and their keys are returned; updateClusters doesn't
+// modify the cluster documents.
+func (rb *operatorRolloutBackend) updateClusters(ctx context.Context, id string, wave *api.OperatorRolloutWave) ([]string, error) {
+	updating := 0
+	for i := range wave.Clusters {
+		c := &wave.Clusters[i]
+		if c.State != api.OperatorRolloutClusterStateUpdating && c.State != api.OperatorRolloutClusterStateStarting {
+			continue
+		}
+
+		doc, err := rb.dbOpenShiftClusters.Get(ctx, c.Key)
+		switch {
+		case cosmosdb.IsErrorStatusCode(err, http.StatusNotFound):
+			c.State = api.OperatorRolloutClusterStateSkipped
+			c.Error = "cluster no longer exists"
+			continue
+		case err != nil:
+			return nil, err
+		}
+
+		adminUpdating := doc.OpenShiftCluster.Properties.ProvisioningState == api.ProvisioningStateAdminUpdating
+
+		// another RP is starting the update, or stopped before it recorded
+		// that it did.  The cluster document records whether the update was
+		// started: if it was, it is followed like any other; if it wasn't,
+		// the cluster is started again once the RP starting it has had
+		// enough time to do so
+		if c.State == api.OperatorRolloutClusterStateStarting {
+			switch {
+			case doc.OperatorRolloutID == id:
+				c.State = api.OperatorRolloutClusterStateUpdating
+			case c.StartTime != nil && rb.now().Sub(*c.StartTime) > operatorRolloutStartTimeout:
+				c.State = api.OperatorRolloutClusterStatePending
+				c.StartTime = nil
+				continue
+			default:
+				updating++
+				continue
+			}
+		}
+
+		switch {
+		case adminUpdating:
+			updating++
+		case doc.OpenShiftCluster.Properties.LastAdminUpdateError != "":
+			c.State = api.OperatorRolloutClusterStateDegraded
+			c.Error = doc.OpenShiftCluster.Properties.LastAdminUpdateError
+		default:
+			c.State = api.OperatorRolloutClusterStateSucceeded
+		}
+	}
+
+	var starting []string
+	for i := range wave.Clusters {
+		c := &wave.Clusters[i]
+		if c.State != api.OperatorRolloutClusterStatePending || updating >= operatorRolloutMaxUpdating {
+			continue
+		}
+
+		now := rb.now().UTC()
+		c.State = api.OperatorRolloutClusterStateStarting
+		c.StartTime = &now
+		starting = append(starting, c.Key)
+		updating++
+	}
+
+	return starting, nil
+}
+
+// startClusters starts the operator updates of the given clusters and records
+// in the rollout whether they were started
+func (rb *operatorRolloutBackend) startClusters(ctx context.Context, log *logrus.Entry, id string, keys []string) (*api.OperatorRolloutDocument, error) {
+	type outcome struct {
+		state api.OperatorRolloutClusterState
+		err   string
+	}
+	outcomes := map[string]outcome{}
+
+	for _, key := range keys {
+		_, err := rb.dbOpenShiftClusters.Patch(ctx, key, func(doc *api.OpenShiftClusterDocument) error {
+			if doc.OpenShiftCluster.Properties.ProvisioningState != api.ProvisioningStateSucceeded {
+				return errClusterNotSucceeded
+			}
+			if doc.OpenShiftCluster.Properties.SubscriptionSuspension.IsSuspended() {
+				return errClusterSuspended
+			}
+
+			doc.OpenShiftCluster.Properties.LastProvisioningState = doc.OpenShiftCluster.Properties.ProvisioningState
+			doc.OpenShiftCluster.Properties.ProvisioningState = api.ProvisioningStateAdminUpdating
+			doc.OpenShiftCluster.Properties.MaintenanceTask = api.MaintenanceTaskOperator
+			if doc.OpenShiftCluster.Properties.MaintenanceState == api.MaintenanceStatePending {
+				doc.OpenShiftCluster.Properties.MaintenanceState = api.MaintenanceStatePlanned
+			} else {
+				doc.OpenShiftCluster.Properties.MaintenanceState = api.MaintenanceStateUnplanned
+			}
+			doc.OpenShiftCluster.Properties.LastAdminUpdateError = ""
+			doc.OperatorRolloutID = id
+			doc.Dequeues = 0
+			return nil
+		})
+		switch {
+		case cosmosdb.IsErrorStatusCode(err, http.StatusNotFound):
+			outcomes[key] = outcome{state: api.OperatorRolloutClusterStateSkipped, err: "cluster no longer exists"}
+		case err == errClusterSuspended:
+			// an update would start the VMs of the deallocated cluster
+			outcomes[key] = outcome{state: api.OperatorRolloutClusterStateSkipped, err: "cluster is suspended"}
+		case err == errClusterNotSucceeded:
+			// the cluster is busy or failed; a failed cluster will not become
+			// Succeeded without an update of its own, so skip it
+			doc, err := rb.dbOpenShiftClusters.Get(ctx, key)
+			switch {
+			case err != nil:
+				log.WithField("resource", key).Error(err)
+				outcomes[key] = outcome{state: api.OperatorRolloutClusterStatePending}
+			case doc.OpenShiftCluster.Properties.ProvisioningState == api.ProvisioningStateFailed:
+				outcomes[key] = outcome{state: api.OperatorRolloutClusterStateSkipped, err: "cluster is in Failed state"}
+			default:
+				outcomes[key] = outcome{state: api.OperatorRolloutClusterStatePending}
+			}
+		case err != nil:
+			log.WithField("resource", key).Error(err)
+			outcomes[key] = outcome{state: api.OperatorRolloutClusterStatePending}
+		default:
+			log.WithField("resource", key).Print("started operator update")
+			outcomes[key] = outcome{state: api.OperatorRolloutClusterStateUpdating}
+		}
+	}
+
+	return rb.dbOperatorRollouts.Patch(ctx, id, func(doc *api.OperatorRolloutDocument) error {
+		for i := range doc.OperatorRollout.Properties.Waves {
+			wave := &doc.OperatorRollout.Properties.Waves[i]
+			for j := range wave.Clusters {
+				c := &wave.Clusters[j]
+				o, found := outcomes[c.Key]
+				if !found || c.State != api.OperatorRolloutClusterStateStarting {
+					continue
+				}
+
+				c.State = o.state
+				c.Error = o.err
+				if o.state == api.OperatorRolloutClusterStatePending {
+					c.StartTime = nil
+				}
+			}
+		}
+
+		if doc.OperatorRollout.Properties.State == api.OperatorRolloutStateProgressing {
+			rb.advance(&doc.OperatorRollout.Properties)
+		}
+		return nil
+	})
+}
+
+// advance halts the rollout if too many updated clusters are degraded, or
+// moves it to the next wave once all clusters of the current wave are done
+func (rb *operatorRolloutBackend) advance(p *api.OperatorRolloutProperties) {
+	wave := &p.Waves[p.CurrentWave]
+
+	if degraded, updated := countDegraded(p); updated > 0 && degraded*100 > p.MaxDegradedPercent*updated {
+		p.State = api.OperatorRolloutStateHalted
+		p.HaltReason = fmt.Sprintf("%d of %d updated clusters are degraded, more than the maximum of %d%%", degraded, updated, p.MaxDegradedPercent)
+	} else if waveIsDone(wave) {
+		wave.State = api.OperatorRolloutWaveStateSucceeded
+		p.CurrentWave++
+		if p.CurrentWave == len(p.Waves) {
+			p.CurrentWave--
+			p.State = api.OperatorRolloutStateCompleted
+		}
+	}
+
+	p.LastUpdateTime = rb.now().UTC()
+}
+
+func (rb *operatorRolloutBackend) emitMetrics(doc *api.OperatorRolloutDocument) {
+	counts := map[api.OperatorRolloutClusterState]int64{}
+	for _, wave := range doc.OperatorRollout.Properties.Waves {
+		for _, c := range wave.Clusters {
+			counts[c.State]++
+		}
+	}
+
+	for _, state := range []api.OperatorRolloutClusterState{
+		api.OperatorRolloutClusterStatePending,
+		api.OperatorRolloutClusterStateStarting,
+		api.OperatorRolloutClusterStateUpdating,
+		api.OperatorRolloutClusterStateSucceeded,
+		api.OperatorRolloutClusterStateDegraded,
+		api.OperatorRolloutClusterStateSkipped,
+	} {
+		rb.m.EmitGauge("backend.operatorrollout.clusters", counts[state], map[string]string{
+			"rollout": doc.ID,
+			"state":   string(state),
+		})
+	}
+
+	rb.m.EmitGauge("backend.operatorrollout.wave", int64(doc.OperatorRollout.Properties.CurrentWave), map[string]string{
+		"rollout": doc.ID,
+		"state":   string(doc.OperatorRollout.Properties.State),
+	})
+}
+
+// countDegraded returns the number of degraded clusters of the rollout and the
+// number of clusters whose update has finished
+func countDegraded(p *api.OperatorRolloutProperties) (degraded, updated int) {
+	for _, wave := range p.Waves {
+		for _, c := range wave.Clusters {
+			switch c.State {
+			case api.OperatorRolloutClusterStateDegraded:
+				degraded++
+				updated++
+			case api.OperatorRolloutClusterStateSucceeded:
+				updated++
+			}
+		}
+	}
+	return
+}
+
+func waveIsDone(wave *api.OperatorRolloutWave) bool {
+	for _, c := range wave.Clusters {
+		if !c.State.IsTerminal() {
+			return false
+		}
+	}
+	return true
+}
+
+func rolloutOrder(id, key string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(id + key))
+	return h.Sum64()
+}
@@ -0,0 +1,466 @@
+package backend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/metrics/noop"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+	"github.com/Azure/ARO-RP/test/util/deterministicuuid"
+)
+
+func TestOperatorRolloutTick(t *testing.T) {
+	ctx := context.Background()
+
+	key := func(subscriptionID, name string) string {
+		return fmt.Sprintf("/subscriptions/%s/resourcegroups/resourcegroup/providers/microsoft.redhatopenshift/openshiftclusters/%s", subscriptionID, name)
+	}
+
+	clusterDoc := func(key string, state api.ProvisioningState, adminUpdateError string) *api.OpenShiftClusterDocument {
+		return &api.OpenShiftClusterDocument{
+			Key: key,
+			OpenShiftCluster: &api.OpenShiftCluster{
+				ID: key,
+				Properties: api.OpenShiftClusterProperties{
+					ProvisioningState:    state,
+					LastAdminUpdateError: adminUpdateError,
+				},
+			},
+		}
+	}
+
+	canary := key("00000000-0000-0000-0000-000000000001", "canary")
+	other := key("00000000-0000-0000-0000-000000000002", "other")
+
+	recently := time.Now().Add(-time.Minute)
+	longAgo := time.Now().Add(-time.Hour)
+
+	operatorUpdating := clusterDoc(canary, api.ProvisioningStateAdminUpdating, "")
+	operatorUpdating.OpenShiftCluster.Properties.MaintenanceTask = api.MaintenanceTaskOperator
+	operatorUpdating.OperatorRolloutID = "rollout"
+
+	operatorUpdated := clusterDoc(canary, api.ProvisioningStateSucceeded, "")
+	operatorUpdated.OperatorRolloutID = "rollout"
+
+	operatorUpdateFailed := clusterDoc(canary, api.ProvisioningStateSucceeded, "operator update failed")
+	operatorUpdateFailed.OperatorRolloutID = "rollout"
+
+	otherUpdating := clusterDoc(canary, api.ProvisioningStateAdminUpdating, "")
+	otherUpdating.OpenShiftCluster.Properties.MaintenanceTask = api.MaintenanceTaskOperator
+	otherUpdating.OperatorRolloutID = "otherrollout"
+
+	suspended := clusterDoc(canary, api.ProvisioningStateSucceeded, "")
+	suspended.OpenShiftCluster.Properties.SubscriptionSuspension = &api.SubscriptionSuspension{
+		State: api.SubscriptionSuspensionStateSuspended,
+	}
+
+	for _, tt := range []struct {
+		name         string
+		clusters     []*api.OpenShiftClusterDocument
+		rollout      api.OperatorRolloutProperties
+		wantState    api.OperatorRolloutState
+		wantWave     int
+		wantClusters map[string]api.OperatorRolloutClusterState
+		wantUpdating []string
+		// wantNotUpdating lists clusters whose update must not be started
+		wantNotUpdating []string
+	}{
+		{
+			name: "canary wave selects the clusters of its subscriptions",
+			clusters: []*api.OpenShiftClusterDocument{
+				clusterDoc(canary, api.ProvisioningStateSucceeded, ""),
+				clusterDoc(other, api.ProvisioningStateSucceeded, ""),
+			},
+			rollout: api.OperatorRolloutProperties{
+				Waves: []api.OperatorRolloutWave{
+					{Subscriptions: []string{"00000000-0000-0000-0000-000000000001"}, State: api.OperatorRolloutWaveStatePending},
+					{Percentage: 100, State: api.OperatorRolloutWaveStatePending},
+				},
+			},
+			wantState: api.OperatorRolloutStateProgressing,
+			wantClusters: map[string]api.OperatorRolloutClusterState{
+				canary: api.OperatorRolloutClusterStateUpdating,
+			},
+			wantUpdating: []string{canary},
+		},
+		{
+			name: "finished updates move the rollout to the next wave",
+			clusters: []*api.OpenShiftClusterDocument{
+				clusterDoc(canary, api.ProvisioningStateSucceeded, ""),
+				clusterDoc(other, api.ProvisioningStateSucceeded, ""),
+			},
+			rollout: api.OperatorRolloutProperties{
+				Waves: []api.OperatorRolloutWave{
+					{
+						Subscriptions: []string{"00000000-0000-0000-0000-000000000001"},
+						State:         api.OperatorRolloutWaveStateProgressing,
+						Clusters:      []api.OperatorRolloutCluster{{Key: canary, State: api.OperatorRolloutClusterStateUpdating}},
+					},
+					{Percentage: 100, State: api.OperatorRolloutWaveStatePending},
+				},
+			},
+			wantState: api.OperatorRolloutStateProgressing,
+			wantWave:  1,
+			wantClusters: map[string]api.OperatorRolloutClusterState{
+				canary: api.OperatorRolloutClusterStateSucceeded,
+			},
+		},
+		{
+			name: "percentage wave selects the remaining clusters",
+			clusters: []*api.OpenShiftClusterDocument{
+				clusterDoc(canary, api.ProvisioningStateSucceeded, ""),
+				clusterDoc(other, api.ProvisioningStateSucceeded, ""),
+			},
+			rollout: api.OperatorRolloutProperties{
+				CurrentWave: 1,
+				Waves: []api.OperatorRolloutWave{
+					{
+						Subscriptions: []string{"00000000-0000-0000-0000-000000000001"},
+						State:         api.OperatorRolloutWaveStateSucceeded,
+						Clusters:      []api.OperatorRolloutCluster{{Key: canary, State: api.OperatorRolloutClusterStateSucceeded}},
+					},
+					{Percentage: 100, State: api.OperatorRolloutWaveStatePending},
+				},
+			},
+			wantState: api.OperatorRolloutStateProgressing,
+			wantWave:  1,
+			wantClusters: map[string]api.OperatorRolloutClusterState{
+				canary: api.OperatorRolloutClusterStateSucceeded,
+				other:  api.OperatorRolloutClusterStateUpdating,
+			},
+			wantUpdating: []string{other},
+		},
+		{
+			name: "failed clusters are skipped",
+			clusters: []*api.OpenShiftClusterDocument{
+				clusterDoc(canary, api.ProvisioningStateFailed, ""),
+			},
+			rollout: api.OperatorRolloutProperties{
+				Waves: []api.OperatorRolloutWave{
+					{Percentage: 100, State: api.OperatorRolloutWaveStatePending},
+				},
+			},
+			wantState: api.OperatorRolloutStateCompleted,
+			wantClusters: map[string]api.OperatorRolloutClusterState{
+				canary: api.OperatorRolloutClusterStateSkipped,
+			},
+		},
+		{
+			name: "suspended clusters are skipped",
+			clusters: []*api.OpenShiftClusterDocument{
+				suspended,
+			},
+			rollout: api.OperatorRolloutProperties{
+				Waves: []api.OperatorRolloutWave{
+					{Percentage: 100, State: api.OperatorRolloutWaveStatePending},
+				},
+			},
+			wantState: api.OperatorRolloutStateCompleted,
+			wantClusters: map[string]api.OperatorRolloutClusterState{
+				canary: api.OperatorRolloutClusterStateSkipped,
+			},
+		},
+		{
+			name: "degraded clusters halt the rollout",
+			clusters: []*api.OpenShiftClusterDocument{
+				clusterDoc(canary, api.ProvisioningStateSucceeded, "ARO Cluster Operator is reporting unhealthy conditions."),
+			},
+			rollout: api.OperatorRolloutProperties{
+				MaxDegradedPercent: 10,
+				Waves: []api.OperatorRolloutWave{
+					{
+						Subscriptions: []string{"00000000-0000-0000-0000-000000000001"},
+						State:         api.OperatorRolloutWaveStateProgressing,
+						Clusters:      []api.OperatorRolloutCluster{{Key: canary, State: api.OperatorRolloutClusterStateUpdating}},
+					},
+					{Percentage: 100, State: api.OperatorRolloutWaveStatePending},
+				},
+			},
+			wantState: api.OperatorRolloutStateHalted,
+			wantClusters: map[string]api.OperatorRolloutClusterState{
+				canary: api.OperatorRolloutClusterStateDegraded,
+			},
+		},
+		{
+			name: "clusters started by another RP are updating",
+			clusters: []*api.OpenShiftClusterDocument{
+				operatorUpdating,
+			},
+			rollout: api.OperatorRolloutProperties{
+				Waves: []api.OperatorRolloutWave{
+					{
+						Percentage: 100,
+						State:      api.OperatorRolloutWaveStateProgressing,
+						Clusters:   []api.OperatorRolloutCluster{{Key: canary, State: api.OperatorRolloutClusterStateStarting, StartTime: &recently}},
+					},
+				},
+			},
+			wantState: api.OperatorRolloutStateProgressing,
+			wantClusters: map[string]api.OperatorRolloutClusterState{
+				canary: api.OperatorRolloutClusterStateUpdating,
+			},
+		},
+		{
+			name: "clusters being started by another RP are left alone",
+			clusters: []*api.OpenShiftClusterDocument{
+				clusterDoc(canary, api.ProvisioningStateSucceeded, ""),
+			},
+			rollout: api.OperatorRolloutProperties{
+				Waves: []api.OperatorRolloutWave{
+					{
+						Percentage: 100,
+						State:      api.OperatorRolloutWaveStateProgressing,
+						Clusters:   []api.OperatorRolloutCluster{{Key: canary, State: api.OperatorRolloutClusterStateStarting, StartTime: &recently}},
+					},
+				},
+			},
+			wantState: api.OperatorRolloutStateProgressing,
+			wantClusters: map[string]api.OperatorRolloutClusterState{
+				canary: api.OperatorRolloutClusterStateStarting,
+			},
+			wantNotUpdating: []string{canary},
+		},
+		{
+			name: "clusters not started in time are started again",
+			clusters: []*api.OpenShiftClusterDocument{
+				clusterDoc(canary, api.ProvisioningStateSucceeded, ""),
+			},
+			rollout: api.OperatorRolloutProperties{
+				Waves: []api.OperatorRolloutWave{
+					{
+						Percentage: 100,
+						State:      api.OperatorRolloutWaveStateProgressing,
+						Clusters:   []api.OperatorRolloutCluster{{Key: canary, State: api.OperatorRolloutClusterStateStarting, StartTime: &longAgo}},
+					},
+				},
+			},
+			wantState: api.OperatorRolloutStateProgressing,
+			wantClusters: map[string]api.OperatorRolloutClusterState{
+				canary: api.OperatorRolloutClusterStateUpdating,
+			},
+			wantUpdating: []string{canary},
+		},
+		{
+			name: "clusters started by another RP whose update finished are not started again",
+			clusters: []*api.OpenShiftClusterDocument{
+				operatorUpdated,
+			},
+			rollout: api.OperatorRolloutProperties{
+				Waves: []api.OperatorRolloutWave{
+					{
+						Percentage: 100,
+						State:      api.OperatorRolloutWaveStateProgressing,
+						Clusters:   []api.OperatorRolloutCluster{{Key: canary, State: api.OperatorRolloutClusterStateStarting, StartTime: &longAgo}},
+					},
+				},
+			},
+			wantState: api.OperatorRolloutStateCompleted,
+			wantClusters: map[string]api.OperatorRolloutClusterState{
+				canary: api.OperatorRolloutClusterStateSucceeded,
+			},
+			wantNotUpdating: []string{canary},
+		},
+		{
+			name: "clusters started by another RP whose update failed are degraded",
+			clusters: []*api.OpenShiftClusterDocument{
+				operatorUpdateFailed,
+			},
+			rollout: api.OperatorRolloutProperties{
+				Waves: []api.OperatorRolloutWave{
+					{
+						Percentage: 100,
+						State:      api.OperatorRolloutWaveStateProgressing,
+						Clusters:   []api.OperatorRolloutCluster{{Key: canary, State: api.OperatorRolloutClusterStateStarting, StartTime: &longAgo}},
+					},
+				},
+			},
+			wantState: api.OperatorRolloutStateHalted,
+			wantClusters: map[string]api.OperatorRolloutClusterState{
+				canary: api.OperatorRolloutClusterStateDegraded,
+			},
+			wantNotUpdating: []string{canary},
+		},
+		{
+			name: "clusters busy with the update of another rollout are started again",
+			clusters: []*api.OpenShiftClusterDocument{
+				otherUpdating,
+			},
+			rollout: api.OperatorRolloutProperties{
+				Waves: []api.OperatorRolloutWave{
+					{
+						Percentage: 100,
+						State:      api.OperatorRolloutWaveStateProgressing,
+						Clusters:   []api.OperatorRolloutCluster{{Key: canary, State: api.OperatorRolloutClusterStateStarting, StartTime: &longAgo}},
+					},
+				},
+			},
+			wantState: api.OperatorRolloutStateProgressing,
+			wantClusters: map[string]api.OperatorRolloutClusterState{
+				canary: api.OperatorRolloutClusterStatePending,
+			},
+		},
+		{
+			name: "clusters still updating hold the wave",
+			clusters: []*api.OpenShiftClusterDocument{
+				clusterDoc(canary, api.ProvisioningStateAdminUpdating, ""),
+			},
+			rollout: api.OperatorRolloutProperties{
+				Waves: []api.OperatorRolloutWave{
+					{
+						Percentage: 100,
+						State:      api.OperatorRolloutWaveStateProgressing,
+						Clusters:   []api.OperatorRolloutCluster{{Key: canary, State: api.OperatorRolloutClusterStateUpdating}},
+					},
+				},
+			},
+			wantState: api.OperatorRolloutStateProgressing,
+			wantClusters: map[string]api.OperatorRolloutClusterState{
+				canary: api.OperatorRolloutClusterStateUpdating,
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			log := logrus.NewEntry(logrus.StandardLogger())
+
+			dbOpenShiftClusters, _ := testdatabase.NewFakeOpenShiftClusters()
+			dbOperatorRollouts, _ := testdatabase.NewFakeOperatorRollouts(deterministicuuid.NewTestUUIDGenerator(deterministicuuid.OPERATOR_ROLLOUTS))
+
+			f := testdatabase.NewFixture().WithOpenShiftClusters(dbOpenShiftClusters)
+			f.AddOpenShiftClusterDocuments(tt.clusters...)
+			err := f.Create()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			tt.rollout.State = api.OperatorRolloutStateProgressing
+			_, err = dbOperatorRollouts.Create(ctx, &api.OperatorRolloutDocument{
+				ID:              "rollout",
+				OperatorRollout: &api.OperatorRollout{ID: "rollout", Properties: tt.rollout},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rb := newOperatorRolloutBackend(&backend{
+				baseLog:             log,
+				dbOpenShiftClusters: dbOpenShiftClusters,
+				dbOperatorRollouts:  dbOperatorRollouts,
+				m:                   &noop.Noop{},
+			})
+
+			err = rb.tick(ctx, log, "rollout")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			doc, err := dbOperatorRollouts.Get(ctx, "rollout")
+			if err != nil {
+				t.Fatal(err)
+			}
+			p := doc.OperatorRollout.Properties
+
+			if p.State != tt.wantState {
+				t.Errorf("got state %s, want %s", p.State, tt.wantState)
+			}
+			if p.CurrentWave != tt.wantWave {
+				t.Errorf("got wave %d, want %d", p.CurrentWave, tt.wantWave)
+			}
+
+			got := map[string]api.OperatorRolloutClusterState{}
+			for _, wave := range p.Waves {
+				for _, c := range wave.Clusters {
+					got[c.Key] = c.State
+				}
+			}
+			if len(got) != len(tt.wantClusters) {
+				t.Errorf("got clusters %v, want %v", got, tt.wantClusters)
+			}
+			for key, state := range tt.wantClusters {
+				if got[key] != state {
+					t.Errorf("%s: got state %s, want %s", key, got[key], state)
+				}
+			}
+
+			for _, key := range tt.wantUpdating {
+				clusterDoc, err := dbOpenShiftClusters.Get(ctx, key)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if clusterDoc.OpenShiftCluster.Properties.ProvisioningState != api.ProvisioningStateAdminUpdating ||
+					clusterDoc.OpenShiftCluster.Properties.MaintenanceTask != api.MaintenanceTaskOperator ||
+					clusterDoc.OperatorRolloutID != "rollout" {
+					t.Errorf("%s: got %s/%s/%s", key, clusterDoc.OpenShiftCluster.Properties.ProvisioningState, clusterDoc.OpenShiftCluster.Properties.MaintenanceTask, clusterDoc.OperatorRolloutID)
+				}
+			}
+
+			for _, key := range tt.wantNotUpdating {
+				clusterDoc, err := dbOpenShiftClusters.Get(ctx, key)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if clusterDoc.OpenShiftCluster.Properties.ProvisioningState == api.ProvisioningStateAdminUpdating {
+					t.Errorf("%s: unexpected update", key)
+				}
+			}
+		})
+	}
+}
+
+func TestOperatorRolloutUpdateClusters(t *testing.T) {
+	ctx := context.Background()
+
+	key := "/subscriptions/00000000-0000-0000-0000-000000000001/resourcegroups/resourcegroup/providers/microsoft.redhatopenshift/openshiftclusters/cluster"
+
+	dbOpenShiftClusters, _ := testdatabase.NewFakeOpenShiftClusters()
+
+	f := testdatabase.NewFixture().WithOpenShiftClusters(dbOpenShiftClusters)
+	f.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+		Key: key,
+		OpenShiftCluster: &api.OpenShiftCluster{
+			ID: key,
+			Properties: api.OpenShiftClusterProperties{
+				ProvisioningState: api.ProvisioningStateSucceeded,
+			},
+		},
+	})
+	err := f.Create()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rb := newOperatorRolloutBackend(&backend{
+		dbOpenShiftClusters: dbOpenShiftClusters,
+	})
+
+	wave := &api.OperatorRolloutWave{
+		Clusters: []api.OperatorRolloutCluster{{Key: key, State: api.OperatorRolloutClusterStatePending}},
+	}
+
+	// updateClusters runs in a mutator which may be retried: it must only
+	// select the clusters to start
+	starting, err := rb.updateClusters(ctx, "rollout", wave)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(starting, []string{key}) || wave.Clusters[0].State != api.OperatorRolloutClusterStateStarting {
+		t.Errorf("got starting %v, state %s", starting, wave.Clusters[0].State)
+	}
+
+	doc, err := dbOpenShiftClusters.Get(ctx, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if doc.OpenShiftCluster.Properties.ProvisioningState != api.ProvisioningStateSucceeded {
+		t.Errorf("got %s", doc.OpenShiftCluster.Properties.ProvisioningState)
+	}
+}
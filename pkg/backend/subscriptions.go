@@ -79,7 +79,19 @@ func (sb *subscriptionBackend) handle(ctx context.Context, log *logrus.Entry, do
 	stop := sb.heartbeat(ctx, cancel, log, doc)
 	defer stop()
 
-	done, err := sb.handleDelete(ctx, log, doc)
+	var done bool
+	var err error
+	switch doc.Subscription.State {
+	case api.SubscriptionStateDeleted:
+		done, err = sb.handleDelete(ctx, log, doc)
+	case api.SubscriptionStateSuspended:
+		done, err = sb.handleSuspend(ctx, log, doc)
+	case api.SubscriptionStateRegistered:
+		done, err = sb.handleResume(ctx, log, doc)
+	default:
+		// Warned and Unregistered subscriptions keep their clusters as they are
+		done = true
+	}
 	if err != nil {
 		log.Error(err)
 		return sb.endLease(ctx, stop, doc, false, false)
@@ -93,52 +105,162 @@ func (sb *subscriptionBackend) handle(ctx context.Context, log *logrus.Entry, do
 // caller indicating whether it this is the case - if this is false, the caller
 // should sleep before calling again
 func (sb *subscriptionBackend) handleDelete(ctx context.Context, log *logrus.Entry, subdoc *api.SubscriptionDocument) (bool, error) {
-	// at the time of writing, subscription docs are only enqueued to enable
-	// cascading delete, but for safety let's double-check our state here before
-	// actually deleting anything in case the above assumption ever changes...
+	// subscription docs are also enqueued when they are suspended or
+	// registered again, so for safety let's double-check our state here
+	// before actually deleting anything
 	if subdoc.Subscription.State != api.SubscriptionStateDeleted {
 		return false, fmt.Errorf("handleDelete was called, but subscription is in state %s", subdoc.Subscription.State)
 	}
 
-	i, err := sb.dbOpenShiftClusters.ListByPrefix(subdoc.ID, "/subscriptions/"+subdoc.ID+"/", "")
+	done := true
+	err := sb.patchClusters(ctx, subdoc, func(doc *api.OpenShiftClusterDocument) error {
+		switch doc.OpenShiftCluster.Properties.ProvisioningState {
+		case api.ProvisioningStateCreating,
+			api.ProvisioningStateUpdating,
+			api.ProvisioningStateAdminUpdating:
+			done = false
+		case api.ProvisioningStateDeleting:
+			// nothing to do
+		case api.ProvisioningStateSucceeded,
+			api.ProvisioningStateFailed:
+			doc.OpenShiftCluster.Properties.ProvisioningState = api.ProvisioningStateDeleting
+		default:
+			return fmt.Errorf("unexpected provisioningState %q", doc.OpenShiftCluster.Properties.ProvisioningState)
+		}
+		return nil
+	})
 	if err != nil {
 		return false, err
 	}
 
+	return done, nil
+}
+
+// handleSuspend ensures that all the clusters in a subscription which is
+// suspended have had their VMs deallocated and their billing stopped.  The
+// clusters are admin updated with the SubscriptionSuspend maintenance task,
+// which records what it stopped on the cluster document.  It returns a
+// boolean to the caller indicating whether this is the case.
+func (sb *subscriptionBackend) handleSuspend(ctx context.Context, log *logrus.Entry, subdoc *api.SubscriptionDocument) (bool, error) {
+	if subdoc.Subscription.State != api.SubscriptionStateSuspended {
+		return false, fmt.Errorf("handleSuspend was called, but subscription is in state %s", subdoc.Subscription.State)
+	}
+
 	done := true
+	err := sb.patchClusters(ctx, subdoc, func(doc *api.OpenShiftClusterDocument) error {
+		switch doc.OpenShiftCluster.Properties.ProvisioningState {
+		case api.ProvisioningStateCreating,
+			api.ProvisioningStateUpdating,
+			api.ProvisioningStateAdminUpdating:
+			done = false
+		case api.ProvisioningStateDeleting:
+			// nothing to do
+		case api.ProvisioningStateSucceeded,
+			api.ProvisioningStateFailed:
+			s := doc.OpenShiftCluster.Properties.SubscriptionSuspension
+			if s != nil && s.State == api.SubscriptionSuspensionStateSuspended {
+				return nil
+			}
+
+			// a cluster which failed to install has nothing to stop
+			if doc.OpenShiftCluster.Properties.FailedProvisioningState == api.ProvisioningStateCreating {
+				return nil
+			}
+
+			log.Printf("suspending %s", doc.Key)
+			setSubscriptionMaintenanceTask(doc, api.MaintenanceTaskSubscriptionSuspend)
+			done = false
+		default:
+			return fmt.Errorf("unexpected provisioningState %q", doc.OpenShiftCluster.Properties.ProvisioningState)
+		}
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return done, nil
+}
+
+// handleResume ensures that all the clusters in a subscription which was
+// registered again after being suspended have been restarted, are healthy
+// and are billed again.  The clusters are admin updated with the
+// SubscriptionResume maintenance task.  It returns a boolean to the caller
+// indicating whether this is the case.
+func (sb *subscriptionBackend) handleResume(ctx context.Context, log *logrus.Entry, subdoc *api.SubscriptionDocument) (bool, error) {
+	if subdoc.Subscription.State != api.SubscriptionStateRegistered {
+		return false, fmt.Errorf("handleResume was called, but subscription is in state %s", subdoc.Subscription.State)
+	}
+
+	done := true
+	err := sb.patchClusters(ctx, subdoc, func(doc *api.OpenShiftClusterDocument) error {
+		s := doc.OpenShiftCluster.Properties.SubscriptionSuspension
+		if s == nil || s.State == api.SubscriptionSuspensionStateResumed {
+			return nil
+		}
+
+		switch doc.OpenShiftCluster.Properties.ProvisioningState {
+		case api.ProvisioningStateCreating,
+			api.ProvisioningStateUpdating,
+			api.ProvisioningStateAdminUpdating:
+			done = false
+		case api.ProvisioningStateDeleting:
+			// nothing to do
+		case api.ProvisioningStateSucceeded,
+			api.ProvisioningStateFailed:
+			log.Printf("resuming %s", doc.Key)
+			setSubscriptionMaintenanceTask(doc, api.MaintenanceTaskSubscriptionResume)
+			done = false
+		default:
+			return fmt.Errorf("unexpected provisioningState %q", doc.OpenShiftCluster.Properties.ProvisioningState)
+		}
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return done, nil
+}
+
+// patchClusters patches each cluster in the subscription with f
+func (sb *subscriptionBackend) patchClusters(ctx context.Context, subdoc *api.SubscriptionDocument, f func(*api.OpenShiftClusterDocument) error) error {
+	i, err := sb.dbOpenShiftClusters.ListByPrefix(subdoc.ID, "/subscriptions/"+subdoc.ID+"/", "")
+	if err != nil {
+		return err
+	}
+
 	for {
 		docs, err := i.Next(ctx, -1)
 		if err != nil {
-			return false, err
+			return err
 		}
 		if docs == nil {
-			break
+			return nil
 		}
 
 		for _, doc := range docs.OpenShiftClusterDocuments {
-			_, err = sb.dbOpenShiftClusters.Patch(ctx, doc.Key, func(doc *api.OpenShiftClusterDocument) error {
-				switch doc.OpenShiftCluster.Properties.ProvisioningState {
-				case api.ProvisioningStateCreating,
-					api.ProvisioningStateUpdating,
-					api.ProvisioningStateAdminUpdating:
-					done = false
-				case api.ProvisioningStateDeleting:
-					// nothing to do
-				case api.ProvisioningStateSucceeded,
-					api.ProvisioningStateFailed:
-					doc.OpenShiftCluster.Properties.ProvisioningState = api.ProvisioningStateDeleting
-				default:
-					return fmt.Errorf("unexpected provisioningState %q", doc.OpenShiftCluster.Properties.ProvisioningState)
-				}
-				return nil
-			})
+			_, err = sb.dbOpenShiftClusters.Patch(ctx, doc.Key, f)
 			if err != nil {
-				return false, err
+				return err
 			}
 		}
 	}
+}
 
-	return done, nil
+// setSubscriptionMaintenanceTask starts an admin update of the cluster with
+// the given maintenance task
+func setSubscriptionMaintenanceTask(doc *api.OpenShiftClusterDocument, task api.MaintenanceTask) {
+	doc.OpenShiftCluster.Properties.LastProvisioningState = doc.OpenShiftCluster.Properties.ProvisioningState
+	doc.OpenShiftCluster.Properties.ProvisioningState = api.ProvisioningStateAdminUpdating
+	doc.OpenShiftCluster.Properties.MaintenanceTask = task
+	if doc.OpenShiftCluster.Properties.MaintenanceState == api.MaintenanceStatePending {
+		doc.OpenShiftCluster.Properties.MaintenanceState = api.MaintenanceStatePlanned
+	} else {
+		doc.OpenShiftCluster.Properties.MaintenanceState = api.MaintenanceStateUnplanned
+	}
+	doc.OpenShiftCluster.Properties.LastAdminUpdateError = ""
+	doc.Dequeues = 0
 }
 
 func (sb *subscriptionBackend) heartbeat(ctx context.Context, cancel context.CancelFunc, log *logrus.Entry, doc *api.SubscriptionDocument) func() {
@@ -183,6 +305,6 @@ func (sb *subscriptionBackend) endLease(ctx context.Context, stop func(), doc *a
 		stop()
 	}
 
-	_, err := sb.dbSubscriptions.EndLease(ctx, doc.ID, done, retryLater)
+	_, err := sb.dbSubscriptions.EndLease(ctx, doc.ID, doc.Subscription.State, done, retryLater)
 	return err
 }
@@ -0,0 +1,216 @@
+package backend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/metrics/noop"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+)
+
+func TestSubscriptionLifecycle(t *testing.T) {
+	ctx := context.Background()
+
+	const subscriptionID = "00000000-0000-0000-0000-000000000000"
+
+	key := func(name string) string {
+		return fmt.Sprintf("/subscriptions/%s/resourcegroups/resourcegroup/providers/microsoft.redhatopenshift/openshiftclusters/%s", subscriptionID, name)
+	}
+
+	clusterDoc := func(name string, state api.ProvisioningState, suspension api.SubscriptionSuspensionState) *api.OpenShiftClusterDocument {
+		doc := &api.OpenShiftClusterDocument{
+			Key: key(name),
+			OpenShiftCluster: &api.OpenShiftCluster{
+				ID: key(name),
+				Properties: api.OpenShiftClusterProperties{
+					ProvisioningState: state,
+				},
+			},
+		}
+		if suspension != "" {
+			doc.OpenShiftCluster.Properties.SubscriptionSuspension = &api.SubscriptionSuspension{
+				State: suspension,
+			}
+		}
+		return doc
+	}
+
+	type wantCluster struct {
+		state api.ProvisioningState
+		task  api.MaintenanceTask
+	}
+
+	for _, tt := range []struct {
+		name         string
+		state        api.SubscriptionState
+		changedState api.SubscriptionState
+		clusters     []*api.OpenShiftClusterDocument
+		wantClusters map[string]wantCluster
+		wantPending  bool
+	}{
+		{
+			name:  "suspended subscription suspends its clusters",
+			state: api.SubscriptionStateSuspended,
+			clusters: []*api.OpenShiftClusterDocument{
+				clusterDoc("succeeded", api.ProvisioningStateSucceeded, ""),
+				clusterDoc("resumed", api.ProvisioningStateFailed, api.SubscriptionSuspensionStateResumed),
+				clusterDoc("deleting", api.ProvisioningStateDeleting, ""),
+			},
+			wantClusters: map[string]wantCluster{
+				"succeeded": {state: api.ProvisioningStateAdminUpdating, task: api.MaintenanceTaskSubscriptionSuspend},
+				"resumed":   {state: api.ProvisioningStateAdminUpdating, task: api.MaintenanceTaskSubscriptionSuspend},
+				"deleting":  {state: api.ProvisioningStateDeleting},
+			},
+			wantPending: true,
+		},
+		{
+			name:  "suspended subscription waits for busy clusters",
+			state: api.SubscriptionStateSuspended,
+			clusters: []*api.OpenShiftClusterDocument{
+				clusterDoc("updating", api.ProvisioningStateUpdating, ""),
+				clusterDoc("suspended", api.ProvisioningStateSucceeded, api.SubscriptionSuspensionStateSuspended),
+			},
+			wantClusters: map[string]wantCluster{
+				"updating":  {state: api.ProvisioningStateUpdating},
+				"suspended": {state: api.ProvisioningStateSucceeded},
+			},
+			wantPending: true,
+		},
+		{
+			name:  "suspended subscription is done once its clusters are suspended",
+			state: api.SubscriptionStateSuspended,
+			clusters: []*api.OpenShiftClusterDocument{
+				clusterDoc("suspended", api.ProvisioningStateSucceeded, api.SubscriptionSuspensionStateSuspended),
+			},
+			wantClusters: map[string]wantCluster{
+				"suspended": {state: api.ProvisioningStateSucceeded},
+			},
+		},
+		{
+			name:         "subscription registered again while its suspension is handled stays queued",
+			state:        api.SubscriptionStateSuspended,
+			changedState: api.SubscriptionStateRegistered,
+			clusters: []*api.OpenShiftClusterDocument{
+				clusterDoc("suspended", api.ProvisioningStateSucceeded, api.SubscriptionSuspensionStateSuspended),
+			},
+			wantClusters: map[string]wantCluster{
+				"suspended": {state: api.ProvisioningStateSucceeded},
+			},
+			wantPending: true,
+		},
+		{
+			name:  "registered subscription resumes suspended clusters",
+			state: api.SubscriptionStateRegistered,
+			clusters: []*api.OpenShiftClusterDocument{
+				clusterDoc("suspended", api.ProvisioningStateSucceeded, api.SubscriptionSuspensionStateSuspended),
+				clusterDoc("suspending", api.ProvisioningStateFailed, api.SubscriptionSuspensionStateSuspending),
+				clusterDoc("never", api.ProvisioningStateSucceeded, ""),
+			},
+			wantClusters: map[string]wantCluster{
+				"suspended":  {state: api.ProvisioningStateAdminUpdating, task: api.MaintenanceTaskSubscriptionResume},
+				"suspending": {state: api.ProvisioningStateAdminUpdating, task: api.MaintenanceTaskSubscriptionResume},
+				"never":      {state: api.ProvisioningStateSucceeded},
+			},
+			wantPending: true,
+		},
+		{
+			name:  "registered subscription is done once its clusters are resumed",
+			state: api.SubscriptionStateRegistered,
+			clusters: []*api.OpenShiftClusterDocument{
+				clusterDoc("resumed", api.ProvisioningStateSucceeded, api.SubscriptionSuspensionStateResumed),
+			},
+			wantClusters: map[string]wantCluster{
+				"resumed": {state: api.ProvisioningStateSucceeded},
+			},
+		},
+		{
+			name:  "warned subscription leaves its clusters as they are",
+			state: api.SubscriptionStateWarned,
+			clusters: []*api.OpenShiftClusterDocument{
+				clusterDoc("succeeded", api.ProvisioningStateSucceeded, ""),
+			},
+			wantClusters: map[string]wantCluster{
+				"succeeded": {state: api.ProvisioningStateSucceeded},
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			log := logrus.NewEntry(logrus.StandardLogger())
+
+			dbOpenShiftClusters, _ := testdatabase.NewFakeOpenShiftClusters()
+			dbSubscriptions, _ := testdatabase.NewFakeSubscriptions()
+
+			f := testdatabase.NewFixture().
+				WithOpenShiftClusters(dbOpenShiftClusters).
+				WithSubscriptions(dbSubscriptions)
+			f.AddOpenShiftClusterDocuments(tt.clusters...)
+			f.AddSubscriptionDocuments(&api.SubscriptionDocument{
+				ID:               subscriptionID,
+				LifecyclePending: true,
+				Subscription: &api.Subscription{
+					State: tt.state,
+				},
+			})
+			err := f.Create()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			sb := newSubscriptionBackend(&backend{
+				baseLog:             log,
+				dbOpenShiftClusters: dbOpenShiftClusters,
+				dbSubscriptions:     dbSubscriptions,
+				m:                   &noop.Noop{},
+			})
+
+			doc, err := dbSubscriptions.Dequeue(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if tt.changedState != "" {
+				// the subscription PUT lands while the backend holds the
+				// lease, after it has read the document
+				changed := *doc
+				changed.Subscription = &api.Subscription{State: tt.changedState}
+				_, err = dbSubscriptions.Update(ctx, &changed)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			err = sb.handle(ctx, log, doc)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			doc, err = dbSubscriptions.Get(ctx, subscriptionID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if doc.LifecyclePending != tt.wantPending {
+				t.Errorf("got lifecyclePending %v, want %v", doc.LifecyclePending, tt.wantPending)
+			}
+
+			for name, want := range tt.wantClusters {
+				clusterDoc, err := dbOpenShiftClusters.Get(ctx, key(name))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if clusterDoc.OpenShiftCluster.Properties.ProvisioningState != want.state ||
+					clusterDoc.OpenShiftCluster.Properties.MaintenanceTask != want.task {
+					t.Errorf("%s: got %s/%s, want %s/%s", name,
+						clusterDoc.OpenShiftCluster.Properties.ProvisioningState, clusterDoc.OpenShiftCluster.Properties.MaintenanceTask,
+						want.state, want.task)
+				}
+			}
+		})
+	}
+}
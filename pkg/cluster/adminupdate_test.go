@@ -53,9 +53,11 @@ func TestAdminUpdateSteps(t *testing.T) {
 				"[Action startVMs-fm]",
 				"[Condition apiServersReady-fm, timeout 30m0s]",
 				"[Action initializeOperatorDeployer-fm]",
+				"[Action recordAROOperatorHealth-fm]",
 				"[Action ensureAROOperator-fm]",
 				"[Condition aroDeploymentReady-fm, timeout 20m0s]",
 				"[Condition ensureAROOperatorRunningDesiredVersion-fm, timeout 5m0s]",
+				"[Condition aroOperatorHealthy-fm, timeout 10m0s]",
 			},
 		},
 		{
@@ -96,9 +98,11 @@ func TestAdminUpdateSteps(t *testing.T) {
 				"[Action startVMs-fm]",
 				"[Condition apiServersReady-fm, timeout 30m0s]",
 				"[Action initializeOperatorDeployer-fm]",
+				"[Action recordAROOperatorHealth-fm]",
 				"[Action ensureAROOperator-fm]",
 				"[Condition aroDeploymentReady-fm, timeout 20m0s]",
 				"[Condition ensureAROOperatorRunningDesiredVersion-fm, timeout 5m0s]",
+				"[Condition aroOperatorHealthy-fm, timeout 10m0s]",
 			},
 		},
 		{
@@ -136,6 +140,7 @@ func TestAdminUpdateSteps(t *testing.T) {
 				"[Action populateRegistryStorageAccountName-fm]",
 				"[Action ensureMTUSize-fm]",
 				"[Action initializeOperatorDeployer-fm]",
+				"[Action updateCertificateInventory-fm]",
 				"[Action ensureAROOperator-fm]",
 				"[Condition aroDeploymentReady-fm, timeout 20m0s]",
 				"[Condition ensureAROOperatorRunningDesiredVersion-fm, timeout 5m0s]",
@@ -182,6 +187,7 @@ func TestAdminUpdateSteps(t *testing.T) {
 				"[Action populateRegistryStorageAccountName-fm]",
 				"[Action ensureMTUSize-fm]",
 				"[Action initializeOperatorDeployer-fm]",
+				"[Action updateCertificateInventory-fm]",
 				"[Action hiveCreateNamespace-fm]",
 				"[Action hiveEnsureResources-fm]",
 				"[Condition hiveClusterDeploymentReady-fm, timeout 5m0s]",
@@ -224,6 +230,7 @@ func TestAdminUpdateSteps(t *testing.T) {
 				"[Action populateRegistryStorageAccountName-fm]",
 				"[Action ensureMTUSize-fm]",
 				"[Action initializeOperatorDeployer-fm]",
+				"[Action updateCertificateInventory-fm]",
 				"[Action ensureAROOperator-fm]",
 				"[Condition aroDeploymentReady-fm, timeout 20m0s]",
 				"[Condition ensureAROOperatorRunningDesiredVersion-fm, timeout 5m0s]",
@@ -265,6 +272,7 @@ func TestAdminUpdateSteps(t *testing.T) {
 				"[Action populateRegistryStorageAccountName-fm]",
 				"[Action ensureMTUSize-fm]",
 				"[Action initializeOperatorDeployer-fm]",
+				"[Action updateCertificateInventory-fm]",
 				"[Action ensureAROOperator-fm]",
 				"[Condition aroDeploymentReady-fm, timeout 20m0s]",
 				"[Condition ensureAROOperatorRunningDesiredVersion-fm, timeout 5m0s]",
@@ -298,6 +306,102 @@ func TestAdminUpdateSteps(t *testing.T) {
 				"[Action configureIngressCertificate-fm]",
 				"[Action initializeOperatorDeployer-fm]",
 				"[Action renewMDSDCertificate-fm]",
+				"[Action updateCertificateInventory-fm]",
+			},
+		},
+		{
+			name: "OpenShift upgrade",
+			fixture: func() (*api.OpenShiftClusterDocument, bool) {
+				doc := baseClusterDoc()
+				doc.OpenShiftCluster.Properties.ProvisioningState = api.ProvisioningStateAdminUpdating
+				doc.OpenShiftCluster.Properties.MaintenanceTask = api.MaintenanceTaskUpgrade
+				return doc, true
+			},
+			shouldRunSteps: []string{
+				"[Action initializeKubernetesClients-fm]",
+				"[Action ensureBillingRecord-fm]",
+				"[Action ensureDefaults-fm]",
+				"[AuthorizationRetryingAction fixupClusterSPObjectID-fm]",
+				"[Action fixInfraID-fm]",
+				"[Action startVMs-fm]",
+				"[Condition apiServersReady-fm, timeout 30m0s]",
+				"[Action validateUpgrade-fm]",
+				"[Action upgradeHealthGates-fm]",
+				"[Action setDesiredUpdate-fm]",
+				"[Condition upgradeCompleted-fm, timeout 4h0m0s]",
+				"[Action finishUpgrade-fm]",
+			},
+		},
+		{
+			name: "Subscription suspension",
+			fixture: func() (*api.OpenShiftClusterDocument, bool) {
+				doc := baseClusterDoc()
+				doc.OpenShiftCluster.Properties.ProvisioningState = api.ProvisioningStateAdminUpdating
+				doc.OpenShiftCluster.Properties.MaintenanceTask = api.MaintenanceTaskSubscriptionSuspend
+				return doc, true
+			},
+			shouldRunSteps: []string{
+				"[Action startSubscriptionSuspension-fm]",
+				"[Action deallocateVMs-fm]",
+				"[Action stopBilling-fm]",
+			},
+		},
+		{
+			name: "Operator update of a suspended cluster is refused",
+			fixture: func() (*api.OpenShiftClusterDocument, bool) {
+				doc := baseClusterDoc()
+				doc.OpenShiftCluster.Properties.ProvisioningState = api.ProvisioningStateAdminUpdating
+				doc.OpenShiftCluster.Properties.MaintenanceTask = api.MaintenanceTaskOperator
+				doc.OpenShiftCluster.Properties.SubscriptionSuspension = &api.SubscriptionSuspension{
+					State: api.SubscriptionSuspensionStateSuspended,
+				}
+				return doc, true
+			},
+			shouldRunSteps: []string{
+				"[Action refuseSuspendedClusterUpdate-fm]",
+			},
+		},
+		{
+			name: "Upgrade of a resumed cluster",
+			fixture: func() (*api.OpenShiftClusterDocument, bool) {
+				doc := baseClusterDoc()
+				doc.OpenShiftCluster.Properties.ProvisioningState = api.ProvisioningStateAdminUpdating
+				doc.OpenShiftCluster.Properties.MaintenanceTask = api.MaintenanceTaskUpgrade
+				doc.OpenShiftCluster.Properties.SubscriptionSuspension = &api.SubscriptionSuspension{
+					State: api.SubscriptionSuspensionStateResumed,
+				}
+				return doc, true
+			},
+			shouldRunSteps: []string{
+				"[Action initializeKubernetesClients-fm]",
+				"[Action ensureBillingRecord-fm]",
+				"[Action ensureDefaults-fm]",
+				"[AuthorizationRetryingAction fixupClusterSPObjectID-fm]",
+				"[Action fixInfraID-fm]",
+				"[Action startVMs-fm]",
+				"[Condition apiServersReady-fm, timeout 30m0s]",
+				"[Action validateUpgrade-fm]",
+				"[Action upgradeHealthGates-fm]",
+				"[Action setDesiredUpdate-fm]",
+				"[Condition upgradeCompleted-fm, timeout 4h0m0s]",
+				"[Action finishUpgrade-fm]",
+			},
+		},
+		{
+			name: "Subscription resumption",
+			fixture: func() (*api.OpenShiftClusterDocument, bool) {
+				doc := baseClusterDoc()
+				doc.OpenShiftCluster.Properties.ProvisioningState = api.ProvisioningStateAdminUpdating
+				doc.OpenShiftCluster.Properties.MaintenanceTask = api.MaintenanceTaskSubscriptionResume
+				return doc, true
+			},
+			shouldRunSteps: []string{
+				"[Action initializeKubernetesClients-fm]",
+				"[Action startSubscriptionResumption-fm]",
+				"[Action startDeallocatedVMs-fm]",
+				"[Condition apiServersReady-fm, timeout 30m0s]",
+				"[Condition clusterHealthy-fm, timeout 30m0s]",
+				"[Action resumeBilling-fm]",
 			},
 		},
 		{
@@ -337,6 +441,7 @@ func TestAdminUpdateSteps(t *testing.T) {
 				"[Action populateRegistryStorageAccountName-fm]",
 				"[Action ensureMTUSize-fm]",
 				"[Action initializeOperatorDeployer-fm]",
+				"[Action updateCertificateInventory-fm]",
 				"[Action ensureAROOperator-fm]",
 				"[Condition aroDeploymentReady-fm, timeout 20m0s]",
 				"[Condition ensureAROOperatorRunningDesiredVersion-fm, timeout 5m0s]",
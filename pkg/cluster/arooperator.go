@@ -7,7 +7,12 @@ import (
 	"context"
 
 	cloudcredentialv1 "github.com/openshift/cloud-credential-operator/pkg/apis/cloudcredential/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/util/conditions"
 )
 
 var (
@@ -61,6 +66,55 @@ func (m *manager) ensureAROOperatorRunningDesiredVersion(ctx context.Context) (b
 	return true, nil
 }
 
+// recordAROOperatorHealth records the operator conditions which are unhealthy
+// before the operator is updated, so that aroOperatorHealthy only considers
+// the conditions made unhealthy by the update
+func (m *manager) recordAROOperatorHealth(ctx context.Context) error {
+	m.aroOperatorUnhealthyConditions = map[string]bool{}
+
+	cluster, err := m.arocli.AroV1alpha1().Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+	if kerrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, c := range conditions.Unhealthy(cluster.Status.Conditions) {
+		m.log.Infof("ARO operator condition %s is already %s: %s", c.Type, c.Status, c.Message)
+		m.aroOperatorUnhealthyConditions[c.Type] = true
+	}
+
+	return nil
+}
+
+// aroOperatorHealthy returns true once the updated operator reports no
+// unhealthy condition, other than those which were already unhealthy before
+// the update.  The conditions are those which the monitor reports on.
+func (m *manager) aroOperatorHealthy(ctx context.Context) (bool, error) {
+	if !m.isIngressProfileAvailable() {
+		// If the ingress profile is not available, ARO operator update/deploy will fail.
+		m.log.Error("skip aroOperatorHealthy")
+		return true, nil
+	}
+
+	cluster, err := m.arocli.AroV1alpha1().Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+	if err != nil {
+		return false, err
+	}
+
+	healthy := true
+	for _, c := range conditions.Unhealthy(cluster.Status.Conditions) {
+		if m.aroOperatorUnhealthyConditions[c.Type] {
+			continue
+		}
+		m.log.Infof("ARO operator condition %s is %s: %s", c.Type, c.Status, c.Message)
+		healthy = false
+	}
+
+	return healthy, nil
+}
+
 func (m *manager) ensureCredentialsRequest(ctx context.Context) error {
 	return m.aroOperatorDeployer.CreateOrUpdateCredentialsRequest(ctx)
 }
@@ -69,6 +123,24 @@ func (m *manager) renewMDSDCertificate(ctx context.Context) error {
 	return m.aroOperatorDeployer.RenewMDSDCertificate(ctx)
 }
 
+func (m *manager) updateClusterAutoscaler(ctx context.Context) error {
+	if !m.isIngressProfileAvailable() {
+		// If the ingress profile is not available, ARO operator update/deploy will fail.
+		m.log.Error("skip updateClusterAutoscaler")
+		return nil
+	}
+	return m.aroOperatorDeployer.UpdateAutoscaler(ctx)
+}
+
+func (m *manager) updateClusterCertificates(ctx context.Context) error {
+	if !m.isIngressProfileAvailable() {
+		// If the ingress profile is not available, ARO operator update/deploy will fail.
+		m.log.Error("skip updateClusterCertificates")
+		return nil
+	}
+	return m.aroOperatorDeployer.UpdateCertificates(ctx)
+}
+
 func (m *manager) restartAROOperatorMaster(ctx context.Context) error {
 	return m.aroOperatorDeployer.Restart(ctx, []string{"aro-operator-master"})
 }
@@ -10,9 +10,14 @@ import (
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	operatorv1 "github.com/openshift/api/operator/v1"
 	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kruntime "k8s.io/apimachinery/pkg/runtime"
 
 	"github.com/Azure/ARO-RP/pkg/api"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	arofake "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/fake"
 	mock_deploy "github.com/Azure/ARO-RP/pkg/util/mocks/operator/deploy"
 	utilerror "github.com/Azure/ARO-RP/test/util/error"
 )
@@ -318,3 +323,97 @@ func TestEnsureAROOperatorRunningDesiredVersion(t *testing.T) {
 		})
 	}
 }
+
+func TestAROOperatorHealthy(t *testing.T) {
+	ctx := context.Background()
+
+	const (
+		key = "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/resourceGroup/providers/Microsoft.RedHatOpenShift/openShiftClusters/resourceName1"
+	)
+
+	doc := &api.OpenShiftClusterDocument{
+		Key: strings.ToLower(key),
+		OpenShiftCluster: &api.OpenShiftCluster{
+			ID: key,
+			Properties: api.OpenShiftClusterProperties{
+				IngressProfiles: []api.IngressProfile{
+					{
+						Visibility: api.VisibilityPublic,
+						Name:       "default",
+					},
+				},
+			},
+		},
+	}
+
+	cluster := func(conds ...operatorv1.OperatorCondition) *arov1alpha1.Cluster {
+		return &arov1alpha1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: arov1alpha1.SingletonClusterName},
+			Status: arov1alpha1.ClusterStatus{
+				Conditions: conds,
+			},
+		}
+	}
+
+	internetReachable := operatorv1.OperatorCondition{Type: arov1alpha1.InternetReachableFromWorker, Status: operatorv1.ConditionTrue}
+	internetUnreachable := operatorv1.OperatorCondition{Type: arov1alpha1.InternetReachableFromWorker, Status: operatorv1.ConditionFalse}
+	machineDegraded := operatorv1.OperatorCondition{Type: "MachineControllerDegraded", Status: operatorv1.ConditionTrue}
+	machineNotDegraded := operatorv1.OperatorCondition{Type: "MachineControllerDegraded", Status: operatorv1.ConditionFalse}
+
+	for _, tt := range []struct {
+		name    string
+		before  *arov1alpha1.Cluster
+		after   *arov1alpha1.Cluster
+		wantRes bool
+	}{
+		{
+			name:    "healthy",
+			before:  cluster(internetReachable, machineNotDegraded),
+			after:   cluster(internetReachable, machineNotDegraded),
+			wantRes: true,
+		},
+		{
+			name:    "newly unhealthy",
+			before:  cluster(internetReachable, machineNotDegraded),
+			after:   cluster(internetReachable, machineDegraded),
+			wantRes: false,
+		},
+		{
+			name:    "already unhealthy before the update",
+			before:  cluster(internetUnreachable, machineNotDegraded),
+			after:   cluster(internetUnreachable, machineNotDegraded),
+			wantRes: true,
+		},
+		{
+			name:    "first install of the operator",
+			after:   cluster(internetUnreachable),
+			wantRes: false,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var objects []kruntime.Object
+			if tt.before != nil {
+				objects = append(objects, tt.before)
+			}
+			arocli := arofake.NewSimpleClientset(objects...)
+
+			m := &manager{
+				log:    logrus.NewEntry(logrus.StandardLogger()),
+				doc:    doc,
+				arocli: arocli,
+			}
+
+			err := m.recordAROOperatorHealth(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			m.arocli = arofake.NewSimpleClientset(tt.after)
+
+			ok, err := m.aroOperatorHealthy(ctx)
+			if err != nil || ok != tt.wantRes {
+				t.Errorf("got %v, %v", ok, err)
+			}
+		})
+	}
+}
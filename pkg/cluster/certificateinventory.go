@@ -0,0 +1,46 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"time"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/util/certinventory"
+)
+
+// updateCertificateInventory inventories the cluster certificates and records
+// when those which the RP renews are next due, so that the backend starts a
+// CertificatesRenewal admin update in time.  Certificates which have to be
+// renewed by an SRE are logged once due.
+func (m *manager) updateCertificateInventory(ctx context.Context) error {
+	inv, err := certinventory.List(ctx, m.kubernetescli, m.doc)
+	if err != nil {
+		return err
+	}
+
+	now := m.now().UTC()
+
+	for _, c := range inv.Certificates {
+		if c.RenewalPolicy == api.CertificateRenewalPolicyManual && !now.Before(*c.RenewAfter) {
+			m.log.Warnf("%s certificate %s/%s expires at %s and must be renewed by an SRE", c.Kind, c.Namespace, c.SecretName, c.NotAfter.Format(time.RFC3339))
+		}
+	}
+
+	dueTime := certinventory.DueTime(inv)
+
+	m.doc, err = m.db.PatchWithLease(ctx, m.doc.Key, func(doc *api.OpenShiftClusterDocument) error {
+		cr := doc.OpenShiftCluster.Properties.CertificateRenewal
+		if cr == nil {
+			cr = &api.CertificateRenewal{}
+			doc.OpenShiftCluster.Properties.CertificateRenewal = cr
+		}
+
+		cr.InventoryTime = &now
+		cr.DueTime = dueTime
+		return nil
+	})
+	return err
+}
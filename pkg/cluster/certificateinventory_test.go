@@ -0,0 +1,153 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	utiltls "github.com/Azure/ARO-RP/pkg/util/tls"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+	utilerror "github.com/Azure/ARO-RP/test/util/error"
+)
+
+func TestUpdateCertificateInventory(t *testing.T) {
+	ctx := context.Background()
+	key := "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/resourceGroup/providers/Microsoft.RedHatOpenShift/openShiftClusters/resourceName"
+	now := time.Unix(1000, 0).UTC()
+
+	secret := func(namespace, name, key string, notAfter time.Time) *corev1.Secret {
+		_, certs, err := utiltls.GenerateTestKeyAndCertificate(name, nil, nil, false, false, func(template *x509.Certificate) {
+			template.NotAfter = notAfter
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+			},
+			Type: corev1.SecretTypeTLS,
+			Data: map[string][]byte{
+				key: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certs[0].Raw}),
+			},
+		}
+	}
+
+	mdsdNotAfter := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	mcsNotAfter := time.Date(2035, 1, 1, 0, 0, 0, 0, time.UTC)
+	mcsDueTime := mcsNotAfter.Add(-180 * 24 * time.Hour)
+
+	for _, tt := range []struct {
+		name        string
+		renewal     *api.CertificateRenewal
+		objects     []kruntime.Object
+		wantRenewal *api.CertificateRenewal
+		wantErr     string
+	}{
+		{
+			name: "due time is the earliest RP certificate renewal",
+			objects: []kruntime.Object{
+				secret("openshift-azure-operator", "cluster", "gcscert.pem", mdsdNotAfter),
+				secret("openshift-machine-config-operator", "machine-config-server-tls", corev1.TLSCertKey, mcsNotAfter),
+			},
+			wantRenewal: &api.CertificateRenewal{
+				InventoryTime: &now,
+				DueTime:       &mcsDueTime,
+			},
+		},
+		{
+			name: "MDSD certificate is renewed manually",
+			objects: []kruntime.Object{
+				secret("openshift-azure-operator", "cluster", "gcscert.pem", now),
+			},
+			wantRenewal: &api.CertificateRenewal{
+				InventoryTime: &now,
+			},
+		},
+		{
+			name: "last start time is kept, due time is cleared",
+			renewal: &api.CertificateRenewal{
+				DueTime:       &now,
+				LastStartTime: &now,
+			},
+			wantRenewal: &api.CertificateRenewal{
+				InventoryTime: &now,
+				LastStartTime: &now,
+			},
+		},
+		{
+			name: "invalid certificate",
+			objects: []kruntime.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "cluster",
+						Namespace: "openshift-azure-operator",
+					},
+				},
+			},
+			wantErr: "secret openshift-azure-operator/cluster: unable to find certificate",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeOpenShiftClustersDatabase, _ := testdatabase.NewFakeOpenShiftClusters()
+
+			fixture := testdatabase.NewFixture().
+				WithOpenShiftClusters(fakeOpenShiftClustersDatabase)
+			fixture.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+				ID:  "00000000-0000-0000-0000-000000000000",
+				Key: strings.ToLower(key),
+				OpenShiftCluster: &api.OpenShiftCluster{
+					ID: key,
+					Properties: api.OpenShiftClusterProperties{
+						ProvisioningState: api.ProvisioningStateAdminUpdating,
+						ClusterProfile: api.ClusterProfile{
+							Version: "4.10.20",
+						},
+						CertificateRenewal: tt.renewal,
+					},
+				},
+			})
+
+			err := fixture.Create()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			doc, err := fakeOpenShiftClustersDatabase.Dequeue(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			m := &manager{
+				log:           logrus.NewEntry(logrus.StandardLogger()),
+				doc:           doc,
+				db:            fakeOpenShiftClustersDatabase,
+				kubernetescli: fake.NewSimpleClientset(tt.objects...),
+				now:           func() time.Time { return now },
+			}
+
+			err = m.updateCertificateInventory(ctx)
+			utilerror.AssertErrorMessage(t, err, tt.wantErr)
+
+			if tt.wantErr == "" && !reflect.DeepEqual(m.doc.OpenShiftCluster.Properties.CertificateRenewal, tt.wantRenewal) {
+				t.Errorf("got %#v, wanted %#v", m.doc.OpenShiftCluster.Properties.CertificateRenewal, tt.wantRenewal)
+			}
+		})
+	}
+}
@@ -106,9 +106,14 @@ type manager struct {
 	installViaHive     bool
 	adoptViaHive       bool
 	hiveClusterManager hive.ClusterManager
+	hiveShardManager   hive.ShardManager
 
 	aroOperatorDeployer deploy.Operator
 
+	// aroOperatorUnhealthyConditions are the operator conditions which were
+	// already unhealthy before the operator was updated
+	aroOperatorUnhealthyConditions map[string]bool
+
 	now func() time.Time
 
 	openShiftClusterDocumentVersioner openShiftClusterDocumentVersioner
@@ -188,6 +193,7 @@ func New(ctx context.Context, log *logrus.Entry, _env env.Interface, db database
 		installViaHive:                    installViaHive,
 		adoptViaHive:                      adoptByHive,
 		hiveClusterManager:                hiveClusterManager,
+		hiveShardManager:                  hive.NewShardManager(log, _env),
 		now:                               func() time.Time { return time.Now() },
 		openShiftClusterDocumentVersioner: new(openShiftClusterDocumentVersionerService),
 	}, nil
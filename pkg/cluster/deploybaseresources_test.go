@@ -16,6 +16,7 @@ import (
 
 	mgmtnetwork "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-08-01/network"
 	mgmtfeatures "github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-07-01/features"
+	mgmtstorage "github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-06-01/storage"
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/Azure/go-autorest/autorest/to"
@@ -1394,3 +1395,53 @@ func TestNewPublicLoadBalancer(t *testing.T) {
 		})
 	}
 }
+
+func TestStorageAccountHiveRule(t *testing.T) {
+	for _, tt := range []struct {
+		name       string
+		shardIndex int
+		wantSubnet string
+	}{
+		{
+			name:       "cluster without a persisted shard uses the default shard",
+			wantSubnet: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rp-eastus/providers/Microsoft.Network/virtualNetworks/aks-net/subnets/PodSubnet-001",
+		},
+		{
+			name:       "cluster on another shard",
+			shardIndex: 3,
+			wantSubnet: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rp-eastus/providers/Microsoft.Network/virtualNetworks/aks-net/subnets/PodSubnet-003",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			env := mock_env.NewMockInterface(controller)
+			env.EXPECT().SubscriptionID().AnyTimes().Return("00000000-0000-0000-0000-000000000000")
+			env.EXPECT().ResourceGroup().AnyTimes().Return("rp-eastus")
+			env.EXPECT().IsLocalDevelopmentMode().AnyTimes().Return(true)
+
+			m := &manager{
+				env:            env,
+				installViaHive: true,
+				doc: &api.OpenShiftClusterDocument{
+					OpenShiftCluster: &api.OpenShiftCluster{
+						Properties: api.OpenShiftClusterProperties{
+							HiveProfile: api.HiveProfile{
+								ShardIndex: tt.shardIndex,
+							},
+						},
+					},
+				},
+			}
+
+			r := m.storageAccount("clustersuffix", "eastus", nil, true)
+
+			rules := *r.Resource.(*mgmtstorage.Account).NetworkRuleSet.VirtualNetworkRules
+			got := *rules[len(rules)-1].VirtualNetworkResourceID
+			if got != tt.wantSubnet {
+				t.Errorf("got %s, wanted %s", got, tt.wantSubnet)
+			}
+		})
+	}
+}
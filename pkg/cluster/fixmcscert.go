@@ -10,11 +10,14 @@ import (
 	"fmt"
 	"net"
 	"strings"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/util/retry"
 
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/util/certinventory"
 	"github.com/Azure/ARO-RP/pkg/util/installer"
 	utilpem "github.com/Azure/ARO-RP/pkg/util/pem"
 	"github.com/Azure/ARO-RP/pkg/util/stringutils"
@@ -49,7 +52,8 @@ func (m *manager) fixMCSCert(ctx context.Context) error {
 			return fmt.Errorf("expected 1 certificate, got %d", len(certs))
 		}
 
-		if len(certs[0].IPAddresses) == 1 && certs[0].IPAddresses[0].Equal(intIP) {
+		if len(certs[0].IPAddresses) == 1 && certs[0].IPAddresses[0].Equal(intIP) &&
+			!certinventory.RenewalDue(api.CertificateKindMachineConfigServer, certs[0], time.Now()) {
 			return nil
 		}
 
@@ -11,6 +11,7 @@ import (
 	"encoding/pem"
 	"net"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
@@ -109,6 +110,75 @@ func TestFixMCSCert(t *testing.T) {
 			},
 			wantDeleteCalled: true,
 		},
+		{
+			name: "renewed when due",
+			manager: func(controller *gomock.Controller, deleteCalled *bool) (*manager, error) {
+				b := x509.MarshalPKCS1PrivateKey(validCaKey)
+
+				_, expiringCerts, err := utiltls.GenerateTestKeyAndCertificate("system:machine-config-server", validCaKey, validCaCerts[0], false, false, func(template *x509.Certificate) {
+					template.IPAddresses = []net.IP{net.ParseIP("10.0.0.1")}
+					template.NotAfter = time.Now().Add(30 * 24 * time.Hour)
+				})
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				pg := graph.PersistedGraph{}
+				root := &installer.RootCA{
+					SelfSignedCertKey: installer.SelfSignedCertKey{
+						CertKey: installer.CertKey{
+							CertRaw: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: validCaCerts[0].Raw}),
+							KeyRaw:  pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: b}),
+						},
+					},
+				}
+
+				data, err := json.Marshal(root)
+				if err != nil {
+					return nil, err
+				}
+
+				pg["*tls.RootCA"] = data
+				graph := mock_graph.NewMockManager(controller)
+				graph.EXPECT().LoadPersisted(ctx, "", "cluster").Return(pg, nil)
+
+				kubernetescli := fake.NewSimpleClientset(&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "machine-config-server-tls",
+						Namespace: "openshift-machine-config-operator",
+					},
+					Data: map[string][]byte{
+						corev1.TLSCertKey: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: expiringCerts[0].Raw}),
+					},
+				})
+				kubernetescli.AddReactor("delete-collection", "pods", func(action ktesting.Action) (handled bool, ret kruntime.Object, err error) {
+					if action, ok := action.(ktesting.DeleteCollectionAction); ok {
+						if action.GetListRestrictions().Labels.String() == "k8s-app=machine-config-server" {
+							*deleteCalled = true
+						}
+					}
+					return false, nil, nil
+				})
+
+				return &manager{
+					doc: &api.OpenShiftClusterDocument{
+						OpenShiftCluster: &api.OpenShiftCluster{
+							Properties: api.OpenShiftClusterProperties{
+								ClusterProfile: api.ClusterProfile{
+									Domain: "foo.bar",
+								},
+								APIServerProfile: api.APIServerProfile{
+									IntIP: "10.0.0.1",
+								},
+							},
+						},
+					},
+					graph:         graph,
+					kubernetescli: kubernetescli,
+				}, nil
+			},
+			wantDeleteCalled: true,
+		},
 		{
 			name: "noop",
 			manager: func(controller *gomock.Controller, deleteCalled *bool) (*manager, error) {
@@ -5,8 +5,12 @@ package cluster
 
 import (
 	"context"
+	"errors"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 
 	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/hive"
 )
 
 func (m *manager) hiveCreateNamespace(ctx context.Context) error {
@@ -59,3 +63,50 @@ func (m *manager) hiveDeleteResources(ctx context.Context) error {
 
 	return m.hiveClusterManager.Delete(ctx, m.doc)
 }
+
+// hiveMoveShard moves the Hive resources of the cluster to the target shard
+// recorded by the hiveshard admin action.  Each step can be repeated, so a
+// failed move is completed by running the action again.
+func (m *manager) hiveMoveShard(ctx context.Context) error {
+	if m.hiveClusterManager == nil {
+		return errors.New("hive is not enabled")
+	}
+
+	target := m.doc.OpenShiftCluster.Properties.HiveProfile.TargetShardIndex
+	current := hive.ShardIndex(m.doc)
+
+	if target != 0 && target != current {
+		m.log.Printf("moving cluster from Hive shard %d to %d", current, target)
+
+		dst, err := m.hiveShardManager.ForShard(ctx, target)
+		if err != nil {
+			return err
+		}
+
+		_, err = dst.CreateNamespace(ctx, m.doc.ID)
+		if err != nil && !kerrors.IsAlreadyExists(err) {
+			return err
+		}
+
+		err = dst.CreateOrUpdate(ctx, m.subscriptionDoc, m.doc)
+		if err != nil {
+			return err
+		}
+
+		// m.hiveClusterManager is the manager of the current shard
+		err = m.hiveClusterManager.Delete(ctx, m.doc)
+		if err != nil {
+			return err
+		}
+	}
+
+	var err error
+	m.doc, err = m.db.PatchWithLease(ctx, m.doc.Key, func(doc *api.OpenShiftClusterDocument) error {
+		if target != 0 {
+			doc.OpenShiftCluster.Properties.HiveProfile.ShardIndex = target
+		}
+		doc.OpenShiftCluster.Properties.HiveProfile.TargetShardIndex = 0
+		return nil
+	})
+	return err
+}
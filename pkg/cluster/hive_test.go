@@ -13,6 +13,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 
 	"github.com/Azure/ARO-RP/pkg/api"
 	mock_hive "github.com/Azure/ARO-RP/pkg/util/mocks/hive"
@@ -311,3 +312,101 @@ func TestHiveDeleteResources(t *testing.T) {
 		})
 	}
 }
+
+func TestHiveMoveShard(t *testing.T) {
+	ctx := context.Background()
+	for _, tt := range []struct {
+		testName       string
+		shardIndex     int
+		targetIndex    int
+		mocks          func(src, dst *mock_hive.MockClusterManager, shards *mock_hive.MockShardManager, m *manager)
+		noHive         bool
+		wantShardIndex int
+		wantErr        string
+	}{
+		{
+			testName:    "moves the cluster to the target shard",
+			shardIndex:  1,
+			targetIndex: 2,
+			mocks: func(src, dst *mock_hive.MockClusterManager, shards *mock_hive.MockShardManager, m *manager) {
+				shards.EXPECT().ForShard(ctx, 2).Return(dst, nil)
+				dst.EXPECT().CreateNamespace(ctx, m.doc.ID).Return(&corev1.Namespace{}, nil)
+				dst.EXPECT().CreateOrUpdate(ctx, m.subscriptionDoc, m.doc).Return(nil)
+				src.EXPECT().Delete(ctx, m.doc).Return(nil)
+			},
+			wantShardIndex: 2,
+		},
+		{
+			testName:    "reuses an existing namespace on the target shard",
+			shardIndex:  1,
+			targetIndex: 2,
+			mocks: func(src, dst *mock_hive.MockClusterManager, shards *mock_hive.MockShardManager, m *manager) {
+				shards.EXPECT().ForShard(ctx, 2).Return(dst, nil)
+				dst.EXPECT().CreateNamespace(ctx, m.doc.ID).Return(nil, kerrors.NewAlreadyExists(corev1.Resource("namespaces"), "aro-"+m.doc.ID))
+				dst.EXPECT().CreateOrUpdate(ctx, m.subscriptionDoc, m.doc).Return(nil)
+				src.EXPECT().Delete(ctx, m.doc).Return(nil)
+			},
+			wantShardIndex: 2,
+		},
+		{
+			testName:    "keeps the source shard if the target shard fails",
+			shardIndex:  1,
+			targetIndex: 2,
+			mocks: func(src, dst *mock_hive.MockClusterManager, shards *mock_hive.MockShardManager, m *manager) {
+				shards.EXPECT().ForShard(ctx, 2).Return(dst, nil)
+				dst.EXPECT().CreateNamespace(ctx, m.doc.ID).Return(&corev1.Namespace{}, nil)
+				dst.EXPECT().CreateOrUpdate(ctx, m.subscriptionDoc, m.doc).Return(fmt.Errorf("cluster manager error"))
+			},
+			wantErr: "cluster manager error",
+		},
+		{
+			testName:       "already on the target shard",
+			shardIndex:     2,
+			targetIndex:    2,
+			wantShardIndex: 2,
+		},
+		{
+			testName:    "hive is not enabled",
+			shardIndex:  1,
+			targetIndex: 2,
+			noHive:      true,
+			wantErr:     "hive is not enabled",
+		},
+	} {
+		t.Run(tt.testName, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			m := createManagerForTests(t, "aro-00000000-0000-0000-0000-000000000000")
+			m.doc.ID = "00000000-0000-0000-0000-000000000000"
+			m.doc.OpenShiftCluster.Properties.HiveProfile.ShardIndex = tt.shardIndex
+			m.doc.OpenShiftCluster.Properties.HiveProfile.TargetShardIndex = tt.targetIndex
+			m.subscriptionDoc = &api.SubscriptionDocument{}
+
+			src := mock_hive.NewMockClusterManager(controller)
+			dst := mock_hive.NewMockClusterManager(controller)
+			shards := mock_hive.NewMockShardManager(controller)
+			if tt.mocks != nil {
+				tt.mocks(src, dst, shards, m)
+			}
+
+			if !tt.noHive {
+				m.hiveClusterManager = src
+			}
+			m.hiveShardManager = shards
+
+			err := m.hiveMoveShard(ctx)
+			utilerror.AssertErrorMessage(t, err, tt.wantErr)
+			if err != nil {
+				return
+			}
+
+			if m.doc.OpenShiftCluster.Properties.HiveProfile.ShardIndex != tt.wantShardIndex {
+				t.Error(m.doc.OpenShiftCluster.Properties.HiveProfile.ShardIndex)
+			}
+			if m.doc.OpenShiftCluster.Properties.HiveProfile.TargetShardIndex != 0 {
+				t.Error(m.doc.OpenShiftCluster.Properties.HiveProfile.TargetShardIndex)
+			}
+		})
+	}
+}
@@ -47,6 +47,42 @@ func (m *manager) adminUpdate() []steps.Step {
 	isEverything := task == api.MaintenanceTaskEverything || task == ""
 	isOperator := task == api.MaintenanceTaskOperator
 	isRenewCerts := task == api.MaintenanceTaskRenewCerts
+	isUpgrade := task == api.MaintenanceTaskUpgrade
+
+	// Subscription suspension and resumption run on their own: the cluster
+	// of a suspended subscription is deallocated and mustn't be started or
+	// otherwise updated
+	switch task {
+	case api.MaintenanceTaskSubscriptionSuspend:
+		return []steps.Step{
+			steps.Action(m.startSubscriptionSuspension),
+			steps.Action(m.deallocateVMs),
+			steps.Action(m.stopBilling),
+		}
+	case api.MaintenanceTaskSubscriptionResume:
+		return []steps.Step{
+			steps.Action(m.initializeKubernetesClients), // must be first
+			steps.Action(m.startSubscriptionResumption),
+			steps.Action(m.startDeallocatedVMs),
+			steps.Condition(m.apiServersReady, 30*time.Minute, true),
+			steps.Condition(m.clusterHealthy, 30*time.Minute, true),
+			steps.Action(m.resumeBilling),
+		}
+
+	// Moving the Hive resources doesn't touch the cluster itself
+	case api.MaintenanceTaskHiveShardMove:
+		return []steps.Step{
+			steps.Action(m.hiveMoveShard),
+		}
+	}
+
+	// Every other admin update starts the VMs, which would run a suspended
+	// cluster without billing it: only a resumption may do that
+	if m.doc.OpenShiftCluster.Properties.SubscriptionSuspension.IsSuspended() {
+		return []steps.Step{
+			steps.Action(m.refuseSuspendedClusterUpdate),
+		}
+	}
 
 	// Generic fix-up or setup actions that are fairly safe to always take, and
 	// don't require a running cluster
@@ -86,6 +122,17 @@ func (m *manager) adminUpdate() []steps.Step {
 		steps.Condition(m.apiServersReady, 30*time.Minute, true),
 	)
 
+	// OpenShift upgrade, gated on the health of the cluster before it starts
+	if isUpgrade {
+		toRun = append(toRun,
+			steps.Action(m.validateUpgrade),
+			steps.Action(m.upgradeHealthGates),
+			steps.Action(m.setDesiredUpdate),
+			steps.Condition(m.upgradeCompleted, 4*time.Hour, true),
+			steps.Action(m.finishUpgrade),
+		)
+	}
+
 	// Requires Kubernetes clients
 	if isEverything {
 		toRun = append(toRun,
@@ -134,13 +181,32 @@ func (m *manager) adminUpdate() []steps.Step {
 		)
 	}
 
+	// Record when the certificates renewed above are next due
+	if isEverything || isRenewCerts {
+		toRun = append(toRun,
+			steps.Action(m.updateCertificateInventory),
+		)
+	}
+
 	// Update the ARO Operator
 	if (isEverything || isOperator) && m.shouldUpdateOperator() {
+		if isOperator {
+			toRun = append(toRun,
+				steps.Action(m.recordAROOperatorHealth),
+			)
+		}
 		toRun = append(toRun,
 			steps.Action(m.ensureAROOperator),
 			steps.Condition(m.aroDeploymentReady, 20*time.Minute, true),
 			steps.Condition(m.ensureAROOperatorRunningDesiredVersion, 5*time.Minute, true),
 		)
+		// Operator-only updates are what operator rollouts run: gate them on
+		// the operator not reporting any newly unhealthy condition
+		if isOperator {
+			toRun = append(toRun,
+				steps.Condition(m.aroOperatorHealthy, 10*time.Minute, true),
+			)
+		}
 	}
 
 	// Hive cluster adoption and reconciliation
@@ -209,6 +275,10 @@ func (m *manager) Update(ctx context.Context) error {
 		steps.Action(m.restartAROOperatorMaster), // depends on m.updateOpenShiftSecret; the point of restarting is to pick up any changes made to the secret
 		steps.Condition(m.aroDeploymentReady, 5*time.Minute, true),
 		steps.Action(m.reconcileLoadBalancerProfile),
+		steps.Action(m.reconcileWorkerProfiles),
+		steps.Condition(m.workerProfilesReconciled, time.Hour, true),
+		steps.Action(m.updateClusterAutoscaler),
+		steps.Action(m.updateClusterCertificates),
 	}
 
 	if m.adoptViaHive {
@@ -368,6 +438,7 @@ func (m *manager) Install(ctx context.Context) error {
 			steps.Action(m.configureIngressCertificate),
 			steps.Condition(m.ingressControllerReady, 30*time.Minute, true),
 			steps.Action(m.configureDefaultStorageClass),
+			steps.Action(m.updateCertificateInventory),
 			steps.Action(m.finishInstallation),
 		},
 	}
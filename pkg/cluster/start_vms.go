@@ -16,48 +16,16 @@ import (
 // startVMs checks cluster VMs power state and starts deallocated and stopped VMs, if any
 func (m *manager) startVMs(ctx context.Context) error {
 	resourceGroupName := stringutils.LastTokenByte(m.doc.OpenShiftCluster.Properties.ClusterProfile.ResourceGroupID, '/')
-	vms, err := m.virtualMachines.List(ctx, resourceGroupName)
+	vms, err := m.listVMsWithInstanceView(ctx, resourceGroupName)
 	if err != nil {
 		return err
 	}
 
-	{
-		g, groupCtx := errgroup.WithContext(ctx)
-		for i, vm := range vms {
-			i, vm := i, vm // https://golang.org/doc/faq#closures_and_goroutines
-			g.Go(func() (err error) {
-				vms[i], err = m.virtualMachines.Get(groupCtx, resourceGroupName, *vm.Name, mgmtcompute.InstanceView)
-				return
-			})
-		}
-
-		if err := g.Wait(); err != nil {
-			return err
-		}
-	}
-
 	vmsToStart := make([]mgmtcompute.VirtualMachine, 0, len(vms))
 	for _, vm := range vms {
-		if vm.VirtualMachineProperties == nil {
-			continue
-		}
-
-		if vm.VirtualMachineProperties.InstanceView == nil || vm.VirtualMachineProperties.InstanceView.Statuses == nil {
-			continue
-		}
-
-		for _, status := range *vm.VirtualMachineProperties.InstanceView.Statuses {
-			if status.Code == nil {
-				continue
-			}
-
-			// Ref: https://docs.microsoft.com/en-us/azure/virtual-machines/windows/states-lifecycle
-			if strings.HasPrefix(*status.Code, "PowerState") {
-				if *status.Code == "PowerState/deallocated" || *status.Code == "PowerState/stopped" {
-					vmsToStart = append(vmsToStart, vm)
-				}
-				break
-			}
+		powerState := vmPowerState(vm)
+		if powerState == "PowerState/deallocated" || powerState == "PowerState/stopped" {
+			vmsToStart = append(vmsToStart, vm)
 		}
 	}
 
@@ -72,3 +40,48 @@ func (m *manager) startVMs(ctx context.Context) error {
 		return g.Wait()
 	}
 }
+
+// listVMsWithInstanceView returns the VMs of the cluster resource group
+// together with their instance views
+func (m *manager) listVMsWithInstanceView(ctx context.Context, resourceGroupName string) ([]mgmtcompute.VirtualMachine, error) {
+	vms, err := m.virtualMachines.List(ctx, resourceGroupName)
+	if err != nil {
+		return nil, err
+	}
+
+	g, groupCtx := errgroup.WithContext(ctx)
+	for i, vm := range vms {
+		i, vm := i, vm // https://golang.org/doc/faq#closures_and_goroutines
+		g.Go(func() (err error) {
+			vms[i], err = m.virtualMachines.Get(groupCtx, resourceGroupName, *vm.Name, mgmtcompute.InstanceView)
+			return
+		})
+	}
+
+	return vms, g.Wait()
+}
+
+// vmPowerState returns the power state code of a VM fetched with its instance
+// view, e.g. "PowerState/running", or "" if it is not known
+func vmPowerState(vm mgmtcompute.VirtualMachine) string {
+	if vm.VirtualMachineProperties == nil {
+		return ""
+	}
+
+	if vm.VirtualMachineProperties.InstanceView == nil || vm.VirtualMachineProperties.InstanceView.Statuses == nil {
+		return ""
+	}
+
+	for _, status := range *vm.VirtualMachineProperties.InstanceView.Statuses {
+		if status.Code == nil {
+			continue
+		}
+
+		// Ref: https://docs.microsoft.com/en-us/azure/virtual-machines/windows/states-lifecycle
+		if strings.HasPrefix(*status.Code, "PowerState") {
+			return *status.Code
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,211 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/util/azureerrors"
+	"github.com/Azure/ARO-RP/pkg/util/stringutils"
+	"github.com/Azure/ARO-RP/pkg/util/uuid"
+)
+
+// startSubscriptionSuspension records on the cluster document that the
+// cluster is being suspended because its subscription was suspended.  A
+// retried suspension keeps the record of the VMs it already deallocated.
+func (m *manager) startSubscriptionSuspension(ctx context.Context) error {
+	var err error
+	m.doc, err = m.db.PatchWithLease(ctx, m.doc.Key, func(doc *api.OpenShiftClusterDocument) error {
+		s := doc.OpenShiftCluster.Properties.SubscriptionSuspension
+		if s == nil || s.State == api.SubscriptionSuspensionStateResumed {
+			s = &api.SubscriptionSuspension{}
+			doc.OpenShiftCluster.Properties.SubscriptionSuspension = s
+		}
+
+		now := m.now().UTC()
+		s.State = api.SubscriptionSuspensionStateSuspending
+		s.SuspendedAt = &now
+		s.ResumedAt = nil
+		return nil
+	})
+	return err
+}
+
+// deallocateVMs deallocates the cluster VMs which are not already
+// deallocated.  The VMs which were running are recorded on the cluster
+// document before they are deallocated, so that only they are started again
+// on resume.
+func (m *manager) deallocateVMs(ctx context.Context) error {
+	resourceGroupName := stringutils.LastTokenByte(m.doc.OpenShiftCluster.Properties.ClusterProfile.ResourceGroupID, '/')
+	vms, err := m.listVMsWithInstanceView(ctx, resourceGroupName)
+	if err != nil {
+		return err
+	}
+
+	var running, toDeallocate []string
+	for _, vm := range vms {
+		switch vmPowerState(vm) {
+		case "PowerState/deallocated", "PowerState/deallocating":
+		case "PowerState/stopped", "PowerState/stopping":
+			// stopped VMs are still charged for, but they weren't running
+			toDeallocate = append(toDeallocate, *vm.Name)
+		default:
+			running = append(running, *vm.Name)
+			toDeallocate = append(toDeallocate, *vm.Name)
+		}
+	}
+
+	m.doc, err = m.db.PatchWithLease(ctx, m.doc.Key, func(doc *api.OpenShiftClusterDocument) error {
+		s := doc.OpenShiftCluster.Properties.SubscriptionSuspension
+		s.DeallocatedVMs = mergeVMNames(s.DeallocatedVMs, running)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	g, groupCtx := errgroup.WithContext(ctx)
+	for _, name := range toDeallocate {
+		name := name // https://golang.org/doc/faq#closures_and_goroutines
+		g.Go(func() error {
+			m.log.Printf("deallocating VM %s", name)
+			return m.virtualMachines.StopAndWait(groupCtx, resourceGroupName, name, true)
+		})
+	}
+	return g.Wait()
+}
+
+// stopBilling marks the cluster billing record as deleted and records that
+// the cluster is suspended
+func (m *manager) stopBilling(ctx context.Context) error {
+	err := m.billing.Delete(ctx, m.doc)
+	if err != nil {
+		return err
+	}
+
+	m.doc, err = m.db.PatchWithLease(ctx, m.doc.Key, func(doc *api.OpenShiftClusterDocument) error {
+		doc.OpenShiftCluster.Properties.SubscriptionSuspension.BillingStopped = true
+		doc.OpenShiftCluster.Properties.SubscriptionSuspension.State = api.SubscriptionSuspensionStateSuspended
+		return nil
+	})
+	return err
+}
+
+// refuseSuspendedClusterUpdate fails admin updates other than a resumption of
+// a suspended cluster
+func (m *manager) refuseSuspendedClusterUpdate(ctx context.Context) error {
+	return fmt.Errorf("cluster is suspended: only %s is allowed", api.MaintenanceTaskSubscriptionResume)
+}
+
+// startSubscriptionResumption records on the cluster document that the
+// cluster is being resumed because its subscription was registered again
+func (m *manager) startSubscriptionResumption(ctx context.Context) error {
+	var err error
+	m.doc, err = m.db.PatchWithLease(ctx, m.doc.Key, func(doc *api.OpenShiftClusterDocument) error {
+		doc.OpenShiftCluster.Properties.SubscriptionSuspension.State = api.SubscriptionSuspensionStateResuming
+		return nil
+	})
+	return err
+}
+
+// startDeallocatedVMs starts the VMs deallocated by the suspension.  VMs
+// which were deleted in the meantime are skipped.
+func (m *manager) startDeallocatedVMs(ctx context.Context) error {
+	resourceGroupName := stringutils.LastTokenByte(m.doc.OpenShiftCluster.Properties.ClusterProfile.ResourceGroupID, '/')
+
+	g, groupCtx := errgroup.WithContext(ctx)
+	for _, name := range m.doc.OpenShiftCluster.Properties.SubscriptionSuspension.DeallocatedVMs {
+		name := name // https://golang.org/doc/faq#closures_and_goroutines
+		g.Go(func() error {
+			m.log.Printf("starting VM %s", name)
+			err := m.virtualMachines.StartAndWait(groupCtx, resourceGroupName, name)
+			if azureerrors.IsNotFoundError(err) {
+				m.log.Printf("VM %s not found", name)
+				return nil
+			}
+			return err
+		})
+	}
+	return g.Wait()
+}
+
+// clusterHealthy returns true once the cluster operators are available and
+// not degraded and all etcd members are available
+func (m *manager) clusterHealthy(ctx context.Context) (bool, error) {
+	for _, f := range []func(context.Context) ([]string, error){
+		m.unhealthyClusterOperators,
+		m.unhealthyEtcd,
+	} {
+		reasons, err := f(ctx)
+		if err != nil {
+			m.log.Info(err)
+			return false, nil
+		}
+		if len(reasons) > 0 {
+			m.log.Infof("waiting for cluster health: %v", reasons)
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// resumeBilling starts a new billing document for the cluster, if the
+// suspension stopped billing, and records that the cluster is resumed.  The
+// billing document closed by the suspension is kept, so that the usage
+// before the suspension remains billed.
+func (m *manager) resumeBilling(ctx context.Context) error {
+	var err error
+	if m.doc.OpenShiftCluster.Properties.SubscriptionSuspension.BillingStopped {
+		m.doc, err = m.db.PatchWithLease(ctx, m.doc.Key, func(doc *api.OpenShiftClusterDocument) error {
+			doc.BillingID = uuid.DefaultGenerator.Generate()
+			doc.OpenShiftCluster.Properties.SubscriptionSuspension.BillingStopped = false
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// also creates the new billing document if a previous attempt recorded
+	// its ID but failed before creating it
+	err = m.billing.Ensure(ctx, m.doc, m.subscriptionDoc)
+	if err != nil {
+		return err
+	}
+
+	m.doc, err = m.db.PatchWithLease(ctx, m.doc.Key, func(doc *api.OpenShiftClusterDocument) error {
+		now := m.now().UTC()
+		s := doc.OpenShiftCluster.Properties.SubscriptionSuspension
+		s.State = api.SubscriptionSuspensionStateResumed
+		s.ResumedAt = &now
+		s.DeallocatedVMs = nil
+		return nil
+	})
+	return err
+}
+
+func mergeVMNames(a, b []string) []string {
+	names := map[string]struct{}{}
+	for _, name := range append(a, b...) {
+		names[name] = struct{}{}
+	}
+
+	if len(names) == 0 {
+		return nil
+	}
+
+	merged := make([]string, 0, len(names))
+	for name := range names {
+		merged = append(merged, name)
+	}
+	sort.Strings(merged)
+
+	return merged
+}
@@ -0,0 +1,382 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	mgmtcompute "github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-06-01/compute"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/golang/mock/gomock"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	mock_compute "github.com/Azure/ARO-RP/pkg/util/mocks/azureclient/mgmt/compute"
+	mock_billing "github.com/Azure/ARO-RP/pkg/util/mocks/billing"
+	"github.com/Azure/ARO-RP/pkg/util/uuid"
+	uuidfake "github.com/Azure/ARO-RP/pkg/util/uuid/fake"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+	utilerror "github.com/Azure/ARO-RP/test/util/error"
+)
+
+func TestSuspend(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(1000, 0).UTC()
+
+	for _, tt := range []struct {
+		name           string
+		suspension     *api.SubscriptionSuspension
+		vms            []mgmtcompute.VirtualMachine
+		stopErr        error
+		wantDeallocate []string
+		wantErr        string
+		wantSuspension *api.SubscriptionSuspension
+	}{
+		{
+			name: "running and stopped VMs are deallocated, running VMs are recorded",
+			vms: []mgmtcompute.VirtualMachine{
+				{
+					Name: to.StringPtr("master-0"),
+					VirtualMachineProperties: &mgmtcompute.VirtualMachineProperties{
+						InstanceView: &mgmtcompute.VirtualMachineInstanceView{
+							Statuses: &[]mgmtcompute.InstanceViewStatus{
+								{Code: to.StringPtr("ProvisioningState/succeeded")},
+								{Code: to.StringPtr("PowerState/running")},
+							},
+						},
+					},
+				},
+				{
+					Name: to.StringPtr("worker-0"),
+					VirtualMachineProperties: &mgmtcompute.VirtualMachineProperties{
+						InstanceView: &mgmtcompute.VirtualMachineInstanceView{
+							Statuses: &[]mgmtcompute.InstanceViewStatus{
+								{Code: to.StringPtr("ProvisioningState/succeeded")},
+								{Code: to.StringPtr("PowerState/starting")},
+							},
+						},
+					},
+				},
+				{
+					Name: to.StringPtr("worker-1"),
+					VirtualMachineProperties: &mgmtcompute.VirtualMachineProperties{
+						InstanceView: &mgmtcompute.VirtualMachineInstanceView{
+							Statuses: &[]mgmtcompute.InstanceViewStatus{
+								{Code: to.StringPtr("ProvisioningState/succeeded")},
+								{Code: to.StringPtr("PowerState/stopped")},
+							},
+						},
+					},
+				},
+				{
+					Name: to.StringPtr("worker-2"),
+					VirtualMachineProperties: &mgmtcompute.VirtualMachineProperties{
+						InstanceView: &mgmtcompute.VirtualMachineInstanceView{
+							Statuses: &[]mgmtcompute.InstanceViewStatus{
+								{Code: to.StringPtr("ProvisioningState/succeeded")},
+								{Code: to.StringPtr("PowerState/deallocated")},
+							},
+						},
+					},
+				},
+			},
+			wantDeallocate: []string{"master-0", "worker-0", "worker-1"},
+			wantSuspension: &api.SubscriptionSuspension{
+				State:          api.SubscriptionSuspensionStateSuspended,
+				DeallocatedVMs: []string{"master-0", "worker-0"},
+				BillingStopped: true,
+				SuspendedAt:    &now,
+			},
+		},
+		{
+			name: "retried suspension keeps the VMs already deallocated",
+			suspension: &api.SubscriptionSuspension{
+				State:          api.SubscriptionSuspensionStateSuspending,
+				DeallocatedVMs: []string{"master-0"},
+			},
+			vms: []mgmtcompute.VirtualMachine{
+				{
+					Name: to.StringPtr("master-0"),
+					VirtualMachineProperties: &mgmtcompute.VirtualMachineProperties{
+						InstanceView: &mgmtcompute.VirtualMachineInstanceView{
+							Statuses: &[]mgmtcompute.InstanceViewStatus{
+								{Code: to.StringPtr("ProvisioningState/succeeded")},
+								{Code: to.StringPtr("PowerState/deallocated")},
+							},
+						},
+					},
+				},
+				{
+					Name: to.StringPtr("worker-0"),
+					VirtualMachineProperties: &mgmtcompute.VirtualMachineProperties{
+						InstanceView: &mgmtcompute.VirtualMachineInstanceView{
+							Statuses: &[]mgmtcompute.InstanceViewStatus{
+								{Code: to.StringPtr("ProvisioningState/succeeded")},
+								{Code: to.StringPtr("PowerState/running")},
+							},
+						},
+					},
+				},
+			},
+			wantDeallocate: []string{"worker-0"},
+			wantSuspension: &api.SubscriptionSuspension{
+				State:          api.SubscriptionSuspensionStateSuspended,
+				DeallocatedVMs: []string{"master-0", "worker-0"},
+				BillingStopped: true,
+				SuspendedAt:    &now,
+			},
+		},
+		{
+			name: "deallocation fails after recording the VM",
+			vms: []mgmtcompute.VirtualMachine{
+				{
+					Name: to.StringPtr("master-0"),
+					VirtualMachineProperties: &mgmtcompute.VirtualMachineProperties{
+						InstanceView: &mgmtcompute.VirtualMachineInstanceView{
+							Statuses: &[]mgmtcompute.InstanceViewStatus{
+								{Code: to.StringPtr("ProvisioningState/succeeded")},
+								{Code: to.StringPtr("PowerState/running")},
+							},
+						},
+					},
+				},
+			},
+			stopErr:        errors.New("random error"),
+			wantDeallocate: []string{"master-0"},
+			wantErr:        "random error",
+			wantSuspension: &api.SubscriptionSuspension{
+				State:          api.SubscriptionSuspensionStateSuspending,
+				DeallocatedVMs: []string{"master-0"},
+				SuspendedAt:    &now,
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			key := "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/resourceGroup/providers/Microsoft.RedHatOpenShift/openShiftClusters/resourceName"
+
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			openShiftClustersDatabase, _ := testdatabase.NewFakeOpenShiftClusters()
+			fixture := testdatabase.NewFixture().WithOpenShiftClusters(openShiftClustersDatabase)
+			fixture.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+				Key: strings.ToLower(key),
+				OpenShiftCluster: &api.OpenShiftCluster{
+					ID: key,
+					Properties: api.OpenShiftClusterProperties{
+						ProvisioningState: api.ProvisioningStateAdminUpdating,
+						ClusterProfile: api.ClusterProfile{
+							ResourceGroupID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/cluster-rg",
+						},
+						SubscriptionSuspension: tt.suspension,
+					},
+				},
+			})
+			err := fixture.Create()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			doc, err := openShiftClustersDatabase.Dequeue(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			vmClient := mock_compute.NewMockVirtualMachinesClient(controller)
+			billing := mock_billing.NewMockManager(controller)
+
+			m := &manager{
+				log:             logrus.NewEntry(logrus.StandardLogger()),
+				doc:             doc,
+				db:              openShiftClustersDatabase,
+				virtualMachines: vmClient,
+				billing:         billing,
+				subscriptionDoc: &api.SubscriptionDocument{},
+				now:             func() time.Time { return now },
+			}
+
+			vmClient.EXPECT().List(gomock.Any(), "cluster-rg").Return(tt.vms, nil)
+			for _, vm := range tt.vms {
+				vmClient.EXPECT().Get(gomock.Any(), "cluster-rg", *vm.Name, mgmtcompute.InstanceView).Return(vm, nil)
+			}
+			for _, name := range tt.wantDeallocate {
+				vmClient.EXPECT().StopAndWait(gomock.Any(), "cluster-rg", name, true).Return(tt.stopErr)
+			}
+			if tt.wantErr == "" {
+				billing.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(nil)
+			}
+
+			err = m.startSubscriptionSuspension(ctx)
+			if err == nil {
+				err = m.deallocateVMs(ctx)
+			}
+			if err == nil {
+				err = m.stopBilling(ctx)
+			}
+			utilerror.AssertErrorMessage(t, err, tt.wantErr)
+
+			doc, err = m.db.Get(ctx, strings.ToLower(key))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(doc.OpenShiftCluster.Properties.SubscriptionSuspension, tt.wantSuspension) {
+				t.Errorf("got %#v, wanted %#v", doc.OpenShiftCluster.Properties.SubscriptionSuspension, tt.wantSuspension)
+			}
+		})
+	}
+}
+
+func TestResume(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(1000, 0).UTC()
+	suspendedAt := time.Unix(500, 0).UTC()
+
+	for _, tt := range []struct {
+		name           string
+		suspension     *api.SubscriptionSuspension
+		startErr       map[string]error
+		wantEnsure     bool
+		wantBillingID  string
+		wantErr        string
+		wantSuspension *api.SubscriptionSuspension
+	}{
+		{
+			name: "deallocated VMs are started and billing resumed in a new billing document",
+			suspension: &api.SubscriptionSuspension{
+				State:          api.SubscriptionSuspensionStateSuspended,
+				DeallocatedVMs: []string{"master-0", "worker-0"},
+				BillingStopped: true,
+				SuspendedAt:    &suspendedAt,
+			},
+			startErr: map[string]error{
+				"master-0": nil,
+				"worker-0": autorest.DetailedError{StatusCode: http.StatusNotFound},
+			},
+			wantEnsure:    true,
+			wantBillingID: "33333333-3333-3333-3333-333333333333",
+			wantSuspension: &api.SubscriptionSuspension{
+				State:       api.SubscriptionSuspensionStateResumed,
+				SuspendedAt: &suspendedAt,
+				ResumedAt:   &now,
+			},
+		},
+		{
+			name: "billing document is kept if the suspension didn't stop billing",
+			suspension: &api.SubscriptionSuspension{
+				State:          api.SubscriptionSuspensionStateSuspending,
+				DeallocatedVMs: []string{"master-0"},
+				SuspendedAt:    &suspendedAt,
+			},
+			startErr: map[string]error{
+				"master-0": nil,
+			},
+			wantEnsure: true,
+			wantSuspension: &api.SubscriptionSuspension{
+				State:       api.SubscriptionSuspensionStateResumed,
+				SuspendedAt: &suspendedAt,
+				ResumedAt:   &now,
+			},
+		},
+		{
+			name: "VM fails to start",
+			suspension: &api.SubscriptionSuspension{
+				State:          api.SubscriptionSuspensionStateSuspended,
+				DeallocatedVMs: []string{"master-0"},
+				BillingStopped: true,
+			},
+			startErr: map[string]error{
+				"master-0": errors.New("random error"),
+			},
+			wantErr: "random error",
+			wantSuspension: &api.SubscriptionSuspension{
+				State:          api.SubscriptionSuspensionStateResuming,
+				DeallocatedVMs: []string{"master-0"},
+				BillingStopped: true,
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			key := "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/resourceGroup/providers/Microsoft.RedHatOpenShift/openShiftClusters/resourceName"
+
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			openShiftClustersDatabase, _ := testdatabase.NewFakeOpenShiftClusters()
+			fixture := testdatabase.NewFixture().WithOpenShiftClusters(openShiftClustersDatabase)
+			fixture.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+				Key: strings.ToLower(key),
+				OpenShiftCluster: &api.OpenShiftCluster{
+					ID: key,
+					Properties: api.OpenShiftClusterProperties{
+						ProvisioningState: api.ProvisioningStateAdminUpdating,
+						ClusterProfile: api.ClusterProfile{
+							ResourceGroupID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/cluster-rg",
+						},
+						SubscriptionSuspension: tt.suspension,
+					},
+				},
+			})
+			err := fixture.Create()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			doc, err := openShiftClustersDatabase.Dequeue(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			vmClient := mock_compute.NewMockVirtualMachinesClient(controller)
+			billing := mock_billing.NewMockManager(controller)
+
+			m := &manager{
+				log:             logrus.NewEntry(logrus.StandardLogger()),
+				doc:             doc,
+				db:              openShiftClustersDatabase,
+				virtualMachines: vmClient,
+				billing:         billing,
+				subscriptionDoc: &api.SubscriptionDocument{},
+				now:             func() time.Time { return now },
+			}
+			uuid.DefaultGenerator = uuidfake.NewGenerator([]string{"33333333-3333-3333-3333-333333333333"})
+
+			for name, err := range tt.startErr {
+				vmClient.EXPECT().StartAndWait(gomock.Any(), "cluster-rg", name).Return(err)
+			}
+			if tt.wantEnsure {
+				billing.EXPECT().Ensure(gomock.Any(), gomock.Any(), m.subscriptionDoc).Return(nil)
+			}
+
+			err = m.startSubscriptionResumption(ctx)
+			if err == nil {
+				err = m.startDeallocatedVMs(ctx)
+			}
+			if err == nil {
+				err = m.resumeBilling(ctx)
+			}
+			utilerror.AssertErrorMessage(t, err, tt.wantErr)
+
+			doc, err = m.db.Get(ctx, strings.ToLower(key))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(doc.OpenShiftCluster.Properties.SubscriptionSuspension, tt.wantSuspension) {
+				t.Errorf("got %#v, wanted %#v", doc.OpenShiftCluster.Properties.SubscriptionSuspension, tt.wantSuspension)
+			}
+
+			if doc.BillingID != tt.wantBillingID {
+				t.Errorf("got billing ID %q, wanted %q", doc.BillingID, tt.wantBillingID)
+			}
+		})
+	}
+}
@@ -0,0 +1,400 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	configv1 "github.com/openshift/api/config/v1"
+	operatorv1 "github.com/openshift/api/operator/v1"
+	configv1helpers "github.com/openshift/library-go/pkg/config/clusteroperator/v1helpers"
+	operatorv1helpers "github.com/openshift/library-go/pkg/operator/v1helpers"
+	mcv1 "github.com/openshift/machine-config-operator/pkg/apis/machineconfiguration.openshift.io/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/util/ready"
+	"github.com/Azure/ARO-RP/pkg/util/version"
+)
+
+// upgradePausedAnnotation marks the machine config pools the RP paused when
+// an in-progress upgrade failed its health gates, so that only those are
+// unpaused when the upgrade is resumed.
+const upgradePausedAnnotation = "aro.openshift.io/upgrade-paused"
+
+// upgradeTargetVersion returns the enabled OpenShift version matching the
+// desired version of the requested upgrade.
+func (m *manager) upgradeTargetVersion(ctx context.Context) (*api.OpenShiftVersion, error) {
+	upgrade := m.doc.OpenShiftCluster.Properties.Upgrade
+	if upgrade == nil || upgrade.DesiredVersion == "" {
+		return nil, errors.New("no upgrade was requested")
+	}
+
+	docs, err := m.dbOpenShiftVersions.ListAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, doc := range docs.OpenShiftVersionDocuments {
+		if doc.OpenShiftVersion.Properties.Enabled &&
+			doc.OpenShiftVersion.Properties.Version == upgrade.DesiredVersion {
+			return doc.OpenShiftVersion, nil
+		}
+	}
+
+	return nil, fmt.Errorf("the requested OpenShift version '%s' is not supported", upgrade.DesiredVersion)
+}
+
+// upgradeInProgress returns true if the ClusterVersion desired update already
+// points at the requested version, e.g. when the backend picks the upgrade
+// back up after its lease was lost.
+func (m *manager) upgradeInProgress(cv *configv1.ClusterVersion) bool {
+	return cv.Spec.DesiredUpdate != nil &&
+		cv.Spec.DesiredUpdate.Version == m.doc.OpenShiftCluster.Properties.Upgrade.DesiredVersion
+}
+
+// validateUpgrade re-checks the requested upgrade against the version the
+// cluster is actually running, as the database copy can be stale, and against
+// the upgrade graph of the cluster.
+func (m *manager) validateUpgrade(ctx context.Context) error {
+	target, err := m.upgradeTargetVersion(ctx)
+	if err != nil {
+		return err
+	}
+
+	cv, err := m.configcli.ConfigV1().ClusterVersions().Get(ctx, "version", metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	if m.upgradeInProgress(cv) {
+		return nil
+	}
+
+	desired, err := version.ParseVersion(target.Properties.Version)
+	if err != nil {
+		return err
+	}
+
+	return version.ValidateUpgrade(cv, desired)
+}
+
+// upgradeHealthGates checks that the cluster is healthy enough to start an
+// upgrade. If it isn't, the upgrade is paused and the reasons are recorded on
+// the cluster document.
+func (m *manager) upgradeHealthGates(ctx context.Context) error {
+	cv, err := m.configcli.ConfigV1().ClusterVersions().Get(ctx, "version", metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	// the gates only make sense before the upgrade starts: while it is in
+	// progress operators and pools are expected to be progressing
+	if m.upgradeInProgress(cv) {
+		return nil
+	}
+
+	unhealthy, err := m.unhealthyUpgradeGates(ctx, false)
+	if err != nil {
+		return err
+	}
+
+	if len(unhealthy) == 0 {
+		return nil
+	}
+
+	return m.pauseUpgrade(ctx, unhealthy)
+}
+
+// unhealthyUpgradeGates returns the reasons the cluster is not healthy enough
+// to start or carry on with an upgrade. While the upgrade is in progress the
+// machine config pools are expected to be updating, so only degraded pools
+// count.
+func (m *manager) unhealthyUpgradeGates(ctx context.Context, inProgress bool) ([]string, error) {
+	var unhealthy []string
+	for _, f := range []func(context.Context) ([]string, error){
+		m.unhealthyClusterOperators,
+		func(ctx context.Context) ([]string, error) {
+			return m.unhealthyMachineConfigPools(ctx, inProgress)
+		},
+		m.unhealthyEtcd,
+	} {
+		reasons, err := f(ctx)
+		if err != nil {
+			return nil, err
+		}
+		unhealthy = append(unhealthy, reasons...)
+	}
+
+	return unhealthy, nil
+}
+
+// pauseUpgrade records why the upgrade is paused on the cluster document and
+// returns an error to stop the admin update.
+func (m *manager) pauseUpgrade(ctx context.Context, unhealthy []string) error {
+	message := "upgrade paused: " + strings.Join(unhealthy, "; ")
+
+	err := m.setUpgradeStatus(ctx, api.UpgradePhasePaused, message)
+	if err != nil {
+		return err
+	}
+
+	return errors.New(message)
+}
+
+func (m *manager) unhealthyClusterOperators(ctx context.Context) ([]string, error) {
+	cos, err := m.configcli.ConfigV1().ClusterOperators().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	var unhealthy []string
+	for _, co := range cos.Items {
+		if !configv1helpers.IsStatusConditionTrue(co.Status.Conditions, configv1.OperatorAvailable) ||
+			configv1helpers.IsStatusConditionTrue(co.Status.Conditions, configv1.OperatorDegraded) {
+			unhealthy = append(unhealthy, co.Name)
+		}
+	}
+
+	if len(unhealthy) == 0 {
+		return nil, nil
+	}
+
+	sort.Strings(unhealthy)
+	return []string{"cluster operators not available or degraded: " + strings.Join(unhealthy, ", ")}, nil
+}
+
+func (m *manager) unhealthyMachineConfigPools(ctx context.Context, inProgress bool) ([]string, error) {
+	mcps, err := m.mcocli.MachineconfigurationV1().MachineConfigPools().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	var unhealthy []string
+	for _, mcp := range mcps.Items {
+		if !inProgress && !ready.MachineConfigPoolIsReady(&mcp) ||
+			machineConfigPoolConditionTrue(&mcp, mcv1.MachineConfigPoolDegraded) {
+			unhealthy = append(unhealthy, mcp.Name)
+		}
+	}
+
+	if len(unhealthy) == 0 {
+		return nil, nil
+	}
+
+	sort.Strings(unhealthy)
+	if inProgress {
+		return []string{"machine config pools degraded: " + strings.Join(unhealthy, ", ")}, nil
+	}
+	return []string{"machine config pools not updated or degraded: " + strings.Join(unhealthy, ", ")}, nil
+}
+
+func machineConfigPoolConditionTrue(mcp *mcv1.MachineConfigPool, t mcv1.MachineConfigPoolConditionType) bool {
+	for _, c := range mcp.Status.Conditions {
+		if c.Type == t {
+			return c.Status == "True"
+		}
+	}
+	return false
+}
+
+func (m *manager) unhealthyEtcd(ctx context.Context) ([]string, error) {
+	etcd, err := m.operatorcli.OperatorV1().Etcds().Get(ctx, "cluster", metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	if !operatorv1helpers.IsOperatorConditionTrue(etcd.Status.Conditions, "EtcdMembersAvailable") {
+		return []string{"not all etcd members are available"}, nil
+	}
+
+	if operatorv1helpers.IsOperatorConditionPresentAndEqual(etcd.Status.Conditions, operatorv1.OperatorStatusTypeDegraded, operatorv1.ConditionTrue) {
+		return []string{"etcd operator is degraded"}, nil
+	}
+
+	return nil, nil
+}
+
+// setDesiredUpdate points the ClusterVersion at the requested release, which
+// makes the cluster version operator start the upgrade.
+func (m *manager) setDesiredUpdate(ctx context.Context) error {
+	target, err := m.upgradeTargetVersion(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cv, err := m.configcli.ConfigV1().ClusterVersions().Get(ctx, "version", metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		if m.upgradeInProgress(cv) {
+			return nil
+		}
+
+		cv.Spec.DesiredUpdate = &configv1.Update{
+			Version: target.Properties.Version,
+			Image:   target.Properties.OpenShiftPullspec,
+		}
+
+		_, err = m.configcli.ConfigV1().ClusterVersions().Update(ctx, cv, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	err = m.unpauseMachineConfigPools(ctx)
+	if err != nil {
+		return err
+	}
+
+	return m.setUpgradeStatus(ctx, api.UpgradePhaseUpgrading, "")
+}
+
+// pauseMachineConfigPools pauses the non-master machine config pools, which
+// stops the rollout of the new release to the workers. The control plane
+// can't be paused safely and is left to the cluster version operator.
+func (m *manager) pauseMachineConfigPools(ctx context.Context) error {
+	mcps, err := m.mcocli.MachineconfigurationV1().MachineConfigPools().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	for _, mcp := range mcps.Items {
+		if mcp.Name == "master" || mcp.Spec.Paused {
+			continue
+		}
+
+		err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			mcp, err := m.mcocli.MachineconfigurationV1().MachineConfigPools().Get(ctx, mcp.Name, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+
+			if mcp.Annotations == nil {
+				mcp.Annotations = map[string]string{}
+			}
+			mcp.Annotations[upgradePausedAnnotation] = "true"
+			mcp.Spec.Paused = true
+
+			_, err = m.mcocli.MachineconfigurationV1().MachineConfigPools().Update(ctx, mcp, metav1.UpdateOptions{})
+			return err
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// unpauseMachineConfigPools unpauses the machine config pools paused by
+// pauseMachineConfigPools, leaving alone pools paused by anybody else.
+func (m *manager) unpauseMachineConfigPools(ctx context.Context) error {
+	mcps, err := m.mcocli.MachineconfigurationV1().MachineConfigPools().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	for _, mcp := range mcps.Items {
+		if _, ok := mcp.Annotations[upgradePausedAnnotation]; !ok {
+			continue
+		}
+
+		err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			mcp, err := m.mcocli.MachineconfigurationV1().MachineConfigPools().Get(ctx, mcp.Name, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+
+			delete(mcp.Annotations, upgradePausedAnnotation)
+			mcp.Spec.Paused = false
+
+			_, err = m.mcocli.MachineconfigurationV1().MachineConfigPools().Update(ctx, mcp, metav1.UpdateOptions{})
+			return err
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// upgradeCompleted waits for the cluster version operator to report the
+// requested version as completed, recording its progress on the cluster
+// document along the way. The health gates are re-evaluated on every poll: if
+// they fail, the rollout to the workers is paused and the admin update stops.
+func (m *manager) upgradeCompleted(ctx context.Context) (bool, error) {
+	cv, err := m.configcli.ConfigV1().ClusterVersions().Get(ctx, "version", metav1.GetOptions{})
+	if err != nil {
+		m.log.Print(err)
+		return false, nil
+	}
+
+	desiredVersion := m.doc.OpenShiftCluster.Properties.Upgrade.DesiredVersion
+	for _, h := range cv.Status.History {
+		if h.Version == desiredVersion && h.State == configv1.CompletedUpdate {
+			return true, nil
+		}
+	}
+
+	unhealthy, err := m.unhealthyUpgradeGates(ctx, true)
+	if err != nil {
+		m.log.Print(err)
+		return false, nil
+	}
+
+	if len(unhealthy) > 0 {
+		err = m.pauseMachineConfigPools(ctx)
+		if err != nil {
+			return false, err
+		}
+
+		return false, m.pauseUpgrade(ctx, unhealthy)
+	}
+
+	var message string
+	if c := configv1helpers.FindStatusCondition(cv.Status.Conditions, configv1.OperatorProgressing); c != nil {
+		message = c.Message
+	}
+
+	if message != m.doc.OpenShiftCluster.Properties.Upgrade.Message {
+		err = m.setUpgradeStatus(ctx, api.UpgradePhaseUpgrading, message)
+		if err != nil {
+			m.log.Print(err)
+		}
+	}
+
+	return false, nil
+}
+
+// finishUpgrade records the new cluster version once the upgrade completed.
+func (m *manager) finishUpgrade(ctx context.Context) error {
+	var err error
+	m.doc, err = m.db.PatchWithLease(ctx, m.doc.Key, func(doc *api.OpenShiftClusterDocument) error {
+		doc.OpenShiftCluster.Properties.ClusterProfile.Version = doc.OpenShiftCluster.Properties.Upgrade.DesiredVersion
+		doc.OpenShiftCluster.Properties.Upgrade.Phase = api.UpgradePhaseCompleted
+		doc.OpenShiftCluster.Properties.Upgrade.Message = ""
+		return nil
+	})
+	return err
+}
+
+func (m *manager) setUpgradeStatus(ctx context.Context, phase api.UpgradePhase, message string) error {
+	var err error
+	m.doc, err = m.db.PatchWithLease(ctx, m.doc.Key, func(doc *api.OpenShiftClusterDocument) error {
+		doc.OpenShiftCluster.Properties.Upgrade.Phase = phase
+		doc.OpenShiftCluster.Properties.Upgrade.Message = message
+		return nil
+	})
+	return err
+}
@@ -0,0 +1,795 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	configv1 "github.com/openshift/api/config/v1"
+	operatorv1 "github.com/openshift/api/operator/v1"
+	configfake "github.com/openshift/client-go/config/clientset/versioned/fake"
+	operatorfake "github.com/openshift/client-go/operator/clientset/versioned/fake"
+	mcv1 "github.com/openshift/machine-config-operator/pkg/apis/machineconfiguration.openshift.io/v1"
+	mcofake "github.com/openshift/machine-config-operator/pkg/generated/clientset/versioned/fake"
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+	"github.com/Azure/ARO-RP/test/util/deterministicuuid"
+	utilerror "github.com/Azure/ARO-RP/test/util/error"
+)
+
+func TestValidateUpgrade(t *testing.T) {
+	ctx := context.Background()
+
+	for _, tt := range []struct {
+		name    string
+		cv      *configv1.ClusterVersion
+		wantErr string
+	}{
+		{
+			name: "available update",
+			cv: &configv1.ClusterVersion{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "version",
+				},
+				Status: configv1.ClusterVersionStatus{
+					History: []configv1.UpdateHistory{
+						{
+							State:   configv1.CompletedUpdate,
+							Version: "4.11.20",
+						},
+					},
+					AvailableUpdates: []configv1.Release{
+						{
+							Version: "4.12.25",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "not an available update",
+			cv: &configv1.ClusterVersion{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "version",
+				},
+				Status: configv1.ClusterVersionStatus{
+					History: []configv1.UpdateHistory{
+						{
+							State:   configv1.CompletedUpdate,
+							Version: "4.11.20",
+						},
+					},
+					AvailableUpdates: []configv1.Release{
+						{
+							Version: "4.11.25",
+						},
+					},
+				},
+			},
+			wantErr: "cannot upgrade from 4.11.20 to 4.12.25: target is not an available update of the cluster",
+		},
+		{
+			name: "cluster already upgraded",
+			cv: &configv1.ClusterVersion{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "version",
+				},
+				Status: configv1.ClusterVersionStatus{
+					History: []configv1.UpdateHistory{
+						{
+							State:   configv1.CompletedUpdate,
+							Version: "4.12.25",
+						},
+					},
+				},
+			},
+			wantErr: "cannot upgrade from 4.12.25 to 4.12.25: target is not newer",
+		},
+		{
+			name: "validation is skipped once the upgrade is in progress",
+			cv: &configv1.ClusterVersion{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "version",
+				},
+				Spec: configv1.ClusterVersionSpec{
+					DesiredUpdate: &configv1.Update{
+						Version: "4.12.25",
+					},
+				},
+				Status: configv1.ClusterVersionStatus{
+					History: []configv1.UpdateHistory{
+						{
+							State:   configv1.PartialUpdate,
+							Version: "4.12.25",
+						},
+						{
+							State:   configv1.CompletedUpdate,
+							Version: "4.11.20",
+						},
+					},
+				},
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			key := "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/resourceGroup/providers/Microsoft.RedHatOpenShift/openShiftClusters/resourceName"
+
+			openShiftClustersDatabase, _ := testdatabase.NewFakeOpenShiftClusters()
+			openShiftVersionsDatabase, _ := testdatabase.NewFakeOpenShiftVersions(deterministicuuid.NewTestUUIDGenerator(deterministicuuid.OPENSHIFT_VERSIONS))
+			fixture := testdatabase.NewFixture().
+				WithOpenShiftClusters(openShiftClustersDatabase).
+				WithOpenShiftVersions(openShiftVersionsDatabase, deterministicuuid.NewTestUUIDGenerator(deterministicuuid.OPENSHIFT_VERSIONS))
+			fixture.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+				Key: strings.ToLower(key),
+				OpenShiftCluster: &api.OpenShiftCluster{
+					ID: key,
+					Properties: api.OpenShiftClusterProperties{
+						ProvisioningState: api.ProvisioningStateAdminUpdating,
+						MaintenanceTask:   api.MaintenanceTaskUpgrade,
+						ClusterProfile: api.ClusterProfile{
+							Version: "4.11.20",
+						},
+						Upgrade: &api.Upgrade{
+							DesiredVersion: "4.12.25",
+							Phase:          api.UpgradePhasePending,
+						},
+					},
+				},
+			})
+			fixture.AddOpenShiftVersionDocuments(&api.OpenShiftVersionDocument{
+				OpenShiftVersion: &api.OpenShiftVersion{
+					Properties: api.OpenShiftVersionProperties{
+						Version:           "4.12.25",
+						OpenShiftPullspec: "quay.io/openshift-release-dev/ocp-release@sha256:4.12.25",
+						Enabled:           true,
+					},
+				},
+			})
+			err := fixture.Create()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			doc, err := openShiftClustersDatabase.Dequeue(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			m := &manager{
+				log:                 logrus.NewEntry(logrus.StandardLogger()),
+				doc:                 doc,
+				db:                  openShiftClustersDatabase,
+				dbOpenShiftVersions: openShiftVersionsDatabase,
+				configcli:           configfake.NewSimpleClientset(tt.cv),
+			}
+
+			err = m.validateUpgrade(ctx)
+			utilerror.AssertErrorMessage(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestUpgradeHealthGates(t *testing.T) {
+	ctx := context.Background()
+
+	for _, tt := range []struct {
+		name        string
+		cv          *configv1.ClusterVersion
+		co          *configv1.ClusterOperator
+		mcp         *mcv1.MachineConfigPool
+		etcd        *operatorv1.Etcd
+		wantErr     string
+		wantPhase   api.UpgradePhase
+		wantMessage string
+	}{
+		{
+			name: "healthy cluster",
+			cv: &configv1.ClusterVersion{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "version",
+				},
+			},
+			co: &configv1.ClusterOperator{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ingress",
+				},
+				Status: configv1.ClusterOperatorStatus{
+					Conditions: []configv1.ClusterOperatorStatusCondition{
+						{
+							Type:   configv1.OperatorAvailable,
+							Status: configv1.ConditionTrue,
+						},
+						{
+							Type:   configv1.OperatorDegraded,
+							Status: configv1.ConditionFalse,
+						},
+					},
+				},
+			},
+			mcp: &mcv1.MachineConfigPool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "worker",
+				},
+				Status: mcv1.MachineConfigPoolStatus{
+					MachineCount:        3,
+					UpdatedMachineCount: 3,
+					ReadyMachineCount:   3,
+				},
+			},
+			etcd: &operatorv1.Etcd{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "cluster",
+				},
+				Status: operatorv1.EtcdStatus{
+					StaticPodOperatorStatus: operatorv1.StaticPodOperatorStatus{
+						OperatorStatus: operatorv1.OperatorStatus{
+							Conditions: []operatorv1.OperatorCondition{
+								{
+									Type:   "EtcdMembersAvailable",
+									Status: operatorv1.ConditionTrue,
+								},
+							},
+						},
+					},
+				},
+			},
+			wantPhase: api.UpgradePhasePending,
+		},
+		{
+			name: "unhealthy cluster pauses the upgrade",
+			cv: &configv1.ClusterVersion{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "version",
+				},
+			},
+			co: &configv1.ClusterOperator{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ingress",
+				},
+				Status: configv1.ClusterOperatorStatus{
+					Conditions: []configv1.ClusterOperatorStatusCondition{
+						{
+							Type:   configv1.OperatorAvailable,
+							Status: configv1.ConditionTrue,
+						},
+						{
+							Type:   configv1.OperatorDegraded,
+							Status: configv1.ConditionTrue,
+						},
+					},
+				},
+			},
+			mcp: &mcv1.MachineConfigPool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "worker",
+				},
+				Status: mcv1.MachineConfigPoolStatus{
+					MachineCount:        3,
+					UpdatedMachineCount: 3,
+					ReadyMachineCount:   2,
+				},
+			},
+			etcd: &operatorv1.Etcd{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "cluster",
+				},
+				Status: operatorv1.EtcdStatus{
+					StaticPodOperatorStatus: operatorv1.StaticPodOperatorStatus{
+						OperatorStatus: operatorv1.OperatorStatus{
+							Conditions: []operatorv1.OperatorCondition{
+								{
+									Type:   "EtcdMembersAvailable",
+									Status: operatorv1.ConditionFalse,
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr:     "upgrade paused: cluster operators not available or degraded: ingress; machine config pools not updated or degraded: worker; not all etcd members are available",
+			wantPhase:   api.UpgradePhasePaused,
+			wantMessage: "upgrade paused: cluster operators not available or degraded: ingress; machine config pools not updated or degraded: worker; not all etcd members are available",
+		},
+		{
+			name: "gates are skipped once the upgrade is in progress",
+			cv: &configv1.ClusterVersion{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "version",
+				},
+				Spec: configv1.ClusterVersionSpec{
+					DesiredUpdate: &configv1.Update{
+						Version: "4.12.25",
+					},
+				},
+			},
+			co: &configv1.ClusterOperator{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ingress",
+				},
+				Status: configv1.ClusterOperatorStatus{
+					Conditions: []configv1.ClusterOperatorStatusCondition{
+						{
+							Type:   configv1.OperatorAvailable,
+							Status: configv1.ConditionFalse,
+						},
+					},
+				},
+			},
+			mcp: &mcv1.MachineConfigPool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "worker",
+				},
+				Status: mcv1.MachineConfigPoolStatus{
+					MachineCount:        3,
+					UpdatedMachineCount: 1,
+					ReadyMachineCount:   1,
+				},
+			},
+			etcd: &operatorv1.Etcd{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "cluster",
+				},
+			},
+			wantPhase: api.UpgradePhasePending,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			key := "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/resourceGroup/providers/Microsoft.RedHatOpenShift/openShiftClusters/resourceName"
+
+			openShiftClustersDatabase, _ := testdatabase.NewFakeOpenShiftClusters()
+			fixture := testdatabase.NewFixture().WithOpenShiftClusters(openShiftClustersDatabase)
+			fixture.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+				Key: strings.ToLower(key),
+				OpenShiftCluster: &api.OpenShiftCluster{
+					ID: key,
+					Properties: api.OpenShiftClusterProperties{
+						ProvisioningState: api.ProvisioningStateAdminUpdating,
+						MaintenanceTask:   api.MaintenanceTaskUpgrade,
+						Upgrade: &api.Upgrade{
+							DesiredVersion: "4.12.25",
+							Phase:          api.UpgradePhasePending,
+						},
+					},
+				},
+			})
+			err := fixture.Create()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			doc, err := openShiftClustersDatabase.Dequeue(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			m := &manager{
+				log:         logrus.NewEntry(logrus.StandardLogger()),
+				doc:         doc,
+				db:          openShiftClustersDatabase,
+				configcli:   configfake.NewSimpleClientset(tt.cv, tt.co),
+				mcocli:      mcofake.NewSimpleClientset(tt.mcp),
+				operatorcli: operatorfake.NewSimpleClientset(tt.etcd),
+			}
+
+			err = m.upgradeHealthGates(ctx)
+			utilerror.AssertErrorMessage(t, err, tt.wantErr)
+
+			if m.doc.OpenShiftCluster.Properties.Upgrade.Phase != tt.wantPhase {
+				t.Error(m.doc.OpenShiftCluster.Properties.Upgrade.Phase)
+			}
+			if m.doc.OpenShiftCluster.Properties.Upgrade.Message != tt.wantMessage {
+				t.Error(m.doc.OpenShiftCluster.Properties.Upgrade.Message)
+			}
+		})
+	}
+}
+
+func TestSetDesiredUpdate(t *testing.T) {
+	ctx := context.Background()
+	key := "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/resourceGroup/providers/Microsoft.RedHatOpenShift/openShiftClusters/resourceName"
+
+	openShiftClustersDatabase, _ := testdatabase.NewFakeOpenShiftClusters()
+	openShiftVersionsDatabase, _ := testdatabase.NewFakeOpenShiftVersions(deterministicuuid.NewTestUUIDGenerator(deterministicuuid.OPENSHIFT_VERSIONS))
+	fixture := testdatabase.NewFixture().
+		WithOpenShiftClusters(openShiftClustersDatabase).
+		WithOpenShiftVersions(openShiftVersionsDatabase, deterministicuuid.NewTestUUIDGenerator(deterministicuuid.OPENSHIFT_VERSIONS))
+	fixture.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+		Key: strings.ToLower(key),
+		OpenShiftCluster: &api.OpenShiftCluster{
+			ID: key,
+			Properties: api.OpenShiftClusterProperties{
+				ProvisioningState: api.ProvisioningStateAdminUpdating,
+				MaintenanceTask:   api.MaintenanceTaskUpgrade,
+				Upgrade: &api.Upgrade{
+					DesiredVersion: "4.12.25",
+					Phase:          api.UpgradePhasePaused,
+					Message:        "upgrade paused: machine config pools degraded: worker",
+				},
+			},
+		},
+	})
+	fixture.AddOpenShiftVersionDocuments(&api.OpenShiftVersionDocument{
+		OpenShiftVersion: &api.OpenShiftVersion{
+			Properties: api.OpenShiftVersionProperties{
+				Version:           "4.12.25",
+				OpenShiftPullspec: "quay.io/openshift-release-dev/ocp-release@sha256:4.12.25",
+				Enabled:           true,
+			},
+		},
+	})
+	err := fixture.Create()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	doc, err := openShiftClustersDatabase.Dequeue(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := &manager{
+		log:                 logrus.NewEntry(logrus.StandardLogger()),
+		doc:                 doc,
+		db:                  openShiftClustersDatabase,
+		dbOpenShiftVersions: openShiftVersionsDatabase,
+		configcli: configfake.NewSimpleClientset(&configv1.ClusterVersion{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "version",
+			},
+		}),
+		mcocli: mcofake.NewSimpleClientset(
+			&mcv1.MachineConfigPool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "worker",
+					Annotations: map[string]string{
+						upgradePausedAnnotation: "true",
+					},
+				},
+				Spec: mcv1.MachineConfigPoolSpec{
+					Paused: true,
+				},
+			},
+			&mcv1.MachineConfigPool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "infra",
+				},
+				Spec: mcv1.MachineConfigPoolSpec{
+					Paused: true,
+				},
+			},
+		),
+	}
+
+	err = m.setDesiredUpdate(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cv, err := m.configcli.ConfigV1().ClusterVersions().Get(ctx, "version", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(cv.Spec.DesiredUpdate, &configv1.Update{
+		Version: "4.12.25",
+		Image:   "quay.io/openshift-release-dev/ocp-release@sha256:4.12.25",
+	}) {
+		t.Error(cv.Spec.DesiredUpdate)
+	}
+
+	for _, want := range []struct {
+		name   string
+		paused bool
+	}{
+		{
+			name: "worker",
+		},
+		{
+			name:   "infra",
+			paused: true,
+		},
+	} {
+		mcp, err := m.mcocli.MachineconfigurationV1().MachineConfigPools().Get(ctx, want.name, metav1.GetOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if mcp.Spec.Paused != want.paused {
+			t.Error(mcp.Name, mcp.Spec.Paused)
+		}
+		if _, ok := mcp.Annotations[upgradePausedAnnotation]; ok {
+			t.Error(mcp.Name, mcp.Annotations)
+		}
+	}
+
+	if m.doc.OpenShiftCluster.Properties.Upgrade.Phase != api.UpgradePhaseUpgrading {
+		t.Error(m.doc.OpenShiftCluster.Properties.Upgrade.Phase)
+	}
+	if m.doc.OpenShiftCluster.Properties.Upgrade.Message != "" {
+		t.Error(m.doc.OpenShiftCluster.Properties.Upgrade.Message)
+	}
+}
+
+func TestUpgradeCompleted(t *testing.T) {
+	ctx := context.Background()
+
+	for _, tt := range []struct {
+		name           string
+		cv             *configv1.ClusterVersion
+		mcp            *mcv1.MachineConfigPool
+		wantDone       bool
+		wantErr        string
+		wantPhase      api.UpgradePhase
+		wantMessage    string
+		wantPoolPaused bool
+	}{
+		{
+			name: "upgrade progressing",
+			cv: &configv1.ClusterVersion{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "version",
+				},
+				Status: configv1.ClusterVersionStatus{
+					Conditions: []configv1.ClusterOperatorStatusCondition{
+						{
+							Type:    configv1.OperatorProgressing,
+							Status:  configv1.ConditionTrue,
+							Message: "Working towards 4.12.25: 106 of 830 done (12% complete)",
+						},
+					},
+					History: []configv1.UpdateHistory{
+						{
+							State:   configv1.PartialUpdate,
+							Version: "4.12.25",
+						},
+						{
+							State:   configv1.CompletedUpdate,
+							Version: "4.11.20",
+						},
+					},
+				},
+			},
+			mcp: &mcv1.MachineConfigPool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "worker",
+				},
+				Status: mcv1.MachineConfigPoolStatus{
+					MachineCount:        3,
+					UpdatedMachineCount: 1,
+					ReadyMachineCount:   2,
+				},
+			},
+			wantPhase:   api.UpgradePhaseUpgrading,
+			wantMessage: "Working towards 4.12.25: 106 of 830 done (12% complete)",
+		},
+		{
+			name: "upgrade completed",
+			cv: &configv1.ClusterVersion{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "version",
+				},
+				Status: configv1.ClusterVersionStatus{
+					History: []configv1.UpdateHistory{
+						{
+							State:   configv1.CompletedUpdate,
+							Version: "4.12.25",
+						},
+						{
+							State:   configv1.CompletedUpdate,
+							Version: "4.11.20",
+						},
+					},
+				},
+			},
+			mcp: &mcv1.MachineConfigPool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "worker",
+				},
+				Status: mcv1.MachineConfigPoolStatus{
+					MachineCount:        3,
+					UpdatedMachineCount: 3,
+					ReadyMachineCount:   3,
+				},
+			},
+			wantDone:  true,
+			wantPhase: api.UpgradePhaseUpgrading,
+		},
+		{
+			name: "degraded pool pauses the upgrade",
+			cv: &configv1.ClusterVersion{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "version",
+				},
+				Status: configv1.ClusterVersionStatus{
+					History: []configv1.UpdateHistory{
+						{
+							State:   configv1.PartialUpdate,
+							Version: "4.12.25",
+						},
+						{
+							State:   configv1.CompletedUpdate,
+							Version: "4.11.20",
+						},
+					},
+				},
+			},
+			mcp: &mcv1.MachineConfigPool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "worker",
+				},
+				Status: mcv1.MachineConfigPoolStatus{
+					MachineCount:        3,
+					UpdatedMachineCount: 1,
+					ReadyMachineCount:   2,
+					Conditions: []mcv1.MachineConfigPoolCondition{
+						{
+							Type:   mcv1.MachineConfigPoolDegraded,
+							Status: "True",
+						},
+					},
+				},
+			},
+			wantErr:        "upgrade paused: machine config pools degraded: worker",
+			wantPhase:      api.UpgradePhasePaused,
+			wantMessage:    "upgrade paused: machine config pools degraded: worker",
+			wantPoolPaused: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			key := "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/resourceGroup/providers/Microsoft.RedHatOpenShift/openShiftClusters/resourceName"
+
+			openShiftClustersDatabase, _ := testdatabase.NewFakeOpenShiftClusters()
+			fixture := testdatabase.NewFixture().WithOpenShiftClusters(openShiftClustersDatabase)
+			fixture.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+				Key: strings.ToLower(key),
+				OpenShiftCluster: &api.OpenShiftCluster{
+					ID: key,
+					Properties: api.OpenShiftClusterProperties{
+						ProvisioningState: api.ProvisioningStateAdminUpdating,
+						MaintenanceTask:   api.MaintenanceTaskUpgrade,
+						Upgrade: &api.Upgrade{
+							DesiredVersion: "4.12.25",
+							Phase:          api.UpgradePhaseUpgrading,
+						},
+					},
+				},
+			})
+			err := fixture.Create()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			doc, err := openShiftClustersDatabase.Dequeue(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			m := &manager{
+				log: logrus.NewEntry(logrus.StandardLogger()),
+				doc: doc,
+				db:  openShiftClustersDatabase,
+				configcli: configfake.NewSimpleClientset(tt.cv, &configv1.ClusterOperator{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "ingress",
+					},
+					Status: configv1.ClusterOperatorStatus{
+						Conditions: []configv1.ClusterOperatorStatusCondition{
+							{
+								Type:   configv1.OperatorAvailable,
+								Status: configv1.ConditionTrue,
+							},
+							{
+								Type:   configv1.OperatorProgressing,
+								Status: configv1.ConditionTrue,
+							},
+						},
+					},
+				}),
+				mcocli: mcofake.NewSimpleClientset(tt.mcp),
+				operatorcli: operatorfake.NewSimpleClientset(&operatorv1.Etcd{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cluster",
+					},
+					Status: operatorv1.EtcdStatus{
+						StaticPodOperatorStatus: operatorv1.StaticPodOperatorStatus{
+							OperatorStatus: operatorv1.OperatorStatus{
+								Conditions: []operatorv1.OperatorCondition{
+									{
+										Type:   "EtcdMembersAvailable",
+										Status: operatorv1.ConditionTrue,
+									},
+								},
+							},
+						},
+					},
+				}),
+			}
+
+			done, err := m.upgradeCompleted(ctx)
+			utilerror.AssertErrorMessage(t, err, tt.wantErr)
+			if done != tt.wantDone {
+				t.Error(done)
+			}
+
+			if m.doc.OpenShiftCluster.Properties.Upgrade.Phase != tt.wantPhase {
+				t.Error(m.doc.OpenShiftCluster.Properties.Upgrade.Phase)
+			}
+			if m.doc.OpenShiftCluster.Properties.Upgrade.Message != tt.wantMessage {
+				t.Error(m.doc.OpenShiftCluster.Properties.Upgrade.Message)
+			}
+
+			mcp, err := m.mcocli.MachineconfigurationV1().MachineConfigPools().Get(ctx, "worker", metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if mcp.Spec.Paused != tt.wantPoolPaused {
+				t.Error(mcp.Spec.Paused)
+			}
+		})
+	}
+}
+
+func TestFinishUpgrade(t *testing.T) {
+	ctx := context.Background()
+	key := "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/resourceGroup/providers/Microsoft.RedHatOpenShift/openShiftClusters/resourceName"
+
+	openShiftClustersDatabase, _ := testdatabase.NewFakeOpenShiftClusters()
+	fixture := testdatabase.NewFixture().WithOpenShiftClusters(openShiftClustersDatabase)
+	fixture.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+		Key: strings.ToLower(key),
+		OpenShiftCluster: &api.OpenShiftCluster{
+			ID: key,
+			Properties: api.OpenShiftClusterProperties{
+				ProvisioningState: api.ProvisioningStateAdminUpdating,
+				MaintenanceTask:   api.MaintenanceTaskUpgrade,
+				ClusterProfile: api.ClusterProfile{
+					Version: "4.11.20",
+				},
+				Upgrade: &api.Upgrade{
+					DesiredVersion: "4.12.25",
+					Phase:          api.UpgradePhaseUpgrading,
+					Message:        "Working towards 4.12.25: 829 of 830 done (99% complete)",
+				},
+			},
+		},
+	})
+	err := fixture.Create()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	doc, err := openShiftClustersDatabase.Dequeue(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := &manager{
+		log: logrus.NewEntry(logrus.StandardLogger()),
+		doc: doc,
+		db:  openShiftClustersDatabase,
+	}
+
+	err = m.finishUpgrade(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	doc, err = m.db.Get(ctx, strings.ToLower(key))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if doc.OpenShiftCluster.Properties.ClusterProfile.Version != "4.12.25" {
+		t.Error(doc.OpenShiftCluster.Properties.ClusterProfile.Version)
+	}
+	if !reflect.DeepEqual(doc.OpenShiftCluster.Properties.Upgrade, &api.Upgrade{
+		DesiredVersion: "4.12.25",
+		Phase:          api.UpgradePhaseCompleted,
+	}) {
+		t.Error(doc.OpenShiftCluster.Properties.Upgrade)
+	}
+}
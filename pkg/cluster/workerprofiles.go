@@ -0,0 +1,265 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+
+	"github.com/ghodss/yaml"
+	machinev1beta1 "github.com/openshift/api/machine/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	_ "github.com/Azure/ARO-RP/pkg/util/scheme"
+)
+
+const (
+	workerMachineSetsNamespace = "openshift-machine-api"
+
+	// machineAutoscalerAnnotation is set by the cluster-autoscaler-operator
+	// on the machinesets targeted by a MachineAutoscaler
+	machineAutoscalerAnnotation = "autoscaling.openshift.io/machineautoscaler"
+)
+
+// reconcileWorkerProfiles scales and resizes the worker machinesets created at
+// install time to match WorkerProfiles following a customer update which
+// changed them, i.e. while WorkerProfilesUpdating is set.  The replicas are
+// spread evenly across the machinesets; the replicas of machinesets with a
+// MachineAutoscaler are left to the autoscaler.  Existing machines are replaced
+// by workerProfilesReconciled.
+func (m *manager) reconcileWorkerProfiles(ctx context.Context) error {
+	if !m.doc.OpenShiftCluster.Properties.WorkerProfilesUpdating {
+		return nil
+	}
+
+	wp, err := m.workerProfile()
+	if err != nil {
+		return err
+	}
+
+	machinesets, err := m.workerMachineSets(ctx)
+	if err != nil {
+		return err
+	}
+
+	for i, machineset := range machinesets {
+		replicas := int32(wp.Count / len(machinesets))
+		if i < wp.Count%len(machinesets) {
+			replicas++
+		}
+
+		err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			machineset, err := m.maocli.MachineV1beta1().MachineSets(workerMachineSetsNamespace).Get(ctx, machineset.Name, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+
+			if _, found := machineset.Annotations[machineAutoscalerAnnotation]; found {
+				m.log.Printf("setting autoscaled machineset %s to %s", machineset.Name, wp.VMSize)
+			} else {
+				m.log.Printf("setting machineset %s to %d replicas of %s", machineset.Name, replicas, wp.VMSize)
+				machineset.Spec.Replicas = &replicas
+			}
+
+			machineset.Spec.Template.Spec.ProviderSpec.Value.Raw, err = setProviderSpecVMSize(machineset.Spec.Template.Spec.ProviderSpec.Value.Raw, wp.VMSize)
+			if err != nil {
+				return err
+			}
+
+			_, err = m.maocli.MachineV1beta1().MachineSets(workerMachineSetsNamespace).Update(ctx, machineset, metav1.UpdateOptions{})
+			return err
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// workerProfilesReconciled waits for the worker machinesets to be ready and
+// then replaces their machines of the wrong size, one machine per machineset
+// at a time.  Once all the machines are of the right size, it clears
+// WorkerProfilesUpdating.  A failed machine fails the update straight away and
+// also clears WorkerProfilesUpdating, so that later updates don't apply the
+// worker profiles again; if the update times out instead, the next update
+// carries on replacing machines.
+func (m *manager) workerProfilesReconciled(ctx context.Context) (bool, error) {
+	if !m.doc.OpenShiftCluster.Properties.WorkerProfilesUpdating {
+		return true, nil
+	}
+
+	wp, err := m.workerProfile()
+	if err != nil {
+		return false, err
+	}
+
+	machinesets, err := m.workerMachineSets(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	machinesByMachineSet := make([][]machinev1beta1.Machine, len(machinesets))
+	for i, machineset := range machinesets {
+		machines, err := m.maocli.MachineV1beta1().Machines(workerMachineSetsNamespace).List(ctx, metav1.ListOptions{
+			LabelSelector: "machine.openshift.io/cluster-api-machineset=" + machineset.Name,
+		})
+		if err != nil {
+			return false, err
+		}
+
+		for _, machine := range machines.Items {
+			if machine.Status.Phase != nil && *machine.Status.Phase == "Failed" {
+				return false, m.workerProfilesFailed(ctx, &machine)
+			}
+		}
+
+		machinesByMachineSet[i] = machines.Items
+	}
+
+	ready := true
+	for i, machineset := range machinesets {
+		if machineset.Spec.Replicas == nil ||
+			machineset.Status.ObservedGeneration != machineset.Generation ||
+			machineset.Status.ReadyReplicas != *machineset.Spec.Replicas ||
+			len(machinesByMachineSet[i]) != int(*machineset.Spec.Replicas) {
+			ready = false
+			continue
+		}
+
+		for _, machine := range machinesByMachineSet[i] {
+			if machine.DeletionTimestamp != nil ||
+				machine.Status.Phase == nil || *machine.Status.Phase != "Running" {
+				ready = false
+			}
+		}
+	}
+	if !ready {
+		return false, nil
+	}
+
+	var replacing bool
+	for _, machines := range machinesByMachineSet {
+		for _, machine := range machines {
+			vmSize, err := providerSpecVMSize(machine.Spec.ProviderSpec.Value.Raw)
+			if err != nil {
+				return false, err
+			}
+
+			if vmSize == wp.VMSize {
+				continue
+			}
+
+			m.log.Printf("replacing machine %s of size %s", machine.Name, vmSize)
+
+			err = m.maocli.MachineV1beta1().Machines(workerMachineSetsNamespace).Delete(ctx, machine.Name, metav1.DeleteOptions{})
+			if err != nil {
+				return false, err
+			}
+
+			replacing = true
+			break
+		}
+	}
+	if replacing {
+		return false, nil
+	}
+
+	m.doc, err = m.db.PatchWithLease(ctx, m.doc.Key, func(doc *api.OpenShiftClusterDocument) error {
+		doc.OpenShiftCluster.Properties.WorkerProfilesUpdating = false
+		return nil
+	})
+	return err == nil, err
+}
+
+// workerProfilesFailed clears WorkerProfilesUpdating and returns an error
+// describing the failed machine
+func (m *manager) workerProfilesFailed(ctx context.Context, machine *machinev1beta1.Machine) error {
+	var err error
+	m.doc, err = m.db.PatchWithLease(ctx, m.doc.Key, func(doc *api.OpenShiftClusterDocument) error {
+		doc.OpenShiftCluster.Properties.WorkerProfilesUpdating = false
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	message := "unknown error"
+	if machine.Status.ErrorMessage != nil {
+		message = *machine.Status.ErrorMessage
+	}
+
+	return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "properties.workerProfiles", "The worker machine '%s' failed to provision: %s", machine.Name, message)
+}
+
+func (m *manager) workerProfile() (*api.WorkerProfile, error) {
+	if len(m.doc.OpenShiftCluster.Properties.WorkerProfiles) != 1 {
+		return nil, fmt.Errorf("expected exactly one worker profile, found %d", len(m.doc.OpenShiftCluster.Properties.WorkerProfiles))
+	}
+
+	return &m.doc.OpenShiftCluster.Properties.WorkerProfiles[0], nil
+}
+
+// workerMachineSets returns the worker machinesets created at install time,
+// sorted by name.  Machinesets created by the customer are left alone.
+func (m *manager) workerMachineSets(ctx context.Context) ([]machinev1beta1.MachineSet, error) {
+	rx := regexp.MustCompile("^" + regexp.QuoteMeta(m.doc.OpenShiftCluster.Properties.InfraID+"-worker-"+m.doc.OpenShiftCluster.Location) + `\d*$`)
+
+	machinesets, err := m.maocli.MachineV1beta1().MachineSets(workerMachineSetsNamespace).List(ctx, metav1.ListOptions{
+		LabelSelector: "machine.openshift.io/cluster-api-machine-role=worker",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var workerMachineSets []machinev1beta1.MachineSet
+	for _, machineset := range machinesets.Items {
+		if rx.MatchString(machineset.Name) && machineset.Spec.Template.Spec.ProviderSpec.Value != nil {
+			workerMachineSets = append(workerMachineSets, machineset)
+		}
+	}
+
+	if len(workerMachineSets) == 0 {
+		return nil, fmt.Errorf("no worker machinesets found")
+	}
+
+	sort.Slice(workerMachineSets, func(i, j int) bool { return workerMachineSets[i].Name < workerMachineSets[j].Name })
+
+	return workerMachineSets, nil
+}
+
+func providerSpecVMSize(raw []byte) (api.VMSize, error) {
+	obj, _, err := scheme.Codecs.UniversalDeserializer().Decode(raw, nil, nil)
+	if err != nil {
+		return "", err
+	}
+
+	machineProviderSpec, ok := obj.(*machinev1beta1.AzureMachineProviderSpec)
+	if !ok {
+		return "", fmt.Errorf("failed to read provider spec: %T", obj)
+	}
+
+	return api.VMSize(machineProviderSpec.VMSize), nil
+}
+
+// setProviderSpecVMSize sets the VM size in a raw provider spec, leaving the
+// remaining fields untouched
+func setProviderSpecVMSize(raw []byte, vmSize api.VMSize) ([]byte, error) {
+	var providerSpec map[string]interface{}
+	err := yaml.Unmarshal(raw, &providerSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	providerSpec["vmSize"] = string(vmSize)
+
+	return json.Marshal(providerSpec)
+}
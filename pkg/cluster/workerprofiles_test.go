@@ -0,0 +1,943 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest/to"
+	machinev1beta1 "github.com/openshift/api/machine/v1beta1"
+	machinefake "github.com/openshift/client-go/machine/clientset/versioned/fake"
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kruntime "k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+	utilerror "github.com/Azure/ARO-RP/test/util/error"
+)
+
+func TestReconcileWorkerProfiles(t *testing.T) {
+	ctx := context.Background()
+
+	for _, tt := range []struct {
+		name         string
+		updating     bool
+		machinesets  []kruntime.Object
+		wantReplicas map[string]int32
+		wantVMSize   api.VMSize
+		wantErr      string
+	}{
+		{
+			name: "not updating",
+			machinesets: []kruntime.Object{
+				&machinev1beta1.MachineSet{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "infraID-worker-eastus1",
+						Namespace: "openshift-machine-api",
+						Labels: map[string]string{
+							"machine.openshift.io/cluster-api-machine-role": "worker",
+						},
+					},
+					Spec: machinev1beta1.MachineSetSpec{
+						Replicas: to.Int32Ptr(1),
+						Template: machinev1beta1.MachineTemplateSpec{
+							Spec: machinev1beta1.MachineSpec{
+								ProviderSpec: machinev1beta1.ProviderSpec{
+									Value: &kruntime.RawExtension{
+										Raw: []byte(`{"apiVersion":"machine.openshift.io/v1beta1","kind":"AzureMachineProviderSpec","vmSize":"Standard_D4s_v3"}`),
+									},
+								},
+							},
+						},
+					},
+					Status: machinev1beta1.MachineSetStatus{
+						ReadyReplicas: 1,
+					},
+				},
+			},
+			wantReplicas: map[string]int32{"infraID-worker-eastus1": 1},
+			wantVMSize:   api.VMSizeStandardD4sV3,
+		},
+		{
+			name:     "scale and resize across machinesets",
+			updating: true,
+			machinesets: []kruntime.Object{
+				&machinev1beta1.MachineSet{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "infraID-worker-eastus1",
+						Namespace: "openshift-machine-api",
+						Labels: map[string]string{
+							"machine.openshift.io/cluster-api-machine-role": "worker",
+						},
+					},
+					Spec: machinev1beta1.MachineSetSpec{
+						Replicas: to.Int32Ptr(1),
+						Template: machinev1beta1.MachineTemplateSpec{
+							Spec: machinev1beta1.MachineSpec{
+								ProviderSpec: machinev1beta1.ProviderSpec{
+									Value: &kruntime.RawExtension{
+										Raw: []byte(`{"apiVersion":"machine.openshift.io/v1beta1","kind":"AzureMachineProviderSpec","vmSize":"Standard_D4s_v3"}`),
+									},
+								},
+							},
+						},
+					},
+					Status: machinev1beta1.MachineSetStatus{
+						ReadyReplicas: 1,
+					},
+				},
+				&machinev1beta1.MachineSet{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "infraID-worker-eastus2",
+						Namespace: "openshift-machine-api",
+						Labels: map[string]string{
+							"machine.openshift.io/cluster-api-machine-role": "worker",
+						},
+					},
+					Spec: machinev1beta1.MachineSetSpec{
+						Replicas: to.Int32Ptr(1),
+						Template: machinev1beta1.MachineTemplateSpec{
+							Spec: machinev1beta1.MachineSpec{
+								ProviderSpec: machinev1beta1.ProviderSpec{
+									Value: &kruntime.RawExtension{
+										Raw: []byte(`{"apiVersion":"machine.openshift.io/v1beta1","kind":"AzureMachineProviderSpec","vmSize":"Standard_D4s_v3"}`),
+									},
+								},
+							},
+						},
+					},
+					Status: machinev1beta1.MachineSetStatus{
+						ReadyReplicas: 1,
+					},
+				},
+				&machinev1beta1.MachineSet{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "infraID-worker-eastus3",
+						Namespace: "openshift-machine-api",
+						Labels: map[string]string{
+							"machine.openshift.io/cluster-api-machine-role": "worker",
+						},
+					},
+					Spec: machinev1beta1.MachineSetSpec{
+						Replicas: to.Int32Ptr(1),
+						Template: machinev1beta1.MachineTemplateSpec{
+							Spec: machinev1beta1.MachineSpec{
+								ProviderSpec: machinev1beta1.ProviderSpec{
+									Value: &kruntime.RawExtension{
+										Raw: []byte(`{"apiVersion":"machine.openshift.io/v1beta1","kind":"AzureMachineProviderSpec","vmSize":"Standard_D4s_v3"}`),
+									},
+								},
+							},
+						},
+					},
+					Status: machinev1beta1.MachineSetStatus{
+						ReadyReplicas: 1,
+					},
+				},
+				&machinev1beta1.MachineSet{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "infraID-worker-eastus-custom",
+						Namespace: "openshift-machine-api",
+						Labels: map[string]string{
+							"machine.openshift.io/cluster-api-machine-role": "worker",
+						},
+					},
+					Spec: machinev1beta1.MachineSetSpec{
+						Replicas: to.Int32Ptr(1),
+						Template: machinev1beta1.MachineTemplateSpec{
+							Spec: machinev1beta1.MachineSpec{
+								ProviderSpec: machinev1beta1.ProviderSpec{
+									Value: &kruntime.RawExtension{
+										Raw: []byte(`{"apiVersion":"machine.openshift.io/v1beta1","kind":"AzureMachineProviderSpec","vmSize":"Standard_D4s_v3"}`),
+									},
+								},
+							},
+						},
+					},
+					Status: machinev1beta1.MachineSetStatus{
+						ReadyReplicas: 1,
+					},
+				},
+			},
+			wantReplicas: map[string]int32{
+				"infraID-worker-eastus1":       3,
+				"infraID-worker-eastus2":       2,
+				"infraID-worker-eastus3":       2,
+				"infraID-worker-eastus-custom": 1,
+			},
+			wantVMSize: api.VMSizeStandardD8sV3,
+		},
+		{
+			name:     "autoscaled machineset keeps its replicas",
+			updating: true,
+			machinesets: []kruntime.Object{
+				&machinev1beta1.MachineSet{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "infraID-worker-eastus1",
+						Namespace: "openshift-machine-api",
+						Labels: map[string]string{
+							"machine.openshift.io/cluster-api-machine-role": "worker",
+						},
+						Annotations: map[string]string{
+							"autoscaling.openshift.io/machineautoscaler": "openshift-machine-api/infraID-worker-eastus1",
+						},
+					},
+					Spec: machinev1beta1.MachineSetSpec{
+						Replicas: to.Int32Ptr(1),
+						Template: machinev1beta1.MachineTemplateSpec{
+							Spec: machinev1beta1.MachineSpec{
+								ProviderSpec: machinev1beta1.ProviderSpec{
+									Value: &kruntime.RawExtension{
+										Raw: []byte(`{"apiVersion":"machine.openshift.io/v1beta1","kind":"AzureMachineProviderSpec","vmSize":"Standard_D4s_v3"}`),
+									},
+								},
+							},
+						},
+					},
+					Status: machinev1beta1.MachineSetStatus{
+						ReadyReplicas: 1,
+					},
+				},
+				&machinev1beta1.MachineSet{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "infraID-worker-eastus2",
+						Namespace: "openshift-machine-api",
+						Labels: map[string]string{
+							"machine.openshift.io/cluster-api-machine-role": "worker",
+						},
+					},
+					Spec: machinev1beta1.MachineSetSpec{
+						Replicas: to.Int32Ptr(1),
+						Template: machinev1beta1.MachineTemplateSpec{
+							Spec: machinev1beta1.MachineSpec{
+								ProviderSpec: machinev1beta1.ProviderSpec{
+									Value: &kruntime.RawExtension{
+										Raw: []byte(`{"apiVersion":"machine.openshift.io/v1beta1","kind":"AzureMachineProviderSpec","vmSize":"Standard_D4s_v3"}`),
+									},
+								},
+							},
+						},
+					},
+					Status: machinev1beta1.MachineSetStatus{
+						ReadyReplicas: 1,
+					},
+				},
+			},
+			wantReplicas: map[string]int32{
+				"infraID-worker-eastus1": 1,
+				"infraID-worker-eastus2": 3,
+			},
+			wantVMSize: api.VMSizeStandardD8sV3,
+		},
+		{
+			name:     "no worker machinesets",
+			updating: true,
+			machinesets: []kruntime.Object{
+				&machinev1beta1.MachineSet{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "custom",
+						Namespace: "openshift-machine-api",
+						Labels: map[string]string{
+							"machine.openshift.io/cluster-api-machine-role": "worker",
+						},
+					},
+					Spec: machinev1beta1.MachineSetSpec{
+						Replicas: to.Int32Ptr(1),
+						Template: machinev1beta1.MachineTemplateSpec{
+							Spec: machinev1beta1.MachineSpec{
+								ProviderSpec: machinev1beta1.ProviderSpec{
+									Value: &kruntime.RawExtension{
+										Raw: []byte(`{"apiVersion":"machine.openshift.io/v1beta1","kind":"AzureMachineProviderSpec","vmSize":"Standard_D4s_v3"}`),
+									},
+								},
+							},
+						},
+					},
+					Status: machinev1beta1.MachineSetStatus{
+						ReadyReplicas: 1,
+					},
+				},
+			},
+			wantErr: "no worker machinesets found",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &manager{
+				log: logrus.NewEntry(logrus.StandardLogger()),
+				doc: &api.OpenShiftClusterDocument{
+					OpenShiftCluster: &api.OpenShiftCluster{
+						Location: "eastus",
+						Properties: api.OpenShiftClusterProperties{
+							InfraID: "infraID",
+							WorkerProfiles: []api.WorkerProfile{
+								{
+									Name:   "worker",
+									VMSize: api.VMSizeStandardD8sV3,
+									Count:  7,
+								},
+							},
+							WorkerProfilesUpdating: tt.updating,
+						},
+					},
+				},
+				maocli: machinefake.NewSimpleClientset(tt.machinesets...),
+			}
+
+			err := m.reconcileWorkerProfiles(ctx)
+			utilerror.AssertErrorMessage(t, err, tt.wantErr)
+			if err != nil {
+				return
+			}
+
+			for name, wantReplicas := range tt.wantReplicas {
+				machineset, err := m.maocli.MachineV1beta1().MachineSets("openshift-machine-api").Get(ctx, name, metav1.GetOptions{})
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if *machineset.Spec.Replicas != wantReplicas {
+					t.Errorf("%s: got %d replicas, wanted %d", name, *machineset.Spec.Replicas, wantReplicas)
+				}
+
+				if name == "infraID-worker-eastus-custom" {
+					continue
+				}
+
+				vmSize, err := providerSpecVMSize(machineset.Spec.Template.Spec.ProviderSpec.Value.Raw)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if vmSize != tt.wantVMSize {
+					t.Errorf("%s: got size %s, wanted %s", name, vmSize, tt.wantVMSize)
+				}
+			}
+		})
+	}
+}
+
+func TestWorkerProfilesReconciled(t *testing.T) {
+	ctx := context.Background()
+
+	for _, tt := range []struct {
+		name            string
+		objects         []kruntime.Object
+		wantDone        bool
+		wantErr         string
+		wantMachines    []string
+		wantNotUpdating bool
+	}{
+		{
+			name: "machineset not ready",
+			objects: []kruntime.Object{
+				&machinev1beta1.MachineSet{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "infraID-worker-eastus1",
+						Namespace: "openshift-machine-api",
+						Labels: map[string]string{
+							"machine.openshift.io/cluster-api-machine-role": "worker",
+						},
+					},
+					Spec: machinev1beta1.MachineSetSpec{
+						Replicas: to.Int32Ptr(2),
+						Template: machinev1beta1.MachineTemplateSpec{
+							Spec: machinev1beta1.MachineSpec{
+								ProviderSpec: machinev1beta1.ProviderSpec{
+									Value: &kruntime.RawExtension{
+										Raw: []byte(`{"apiVersion":"machine.openshift.io/v1beta1","kind":"AzureMachineProviderSpec","vmSize":"Standard_D8s_v3"}`),
+									},
+								},
+							},
+						},
+					},
+					Status: machinev1beta1.MachineSetStatus{
+						ReadyReplicas: 1,
+					},
+				},
+				&machinev1beta1.Machine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "old-1",
+						Namespace: "openshift-machine-api",
+						Labels: map[string]string{
+							"machine.openshift.io/cluster-api-machineset": "infraID-worker-eastus1",
+						},
+					},
+					Spec: machinev1beta1.MachineSpec{
+						ProviderSpec: machinev1beta1.ProviderSpec{
+							Value: &kruntime.RawExtension{
+								Raw: []byte(`{"apiVersion":"machine.openshift.io/v1beta1","kind":"AzureMachineProviderSpec","vmSize":"Standard_D4s_v3"}`),
+							},
+						},
+					},
+					Status: machinev1beta1.MachineStatus{
+						Phase: to.StringPtr("Running"),
+					},
+				},
+				&machinev1beta1.Machine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "old-2",
+						Namespace: "openshift-machine-api",
+						Labels: map[string]string{
+							"machine.openshift.io/cluster-api-machineset": "infraID-worker-eastus1",
+						},
+					},
+					Spec: machinev1beta1.MachineSpec{
+						ProviderSpec: machinev1beta1.ProviderSpec{
+							Value: &kruntime.RawExtension{
+								Raw: []byte(`{"apiVersion":"machine.openshift.io/v1beta1","kind":"AzureMachineProviderSpec","vmSize":"Standard_D4s_v3"}`),
+							},
+						},
+					},
+					Status: machinev1beta1.MachineStatus{
+						Phase: to.StringPtr("Running"),
+					},
+				},
+			},
+			wantMachines: []string{"old-1", "old-2"},
+		},
+		{
+			name: "machine being provisioned",
+			objects: []kruntime.Object{
+				&machinev1beta1.MachineSet{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "infraID-worker-eastus1",
+						Namespace: "openshift-machine-api",
+						Labels: map[string]string{
+							"machine.openshift.io/cluster-api-machine-role": "worker",
+						},
+					},
+					Spec: machinev1beta1.MachineSetSpec{
+						Replicas: to.Int32Ptr(2),
+						Template: machinev1beta1.MachineTemplateSpec{
+							Spec: machinev1beta1.MachineSpec{
+								ProviderSpec: machinev1beta1.ProviderSpec{
+									Value: &kruntime.RawExtension{
+										Raw: []byte(`{"apiVersion":"machine.openshift.io/v1beta1","kind":"AzureMachineProviderSpec","vmSize":"Standard_D8s_v3"}`),
+									},
+								},
+							},
+						},
+					},
+					Status: machinev1beta1.MachineSetStatus{
+						ReadyReplicas: 2,
+					},
+				},
+				&machinev1beta1.Machine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "new-1",
+						Namespace: "openshift-machine-api",
+						Labels: map[string]string{
+							"machine.openshift.io/cluster-api-machineset": "infraID-worker-eastus1",
+						},
+					},
+					Spec: machinev1beta1.MachineSpec{
+						ProviderSpec: machinev1beta1.ProviderSpec{
+							Value: &kruntime.RawExtension{
+								Raw: []byte(`{"apiVersion":"machine.openshift.io/v1beta1","kind":"AzureMachineProviderSpec","vmSize":"Standard_D8s_v3"}`),
+							},
+						},
+					},
+				},
+				&machinev1beta1.Machine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "old-2",
+						Namespace: "openshift-machine-api",
+						Labels: map[string]string{
+							"machine.openshift.io/cluster-api-machineset": "infraID-worker-eastus1",
+						},
+					},
+					Spec: machinev1beta1.MachineSpec{
+						ProviderSpec: machinev1beta1.ProviderSpec{
+							Value: &kruntime.RawExtension{
+								Raw: []byte(`{"apiVersion":"machine.openshift.io/v1beta1","kind":"AzureMachineProviderSpec","vmSize":"Standard_D4s_v3"}`),
+							},
+						},
+					},
+					Status: machinev1beta1.MachineStatus{
+						Phase: to.StringPtr("Running"),
+					},
+				},
+			},
+			wantMachines: []string{"new-1", "old-2"},
+		},
+		{
+			name: "machineset scaling down",
+			objects: []kruntime.Object{
+				&machinev1beta1.MachineSet{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "infraID-worker-eastus1",
+						Namespace: "openshift-machine-api",
+						Labels: map[string]string{
+							"machine.openshift.io/cluster-api-machine-role": "worker",
+						},
+					},
+					Spec: machinev1beta1.MachineSetSpec{
+						Replicas: to.Int32Ptr(2),
+						Template: machinev1beta1.MachineTemplateSpec{
+							Spec: machinev1beta1.MachineSpec{
+								ProviderSpec: machinev1beta1.ProviderSpec{
+									Value: &kruntime.RawExtension{
+										Raw: []byte(`{"apiVersion":"machine.openshift.io/v1beta1","kind":"AzureMachineProviderSpec","vmSize":"Standard_D8s_v3"}`),
+									},
+								},
+							},
+						},
+					},
+					Status: machinev1beta1.MachineSetStatus{
+						ReadyReplicas: 2,
+					},
+				},
+				&machinev1beta1.Machine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "new-1",
+						Namespace: "openshift-machine-api",
+						Labels: map[string]string{
+							"machine.openshift.io/cluster-api-machineset": "infraID-worker-eastus1",
+						},
+					},
+					Spec: machinev1beta1.MachineSpec{
+						ProviderSpec: machinev1beta1.ProviderSpec{
+							Value: &kruntime.RawExtension{
+								Raw: []byte(`{"apiVersion":"machine.openshift.io/v1beta1","kind":"AzureMachineProviderSpec","vmSize":"Standard_D8s_v3"}`),
+							},
+						},
+					},
+					Status: machinev1beta1.MachineStatus{
+						Phase: to.StringPtr("Running"),
+					},
+				},
+				&machinev1beta1.Machine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "old-2",
+						Namespace: "openshift-machine-api",
+						Labels: map[string]string{
+							"machine.openshift.io/cluster-api-machineset": "infraID-worker-eastus1",
+						},
+					},
+					Spec: machinev1beta1.MachineSpec{
+						ProviderSpec: machinev1beta1.ProviderSpec{
+							Value: &kruntime.RawExtension{
+								Raw: []byte(`{"apiVersion":"machine.openshift.io/v1beta1","kind":"AzureMachineProviderSpec","vmSize":"Standard_D4s_v3"}`),
+							},
+						},
+					},
+					Status: machinev1beta1.MachineStatus{
+						Phase: to.StringPtr("Running"),
+					},
+				},
+				&machinev1beta1.Machine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "old-3",
+						Namespace: "openshift-machine-api",
+						Labels: map[string]string{
+							"machine.openshift.io/cluster-api-machineset": "infraID-worker-eastus1",
+						},
+					},
+					Spec: machinev1beta1.MachineSpec{
+						ProviderSpec: machinev1beta1.ProviderSpec{
+							Value: &kruntime.RawExtension{
+								Raw: []byte(`{"apiVersion":"machine.openshift.io/v1beta1","kind":"AzureMachineProviderSpec","vmSize":"Standard_D4s_v3"}`),
+							},
+						},
+					},
+					Status: machinev1beta1.MachineStatus{
+						Phase: to.StringPtr("Running"),
+					},
+				},
+			},
+			wantMachines: []string{"new-1", "old-2", "old-3"},
+		},
+		{
+			name: "one machine replaced at a time",
+			objects: []kruntime.Object{
+				&machinev1beta1.MachineSet{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "infraID-worker-eastus1",
+						Namespace: "openshift-machine-api",
+						Labels: map[string]string{
+							"machine.openshift.io/cluster-api-machine-role": "worker",
+						},
+					},
+					Spec: machinev1beta1.MachineSetSpec{
+						Replicas: to.Int32Ptr(2),
+						Template: machinev1beta1.MachineTemplateSpec{
+							Spec: machinev1beta1.MachineSpec{
+								ProviderSpec: machinev1beta1.ProviderSpec{
+									Value: &kruntime.RawExtension{
+										Raw: []byte(`{"apiVersion":"machine.openshift.io/v1beta1","kind":"AzureMachineProviderSpec","vmSize":"Standard_D8s_v3"}`),
+									},
+								},
+							},
+						},
+					},
+					Status: machinev1beta1.MachineSetStatus{
+						ReadyReplicas: 2,
+					},
+				},
+				&machinev1beta1.Machine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "new-1",
+						Namespace: "openshift-machine-api",
+						Labels: map[string]string{
+							"machine.openshift.io/cluster-api-machineset": "infraID-worker-eastus1",
+						},
+					},
+					Spec: machinev1beta1.MachineSpec{
+						ProviderSpec: machinev1beta1.ProviderSpec{
+							Value: &kruntime.RawExtension{
+								Raw: []byte(`{"apiVersion":"machine.openshift.io/v1beta1","kind":"AzureMachineProviderSpec","vmSize":"Standard_D8s_v3"}`),
+							},
+						},
+					},
+					Status: machinev1beta1.MachineStatus{
+						Phase: to.StringPtr("Running"),
+					},
+				},
+				&machinev1beta1.Machine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "old-2",
+						Namespace: "openshift-machine-api",
+						Labels: map[string]string{
+							"machine.openshift.io/cluster-api-machineset": "infraID-worker-eastus1",
+						},
+					},
+					Spec: machinev1beta1.MachineSpec{
+						ProviderSpec: machinev1beta1.ProviderSpec{
+							Value: &kruntime.RawExtension{
+								Raw: []byte(`{"apiVersion":"machine.openshift.io/v1beta1","kind":"AzureMachineProviderSpec","vmSize":"Standard_D4s_v3"}`),
+							},
+						},
+					},
+					Status: machinev1beta1.MachineStatus{
+						Phase: to.StringPtr("Running"),
+					},
+				},
+			},
+			wantMachines: []string{"new-1"},
+		},
+		{
+			name: "one machine per machineset replaced at a time",
+			objects: []kruntime.Object{
+				&machinev1beta1.MachineSet{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "infraID-worker-eastus1",
+						Namespace: "openshift-machine-api",
+						Labels: map[string]string{
+							"machine.openshift.io/cluster-api-machine-role": "worker",
+						},
+					},
+					Spec: machinev1beta1.MachineSetSpec{
+						Replicas: to.Int32Ptr(2),
+						Template: machinev1beta1.MachineTemplateSpec{
+							Spec: machinev1beta1.MachineSpec{
+								ProviderSpec: machinev1beta1.ProviderSpec{
+									Value: &kruntime.RawExtension{
+										Raw: []byte(`{"apiVersion":"machine.openshift.io/v1beta1","kind":"AzureMachineProviderSpec","vmSize":"Standard_D8s_v3"}`),
+									},
+								},
+							},
+						},
+					},
+					Status: machinev1beta1.MachineSetStatus{
+						ReadyReplicas: 2,
+					},
+				},
+				&machinev1beta1.MachineSet{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "infraID-worker-eastus2",
+						Namespace: "openshift-machine-api",
+						Labels: map[string]string{
+							"machine.openshift.io/cluster-api-machine-role": "worker",
+						},
+					},
+					Spec: machinev1beta1.MachineSetSpec{
+						Replicas: to.Int32Ptr(1),
+						Template: machinev1beta1.MachineTemplateSpec{
+							Spec: machinev1beta1.MachineSpec{
+								ProviderSpec: machinev1beta1.ProviderSpec{
+									Value: &kruntime.RawExtension{
+										Raw: []byte(`{"apiVersion":"machine.openshift.io/v1beta1","kind":"AzureMachineProviderSpec","vmSize":"Standard_D8s_v3"}`),
+									},
+								},
+							},
+						},
+					},
+					Status: machinev1beta1.MachineSetStatus{
+						ReadyReplicas: 1,
+					},
+				},
+				&machinev1beta1.Machine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "old-1",
+						Namespace: "openshift-machine-api",
+						Labels: map[string]string{
+							"machine.openshift.io/cluster-api-machineset": "infraID-worker-eastus1",
+						},
+					},
+					Spec: machinev1beta1.MachineSpec{
+						ProviderSpec: machinev1beta1.ProviderSpec{
+							Value: &kruntime.RawExtension{
+								Raw: []byte(`{"apiVersion":"machine.openshift.io/v1beta1","kind":"AzureMachineProviderSpec","vmSize":"Standard_D4s_v3"}`),
+							},
+						},
+					},
+					Status: machinev1beta1.MachineStatus{
+						Phase: to.StringPtr("Running"),
+					},
+				},
+				&machinev1beta1.Machine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "old-2",
+						Namespace: "openshift-machine-api",
+						Labels: map[string]string{
+							"machine.openshift.io/cluster-api-machineset": "infraID-worker-eastus1",
+						},
+					},
+					Spec: machinev1beta1.MachineSpec{
+						ProviderSpec: machinev1beta1.ProviderSpec{
+							Value: &kruntime.RawExtension{
+								Raw: []byte(`{"apiVersion":"machine.openshift.io/v1beta1","kind":"AzureMachineProviderSpec","vmSize":"Standard_D4s_v3"}`),
+							},
+						},
+					},
+					Status: machinev1beta1.MachineStatus{
+						Phase: to.StringPtr("Running"),
+					},
+				},
+				&machinev1beta1.Machine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "old-3",
+						Namespace: "openshift-machine-api",
+						Labels: map[string]string{
+							"machine.openshift.io/cluster-api-machineset": "infraID-worker-eastus2",
+						},
+					},
+					Spec: machinev1beta1.MachineSpec{
+						ProviderSpec: machinev1beta1.ProviderSpec{
+							Value: &kruntime.RawExtension{
+								Raw: []byte(`{"apiVersion":"machine.openshift.io/v1beta1","kind":"AzureMachineProviderSpec","vmSize":"Standard_D4s_v3"}`),
+							},
+						},
+					},
+					Status: machinev1beta1.MachineStatus{
+						Phase: to.StringPtr("Running"),
+					},
+				},
+			},
+			wantMachines: []string{"old-2"},
+		},
+		{
+			name: "failed machine fails the update",
+			objects: []kruntime.Object{
+				&machinev1beta1.MachineSet{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "infraID-worker-eastus1",
+						Namespace: "openshift-machine-api",
+						Labels: map[string]string{
+							"machine.openshift.io/cluster-api-machine-role": "worker",
+						},
+					},
+					Spec: machinev1beta1.MachineSetSpec{
+						Replicas: to.Int32Ptr(2),
+						Template: machinev1beta1.MachineTemplateSpec{
+							Spec: machinev1beta1.MachineSpec{
+								ProviderSpec: machinev1beta1.ProviderSpec{
+									Value: &kruntime.RawExtension{
+										Raw: []byte(`{"apiVersion":"machine.openshift.io/v1beta1","kind":"AzureMachineProviderSpec","vmSize":"Standard_D8s_v3"}`),
+									},
+								},
+							},
+						},
+					},
+					Status: machinev1beta1.MachineSetStatus{
+						ReadyReplicas: 1,
+					},
+				},
+				&machinev1beta1.Machine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "new-1",
+						Namespace: "openshift-machine-api",
+						Labels: map[string]string{
+							"machine.openshift.io/cluster-api-machineset": "infraID-worker-eastus1",
+						},
+					},
+					Spec: machinev1beta1.MachineSpec{
+						ProviderSpec: machinev1beta1.ProviderSpec{
+							Value: &kruntime.RawExtension{
+								Raw: []byte(`{"apiVersion":"machine.openshift.io/v1beta1","kind":"AzureMachineProviderSpec","vmSize":"Standard_D8s_v3"}`),
+							},
+						},
+					},
+					Status: machinev1beta1.MachineStatus{
+						Phase:        to.StringPtr("Failed"),
+						ErrorMessage: to.StringPtr("quota exceeded"),
+					},
+				},
+				&machinev1beta1.Machine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "old-2",
+						Namespace: "openshift-machine-api",
+						Labels: map[string]string{
+							"machine.openshift.io/cluster-api-machineset": "infraID-worker-eastus1",
+						},
+					},
+					Spec: machinev1beta1.MachineSpec{
+						ProviderSpec: machinev1beta1.ProviderSpec{
+							Value: &kruntime.RawExtension{
+								Raw: []byte(`{"apiVersion":"machine.openshift.io/v1beta1","kind":"AzureMachineProviderSpec","vmSize":"Standard_D4s_v3"}`),
+							},
+						},
+					},
+					Status: machinev1beta1.MachineStatus{
+						Phase: to.StringPtr("Running"),
+					},
+				},
+			},
+			wantErr:         "400: InvalidParameter: properties.workerProfiles: The worker machine 'new-1' failed to provision: quota exceeded",
+			wantMachines:    []string{"new-1", "old-2"},
+			wantNotUpdating: true,
+		},
+		{
+			name: "all machines resized",
+			objects: []kruntime.Object{
+				&machinev1beta1.MachineSet{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "infraID-worker-eastus1",
+						Namespace: "openshift-machine-api",
+						Labels: map[string]string{
+							"machine.openshift.io/cluster-api-machine-role": "worker",
+						},
+					},
+					Spec: machinev1beta1.MachineSetSpec{
+						Replicas: to.Int32Ptr(2),
+						Template: machinev1beta1.MachineTemplateSpec{
+							Spec: machinev1beta1.MachineSpec{
+								ProviderSpec: machinev1beta1.ProviderSpec{
+									Value: &kruntime.RawExtension{
+										Raw: []byte(`{"apiVersion":"machine.openshift.io/v1beta1","kind":"AzureMachineProviderSpec","vmSize":"Standard_D8s_v3"}`),
+									},
+								},
+							},
+						},
+					},
+					Status: machinev1beta1.MachineSetStatus{
+						ReadyReplicas: 2,
+					},
+				},
+				&machinev1beta1.Machine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "new-1",
+						Namespace: "openshift-machine-api",
+						Labels: map[string]string{
+							"machine.openshift.io/cluster-api-machineset": "infraID-worker-eastus1",
+						},
+					},
+					Spec: machinev1beta1.MachineSpec{
+						ProviderSpec: machinev1beta1.ProviderSpec{
+							Value: &kruntime.RawExtension{
+								Raw: []byte(`{"apiVersion":"machine.openshift.io/v1beta1","kind":"AzureMachineProviderSpec","vmSize":"Standard_D8s_v3"}`),
+							},
+						},
+					},
+					Status: machinev1beta1.MachineStatus{
+						Phase: to.StringPtr("Running"),
+					},
+				},
+				&machinev1beta1.Machine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "new-2",
+						Namespace: "openshift-machine-api",
+						Labels: map[string]string{
+							"machine.openshift.io/cluster-api-machineset": "infraID-worker-eastus1",
+						},
+					},
+					Spec: machinev1beta1.MachineSpec{
+						ProviderSpec: machinev1beta1.ProviderSpec{
+							Value: &kruntime.RawExtension{
+								Raw: []byte(`{"apiVersion":"machine.openshift.io/v1beta1","kind":"AzureMachineProviderSpec","vmSize":"Standard_D8s_v3"}`),
+							},
+						},
+					},
+					Status: machinev1beta1.MachineStatus{
+						Phase: to.StringPtr("Running"),
+					},
+				},
+			},
+			wantDone:        true,
+			wantMachines:    []string{"new-1", "new-2"},
+			wantNotUpdating: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			key := "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/resourcegroup/providers/microsoft.redhatopenshift/openshiftclusters/resourcename"
+
+			openShiftClustersDatabase, _ := testdatabase.NewFakeOpenShiftClusters()
+			fixture := testdatabase.NewFixture().WithOpenShiftClusters(openShiftClustersDatabase)
+			fixture.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+				Key: key,
+				OpenShiftCluster: &api.OpenShiftCluster{
+					ID:       key,
+					Location: "eastus",
+					Properties: api.OpenShiftClusterProperties{
+						InfraID: "infraID",
+						WorkerProfiles: []api.WorkerProfile{
+							{
+								Name:   "worker",
+								VMSize: api.VMSizeStandardD8sV3,
+								Count:  2,
+							},
+						},
+						WorkerProfilesUpdating: true,
+					},
+				},
+			})
+			err := fixture.Create()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			doc, err := openShiftClustersDatabase.Get(ctx, key)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			m := &manager{
+				log:    logrus.NewEntry(logrus.StandardLogger()),
+				db:     openShiftClustersDatabase,
+				doc:    doc,
+				maocli: machinefake.NewSimpleClientset(tt.objects...),
+			}
+
+			done, err := m.workerProfilesReconciled(ctx)
+			utilerror.AssertErrorMessage(t, err, tt.wantErr)
+
+			if done != tt.wantDone {
+				t.Errorf("got %v, wanted %v", done, tt.wantDone)
+			}
+
+			machines, err := m.maocli.MachineV1beta1().Machines("openshift-machine-api").List(ctx, metav1.ListOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var names []string
+			for _, machine := range machines.Items {
+				names = append(names, machine.Name)
+			}
+			sort.Strings(names)
+
+			if !reflect.DeepEqual(names, tt.wantMachines) {
+				t.Errorf("got machines %v, wanted %v", names, tt.wantMachines)
+			}
+
+			if m.doc.OpenShiftCluster.Properties.WorkerProfilesUpdating == tt.wantNotUpdating {
+				t.Errorf("got workerProfilesUpdating %v", m.doc.OpenShiftCluster.Properties.WorkerProfilesUpdating)
+			}
+		})
+	}
+}
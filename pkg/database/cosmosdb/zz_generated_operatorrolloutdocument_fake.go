@@ -0,0 +1,361 @@
+// Code generated by github.com/jewzaam/go-cosmosdb, DO NOT EDIT.
+
+package cosmosdb
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/ugorji/go/codec"
+
+	pkg "github.com/Azure/ARO-RP/pkg/api"
+)
+
+type fakeOperatorRolloutDocumentTriggerHandler func(context.Context, *pkg.OperatorRolloutDocument) error
+type fakeOperatorRolloutDocumentQueryHandler func(OperatorRolloutDocumentClient, *Query, *Options) OperatorRolloutDocumentRawIterator
+
+var _ OperatorRolloutDocumentClient = &FakeOperatorRolloutDocumentClient{}
+
+// NewFakeOperatorRolloutDocumentClient returns a FakeOperatorRolloutDocumentClient
+func NewFakeOperatorRolloutDocumentClient(h *codec.JsonHandle) *FakeOperatorRolloutDocumentClient {
+	return &FakeOperatorRolloutDocumentClient{
+		jsonHandle:               h,
+		operatorRolloutDocuments: make(map[string]*pkg.OperatorRolloutDocument),
+		triggerHandlers:          make(map[string]fakeOperatorRolloutDocumentTriggerHandler),
+		queryHandlers:            make(map[string]fakeOperatorRolloutDocumentQueryHandler),
+	}
+}
+
+// FakeOperatorRolloutDocumentClient is a FakeOperatorRolloutDocumentClient
+type FakeOperatorRolloutDocumentClient struct {
+	lock                     sync.RWMutex
+	jsonHandle               *codec.JsonHandle
+	operatorRolloutDocuments map[string]*pkg.OperatorRolloutDocument
+	triggerHandlers          map[string]fakeOperatorRolloutDocumentTriggerHandler
+	queryHandlers            map[string]fakeOperatorRolloutDocumentQueryHandler
+	sorter                   func([]*pkg.OperatorRolloutDocument)
+	etag                     int
+
+	// returns true if documents conflict
+	conflictChecker func(*pkg.OperatorRolloutDocument, *pkg.OperatorRolloutDocument) bool
+
+	// err, if not nil, is an error to return when attempting to communicate
+	// with this Client
+	err error
+}
+
+// SetError sets or unsets an error that will be returned on any
+// FakeOperatorRolloutDocumentClient method invocation
+func (c *FakeOperatorRolloutDocumentClient) SetError(err error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.err = err
+}
+
+// SetSorter sets or unsets a sorter function which will be used to sort values
+// returned by List() for test stability
+func (c *FakeOperatorRolloutDocumentClient) SetSorter(sorter func([]*pkg.OperatorRolloutDocument)) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.sorter = sorter
+}
+
+// SetConflictChecker sets or unsets a function which can be used to validate
+// additional unique keys in a OperatorRolloutDocument
+func (c *FakeOperatorRolloutDocumentClient) SetConflictChecker(conflictChecker func(*pkg.OperatorRolloutDocument, *pkg.OperatorRolloutDocument) bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.conflictChecker = conflictChecker
+}
+
+// SetTriggerHandler sets or unsets a trigger handler
+func (c *FakeOperatorRolloutDocumentClient) SetTriggerHandler(triggerName string, trigger fakeOperatorRolloutDocumentTriggerHandler) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.triggerHandlers[triggerName] = trigger
+}
+
+// SetQueryHandler sets or unsets a query handler
+func (c *FakeOperatorRolloutDocumentClient) SetQueryHandler(queryName string, query fakeOperatorRolloutDocumentQueryHandler) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.queryHandlers[queryName] = query
+}
+
+func (c *FakeOperatorRolloutDocumentClient) deepCopy(operatorRolloutDocument *pkg.OperatorRolloutDocument) (*pkg.OperatorRolloutDocument, error) {
+	var b []byte
+	err := codec.NewEncoderBytes(&b, c.jsonHandle).Encode(operatorRolloutDocument)
+	if err != nil {
+		return nil, err
+	}
+
+	operatorRolloutDocument = nil
+	err = codec.NewDecoderBytes(b, c.jsonHandle).Decode(&operatorRolloutDocument)
+	if err != nil {
+		return nil, err
+	}
+
+	return operatorRolloutDocument, nil
+}
+
+func (c *FakeOperatorRolloutDocumentClient) apply(ctx context.Context, partitionkey string, operatorRolloutDocument *pkg.OperatorRolloutDocument, options *Options, isCreate bool) (*pkg.OperatorRolloutDocument, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	operatorRolloutDocument, err := c.deepCopy(operatorRolloutDocument) // copy now because pretriggers can mutate operatorRolloutDocument
+	if err != nil {
+		return nil, err
+	}
+
+	if options != nil {
+		err := c.processPreTriggers(ctx, operatorRolloutDocument, options)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	existingOperatorRolloutDocument, exists := c.operatorRolloutDocuments[operatorRolloutDocument.ID]
+	if isCreate && exists {
+		return nil, &Error{
+			StatusCode: http.StatusConflict,
+			Message:    "Entity with the specified id already exists in the system",
+		}
+	}
+	if !isCreate {
+		if !exists {
+			return nil, &Error{StatusCode: http.StatusNotFound}
+		}
+
+		if operatorRolloutDocument.ETag != existingOperatorRolloutDocument.ETag {
+			return nil, &Error{StatusCode: http.StatusPreconditionFailed}
+		}
+	}
+
+	if c.conflictChecker != nil {
+		for _, operatorRolloutDocumentToCheck := range c.operatorRolloutDocuments {
+			if c.conflictChecker(operatorRolloutDocumentToCheck, operatorRolloutDocument) {
+				return nil, &Error{
+					StatusCode: http.StatusConflict,
+					Message:    "Entity with the specified id already exists in the system",
+				}
+			}
+		}
+	}
+
+	operatorRolloutDocument.ETag = fmt.Sprint(c.etag)
+	c.etag++
+
+	c.operatorRolloutDocuments[operatorRolloutDocument.ID] = operatorRolloutDocument
+
+	return c.deepCopy(operatorRolloutDocument)
+}
+
+// Create creates a OperatorRolloutDocument in the database
+func (c *FakeOperatorRolloutDocumentClient) Create(ctx context.Context, partitionkey string, operatorRolloutDocument *pkg.OperatorRolloutDocument, options *Options) (*pkg.OperatorRolloutDocument, error) {
+	return c.apply(ctx, partitionkey, operatorRolloutDocument, options, true)
+}
+
+// Replace replaces a OperatorRolloutDocument in the database
+func (c *FakeOperatorRolloutDocumentClient) Replace(ctx context.Context, partitionkey string, operatorRolloutDocument *pkg.OperatorRolloutDocument, options *Options) (*pkg.OperatorRolloutDocument, error) {
+	return c.apply(ctx, partitionkey, operatorRolloutDocument, options, false)
+}
+
+// List returns a OperatorRolloutDocumentIterator to list all OperatorRolloutDocuments in the database
+func (c *FakeOperatorRolloutDocumentClient) List(*Options) OperatorRolloutDocumentIterator {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	if c.err != nil {
+		return NewFakeOperatorRolloutDocumentErroringRawIterator(c.err)
+	}
+
+	operatorRolloutDocuments := make([]*pkg.OperatorRolloutDocument, 0, len(c.operatorRolloutDocuments))
+	for _, operatorRolloutDocument := range c.operatorRolloutDocuments {
+		operatorRolloutDocument, err := c.deepCopy(operatorRolloutDocument)
+		if err != nil {
+			return NewFakeOperatorRolloutDocumentErroringRawIterator(err)
+		}
+		operatorRolloutDocuments = append(operatorRolloutDocuments, operatorRolloutDocument)
+	}
+
+	if c.sorter != nil {
+		c.sorter(operatorRolloutDocuments)
+	}
+
+	return NewFakeOperatorRolloutDocumentIterator(operatorRolloutDocuments, 0)
+}
+
+// ListAll lists all OperatorRolloutDocuments in the database
+func (c *FakeOperatorRolloutDocumentClient) ListAll(ctx context.Context, options *Options) (*pkg.OperatorRolloutDocuments, error) {
+	iter := c.List(options)
+	return iter.Next(ctx, -1)
+}
+
+// Get gets a OperatorRolloutDocument from the database
+func (c *FakeOperatorRolloutDocumentClient) Get(ctx context.Context, partitionkey string, id string, options *Options) (*pkg.OperatorRolloutDocument, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	operatorRolloutDocument, exists := c.operatorRolloutDocuments[id]
+	if !exists {
+		return nil, &Error{StatusCode: http.StatusNotFound}
+	}
+
+	return c.deepCopy(operatorRolloutDocument)
+}
+
+// Delete deletes a OperatorRolloutDocument from the database
+func (c *FakeOperatorRolloutDocumentClient) Delete(ctx context.Context, partitionKey string, operatorRolloutDocument *pkg.OperatorRolloutDocument, options *Options) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.err != nil {
+		return c.err
+	}
+
+	_, exists := c.operatorRolloutDocuments[operatorRolloutDocument.ID]
+	if !exists {
+		return &Error{StatusCode: http.StatusNotFound}
+	}
+
+	delete(c.operatorRolloutDocuments, operatorRolloutDocument.ID)
+	return nil
+}
+
+// ChangeFeed is unimplemented
+func (c *FakeOperatorRolloutDocumentClient) ChangeFeed(*Options) OperatorRolloutDocumentIterator {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	if c.err != nil {
+		return NewFakeOperatorRolloutDocumentErroringRawIterator(c.err)
+	}
+
+	return NewFakeOperatorRolloutDocumentErroringRawIterator(ErrNotImplemented)
+}
+
+func (c *FakeOperatorRolloutDocumentClient) processPreTriggers(ctx context.Context, operatorRolloutDocument *pkg.OperatorRolloutDocument, options *Options) error {
+	for _, triggerName := range options.PreTriggers {
+		if triggerHandler := c.triggerHandlers[triggerName]; triggerHandler != nil {
+			c.lock.Unlock()
+			err := triggerHandler(ctx, operatorRolloutDocument)
+			c.lock.Lock()
+			if err != nil {
+				return err
+			}
+		} else {
+			return ErrNotImplemented
+		}
+	}
+
+	return nil
+}
+
+// Query calls a query handler to implement database querying
+func (c *FakeOperatorRolloutDocumentClient) Query(name string, query *Query, options *Options) OperatorRolloutDocumentRawIterator {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	if c.err != nil {
+		return NewFakeOperatorRolloutDocumentErroringRawIterator(c.err)
+	}
+
+	if queryHandler := c.queryHandlers[query.Query]; queryHandler != nil {
+		c.lock.RUnlock()
+		i := queryHandler(c, query, options)
+		c.lock.RLock()
+		return i
+	}
+
+	return NewFakeOperatorRolloutDocumentErroringRawIterator(ErrNotImplemented)
+}
+
+// QueryAll calls a query handler to implement database querying
+func (c *FakeOperatorRolloutDocumentClient) QueryAll(ctx context.Context, partitionkey string, query *Query, options *Options) (*pkg.OperatorRolloutDocuments, error) {
+	iter := c.Query("", query, options)
+	return iter.Next(ctx, -1)
+}
+
+func NewFakeOperatorRolloutDocumentIterator(operatorRolloutDocuments []*pkg.OperatorRolloutDocument, continuation int) OperatorRolloutDocumentRawIterator {
+	return &fakeOperatorRolloutDocumentIterator{operatorRolloutDocuments: operatorRolloutDocuments, continuation: continuation}
+}
+
+type fakeOperatorRolloutDocumentIterator struct {
+	operatorRolloutDocuments []*pkg.OperatorRolloutDocument
+	continuation             int
+	done                     bool
+}
+
+func (i *fakeOperatorRolloutDocumentIterator) NextRaw(ctx context.Context, maxItemCount int, out interface{}) error {
+	return ErrNotImplemented
+}
+
+func (i *fakeOperatorRolloutDocumentIterator) Next(ctx context.Context, maxItemCount int) (*pkg.OperatorRolloutDocuments, error) {
+	if i.done {
+		return nil, nil
+	}
+
+	var operatorRolloutDocuments []*pkg.OperatorRolloutDocument
+	if maxItemCount == -1 {
+		operatorRolloutDocuments = i.operatorRolloutDocuments[i.continuation:]
+		i.continuation = len(i.operatorRolloutDocuments)
+		i.done = true
+	} else {
+		max := i.continuation + maxItemCount
+		if max > len(i.operatorRolloutDocuments) {
+			max = len(i.operatorRolloutDocuments)
+		}
+		operatorRolloutDocuments = i.operatorRolloutDocuments[i.continuation:max]
+		i.continuation += max
+		i.done = i.Continuation() == ""
+	}
+
+	return &pkg.OperatorRolloutDocuments{
+		OperatorRolloutDocuments: operatorRolloutDocuments,
+		Count:                    len(operatorRolloutDocuments),
+	}, nil
+}
+
+func (i *fakeOperatorRolloutDocumentIterator) Continuation() string {
+	if i.continuation >= len(i.operatorRolloutDocuments) {
+		return ""
+	}
+	return fmt.Sprintf("%d", i.continuation)
+}
+
+// NewFakeOperatorRolloutDocumentErroringRawIterator returns a OperatorRolloutDocumentRawIterator which
+// whose methods return the given error
+func NewFakeOperatorRolloutDocumentErroringRawIterator(err error) OperatorRolloutDocumentRawIterator {
+	return &fakeOperatorRolloutDocumentErroringRawIterator{err: err}
+}
+
+type fakeOperatorRolloutDocumentErroringRawIterator struct {
+	err error
+}
+
+func (i *fakeOperatorRolloutDocumentErroringRawIterator) Next(ctx context.Context, maxItemCount int) (*pkg.OperatorRolloutDocuments, error) {
+	return nil, i.err
+}
+
+func (i *fakeOperatorRolloutDocumentErroringRawIterator) NextRaw(context.Context, int, interface{}) error {
+	return i.err
+}
+
+func (i *fakeOperatorRolloutDocumentErroringRawIterator) Continuation() string {
+	return ""
+}
@@ -17,6 +17,7 @@ import (
 
 	"github.com/Azure/ARO-RP/pkg/api"
 	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+	"github.com/Azure/ARO-RP/pkg/database/embedded"
 	"github.com/Azure/ARO-RP/pkg/env"
 	"github.com/Azure/ARO-RP/pkg/metrics"
 	dbmetrics "github.com/Azure/ARO-RP/pkg/metrics/statsd/cosmosdb"
@@ -33,6 +34,7 @@ const (
 	collMonitors          = "Monitors"
 	collOpenShiftClusters = "OpenShiftClusters"
 	collOpenShiftVersion  = "OpenShiftVersions"
+	collOperatorRollouts  = "OperatorRollouts"
 	collPortal            = "Portal"
 	collSubscriptions     = "Subscriptions"
 )
@@ -55,6 +57,26 @@ func NewDatabaseClient(log *logrus.Entry, _env env.Core, authorizer cosmosdb.Aut
 	return cosmosdb.NewDatabaseClient(log, c, h, databaseAccountName+"."+_env.Environment().CosmosDBDNSSuffix, authorizer), nil
 }
 
+// NewEmbeddedDatabaseClient returns a database client backed by the local
+// database file at path, for running the RP without a Cosmos DB account.
+func NewEmbeddedDatabaseClient(log *logrus.Entry, path string, aead encryption.AEAD) (cosmosdb.DatabaseClient, error) {
+	h, err := NewJSONHandle(aead)
+	if err != nil {
+		return nil, err
+	}
+
+	store, err := embedded.New(log, path)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &http.Client{
+		Transport: store,
+	}
+
+	return cosmosdb.NewDatabaseClient(log, c, h, "localhost", nil), nil
+}
+
 func NewMasterKeyAuthorizer(ctx context.Context, token azcore.TokenCredential, clientOptions *policy.ClientOptions, subscriptionID, resourceGroup, databaseAccountName string) (cosmosdb.Authorizer, error) {
 	databaseaccounts, err := armcosmos.NewDatabaseAccountsClient(subscriptionID, token, clientOptions)
 	if err != nil {
@@ -0,0 +1,374 @@
+package embedded
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+	"github.com/Azure/ARO-RP/pkg/util/uuid"
+)
+
+// documentList is the body of list, query and change feed responses.
+type documentList struct {
+	ResourceID string        `json:"_rid"`
+	Documents  []interface{} `json:"Documents"`
+	Count      int           `json:"_count"`
+}
+
+func newDocumentList(docs []interface{}) *documentList {
+	if docs == nil {
+		docs = []interface{}{}
+	}
+
+	return &documentList{
+		Documents: docs,
+		Count:     len(docs),
+	}
+}
+
+func decodeDocument(data []byte) (map[string]interface{}, error) {
+	var doc map[string]interface{}
+
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+
+	return doc, d.Decode(&doc)
+}
+
+// forEachDocument calls f for every document in the collection, in ID order.
+func forEachDocument(tx *bolt.Tx, coll []byte, f func(id []byte, doc map[string]interface{}) error) error {
+	b, err := collectionBucket(tx, coll, bucketDocs, false)
+	if err != nil || b == nil {
+		return err
+	}
+
+	return b.ForEach(func(k, v []byte) error {
+		doc, err := decodeDocument(v)
+		if err != nil {
+			return err
+		}
+
+		return f(k, doc)
+	})
+}
+
+func maxItemCount(req *http.Request) int {
+	i, err := strconv.Atoi(req.Header.Get("X-Ms-Max-Item-Count"))
+	if err != nil || i <= 0 {
+		return -1
+	}
+	return i
+}
+
+func (s *Store) getDocument(req *http.Request, coll []byte, id string) (*http.Response, error) {
+	var doc map[string]interface{}
+
+	err := s.view(func(tx *bolt.Tx) error {
+		b, err := collectionBucket(tx, coll, bucketDocs, false)
+		if err != nil {
+			return err
+		}
+
+		var data []byte
+		if b != nil {
+			data = b.Get([]byte(id))
+		}
+		if data == nil {
+			return newStatusError(http.StatusNotFound, "Entity with the specified id does not exist in the system")
+		}
+
+		doc, err = decodeDocument(data)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return newResponse(req, http.StatusOK, nil, doc)
+}
+
+// putDocument creates (POST) or replaces (PUT) a document, honouring If-Match
+// and running any requested pre-triggers. Every write is given a new ETag and
+// the next log sequence number of the collection, which orders the change
+// feed.
+func (s *Store) putDocument(req *http.Request, coll []byte, id string, isCreate bool) (*http.Response, error) {
+	var doc map[string]interface{}
+	err := decodeBody(req, &doc)
+	if err != nil {
+		return nil, err
+	}
+
+	if isCreate {
+		id, _ = doc["id"].(string)
+		if id == "" {
+			return nil, newStatusError(http.StatusBadRequest, "The input content is invalid because the required properties - 'id; ' - are missing")
+		}
+	} else if doc["id"] != id {
+		return nil, newStatusError(http.StatusBadRequest, "The id in the request body does not match the id in the path")
+	}
+
+	operation := cosmosdb.TriggerOperationReplace
+	statusCode := http.StatusOK
+	if isCreate {
+		operation = cosmosdb.TriggerOperationCreate
+		statusCode = http.StatusCreated
+	}
+
+	err = s.update(func(tx *bolt.Tx) error {
+		b, err := collectionBucket(tx, coll, bucketDocs, true)
+		if err != nil {
+			return err
+		}
+
+		existing := b.Get([]byte(id))
+		switch {
+		case isCreate && existing != nil:
+			return newStatusError(http.StatusConflict, "Entity with the specified id already exists in the system")
+		case !isCreate && existing == nil:
+			return newStatusError(http.StatusNotFound, "Entity with the specified id does not exist in the system")
+		case !isCreate:
+			err = checkETag(req, existing)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = s.runPreTriggers(tx, req, coll, doc, operation)
+		if err != nil {
+			return err
+		}
+
+		lsn, err := tx.Bucket(coll).NextSequence()
+		if err != nil {
+			return err
+		}
+
+		doc["_self"] = string(coll) + "/docs/" + id
+		doc["_etag"] = `"` + uuid.DefaultGenerator.Generate() + `"`
+		doc["_ts"] = s.now().Unix()
+		doc["_lsn"] = lsn
+
+		data, err := json.Marshal(doc)
+		if err != nil {
+			return err
+		}
+
+		return b.Put([]byte(id), data)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return newResponse(req, statusCode, nil, doc)
+}
+
+func (s *Store) deleteDocument(req *http.Request, coll []byte, id string) (*http.Response, error) {
+	err := s.update(func(tx *bolt.Tx) error {
+		b, err := collectionBucket(tx, coll, bucketDocs, true)
+		if err != nil {
+			return err
+		}
+
+		existing := b.Get([]byte(id))
+		if existing == nil {
+			return newStatusError(http.StatusNotFound, "Entity with the specified id does not exist in the system")
+		}
+
+		err = checkETag(req, existing)
+		if err != nil {
+			return err
+		}
+
+		return b.Delete([]byte(id))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return newResponse(req, http.StatusNoContent, nil, nil)
+}
+
+func checkETag(req *http.Request, existing []byte) error {
+	etag := req.Header.Get("If-Match")
+	if etag == "" {
+		return nil
+	}
+
+	doc, err := decodeDocument(existing)
+	if err != nil {
+		return err
+	}
+
+	if doc["_etag"] != etag {
+		return newStatusError(http.StatusPreconditionFailed, "Operation cannot be performed because one of the specified precondition is not met")
+	}
+
+	return nil
+}
+
+// listDocuments lists documents in ID order. The continuation token is the ID
+// of the next document to return.
+func (s *Store) listDocuments(req *http.Request, coll []byte) (*http.Response, error) {
+	max := maxItemCount(req)
+	start := req.Header.Get("X-Ms-Continuation")
+
+	var docs []interface{}
+	var continuation string
+
+	err := s.view(func(tx *bolt.Tx) error {
+		b, err := collectionBucket(tx, coll, bucketDocs, false)
+		if err != nil || b == nil {
+			return err
+		}
+
+		c := b.Cursor()
+		k, v := c.First()
+		if start != "" {
+			k, v = c.Seek([]byte(start))
+		}
+
+		for ; k != nil; k, v = c.Next() {
+			if max != -1 && len(docs) == max {
+				continuation = string(k)
+				break
+			}
+
+			doc, err := decodeDocument(v)
+			if err != nil {
+				return err
+			}
+			docs = append(docs, doc)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	header := http.Header{}
+	if continuation != "" {
+		header.Set("X-Ms-Continuation", continuation)
+	}
+
+	return newResponse(req, http.StatusOK, header, newDocumentList(docs))
+}
+
+// queryDocuments runs a query over the collection. Queries run across the
+// whole collection: the store is not partitioned, and all the queries in
+// pkg/database filter on the partition key themselves. The continuation token
+// is the offset of the next result.
+func (s *Store) queryDocuments(req *http.Request, coll []byte) (*http.Response, error) {
+	var body *cosmosdb.Query
+	err := decodeBody(req, &body)
+	if err != nil {
+		return nil, err
+	}
+
+	q, err := parseQuery(body.Query)
+	if err != nil {
+		return nil, newStatusError(http.StatusBadRequest, "%s", err)
+	}
+
+	ctx := &evalContext{
+		parameters: map[string]string{},
+		now:        s.now(),
+	}
+	for _, p := range body.Parameters {
+		ctx.parameters[p.Name] = p.Value
+	}
+
+	var matches []map[string]interface{}
+	err = s.view(func(tx *bolt.Tx) error {
+		return forEachDocument(tx, coll, func(id []byte, doc map[string]interface{}) error {
+			ctx.doc = doc
+			if q.matches(ctx) {
+				matches = append(matches, doc)
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if q.count {
+		return newResponse(req, http.StatusOK, nil, newDocumentList([]interface{}{len(matches)}))
+	}
+
+	q.sort(matches, ctx)
+
+	offset, _ := strconv.Atoi(req.Header.Get("X-Ms-Continuation"))
+	if offset > len(matches) {
+		offset = len(matches)
+	}
+	matches = matches[offset:]
+
+	header := http.Header{}
+	if max := maxItemCount(req); max != -1 && len(matches) > max {
+		matches = matches[:max]
+		header.Set("X-Ms-Continuation", strconv.Itoa(offset+max))
+	}
+
+	docs := make([]interface{}, 0, len(matches))
+	for _, doc := range matches {
+		ctx.doc = doc
+		docs = append(docs, q.project(ctx))
+	}
+
+	return newResponse(req, http.StatusOK, header, newDocumentList(docs))
+}
+
+// changeFeed returns the latest version of every document written since the
+// log sequence number in If-None-Match, oldest first, and the log sequence
+// number to continue from in the ETag header. As with Cosmos DB, deletions are
+// not reported.
+func (s *Store) changeFeed(req *http.Request, coll []byte) (*http.Response, error) {
+	since, _ := strconv.ParseUint(req.Header.Get("If-None-Match"), 10, 64)
+
+	type change struct {
+		lsn uint64
+		doc map[string]interface{}
+	}
+	var changes []change
+
+	err := s.view(func(tx *bolt.Tx) error {
+		return forEachDocument(tx, coll, func(id []byte, doc map[string]interface{}) error {
+			lsn, _ := strconv.ParseUint(string(doc["_lsn"].(json.Number)), 10, 64)
+			if lsn > since {
+				changes = append(changes, change{lsn: lsn, doc: doc})
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	header := http.Header{}
+	if len(changes) == 0 {
+		header.Set("Etag", strconv.FormatUint(since, 10))
+		return newResponse(req, http.StatusNotModified, header, nil)
+	}
+
+	sort.Slice(changes, func(i, j int) bool { return changes[i].lsn < changes[j].lsn })
+	if max := maxItemCount(req); max != -1 && len(changes) > max {
+		changes = changes[:max]
+	}
+
+	docs := make([]interface{}, 0, len(changes))
+	for _, c := range changes {
+		docs = append(docs, c.doc)
+	}
+
+	header.Set("Etag", strconv.FormatUint(changes[len(changes)-1].lsn, 10))
+	return newResponse(req, http.StatusOK, header, newDocumentList(docs))
+}
@@ -0,0 +1,244 @@
+// Package embedded implements a local, file-backed stand-in for Cosmos DB.
+//
+// A Store serves the subset of the Cosmos DB REST API which the generated
+// clients in pkg/database/cosmosdb use, on top of a bbolt database file. It is
+// an http.RoundTripper, so wrapping it in an http.Client and passing that to
+// cosmosdb.NewDatabaseClient lets every database in pkg/database run against
+// it unchanged: documents, ETag concurrency, the pre-triggers used for leases
+// and billing timestamps, change feeds and the queries in pkg/database all
+// behave as they do against Cosmos DB.
+//
+// The database file is opened for the duration of each request only, so the
+// RP, monitor and portal processes can share it.
+package embedded
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	bucketDocs     = []byte("docs")
+	bucketTriggers = []byte("triggers")
+)
+
+// Store is an embedded Cosmos DB stand-in backed by a bbolt database file.
+type Store struct {
+	log  *logrus.Entry
+	path string
+	now  func() time.Time
+
+	mu sync.RWMutex
+}
+
+// New returns a Store persisting to the bbolt database file at path, creating
+// the file if it doesn't exist.
+func New(log *logrus.Entry, path string) (*Store, error) {
+	s := &Store{
+		log:  log,
+		path: path,
+		now:  time.Now,
+	}
+
+	// create the file up front so that read-only opens succeed
+	err := s.update(func(*bolt.Tx) error { return nil })
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (s *Store) view(f func(*bolt.Tx) error) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	db, err := bolt.Open(s.path, 0600, &bolt.Options{Timeout: time.Minute, ReadOnly: true})
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	return db.View(f)
+}
+
+func (s *Store) update(f func(*bolt.Tx) error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	db, err := bolt.Open(s.path, 0600, &bolt.Options{Timeout: time.Minute})
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	return db.Update(f)
+}
+
+// RoundTrip implements http.RoundTripper.
+func (s *Store) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := s.roundTrip(req)
+	if err != nil {
+		if err, ok := err.(*statusError); ok {
+			return newResponse(req, err.statusCode, nil, map[string]string{
+				"code":    strings.ReplaceAll(http.StatusText(err.statusCode), " ", ""),
+				"message": err.message,
+			})
+		}
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *Store) roundTrip(req *http.Request) (*http.Response, error) {
+	// dbs/{db}/colls/{coll}/{resource}/{id}
+	parts := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+
+	if len(parts) < 4 || parts[0] != "dbs" || parts[2] != "colls" {
+		return s.metadata(req, parts)
+	}
+
+	coll := []byte(strings.Join(parts[:4], "/"))
+
+	switch {
+	case len(parts) == 4 && req.Method == http.MethodGet:
+		return newResponse(req, http.StatusOK, nil, map[string]string{"id": parts[3]})
+
+	case len(parts) == 5 && parts[4] == "pkranges" && req.Method == http.MethodGet:
+		// the store is not partitioned: report a single range
+		return newResponse(req, http.StatusOK, nil, map[string]interface{}{
+			"_count":             1,
+			"PartitionKeyRanges": []map[string]string{{"id": "0"}},
+		})
+
+	case len(parts) == 5 && parts[4] == "triggers" && req.Method == http.MethodPost:
+		return s.createTrigger(req, coll)
+
+	case len(parts) == 6 && parts[4] == "triggers" && req.Method == http.MethodGet:
+		return s.getTrigger(req, coll, parts[5])
+
+	case len(parts) == 5 && parts[4] == "docs" && req.Method == http.MethodPost && strings.EqualFold(req.Header.Get("X-Ms-Documentdb-Isquery"), "true"):
+		return s.queryDocuments(req, coll)
+
+	case len(parts) == 5 && parts[4] == "docs" && req.Method == http.MethodPost:
+		return s.putDocument(req, coll, "", true)
+
+	case len(parts) == 5 && parts[4] == "docs" && req.Method == http.MethodGet && req.Header.Get("A-IM") == "Incremental feed":
+		return s.changeFeed(req, coll)
+
+	case len(parts) == 5 && parts[4] == "docs" && req.Method == http.MethodGet:
+		return s.listDocuments(req, coll)
+
+	case len(parts) == 6 && parts[4] == "docs" && req.Method == http.MethodGet:
+		return s.getDocument(req, coll, parts[5])
+
+	case len(parts) == 6 && parts[4] == "docs" && req.Method == http.MethodPut:
+		return s.putDocument(req, coll, parts[5], false)
+
+	case len(parts) == 6 && parts[4] == "docs" && req.Method == http.MethodDelete:
+		return s.deleteDocument(req, coll, parts[5])
+	}
+
+	return nil, newStatusError(http.StatusNotImplemented, "%s %s is not supported", req.Method, req.URL.Path)
+}
+
+// metadata serves requests for databases. Databases and collections are
+// created implicitly on first use, so every one of them exists.
+func (s *Store) metadata(req *http.Request, parts []string) (*http.Response, error) {
+	if len(parts) == 2 && parts[0] == "dbs" && req.Method == http.MethodGet {
+		return newResponse(req, http.StatusOK, nil, map[string]string{"id": parts[1]})
+	}
+
+	return nil, newStatusError(http.StatusNotImplemented, "%s %s is not supported", req.Method, req.URL.Path)
+}
+
+// collectionBucket returns the bucket holding the given sub-bucket of a
+// collection, creating it if create is set. It returns nil if the bucket
+// doesn't exist and create isn't set.
+func collectionBucket(tx *bolt.Tx, coll, name []byte, create bool) (*bolt.Bucket, error) {
+	if !create {
+		b := tx.Bucket(coll)
+		if b == nil {
+			return nil, nil
+		}
+		return b.Bucket(name), nil
+	}
+
+	b, err := tx.CreateBucketIfNotExists(coll)
+	if err != nil {
+		return nil, err
+	}
+
+	return b.CreateBucketIfNotExists(name)
+}
+
+type statusError struct {
+	statusCode int
+	message    string
+}
+
+func newStatusError(statusCode int, format string, a ...interface{}) *statusError {
+	return &statusError{statusCode: statusCode, message: fmt.Sprintf(format, a...)}
+}
+
+func (err *statusError) Error() string {
+	return fmt.Sprintf("%d: %s", err.statusCode, err.message)
+}
+
+func newResponse(req *http.Request, statusCode int, header http.Header, body interface{}) (*http.Response, error) {
+	if header == nil {
+		header = http.Header{}
+	}
+
+	var b []byte
+	if body != nil {
+		var err error
+		b, err = json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		header.Set("Content-Type", "application/json")
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", statusCode, http.StatusText(statusCode)),
+		StatusCode:    statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(b)),
+		ContentLength: int64(len(b)),
+		Request:       req,
+	}, nil
+}
+
+func decodeBody(req *http.Request, v interface{}) error {
+	if req.Body == nil {
+		return newStatusError(http.StatusBadRequest, "request body is required")
+	}
+	defer req.Body.Close()
+
+	d := json.NewDecoder(req.Body)
+	d.UseNumber()
+
+	err := d.Decode(v)
+	if err != nil {
+		return newStatusError(http.StatusBadRequest, "invalid request body: %s", err)
+	}
+
+	return nil
+}
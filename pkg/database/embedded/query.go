@@ -0,0 +1,1052 @@
+package embedded
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// This file implements the subset of the Cosmos DB SQL dialect which the RP
+// uses:
+//
+//   SELECT * | SELECT VALUE COUNT(1) | SELECT VALUE <expression>
+//   FROM <collection> [<alias>]
+//   [WHERE <expression>]
+//   [ORDER BY <expression> [ASC | DESC]]
+//
+// Expressions support property paths, string, number, boolean and null
+// literals, object literals, @parameters, the arithmetic, comparison, logical, IN and ??
+// operators and a handful of built-in functions. Cosmos DB's undefined
+// semantics are kept: a missing property is undefined, and any comparison
+// involving undefined is itself undefined, so it never matches.
+
+// undefined represents the Cosmos DB undefined value.
+type undefined struct{}
+
+type query struct {
+	count      bool
+	value      expr
+	alias      string
+	where      expr
+	orderBy    expr
+	descending bool
+}
+
+type expr interface {
+	eval(*evalContext) interface{}
+}
+
+type evalContext struct {
+	doc        map[string]interface{}
+	parameters map[string]string
+	now        time.Time
+}
+
+func parseQuery(s string) (*query, error) {
+	tokens, err := lex(s)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens}
+	q, err := p.parseQuery()
+	if err != nil {
+		return nil, fmt.Errorf("invalid query %q: %w", s, err)
+	}
+
+	return q, nil
+}
+
+func (q *query) matches(ctx *evalContext) bool {
+	if q.where == nil {
+		return true
+	}
+
+	b, ok := q.where.eval(ctx).(bool)
+	return ok && b
+}
+
+// project returns the result of the query for the document in ctx.
+func (q *query) project(ctx *evalContext) interface{} {
+	if q.value == nil {
+		return ctx.doc
+	}
+	return q.value.eval(ctx)
+}
+
+// sort orders docs by the ORDER BY expression, if any. Values of different
+// types are ordered undefined, null, boolean, number, string, as in Cosmos
+// DB.
+func (q *query) sort(docs []map[string]interface{}, ctx *evalContext) {
+	if q.orderBy == nil {
+		return
+	}
+
+	keys := make([]interface{}, len(docs))
+	for i, doc := range docs {
+		ctx.doc = doc
+		keys[i] = q.orderBy.eval(ctx)
+	}
+
+	idx := make([]int, len(docs))
+	for i := range idx {
+		idx[i] = i
+	}
+
+	sort.SliceStable(idx, func(i, j int) bool {
+		c := compareOrder(keys[idx[i]], keys[idx[j]])
+		if q.descending {
+			return c > 0
+		}
+		return c < 0
+	})
+
+	sorted := make([]map[string]interface{}, len(docs))
+	for i, j := range idx {
+		sorted[i] = docs[j]
+	}
+	copy(docs, sorted)
+}
+
+//
+// lexer
+//
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenNumber
+	tokenString
+	tokenParameter
+	tokenOperator
+)
+
+type token struct {
+	kind  tokenKind
+	value string
+}
+
+func lex(s string) ([]token, error) {
+	var tokens []token
+
+	for i := 0; i < len(s); {
+		c := rune(s[i])
+
+		switch {
+		case unicode.IsSpace(c):
+			i++
+
+		case c == '_' || unicode.IsLetter(c) || c == '@':
+			j := i + 1
+			for j < len(s) && (s[j] == '_' || unicode.IsLetter(rune(s[j])) || unicode.IsDigit(rune(s[j]))) {
+				j++
+			}
+			if c == '@' {
+				tokens = append(tokens, token{kind: tokenParameter, value: s[i:j]})
+			} else {
+				tokens = append(tokens, token{kind: tokenIdent, value: s[i:j]})
+			}
+			i = j
+
+		case unicode.IsDigit(c):
+			j := i + 1
+			for j < len(s) && (unicode.IsDigit(rune(s[j])) || s[j] == '.') {
+				j++
+			}
+			tokens = append(tokens, token{kind: tokenNumber, value: s[i:j]})
+			i = j
+
+		case c == '"' || c == '\'':
+			var sb strings.Builder
+			j := i + 1
+			for ; j < len(s) && rune(s[j]) != c; j++ {
+				if s[j] == '\\' && j+1 < len(s) {
+					j++
+				}
+				sb.WriteByte(s[j])
+			}
+			if j == len(s) {
+				return nil, fmt.Errorf("unterminated string at offset %d", i)
+			}
+			tokens = append(tokens, token{kind: tokenString, value: sb.String()})
+			i = j + 1
+
+		default:
+			op := ""
+			for _, candidate := range []string{"??", "!=", "<>", "<=", ">=", "=", "<", ">", "+", "-", "*", "/", "%", "(", ")", ",", ".", "[", "]", "{", "}", ":"} {
+				if strings.HasPrefix(s[i:], candidate) {
+					op = candidate
+					break
+				}
+			}
+			if op == "" {
+				return nil, fmt.Errorf("unexpected character %q at offset %d", c, i)
+			}
+			tokens = append(tokens, token{kind: tokenOperator, value: op})
+			i += len(op)
+		}
+	}
+
+	return append(tokens, token{kind: tokenEOF}), nil
+}
+
+//
+// parser
+//
+
+type parser struct {
+	tokens []token
+	pos    int
+	alias  string
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) isKeyword(keyword string) bool {
+	t := p.peek()
+	return t.kind == tokenIdent && strings.EqualFold(t.value, keyword)
+}
+
+func (p *parser) isOperator(op string) bool {
+	t := p.peek()
+	return t.kind == tokenOperator && t.value == op
+}
+
+func (p *parser) expectKeyword(keyword string) error {
+	if !p.isKeyword(keyword) {
+		return fmt.Errorf("expected %s, got %q", keyword, p.peek().value)
+	}
+	p.next()
+	return nil
+}
+
+func (p *parser) expectOperator(op string) error {
+	if !p.isOperator(op) {
+		return fmt.Errorf("expected %q, got %q", op, p.peek().value)
+	}
+	p.next()
+	return nil
+}
+
+func (p *parser) parseQuery() (*query, error) {
+	q := &query{}
+	valueStart, valueEnd := -1, -1
+
+	err := p.expectKeyword("SELECT")
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case p.isOperator("*"):
+		p.next()
+
+	case p.isKeyword("VALUE") && strings.EqualFold(p.tokens[p.pos+1].value, "COUNT"):
+		p.next()
+		for _, expected := range []string{"COUNT", "(", "1", ")"} {
+			if t := p.next(); !strings.EqualFold(t.value, expected) {
+				return nil, fmt.Errorf("unsupported projection near %q", t.value)
+			}
+		}
+		q.count = true
+
+	case p.isKeyword("VALUE"):
+		// the projection refers to the alias, which follows it: skip it for
+		// now and parse it once the alias is known
+		p.next()
+		valueStart = p.pos
+		for !p.isKeyword("FROM") && p.peek().kind != tokenEOF {
+			p.next()
+		}
+		valueEnd = p.pos
+
+	default:
+		return nil, fmt.Errorf("unsupported projection near %q", p.peek().value)
+	}
+
+	err = p.expectKeyword("FROM")
+	if err != nil {
+		return nil, err
+	}
+
+	if p.peek().kind != tokenIdent {
+		return nil, fmt.Errorf("expected collection, got %q", p.peek().value)
+	}
+	q.alias = p.next().value
+
+	if p.peek().kind == tokenIdent && !p.isKeyword("WHERE") && !p.isKeyword("ORDER") {
+		q.alias = p.next().value
+	}
+	p.alias = q.alias
+
+	if valueStart != -1 {
+		q.value, err = p.parseSub(valueStart, valueEnd)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if p.isKeyword("WHERE") {
+		p.next()
+		q.where, err = p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if p.isKeyword("ORDER") {
+		p.next()
+		err = p.expectKeyword("BY")
+		if err != nil {
+			return nil, err
+		}
+
+		q.orderBy, err = p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case p.isKeyword("ASC"):
+			p.next()
+		case p.isKeyword("DESC"):
+			p.next()
+			q.descending = true
+		}
+	}
+
+	if p.peek().kind != tokenEOF {
+		return nil, fmt.Errorf("unexpected %q", p.peek().value)
+	}
+
+	return q, nil
+}
+
+// parseSub parses the tokens in [start, end) as a single expression.
+func (p *parser) parseSub(start, end int) (expr, error) {
+	sub := &parser{
+		tokens: append(append([]token{}, p.tokens[start:end]...), token{kind: tokenEOF}),
+		alias:  p.alias,
+	}
+
+	e, err := sub.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+
+	if sub.peek().kind != tokenEOF {
+		return nil, fmt.Errorf("unexpected %q", sub.peek().value)
+	}
+
+	return e, nil
+}
+
+func (p *parser) parseExpr() (expr, error) {
+	return p.parseCoalesce()
+}
+
+func (p *parser) parseCoalesce() (expr, error) {
+	left, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.isOperator("??") {
+		p.next()
+		right, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		left = &coalesceExpr{left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseOr() (expr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.isKeyword("OR") {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &logicalExpr{and: false, left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseAnd() (expr, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.isKeyword("AND") {
+		p.next()
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = &logicalExpr{and: true, left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseNot() (expr, error) {
+	if p.isKeyword("NOT") {
+		p.next()
+		e, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return &notExpr{e: e}, nil
+	}
+
+	return p.parseComparison()
+}
+
+func (p *parser) parseComparison() (expr, error) {
+	left, err := p.parseAdditive()
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case p.isKeyword("IN"), p.isKeyword("NOT") && p.tokens[p.pos+1].kind == tokenIdent && strings.EqualFold(p.tokens[p.pos+1].value, "IN"):
+		negate := p.isKeyword("NOT")
+		if negate {
+			p.next()
+		}
+		p.next()
+
+		err = p.expectOperator("(")
+		if err != nil {
+			return nil, err
+		}
+
+		var list []expr
+		for {
+			e, err := p.parseExpr()
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, e)
+
+			if !p.isOperator(",") {
+				break
+			}
+			p.next()
+		}
+
+		err = p.expectOperator(")")
+		if err != nil {
+			return nil, err
+		}
+
+		var e expr = &inExpr{left: left, list: list}
+		if negate {
+			e = &notExpr{e: e}
+		}
+		return e, nil
+	}
+
+	for _, op := range []string{"=", "!=", "<>", "<=", ">=", "<", ">"} {
+		if p.isOperator(op) {
+			p.next()
+			right, err := p.parseAdditive()
+			if err != nil {
+				return nil, err
+			}
+			if op == "<>" {
+				op = "!="
+			}
+			return &comparisonExpr{op: op, left: left, right: right}, nil
+		}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseAdditive() (expr, error) {
+	left, err := p.parseMultiplicative()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.isOperator("+") || p.isOperator("-") {
+		op := p.next().value
+		right, err := p.parseMultiplicative()
+		if err != nil {
+			return nil, err
+		}
+		left = &arithmeticExpr{op: op, left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseMultiplicative() (expr, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.isOperator("*") || p.isOperator("/") || p.isOperator("%") {
+		op := p.next().value
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &arithmeticExpr{op: op, left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseUnary() (expr, error) {
+	if p.isOperator("-") {
+		p.next()
+		e, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &arithmeticExpr{op: "-", left: &literalExpr{value: float64(0)}, right: e}, nil
+	}
+
+	return p.parsePrimary()
+}
+
+func (p *parser) parsePrimary() (expr, error) {
+	t := p.next()
+
+	switch t.kind {
+	case tokenNumber:
+		f, err := strconv.ParseFloat(t.value, 64)
+		if err != nil {
+			return nil, err
+		}
+		return &literalExpr{value: f}, nil
+
+	case tokenString:
+		return &literalExpr{value: t.value}, nil
+
+	case tokenParameter:
+		return &parameterExpr{name: t.value}, nil
+
+	case tokenOperator:
+		switch t.value {
+		case "(":
+			e, err := p.parseExpr()
+			if err != nil {
+				return nil, err
+			}
+			return e, p.expectOperator(")")
+
+		case "{":
+			return p.parseObject()
+		}
+
+	case tokenIdent:
+		switch strings.ToLower(t.value) {
+		case "true":
+			return &literalExpr{value: true}, nil
+		case "false":
+			return &literalExpr{value: false}, nil
+		case "null":
+			return &literalExpr{value: nil}, nil
+		case "undefined":
+			return &literalExpr{value: undefined{}}, nil
+		}
+
+		if p.isOperator("(") {
+			return p.parseFunction(t.value)
+		}
+
+		if t.value != p.alias {
+			return nil, fmt.Errorf("unknown identifier %q", t.value)
+		}
+
+		return p.parsePath()
+	}
+
+	return nil, fmt.Errorf("unexpected %q", t.value)
+}
+
+func (p *parser) parsePath() (expr, error) {
+	path := &pathExpr{}
+
+	for {
+		switch {
+		case p.isOperator("."):
+			p.next()
+			t := p.next()
+			if t.kind != tokenIdent {
+				return nil, fmt.Errorf("expected property name, got %q", t.value)
+			}
+			path.path = append(path.path, t.value)
+
+		case p.isOperator("["):
+			p.next()
+			t := p.next()
+			if t.kind != tokenString {
+				return nil, fmt.Errorf("expected property name, got %q", t.value)
+			}
+			path.path = append(path.path, t.value)
+
+			err := p.expectOperator("]")
+			if err != nil {
+				return nil, err
+			}
+
+		default:
+			return path, nil
+		}
+	}
+}
+
+func (p *parser) parseObject() (expr, error) {
+	o := &objectExpr{}
+
+	for !p.isOperator("}") {
+		t := p.next()
+		if t.kind != tokenString && t.kind != tokenIdent {
+			return nil, fmt.Errorf("expected property name, got %q", t.value)
+		}
+
+		err := p.expectOperator(":")
+		if err != nil {
+			return nil, err
+		}
+
+		e, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		o.keys = append(o.keys, t.value)
+		o.values = append(o.values, e)
+
+		if !p.isOperator(",") {
+			break
+		}
+		p.next()
+	}
+
+	return o, p.expectOperator("}")
+}
+
+func (p *parser) parseFunction(name string) (expr, error) {
+	f, ok := functions[strings.ToUpper(name)]
+	if !ok {
+		return nil, fmt.Errorf("unsupported function %q", name)
+	}
+
+	p.next() // (
+
+	var args []expr
+	for !p.isOperator(")") {
+		e, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, e)
+
+		if !p.isOperator(",") {
+			break
+		}
+		p.next()
+	}
+
+	err := p.expectOperator(")")
+	if err != nil {
+		return nil, err
+	}
+
+	return &functionExpr{f: f, args: args}, nil
+}
+
+//
+// evaluation
+//
+
+type literalExpr struct {
+	value interface{}
+}
+
+func (e *literalExpr) eval(*evalContext) interface{} {
+	return e.value
+}
+
+type parameterExpr struct {
+	name string
+}
+
+func (e *parameterExpr) eval(ctx *evalContext) interface{} {
+	if v, ok := ctx.parameters[e.name]; ok {
+		return v
+	}
+	return undefined{}
+}
+
+type pathExpr struct {
+	path []string
+}
+
+func (e *pathExpr) eval(ctx *evalContext) interface{} {
+	var v interface{} = ctx.doc
+	for _, p := range e.path {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return undefined{}
+		}
+		v, ok = m[p]
+		if !ok {
+			return undefined{}
+		}
+	}
+	return normalize(v)
+}
+
+// objectExpr is an object literal. Properties whose value is undefined are
+// omitted.
+type objectExpr struct {
+	keys   []string
+	values []expr
+}
+
+func (e *objectExpr) eval(ctx *evalContext) interface{} {
+	o := map[string]interface{}{}
+	for i, key := range e.keys {
+		if v := e.values[i].eval(ctx); v != (undefined{}) {
+			o[key] = v
+		}
+	}
+	return o
+}
+
+type coalesceExpr struct {
+	left, right expr
+}
+
+func (e *coalesceExpr) eval(ctx *evalContext) interface{} {
+	if v := e.left.eval(ctx); v != (undefined{}) {
+		return v
+	}
+	return e.right.eval(ctx)
+}
+
+type logicalExpr struct {
+	and         bool
+	left, right expr
+}
+
+func (e *logicalExpr) eval(ctx *evalContext) interface{} {
+	l, lok := e.left.eval(ctx).(bool)
+	r, rok := e.right.eval(ctx).(bool)
+
+	if e.and {
+		switch {
+		case lok && !l, rok && !r:
+			return false
+		case lok && rok:
+			return true
+		}
+	} else {
+		switch {
+		case lok && l, rok && r:
+			return true
+		case lok && rok:
+			return false
+		}
+	}
+
+	return undefined{}
+}
+
+type notExpr struct {
+	e expr
+}
+
+func (e *notExpr) eval(ctx *evalContext) interface{} {
+	if b, ok := e.e.eval(ctx).(bool); ok {
+		return !b
+	}
+	return undefined{}
+}
+
+type inExpr struct {
+	left expr
+	list []expr
+}
+
+func (e *inExpr) eval(ctx *evalContext) interface{} {
+	l := e.left.eval(ctx)
+	if l == (undefined{}) {
+		return undefined{}
+	}
+
+	for _, item := range e.list {
+		if c, ok := compare(l, item.eval(ctx)); ok && c == 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+type comparisonExpr struct {
+	op          string
+	left, right expr
+}
+
+func (e *comparisonExpr) eval(ctx *evalContext) interface{} {
+	l, r := e.left.eval(ctx), e.right.eval(ctx)
+	if l == (undefined{}) || r == (undefined{}) {
+		return undefined{}
+	}
+
+	c, ok := compare(l, r)
+	if !ok {
+		return undefined{}
+	}
+
+	switch e.op {
+	case "=":
+		return c == 0
+	case "!=":
+		return c != 0
+	case "<":
+		return c < 0
+	case "<=":
+		return c <= 0
+	case ">":
+		return c > 0
+	case ">=":
+		return c >= 0
+	}
+
+	return undefined{}
+}
+
+type arithmeticExpr struct {
+	op          string
+	left, right expr
+}
+
+func (e *arithmeticExpr) eval(ctx *evalContext) interface{} {
+	l, lok := e.left.eval(ctx).(float64)
+	r, rok := e.right.eval(ctx).(float64)
+	if !lok || !rok {
+		return undefined{}
+	}
+
+	switch e.op {
+	case "+":
+		return l + r
+	case "-":
+		return l - r
+	case "*":
+		return l * r
+	case "/":
+		if r == 0 {
+			return undefined{}
+		}
+		return l / r
+	case "%":
+		if int64(r) == 0 {
+			return undefined{}
+		}
+		return float64(int64(l) % int64(r))
+	}
+
+	return undefined{}
+}
+
+type functionExpr struct {
+	f    func(*evalContext, []interface{}) interface{}
+	args []expr
+}
+
+func (e *functionExpr) eval(ctx *evalContext) interface{} {
+	args := make([]interface{}, 0, len(e.args))
+	for _, arg := range e.args {
+		args = append(args, arg.eval(ctx))
+	}
+	return e.f(ctx, args)
+}
+
+var functions = map[string]func(*evalContext, []interface{}) interface{}{
+	"GETCURRENTTIMESTAMP": func(ctx *evalContext, args []interface{}) interface{} {
+		return float64(ctx.now.UnixNano() / int64(time.Millisecond))
+	},
+	"IS_DEFINED": func(ctx *evalContext, args []interface{}) interface{} {
+		if len(args) != 1 {
+			return undefined{}
+		}
+		return args[0] != (undefined{})
+	},
+	"TOSTRING": func(ctx *evalContext, args []interface{}) interface{} {
+		if len(args) != 1 {
+			return undefined{}
+		}
+		switch v := args[0].(type) {
+		case string:
+			return v
+		case float64:
+			return strconv.FormatFloat(v, 'f', -1, 64)
+		case bool:
+			return strconv.FormatBool(v)
+		case nil:
+			return "null"
+		}
+		return undefined{}
+	},
+	"LOWER":      stringFunction(strings.ToLower),
+	"UPPER":      stringFunction(strings.ToUpper),
+	"STARTSWITH": stringPredicate(strings.HasPrefix),
+	"ENDSWITH":   stringPredicate(strings.HasSuffix),
+	"CONTAINS":   stringPredicate(strings.Contains),
+}
+
+func stringFunction(f func(string) string) func(*evalContext, []interface{}) interface{} {
+	return func(ctx *evalContext, args []interface{}) interface{} {
+		if len(args) != 1 {
+			return undefined{}
+		}
+		s, ok := args[0].(string)
+		if !ok {
+			return undefined{}
+		}
+		return f(s)
+	}
+}
+
+// stringPredicate implements functions with the signature
+// F(<str_expr1>, <str_expr2> [, <bool_expr>]), where the optional third
+// argument requests a case-insensitive comparison.
+func stringPredicate(f func(string, string) bool) func(*evalContext, []interface{}) interface{} {
+	return func(ctx *evalContext, args []interface{}) interface{} {
+		if len(args) != 2 && len(args) != 3 {
+			return undefined{}
+		}
+		s1, ok1 := args[0].(string)
+		s2, ok2 := args[1].(string)
+		if !ok1 || !ok2 {
+			return undefined{}
+		}
+		if len(args) == 3 {
+			if ignoreCase, ok := args[2].(bool); ok && ignoreCase {
+				s1, s2 = strings.ToLower(s1), strings.ToLower(s2)
+			}
+		}
+		return f(s1, s2)
+	}
+}
+
+// normalize converts a decoded JSON value into the types used during
+// evaluation.
+func normalize(v interface{}) interface{} {
+	switch v := v.(type) {
+	case json.Number:
+		f, err := v.Float64()
+		if err != nil {
+			return undefined{}
+		}
+		return f
+	case int:
+		return float64(v)
+	case int64:
+		return float64(v)
+	}
+	return v
+}
+
+// compareOrder orders any two values for ORDER BY: by type, then by value.
+func compareOrder(a, b interface{}) int {
+	rank := func(v interface{}) int {
+		switch v.(type) {
+		case undefined:
+			return 0
+		case nil:
+			return 1
+		case bool:
+			return 2
+		case float64:
+			return 3
+		case string:
+			return 4
+		}
+		return 5
+	}
+
+	if ra, rb := rank(a), rank(b); ra != rb {
+		return ra - rb
+	}
+
+	c, _ := compare(a, b)
+	return c
+}
+
+// compare returns the ordering of two scalar values of the same type; ok is
+// false if the values are not comparable.
+func compare(a, b interface{}) (c int, ok bool) {
+	switch a := a.(type) {
+	case nil:
+		if b == nil {
+			return 0, true
+		}
+	case bool:
+		if b, isBool := b.(bool); isBool {
+			switch {
+			case a == b:
+				return 0, true
+			case !a:
+				return -1, true
+			default:
+				return 1, true
+			}
+		}
+	case float64:
+		if b, isFloat := b.(float64); isFloat {
+			switch {
+			case a < b:
+				return -1, true
+			case a > b:
+				return 1, true
+			default:
+				return 0, true
+			}
+		}
+	case string:
+		if b, isString := b.(string); isString {
+			return strings.Compare(a, b), true
+		}
+	}
+
+	return 0, false
+}
@@ -0,0 +1,190 @@
+package embedded
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestQuery(t *testing.T) {
+	now := time.Unix(1000, 0)
+
+	for _, tt := range []struct {
+		name       string
+		query      string
+		doc        string
+		parameters map[string]string
+		wantCount  bool
+		want       bool
+		wantErr    string
+	}{
+		{
+			name:  "select all",
+			query: `SELECT * FROM OpenShiftClusters doc`,
+			doc:   `{}`,
+			want:  true,
+		},
+		{
+			name:       "equality with parameter",
+			query:      `SELECT * FROM OpenShiftClusters doc WHERE doc.key = @key`,
+			doc:        `{"key": "a"}`,
+			parameters: map[string]string{"@key": "a"},
+			want:       true,
+		},
+		{
+			name:       "equality with parameter, no match",
+			query:      `SELECT * FROM OpenShiftClusters doc WHERE doc.key = @key`,
+			doc:        `{"key": "b"}`,
+			parameters: map[string]string{"@key": "a"},
+		},
+		{
+			name:  "inequality",
+			query: `SELECT * FROM Monitors doc WHERE doc.id != "master"`,
+			doc:   `{"id": "bucket"}`,
+			want:  true,
+		},
+		{
+			name:  "missing property is not unequal",
+			query: `SELECT * FROM Monitors doc WHERE doc.id != "master"`,
+			doc:   `{}`,
+		},
+		{
+			name:  "dequeue, lease expired",
+			query: `SELECT * FROM OpenShiftClusters doc WHERE doc.openShiftCluster.properties.provisioningState IN ("Creating", "Deleting", "Updating", "AdminUpdating") AND (doc.leaseExpires ?? 0) < GetCurrentTimestamp() / 1000`,
+			doc:   `{"openShiftCluster": {"properties": {"provisioningState": "Creating"}}, "leaseExpires": 999}`,
+			want:  true,
+		},
+		{
+			name:  "dequeue, no lease",
+			query: `SELECT * FROM OpenShiftClusters doc WHERE doc.openShiftCluster.properties.provisioningState IN ("Creating", "Deleting", "Updating", "AdminUpdating") AND (doc.leaseExpires ?? 0) < GetCurrentTimestamp() / 1000`,
+			doc:   `{"openShiftCluster": {"properties": {"provisioningState": "Updating"}}}`,
+			want:  true,
+		},
+		{
+			name:  "dequeue, lease held",
+			query: `SELECT * FROM OpenShiftClusters doc WHERE doc.openShiftCluster.properties.provisioningState IN ("Creating", "Deleting", "Updating", "AdminUpdating") AND (doc.leaseExpires ?? 0) < GetCurrentTimestamp() / 1000`,
+			doc:   `{"openShiftCluster": {"properties": {"provisioningState": "Creating"}}, "leaseExpires": 1001}`,
+		},
+		{
+			name:  "dequeue, terminal state",
+			query: `SELECT * FROM OpenShiftClusters doc WHERE doc.openShiftCluster.properties.provisioningState IN ("Creating", "Deleting", "Updating", "AdminUpdating") AND (doc.leaseExpires ?? 0) < GetCurrentTimestamp() / 1000`,
+			doc:   `{"openShiftCluster": {"properties": {"provisioningState": "Succeeded"}}}`,
+		},
+		{
+			name:      "count",
+			query:     `SELECT VALUE COUNT(1) FROM Subscriptions doc WHERE (doc.deleting ?? false) AND (doc.leaseExpires ?? 0) < GetCurrentTimestamp() / 1000`,
+			doc:       `{"deleting": true}`,
+			wantCount: true,
+			want:      true,
+		},
+		{
+			name:       "startswith",
+			query:      `SELECT * FROM OpenShiftClusters doc WHERE STARTSWITH(doc.key, @prefix)`,
+			doc:        `{"key": "/subscriptions/sub/resourcegroups/rg"}`,
+			parameters: map[string]string{"@prefix": "/subscriptions/sub/"},
+			want:       true,
+		},
+		{
+			name:       "tostring with coalesce",
+			query:      `SELECT VALUE COUNT(1) FROM OpenShiftClusters doc WHERE ToString(doc.openShiftCluster.properties.hiveProfile.shardIndex ?? 1) = @shardIndex`,
+			doc:        `{"openShiftCluster": {"properties": {}}}`,
+			parameters: map[string]string{"@shardIndex": "1"},
+			wantCount:  true,
+			want:       true,
+		},
+		{
+			name:       "tostring of number",
+			query:      `SELECT * FROM OpenShiftClusters doc WHERE ToString(doc.openShiftCluster.properties.hiveProfile.shardIndex ?? 1) = @shardIndex`,
+			doc:        `{"openShiftCluster": {"properties": {"hiveProfile": {"shardIndex": 2}}}}`,
+			parameters: map[string]string{"@shardIndex": "2"},
+			want:       true,
+		},
+		{
+			name:  "bracket path, or, not",
+			query: `SELECT * FROM c WHERE NOT (c["a"] = 1 OR c.b = "x")`,
+			doc:   `{"a": 2, "b": "y"}`,
+			want:  true,
+		},
+		{
+			name:    "unknown function",
+			query:   `SELECT * FROM c WHERE FOO(c.a)`,
+			wantErr: "FOO",
+		},
+		{
+			name:    "unsupported projection",
+			query:   `SELECT c.id FROM c`,
+			wantErr: "SELECT",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := parseQuery(tt.query)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if q.count != tt.wantCount {
+				t.Errorf("count: got %v", q.count)
+			}
+
+			doc, err := decodeDocument([]byte(tt.doc))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got := q.matches(&evalContext{doc: doc, parameters: tt.parameters, now: now})
+			if got != tt.want {
+				b, _ := json.Marshal(doc)
+				t.Errorf("matches(%s): got %v", b, got)
+			}
+		})
+	}
+}
+
+func TestQuerySortAndProject(t *testing.T) {
+	q, err := parseQuery(`SELECT VALUE {"id": doc.id, "p": {"v": doc.p.v}, "missing": doc.missing} FROM Coll doc WHERE doc.id != "x" ORDER BY doc.p.v DESC`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var docs []map[string]interface{}
+	for _, s := range []string{
+		`{"id": "a", "p": {"v": "4.12.25"}, "secret": "s"}`,
+		`{"id": "b"}`,
+		`{"id": "c", "p": {"v": "4.13.0"}}`,
+	} {
+		doc, err := decodeDocument([]byte(s))
+		if err != nil {
+			t.Fatal(err)
+		}
+		docs = append(docs, doc)
+	}
+
+	ctx := &evalContext{}
+	q.sort(docs, ctx)
+
+	var got []interface{}
+	for _, doc := range docs {
+		ctx.doc = doc
+		got = append(got, q.project(ctx))
+	}
+
+	b, err := json.Marshal(got)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `[{"id":"c","p":{"v":"4.13.0"}},{"id":"a","p":{"v":"4.12.25"}},{"id":"b","p":{}}]`
+	if string(b) != want {
+		t.Error(string(b))
+	}
+}
@@ -0,0 +1,148 @@
+package embedded
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+)
+
+// triggerHandler mutates a document before it is written.
+type triggerHandler func(doc map[string]interface{}, now time.Time)
+
+// triggerHandlers holds native implementations of the pre-triggers which
+// pkg/database registers, keyed by trigger ID. The store can't run the
+// JavaScript trigger bodies, so a trigger without an entry here can be
+// created but fails when a request includes it.
+var triggerHandlers = map[string]triggerHandler{
+	"renewLease":                  leaseTrigger(60 * time.Second),
+	"retryLater":                  leaseTrigger(600 * time.Second),
+	"setCreationBillingTimeStamp": billingTimestampTrigger("creationTime"),
+	"setDeletionBillingTimeStamp": billingTimestampTrigger("deletionTime"),
+}
+
+func leaseTrigger(d time.Duration) triggerHandler {
+	return func(doc map[string]interface{}, now time.Time) {
+		doc["leaseExpires"] = now.Add(d).Unix()
+	}
+}
+
+func billingTimestampTrigger(field string) triggerHandler {
+	return func(doc map[string]interface{}, now time.Time) {
+		billing, ok := doc["billing"].(map[string]interface{})
+		if !ok {
+			return
+		}
+
+		if v, ok := billing[field]; !ok || v == nil || normalize(v) == float64(0) {
+			billing[field] = now.Unix()
+		}
+	}
+}
+
+func (s *Store) createTrigger(req *http.Request, coll []byte) (*http.Response, error) {
+	var trigger *cosmosdb.Trigger
+	err := decodeBody(req, &trigger)
+	if err != nil {
+		return nil, err
+	}
+
+	if trigger.ID == "" {
+		return nil, newStatusError(http.StatusBadRequest, "trigger id is required")
+	}
+
+	err = s.update(func(tx *bolt.Tx) error {
+		b, err := collectionBucket(tx, coll, bucketTriggers, true)
+		if err != nil {
+			return err
+		}
+
+		if b.Get([]byte(trigger.ID)) != nil {
+			return newStatusError(http.StatusConflict, "Entity with the specified id already exists in the system")
+		}
+
+		trigger.Self = string(coll) + "/triggers/" + trigger.ID
+		trigger.Timestamp = int(s.now().Unix())
+
+		data, err := json.Marshal(trigger)
+		if err != nil {
+			return err
+		}
+
+		return b.Put([]byte(trigger.ID), data)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return newResponse(req, http.StatusCreated, nil, trigger)
+}
+
+func (s *Store) getTrigger(req *http.Request, coll []byte, id string) (*http.Response, error) {
+	var trigger *cosmosdb.Trigger
+
+	err := s.view(func(tx *bolt.Tx) (err error) {
+		trigger, err = loadTrigger(tx, coll, id)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return newResponse(req, http.StatusOK, nil, trigger)
+}
+
+func loadTrigger(tx *bolt.Tx, coll []byte, id string) (*cosmosdb.Trigger, error) {
+	b, err := collectionBucket(tx, coll, bucketTriggers, false)
+	if err != nil {
+		return nil, err
+	}
+
+	var data []byte
+	if b != nil {
+		data = b.Get([]byte(id))
+	}
+	if data == nil {
+		return nil, newStatusError(http.StatusNotFound, "trigger %q not found", id)
+	}
+
+	var trigger *cosmosdb.Trigger
+	return trigger, json.Unmarshal(data, &trigger)
+}
+
+// runPreTriggers runs the pre-triggers requested in the
+// X-Ms-Documentdb-Pre-Trigger-Include header against doc.
+func (s *Store) runPreTriggers(tx *bolt.Tx, req *http.Request, coll []byte, doc map[string]interface{}, operation cosmosdb.TriggerOperation) error {
+	header := req.Header.Get("X-Ms-Documentdb-Pre-Trigger-Include")
+	if header == "" {
+		return nil
+	}
+
+	for _, id := range strings.Split(header, ",") {
+		trigger, err := loadTrigger(tx, coll, id)
+		if err != nil {
+			return newStatusError(http.StatusBadRequest, "pre-trigger %q does not exist", id)
+		}
+
+		if trigger.TriggerType != cosmosdb.TriggerTypePre ||
+			(trigger.TriggerOperation != cosmosdb.TriggerOperationAll && trigger.TriggerOperation != operation) {
+			return newStatusError(http.StatusBadRequest, "trigger %q can't be run as a pre-trigger on %s", id, operation)
+		}
+
+		handler, ok := triggerHandlers[id]
+		if !ok {
+			return newStatusError(http.StatusBadRequest, "trigger %q is not supported by the embedded database", id)
+		}
+
+		handler(doc, s.now())
+	}
+
+	return nil
+}
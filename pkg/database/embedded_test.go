@@ -0,0 +1,180 @@
+package database
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+)
+
+func TestEmbeddedDatabase(t *testing.T) {
+	ctx := context.Background()
+	log := logrus.NewEntry(logrus.StandardLogger())
+
+	key := "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/resourcegroup/providers/microsoft.redhatopenshift/openshiftclusters/resourcename"
+
+	path := filepath.Join(t.TempDir(), "aro.db")
+
+	dbc, err := NewEmbeddedDatabaseClient(log, path, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	clusters, err := NewOpenShiftClusters(ctx, dbc, "ARO")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// triggers already exist the second time round
+	_, err = NewOpenShiftClusters(ctx, dbc, "ARO")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = clusters.Create(ctx, &api.OpenShiftClusterDocument{
+		ID:  clusters.NewUUID(),
+		Key: key,
+		OpenShiftCluster: &api.OpenShiftCluster{
+			ID: key,
+			Properties: api.OpenShiftClusterProperties{
+				ProvisioningState: api.ProvisioningStateCreating,
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = clusters.Create(ctx, &api.OpenShiftClusterDocument{
+		ID:  clusters.NewUUID(),
+		Key: key + "-succeeded",
+		OpenShiftCluster: &api.OpenShiftCluster{
+			Properties: api.OpenShiftClusterProperties{
+				ProvisioningState: api.ProvisioningStateSucceeded,
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	doc, err := clusters.Get(ctx, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if doc.OpenShiftCluster.ID != key || doc.ETag == "" {
+		t.Errorf("unexpected document %#v", doc)
+	}
+
+	_, err = clusters.Get(ctx, key+"-missing")
+	if !cosmosdb.IsErrorStatusCode(err, http.StatusNotFound) {
+		t.Errorf("expected not found, got %v", err)
+	}
+
+	// a stale ETag is rejected
+	stale := *doc
+	_, err = clusters.(*openShiftClusters).update(ctx, doc, &cosmosdb.Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = clusters.(*openShiftClusters).update(ctx, &stale, &cosmosdb.Options{})
+	if !cosmosdb.IsErrorStatusCode(err, http.StatusPreconditionFailed) {
+		t.Errorf("expected precondition failed, got %v", err)
+	}
+
+	length, err := clusters.QueueLength(ctx, collOpenShiftClusters)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if length != 1 {
+		t.Errorf("queue length: got %d", length)
+	}
+
+	// Dequeue takes the lease through the renewLease trigger, so the
+	// document is no longer queued
+	doc, err = clusters.Dequeue(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if doc == nil || doc.Key != key || doc.LeaseExpires == 0 || doc.Dequeues != 1 {
+		t.Fatalf("unexpected dequeued document %#v", doc)
+	}
+
+	doc, err = clusters.Dequeue(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if doc != nil {
+		t.Errorf("unexpected dequeued document %#v", doc)
+	}
+
+	_, err = clusters.EndLease(ctx, key, api.ProvisioningStateSucceeded, api.ProvisioningStateCreating, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the change feed returns each document once at its latest version, and
+	// then only documents changed since
+	i := clusters.ChangeFeed()
+	docs, err := i.Next(ctx, -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if docs == nil || len(docs.OpenShiftClusterDocuments) != 2 {
+		t.Fatalf("unexpected change feed %#v", docs)
+	}
+	if docs.OpenShiftClusterDocuments[1].OpenShiftCluster.Properties.ProvisioningState != api.ProvisioningStateSucceeded {
+		t.Error(docs.OpenShiftClusterDocuments[1].OpenShiftCluster.Properties.ProvisioningState)
+	}
+
+	docs, err = i.Next(ctx, -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if docs != nil {
+		t.Errorf("unexpected change feed %#v", docs)
+	}
+
+	_, err = clusters.Patch(ctx, key+"-succeeded", func(doc *api.OpenShiftClusterDocument) error {
+		doc.OpenShiftCluster.Properties.ProvisioningState = api.ProvisioningStateDeleting
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	docs, err = i.Next(ctx, -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if docs == nil || len(docs.OpenShiftClusterDocuments) != 1 || docs.OpenShiftClusterDocuments[0].Key != key+"-succeeded" {
+		t.Errorf("unexpected change feed %#v", docs)
+	}
+
+	// documents persist across clients
+	dbc, err = NewEmbeddedDatabaseClient(log, path, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	clusters, err = NewOpenShiftClusters(ctx, dbc, "ARO")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	all, err := clusters.ListAll(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all.OpenShiftClusterDocuments) != 2 {
+		t.Errorf("unexpected documents %#v", all)
+	}
+}
@@ -0,0 +1,227 @@
+package database
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+)
+
+// OpenShiftClusterField is a field of OpenShiftClusters documents which a
+// filtered listing may project. Only fields listed here can be selected, so
+// secure fields are never returned.
+type OpenShiftClusterField struct {
+	// Path is the path of the field within the document.
+	Path string
+	// Filterable fields take string values and may be matched on.
+	Filterable bool
+	// Sortable fields may be ordered by.
+	Sortable bool
+}
+
+// OpenShiftClusterFields lists the fields of OpenShiftClusters documents
+// which a filtered listing may filter, sort or project on, by name.
+var OpenShiftClusterFields = map[string]OpenShiftClusterField{
+	"subscriptionId":          {Path: "partitionKey", Filterable: true, Sortable: true},
+	"name":                    {Path: "openShiftCluster.name", Filterable: true, Sortable: true},
+	"location":                {Path: "openShiftCluster.location", Filterable: true, Sortable: true},
+	"tags":                    {Path: "openShiftCluster.tags"},
+	"provisioningState":       {Path: "openShiftCluster.properties.provisioningState", Filterable: true, Sortable: true},
+	"failedProvisioningState": {Path: "openShiftCluster.properties.failedProvisioningState", Filterable: true},
+	"lastAdminUpdateError":    {Path: "openShiftCluster.properties.lastAdminUpdateError"},
+	"maintenanceTask":         {Path: "openShiftCluster.properties.maintenanceTask", Filterable: true},
+	"maintenanceState":        {Path: "openShiftCluster.properties.maintenanceState", Filterable: true},
+	"operatorVersion":         {Path: "openShiftCluster.properties.operatorVersion", Filterable: true},
+	"createdAt":               {Path: "openShiftCluster.properties.createdAt", Sortable: true},
+	"version":                 {Path: "openShiftCluster.properties.clusterProfile.version", Filterable: true, Sortable: true},
+	"domain":                  {Path: "openShiftCluster.properties.clusterProfile.domain"},
+	"resourceGroupId":         {Path: "openShiftCluster.properties.clusterProfile.resourceGroupId"},
+}
+
+// projectedPaths are always returned by a projected listing, so that each
+// result can still be identified.
+var projectedPaths = []string{
+	"id",
+	"key",
+	"partitionKey",
+	"openShiftCluster.id",
+	"openShiftCluster.name",
+	"openShiftCluster.type",
+}
+
+// OpenShiftClusterFilter filters, sorts and projects a listing of
+// OpenShiftClusters documents. Fields are named by their key in
+// OpenShiftClusterFields.
+type OpenShiftClusterFilter struct {
+	// Match restricts the listing to documents where each field takes one of
+	// the given values.
+	Match map[string][]string
+
+	OrderBy    string
+	Descending bool
+
+	// Select, if set, restricts the fields returned.
+	Select []string
+}
+
+// SubscriptionID returns the subscription the filter is restricted to, or ""
+// if it may match documents across subscriptions.
+func (f *OpenShiftClusterFilter) SubscriptionID() string {
+	if values := f.Match["subscriptionId"]; len(values) == 1 {
+		return values[0]
+	}
+	return ""
+}
+
+// Validate checks that the filter only refers to fields which may be used as
+// requested. Sorting is served within a single partition only, as the
+// gateway can't order cross-partition queries.
+func (f *OpenShiftClusterFilter) Validate() error {
+	for name, values := range f.Match {
+		if !OpenShiftClusterFields[name].Filterable {
+			return fmt.Errorf("field %q cannot be filtered on", name)
+		}
+		if len(values) == 0 {
+			return fmt.Errorf("no values given for field %q", name)
+		}
+	}
+
+	if f.OrderBy != "" {
+		if !OpenShiftClusterFields[f.OrderBy].Sortable {
+			return fmt.Errorf("field %q cannot be sorted on", f.OrderBy)
+		}
+		if f.SubscriptionID() == "" {
+			return fmt.Errorf("sorting requires a single subscriptionId")
+		}
+	}
+
+	for _, name := range f.Select {
+		if _, ok := OpenShiftClusterFields[name]; !ok {
+			return fmt.Errorf("field %q cannot be selected", name)
+		}
+	}
+
+	return nil
+}
+
+// query returns the Cosmos DB query implementing the filter. Values are
+// passed as parameters; field paths come from OpenShiftClusterFields only.
+func (f *OpenShiftClusterFilter) query() (*cosmosdb.Query, error) {
+	err := f.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	q := &cosmosdb.Query{}
+	var sb strings.Builder
+
+	sb.WriteString("SELECT ")
+	if len(f.Select) > 0 {
+		paths := append([]string{}, projectedPaths...)
+		for _, name := range f.Select {
+			paths = append(paths, OpenShiftClusterFields[name].Path)
+		}
+		sb.WriteString("VALUE ")
+		writeProjection(&sb, "doc", newProjectionTree(paths))
+	} else {
+		sb.WriteString("*")
+	}
+	sb.WriteString(" FROM OpenShiftClusters doc")
+
+	names := make([]string, 0, len(f.Match))
+	for name := range f.Match {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for i, name := range names {
+		if i == 0 {
+			sb.WriteString(" WHERE ")
+		} else {
+			sb.WriteString(" AND ")
+		}
+
+		sb.WriteString("doc." + OpenShiftClusterFields[name].Path + " IN (")
+		for j, value := range f.Match[name] {
+			if j > 0 {
+				sb.WriteString(", ")
+			}
+			p := fmt.Sprintf("@p%d", len(q.Parameters))
+			sb.WriteString(p)
+			q.Parameters = append(q.Parameters, cosmosdb.Parameter{Name: p, Value: value})
+		}
+		sb.WriteString(")")
+	}
+
+	if f.OrderBy != "" {
+		sb.WriteString(" ORDER BY doc." + OpenShiftClusterFields[f.OrderBy].Path)
+		if f.Descending {
+			sb.WriteString(" DESC")
+		} else {
+			sb.WriteString(" ASC")
+		}
+	}
+
+	q.Query = sb.String()
+	return q, nil
+}
+
+// projectionTree is the nested set of properties which a projection returns;
+// a nil subtree selects the whole property.
+type projectionTree map[string]projectionTree
+
+func newProjectionTree(paths []string) projectionTree {
+	t := projectionTree{}
+
+	for _, path := range paths {
+		node := t
+		parts := strings.Split(path, ".")
+		for i, part := range parts {
+			child, exists := node[part]
+			if exists && child == nil {
+				// an ancestor is already selected whole
+				break
+			}
+			if i == len(parts)-1 {
+				node[part] = nil
+				break
+			}
+			if !exists {
+				child = projectionTree{}
+				node[part] = child
+			}
+			node = child
+		}
+	}
+
+	return t
+}
+
+// writeProjection writes an object literal rebuilding the document shape
+// from the selected properties. Cosmos DB omits properties whose value is
+// undefined.
+func writeProjection(sb *strings.Builder, prefix string, t projectionTree) {
+	keys := make([]string, 0, len(t))
+	for key := range t {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	sb.WriteString("{")
+	for i, key := range keys {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		fmt.Fprintf(sb, "%q: ", key)
+		if t[key] == nil {
+			sb.WriteString(prefix + "." + key)
+		} else {
+			writeProjection(sb, prefix+"."+key, t[key])
+		}
+	}
+	sb.WriteString("}")
+}
@@ -0,0 +1,95 @@
+package database
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+	utilerror "github.com/Azure/ARO-RP/test/util/error"
+)
+
+func TestOpenShiftClusterFilterQuery(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		filter  *OpenShiftClusterFilter
+		want    *cosmosdb.Query
+		wantErr string
+	}{
+		{
+			name:   "no filter",
+			filter: &OpenShiftClusterFilter{},
+			want: &cosmosdb.Query{
+				Query: `SELECT * FROM OpenShiftClusters doc`,
+			},
+		},
+		{
+			name: "match",
+			filter: &OpenShiftClusterFilter{
+				Match: map[string][]string{
+					"version":           {"4.12.25", "4.13.0"},
+					"provisioningState": {"Succeeded"},
+				},
+			},
+			want: &cosmosdb.Query{
+				Query: `SELECT * FROM OpenShiftClusters doc WHERE doc.openShiftCluster.properties.provisioningState IN (@p0) AND doc.openShiftCluster.properties.clusterProfile.version IN (@p1, @p2)`,
+				Parameters: []cosmosdb.Parameter{
+					{Name: "@p0", Value: "Succeeded"},
+					{Name: "@p1", Value: "4.12.25"},
+					{Name: "@p2", Value: "4.13.0"},
+				},
+			},
+		},
+		{
+			name: "order and select",
+			filter: &OpenShiftClusterFilter{
+				Match: map[string][]string{
+					"subscriptionId": {"00000000-0000-0000-0000-000000000000"},
+				},
+				OrderBy:    "version",
+				Descending: true,
+				Select:     []string{"version", "location"},
+			},
+			want: &cosmosdb.Query{
+				Query: `SELECT VALUE {"id": doc.id, "key": doc.key, "openShiftCluster": {"id": doc.openShiftCluster.id, "location": doc.openShiftCluster.location, "name": doc.openShiftCluster.name, "properties": {"clusterProfile": {"version": doc.openShiftCluster.properties.clusterProfile.version}}, "type": doc.openShiftCluster.type}, "partitionKey": doc.partitionKey} FROM OpenShiftClusters doc WHERE doc.partitionKey IN (@p0) ORDER BY doc.openShiftCluster.properties.clusterProfile.version DESC`,
+				Parameters: []cosmosdb.Parameter{
+					{Name: "@p0", Value: "00000000-0000-0000-0000-000000000000"},
+				},
+			},
+		},
+		{
+			name: "field cannot be filtered on",
+			filter: &OpenShiftClusterFilter{
+				Match: map[string][]string{
+					"domain": {"example"},
+				},
+			},
+			wantErr: `field "domain" cannot be filtered on`,
+		},
+		{
+			name: "unknown field",
+			filter: &OpenShiftClusterFilter{
+				Select: []string{"servicePrincipalProfile"},
+			},
+			wantErr: `field "servicePrincipalProfile" cannot be selected`,
+		},
+		{
+			name: "sorting across subscriptions",
+			filter: &OpenShiftClusterFilter{
+				OrderBy: "name",
+			},
+			wantErr: "sorting requires a single subscriptionId",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.filter.query()
+			utilerror.AssertErrorMessage(t, err, tt.wantErr)
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %#v", got)
+			}
+		})
+	}
+}
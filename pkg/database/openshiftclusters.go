@@ -7,7 +7,9 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/Azure/go-autorest/autorest/azure"
 
@@ -23,6 +25,10 @@ const (
 	OpenshiftClustersPrefixQuery        = `SELECT * FROM OpenShiftClusters doc WHERE STARTSWITH(doc.key, @prefix)`
 	OpenshiftClustersClientIdQuery      = `SELECT * FROM OpenShiftClusters doc WHERE doc.clientIdKey = @clientID`
 	OpenshiftClustersResourceGroupQuery = `SELECT * FROM OpenShiftClusters doc WHERE doc.clusterResourceGroupIdKey = @resourceGroupID`
+	OpenShiftClustersHiveShardQuery     = `SELECT VALUE COUNT(1) FROM OpenShiftClusters doc WHERE ToString(doc.openShiftCluster.properties.hiveProfile.shardIndex ?? 1) = @shardIndex`
+	// dueTime is recorded in UTC with second precision, so it compares as a
+	// string against an RFC3339 timestamp
+	OpenShiftClustersCertificateRenewalDueQuery = `SELECT * FROM OpenShiftClusters doc WHERE doc.openShiftCluster.properties.provisioningState = "Succeeded" AND doc.openShiftCluster.properties.certificateRenewal.dueTime <= @now`
 )
 
 type OpenShiftClusterDocumentMutator func(*api.OpenShiftClusterDocument) error
@@ -39,6 +45,7 @@ type OpenShiftClusters interface {
 	Create(context.Context, *api.OpenShiftClusterDocument) (*api.OpenShiftClusterDocument, error)
 	Get(context.Context, string) (*api.OpenShiftClusterDocument, error)
 	QueueLength(context.Context, string) (int, error)
+	CountByHiveShard(context.Context, int) (int, error)
 	Patch(context.Context, string, OpenShiftClusterDocumentMutator) (*api.OpenShiftClusterDocument, error)
 	PatchWithLease(context.Context, string, OpenShiftClusterDocumentMutator) (*api.OpenShiftClusterDocument, error)
 	Update(context.Context, *api.OpenShiftClusterDocument) (*api.OpenShiftClusterDocument, error)
@@ -47,6 +54,8 @@ type OpenShiftClusters interface {
 	List(string) cosmosdb.OpenShiftClusterDocumentIterator
 	ListAll(context.Context) (*api.OpenShiftClusterDocuments, error)
 	ListByPrefix(string, string, string) (cosmosdb.OpenShiftClusterDocumentIterator, error)
+	ListByFilter(*OpenShiftClusterFilter, string) (cosmosdb.OpenShiftClusterDocumentIterator, error)
+	ListByCertificateRenewalDue(context.Context, time.Time) (*api.OpenShiftClusterDocuments, error)
 	Dequeue(context.Context) (*api.OpenShiftClusterDocument, error)
 	Lease(context.Context, string) (*api.OpenShiftClusterDocument, error)
 	EndLease(context.Context, string, api.ProvisioningState, api.ProvisioningState, *string) (*api.OpenShiftClusterDocument, error)
@@ -155,6 +164,29 @@ func (c *openShiftClusters) Get(ctx context.Context, key string) (*api.OpenShift
 // QueueLength returns OpenShiftClusters un-queued document count.
 // If error occurs, 0 is returned with error message
 func (c *openShiftClusters) QueueLength(ctx context.Context, collid string) (int, error) {
+	return c.count(ctx, collid, &cosmosdb.Query{
+		Query: OpenShiftClustersQueueLengthQuery,
+	})
+}
+
+// CountByHiveShard returns the number of OpenShiftClusters documents assigned
+// to the given Hive shard. Documents without a shard index count towards
+// shard 1.
+func (c *openShiftClusters) CountByHiveShard(ctx context.Context, shard int) (int, error) {
+	return c.count(ctx, collOpenShiftClusters, &cosmosdb.Query{
+		Query: OpenShiftClustersHiveShardQuery,
+		Parameters: []cosmosdb.Parameter{
+			{
+				Name:  "@shardIndex",
+				Value: strconv.Itoa(shard),
+			},
+		},
+	})
+}
+
+// count runs an aggregate COUNT query against every partition key range of
+// the collection and returns the total
+func (c *openShiftClusters) count(ctx context.Context, collid string, query *cosmosdb.Query) (int, error) {
 	partitions, err := c.collc.PartitionKeyRanges(ctx, collid)
 	if err != nil {
 		return 0, err
@@ -162,9 +194,7 @@ func (c *openShiftClusters) QueueLength(ctx context.Context, collid string) (int
 
 	var countTotal int
 	for _, r := range partitions.PartitionKeyRanges {
-		result := c.c.Query("", &cosmosdb.Query{
-			Query: OpenShiftClustersQueueLengthQuery,
-		}, &cosmosdb.Options{
+		result := c.c.Query("", query, &cosmosdb.Options{
 			PartitionKeyRangeID: r.ID,
 		})
 		// because we aggregate count we don't expect pagination in this query result,
@@ -274,6 +304,32 @@ func (c *openShiftClusters) ListByPrefix(subscriptionID, prefix, continuation st
 	), nil
 }
 
+// ListByFilter lists the documents matching filter. If the filter is
+// restricted to a single subscription, the query is served from that
+// subscription's partition only.
+func (c *openShiftClusters) ListByFilter(filter *OpenShiftClusterFilter, continuation string) (cosmosdb.OpenShiftClusterDocumentIterator, error) {
+	query, err := filter.query()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.c.Query(filter.SubscriptionID(), query, &cosmosdb.Options{Continuation: continuation}), nil
+}
+
+// ListByCertificateRenewalDue lists the succeeded clusters whose certificates
+// renewed by the RP were due at the given time
+func (c *openShiftClusters) ListByCertificateRenewalDue(ctx context.Context, now time.Time) (*api.OpenShiftClusterDocuments, error) {
+	return c.c.QueryAll(ctx, "", &cosmosdb.Query{
+		Query: OpenShiftClustersCertificateRenewalDueQuery,
+		Parameters: []cosmosdb.Parameter{
+			{
+				Name:  "@now",
+				Value: now.UTC().Format(time.RFC3339),
+			},
+		},
+	}, nil)
+}
+
 func (c *openShiftClusters) Dequeue(ctx context.Context) (*api.OpenShiftClusterDocument, error) {
 	i := c.c.Query("", &cosmosdb.Query{
 		Query: OpenShiftClustersDequeueQuery,
@@ -0,0 +1,88 @@
+package database
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+	"github.com/Azure/ARO-RP/pkg/util/uuid"
+)
+
+type operatorRollouts struct {
+	c    cosmosdb.OperatorRolloutDocumentClient
+	uuid uuid.Generator
+}
+
+// OperatorRollouts is the database interface for OperatorRolloutDocuments
+type OperatorRollouts interface {
+	Create(context.Context, *api.OperatorRolloutDocument) (*api.OperatorRolloutDocument, error)
+	Get(context.Context, string) (*api.OperatorRolloutDocument, error)
+	Patch(context.Context, string, func(*api.OperatorRolloutDocument) error) (*api.OperatorRolloutDocument, error)
+	ListAll(context.Context) (*api.OperatorRolloutDocuments, error)
+	NewUUID() string
+}
+
+// NewOperatorRollouts returns a new OperatorRollouts
+func NewOperatorRollouts(ctx context.Context, dbc cosmosdb.DatabaseClient, dbName string) (OperatorRollouts, error) {
+	collc := cosmosdb.NewCollectionClient(dbc, dbName)
+
+	documentClient := cosmosdb.NewOperatorRolloutDocumentClient(collc, collOperatorRollouts)
+	return NewOperatorRolloutsWithProvidedClient(documentClient, uuid.DefaultGenerator), nil
+}
+
+func NewOperatorRolloutsWithProvidedClient(client cosmosdb.OperatorRolloutDocumentClient, uuid uuid.Generator) OperatorRollouts {
+	return &operatorRollouts{
+		c:    client,
+		uuid: uuid,
+	}
+}
+
+func (c *operatorRollouts) Create(ctx context.Context, doc *api.OperatorRolloutDocument) (*api.OperatorRolloutDocument, error) {
+	if doc.ID != strings.ToLower(doc.ID) {
+		return nil, fmt.Errorf("id %q is not lower case", doc.ID)
+	}
+
+	return c.c.Create(ctx, doc.ID, doc, nil)
+}
+
+func (c *operatorRollouts) Get(ctx context.Context, id string) (*api.OperatorRolloutDocument, error) {
+	if id != strings.ToLower(id) {
+		return nil, fmt.Errorf("id %q is not lower case", id)
+	}
+
+	return c.c.Get(ctx, id, id, nil)
+}
+
+func (c *operatorRollouts) Patch(ctx context.Context, id string, f func(*api.OperatorRolloutDocument) error) (*api.OperatorRolloutDocument, error) {
+	var doc *api.OperatorRolloutDocument
+
+	err := cosmosdb.RetryOnPreconditionFailed(func() (err error) {
+		doc, err = c.Get(ctx, id)
+		if err != nil {
+			return
+		}
+
+		err = f(doc)
+		if err != nil {
+			return
+		}
+
+		doc, err = c.c.Replace(ctx, doc.ID, doc, nil)
+		return
+	})
+
+	return doc, err
+}
+
+func (c *operatorRollouts) ListAll(ctx context.Context) (*api.OperatorRolloutDocuments, error) {
+	return c.c.ListAll(ctx, nil)
+}
+
+func (c *operatorRollouts) NewUUID() string {
+	return c.uuid.Generate()
+}
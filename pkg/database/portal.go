@@ -14,6 +14,10 @@ import (
 	"github.com/Azure/ARO-RP/pkg/util/uuid"
 )
 
+const (
+	PortalElevatedAccessQuery = `SELECT * FROM Portals doc WHERE IS_DEFINED(doc.portal.elevatedAccess)`
+)
+
 type portals struct {
 	c             cosmosdb.PortalDocumentClient
 	uuidGenerator uuid.Generator
@@ -24,6 +28,7 @@ type Portal interface {
 	Create(context.Context, *api.PortalDocument) (*api.PortalDocument, error)
 	Get(context.Context, string) (*api.PortalDocument, error)
 	Patch(context.Context, string, func(*api.PortalDocument) error) (*api.PortalDocument, error)
+	ListElevatedAccess(context.Context) (*api.PortalDocuments, error)
 	NewUUID() string
 }
 
@@ -88,3 +93,11 @@ func (c *portals) Patch(ctx context.Context, id string, f func(*api.PortalDocume
 
 	return doc, err
 }
+
+// ListElevatedAccess returns the elevated access grants which have not yet
+// expired from the database
+func (c *portals) ListElevatedAccess(ctx context.Context) (*api.PortalDocuments, error) {
+	return c.c.QueryAll(ctx, "", &cosmosdb.Query{
+		Query: PortalElevatedAccessQuery,
+	}, nil)
+}
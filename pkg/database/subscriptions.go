@@ -14,7 +14,7 @@ import (
 	"github.com/Azure/ARO-RP/pkg/util/uuid"
 )
 
-const SubscriptionsDequeueQuery string = `SELECT * FROM Subscriptions doc WHERE (doc.deleting ?? false) AND (doc.leaseExpires ?? 0) < GetCurrentTimestamp() / 1000`
+const SubscriptionsDequeueQuery string = `SELECT * FROM Subscriptions doc WHERE ((doc.deleting ?? false) OR (doc.lifecyclePending ?? false)) AND (doc.leaseExpires ?? 0) < GetCurrentTimestamp() / 1000`
 
 type subscriptions struct {
 	c    cosmosdb.SubscriptionDocumentClient
@@ -29,7 +29,7 @@ type Subscriptions interface {
 	ChangeFeed() cosmosdb.SubscriptionDocumentIterator
 	Dequeue(context.Context) (*api.SubscriptionDocument, error)
 	Lease(context.Context, string) (*api.SubscriptionDocument, error)
-	EndLease(context.Context, string, bool, bool) (*api.SubscriptionDocument, error)
+	EndLease(context.Context, string, api.SubscriptionState, bool, bool) (*api.SubscriptionDocument, error)
 }
 
 // NewSubscriptions returns a new Subscriptions
@@ -181,15 +181,19 @@ func (c *subscriptions) Lease(ctx context.Context, id string) (*api.Subscription
 	}, &cosmosdb.Options{PreTriggers: []string{"renewLease"}})
 }
 
-func (c *subscriptions) EndLease(ctx context.Context, id string, done, retryLater bool) (*api.SubscriptionDocument, error) {
+// EndLease ends the lease on a subscription.  If done, the subscription is
+// dequeued unless its state has changed from handledState in the meantime, in
+// which case it is left queued so that the new state is handled.
+func (c *subscriptions) EndLease(ctx context.Context, id string, handledState api.SubscriptionState, done, retryLater bool) (*api.SubscriptionDocument, error) {
 	var options *cosmosdb.Options
 	if retryLater {
 		options = &cosmosdb.Options{PreTriggers: []string{"retryLater"}}
 	}
 
 	return c.patchWithLease(ctx, id, func(doc *api.SubscriptionDocument) error {
-		if done {
+		if done && doc.Subscription.State == handledState {
 			doc.Deleting = false
+			doc.LifecyclePending = false
 		}
 
 		doc.LeaseOwner = ""
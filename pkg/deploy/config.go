@@ -8,6 +8,7 @@ import (
 	"crypto/rsa"
 	"fmt"
 	"os"
+	"path"
 	"reflect"
 	"strings"
 
@@ -87,6 +88,10 @@ type Configuration struct {
 	PortalClientID                     *string                `json:"portalClientId,omitempty" value:"required"`
 	PortalElevatedGroupIDs             []string               `json:"portalElevatedGroupIds,omitempty" value:"required"`
 	RPFeatures                         []string               `json:"rpFeatures,omitempty"`
+	RPHealthGates                      []string               `json:"rpHealthGates,omitempty"`
+	RPHealthGateMaxErrors              *int                   `json:"rpHealthGateMaxErrors,omitempty"`
+	RPHealthGateMinDequeueRate         *int                   `json:"rpHealthGateMinDequeueRate,omitempty"`
+	RPHealthGateReportPath             *string                `json:"rpHealthGateReportPath,omitempty"`
 	RPImagePrefix                      *string                `json:"rpImagePrefix,omitempty" value:"required"`
 	RPMDMAccount                       *string                `json:"rpMdmAccount,omitempty" value:"required"`
 	RPMDSDAccount                      *string                `json:"rpMdsdAccount,omitempty" value:"required"`
@@ -101,6 +106,8 @@ type Configuration struct {
 	SubscriptionResourceGroupLocation  *string                `json:"subscriptionResourceGroupLocation,omitempty" value:"required"`
 	VMSize                             *string                `json:"vmSize,omitempty" value:"required"`
 	VMSSCleanupEnabled                 *bool                  `json:"vmssCleanupEnabled,omitempty"`
+	WhatIfAllowedDeletes               []string               `json:"whatIfAllowedDeletes,omitempty"`
+	WhatIfEnabled                      *bool                  `json:"whatIfEnabled,omitempty"`
 
 	// TODO: Replace with Live Service Configuration in KeyVault
 	InstallViaHive           *string `json:"clustersInstallViaHive,omitempty"`
@@ -187,9 +194,21 @@ func (conf *RPConfig) validate() error {
 		}
 	}
 
-	if len(missingFields) == 0 {
-		return nil
+	if len(missingFields) > 0 {
+		return fmt.Errorf("configuration has missing fields: %s", strings.Join(missingFields, ","))
 	}
 
-	return fmt.Errorf("configuration has missing fields: %s", strings.Join(missingFields, ","))
+	for _, name := range configuration.RPHealthGates {
+		if !rpHealthGateEnabled(rpHealthGateNames, name) {
+			return fmt.Errorf("configuration has unknown health gate %q", name)
+		}
+	}
+
+	for _, pattern := range configuration.WhatIfAllowedDeletes {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("configuration has invalid whatIfAllowedDeletes pattern %q", pattern)
+		}
+	}
+
+	return nil
 }
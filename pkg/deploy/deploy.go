@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	mgmtfeatures "github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-07-01/features"
@@ -37,6 +38,8 @@ type Deployer interface {
 	UpgradeRP(context.Context) error
 	UpgradeGateway(context.Context) error
 	SaveVersion(context.Context) error
+	DiffParameters(context.Context) ([]*ParameterDiff, error)
+	Preview(context.Context) ([]*ChangeSummary, error)
 }
 
 type deployer struct {
@@ -66,6 +69,10 @@ type deployer struct {
 	config      *RPConfig
 	version     string
 	vmssCleaner vmsscleaner.Interface
+
+	now                func() time.Time
+	healthGateTimeout  time.Duration
+	healthGateInterval time.Duration
 }
 
 // KnownDeploymentErrorType represents a type of error we encounter during an
@@ -118,6 +125,10 @@ func New(ctx context.Context, log *logrus.Entry, _env env.Core, config *RPConfig
 		config:      config,
 		version:     version,
 		vmssCleaner: vmsscleaner.New(log, vmssClient),
+
+		now:                time.Now,
+		healthGateTimeout:  10 * time.Minute,
+		healthGateInterval: 10 * time.Second,
 	}, nil
 }
 
@@ -161,6 +172,11 @@ func (d *deployer) getParameters(ps map[string]interface{}) *arm.Parameters {
 }
 
 func (d *deployer) deploy(ctx context.Context, rgName, deploymentName, vmssName string, deployment mgmtfeatures.Deployment) (err error) {
+	err = d.checkWhatIf(ctx, rgName, deploymentName, deployment)
+	if err != nil {
+		return err
+	}
+
 	numAttempts := 3
 
 	for i := 0; i < numAttempts; i++ {
@@ -25,50 +25,65 @@ func (d *deployer) DeployGateway(ctx context.Context) error {
 		return err
 	}
 
-	deploymentName := "gateway-production-" + d.version
+	deployment, err := d.gatewayProductionDeployment(rpMSI.PrincipalID.String(), gwMSI.PrincipalID.String())
+	if err != nil {
+		return err
+	}
 
+	return d.deploy(ctx, d.config.GatewayResourceGroupName, "gateway-production-"+d.version, gatewayVMSSPrefix+d.version, deployment)
+}
+
+// gatewayProductionDeployment returns the gateway-production deployment for
+// the current version
+func (d *deployer) gatewayProductionDeployment(rpServicePrincipalID, gatewayServicePrincipalID string) (mgmtfeatures.Deployment, error) {
 	asset, err := assets.EmbeddedFiles.ReadFile(generator.FileGatewayProduction)
 	if err != nil {
-		return err
+		return mgmtfeatures.Deployment{}, err
 	}
 
 	var template map[string]interface{}
 	err = json.Unmarshal(asset, &template)
 	if err != nil {
-		return err
+		return mgmtfeatures.Deployment{}, err
 	}
 
-	// Special cases where the config isn't marshalled into the ARM template parameters cleanly
-	parameters := d.getParameters(template["parameters"].(map[string]interface{}))
-	parameters.Parameters["dbtokenURL"] = &arm.ParametersParameter{
-		Value: "https://dbtoken." + d.config.Location + "." + *d.config.Configuration.RPParentDomainName + ":8445",
-	}
+	parameters := d.gatewayProductionParameters(template)
 	parameters.Parameters["rpImage"] = &arm.ParametersParameter{
 		Value: *d.config.Configuration.RPImagePrefix + ":" + d.version,
 	}
-	parameters.Parameters["rpResourceGroupName"] = &arm.ParametersParameter{
-		Value: d.config.RPResourceGroupName,
-	}
 	parameters.Parameters["rpServicePrincipalId"] = &arm.ParametersParameter{
-		Value: rpMSI.PrincipalID.String(),
+		Value: rpServicePrincipalID,
 	}
 	parameters.Parameters["gatewayServicePrincipalId"] = &arm.ParametersParameter{
-		Value: gwMSI.PrincipalID.String(),
+		Value: gatewayServicePrincipalID,
 	}
 	parameters.Parameters["vmssName"] = &arm.ParametersParameter{
 		Value: d.version,
 	}
+
+	return mgmtfeatures.Deployment{
+		Properties: &mgmtfeatures.DeploymentProperties{
+			Template:   template,
+			Mode:       mgmtfeatures.Incremental,
+			Parameters: parameters.Parameters,
+		},
+	}, nil
+}
+
+// gatewayProductionParameters returns the gateway-production template
+// parameters which are derived from the configuration
+func (d *deployer) gatewayProductionParameters(template map[string]interface{}) *arm.Parameters {
+	// Special cases where the config isn't marshalled into the ARM template parameters cleanly
+	parameters := d.getParameters(template["parameters"].(map[string]interface{}))
+	parameters.Parameters["dbtokenURL"] = &arm.ParametersParameter{
+		Value: "https://dbtoken." + d.config.Location + "." + *d.config.Configuration.RPParentDomainName + ":8445",
+	}
+	parameters.Parameters["rpResourceGroupName"] = &arm.ParametersParameter{
+		Value: d.config.RPResourceGroupName,
+	}
 	parameters.Parameters["azureCloudName"] = &arm.ParametersParameter{
 		Value: d.env.Environment().ActualCloudName,
 	}
 
-	return d.deploy(ctx, d.config.GatewayResourceGroupName, deploymentName, gatewayVMSSPrefix+d.version,
-		mgmtfeatures.Deployment{
-			Properties: &mgmtfeatures.DeploymentProperties{
-				Template:   template,
-				Mode:       mgmtfeatures.Incremental,
-				Parameters: parameters.Parameters,
-			},
-		},
-	)
+	return parameters
 }
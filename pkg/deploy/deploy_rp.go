@@ -29,19 +29,59 @@ func (d *deployer) DeployRP(ctx context.Context) error {
 		return err
 	}
 
-	deploymentName := "rp-production-" + d.version
+	deployment, err := d.rpProductionDeployment(rpMSI.PrincipalID.String(), gwMSI.PrincipalID.String())
+	if err != nil {
+		return err
+	}
 
-	asset, err := assets.EmbeddedFiles.ReadFile(generator.FileRPProduction)
+	err = d.deploy(ctx, d.config.RPResourceGroupName, "rp-production-"+d.version, rpVMSSPrefix+d.version, deployment)
 	if err != nil {
 		return err
 	}
 
+	return d.configureDNS(ctx)
+}
+
+// rpProductionDeployment returns the rp-production deployment for the current
+// version
+func (d *deployer) rpProductionDeployment(rpServicePrincipalID, gatewayServicePrincipalID string) (mgmtfeatures.Deployment, error) {
+	asset, err := assets.EmbeddedFiles.ReadFile(generator.FileRPProduction)
+	if err != nil {
+		return mgmtfeatures.Deployment{}, err
+	}
+
 	var template map[string]interface{}
 	err = json.Unmarshal(asset, &template)
 	if err != nil {
-		return err
+		return mgmtfeatures.Deployment{}, err
 	}
 
+	parameters := d.rpProductionParameters(template)
+	parameters.Parameters["gatewayServicePrincipalId"] = &arm.ParametersParameter{
+		Value: gatewayServicePrincipalID,
+	}
+	parameters.Parameters["rpImage"] = &arm.ParametersParameter{
+		Value: *d.config.Configuration.RPImagePrefix + ":" + d.version,
+	}
+	parameters.Parameters["rpServicePrincipalId"] = &arm.ParametersParameter{
+		Value: rpServicePrincipalID,
+	}
+	parameters.Parameters["vmssName"] = &arm.ParametersParameter{
+		Value: d.version,
+	}
+
+	return mgmtfeatures.Deployment{
+		Properties: &mgmtfeatures.DeploymentProperties{
+			Template:   template,
+			Mode:       mgmtfeatures.Incremental,
+			Parameters: parameters.Parameters,
+		},
+	}, nil
+}
+
+// rpProductionParameters returns the rp-production template parameters which
+// are derived from the configuration
+func (d *deployer) rpProductionParameters(template map[string]interface{}) *arm.Parameters {
 	// Special cases where the config isn't marshalled into the ARM template parameters cleanly
 	parameters := d.getParameters(template["parameters"].(map[string]interface{}))
 	parameters.Parameters["adminApiCaBundle"] = &arm.ParametersParameter{
@@ -59,18 +99,6 @@ func (d *deployer) DeployRP(ctx context.Context) error {
 	parameters.Parameters["gatewayResourceGroupName"] = &arm.ParametersParameter{
 		Value: d.config.GatewayResourceGroupName,
 	}
-	parameters.Parameters["gatewayServicePrincipalId"] = &arm.ParametersParameter{
-		Value: gwMSI.PrincipalID.String(),
-	}
-	parameters.Parameters["rpImage"] = &arm.ParametersParameter{
-		Value: *d.config.Configuration.RPImagePrefix + ":" + d.version,
-	}
-	parameters.Parameters["rpServicePrincipalId"] = &arm.ParametersParameter{
-		Value: rpMSI.PrincipalID.String(),
-	}
-	parameters.Parameters["vmssName"] = &arm.ParametersParameter{
-		Value: d.version,
-	}
 	parameters.Parameters["keyvaultDNSSuffix"] = &arm.ParametersParameter{
 		Value: d.env.Environment().KeyVaultDNSSuffix,
 	}
@@ -87,20 +115,7 @@ func (d *deployer) DeployRP(ctx context.Context) error {
 		}
 	}
 
-	err = d.deploy(ctx, d.config.RPResourceGroupName, deploymentName, rpVMSSPrefix+d.version,
-		mgmtfeatures.Deployment{
-			Properties: &mgmtfeatures.DeploymentProperties{
-				Template:   template,
-				Mode:       mgmtfeatures.Incremental,
-				Parameters: parameters.Parameters,
-			},
-		},
-	)
-	if err != nil {
-		return err
-	}
-
-	return d.configureDNS(ctx)
+	return parameters
 }
 
 func (d *deployer) configureDNS(ctx context.Context) error {
@@ -183,6 +183,15 @@ func DevConfig(_env env.Core) (*Config, error) {
 				"DisableReadinessDelay",
 				"EnableOCMEndpoints",
 			},
+			RPHealthGates: []string{
+				"frontend-operations",
+				"frontend-openshiftversions",
+				"frontend-preflight",
+				"rp-metrics",
+				"monitor-ready",
+				"portal-ready",
+				"gateway-ready",
+			},
 			// TODO update this to support FF
 			RPImagePrefix:                     to.StringPtr(os.Getenv("USER") + "aro.azurecr.io/aro"),
 			RPMDMAccount:                      to.StringPtr(version.DevRPGenevaMetricsAccount),
@@ -197,6 +206,7 @@ func DevConfig(_env env.Core) (*Config, error) {
 			SubscriptionResourceGroupName:     to.StringPtr(os.Getenv("USER") + "-subscription"),
 			VMSSCleanupEnabled:                to.BoolPtr(true),
 			VMSize:                            to.StringPtr("Standard_D2s_v3"),
+			WhatIfEnabled:                     to.BoolPtr(true),
 
 			// TODO: Replace with Live Service Configuration in KeyVault
 			InstallViaHive:           to.StringPtr(os.Getenv("ARO_INSTALL_VIA_HIVE")),
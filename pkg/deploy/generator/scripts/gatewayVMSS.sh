@@ -264,6 +264,7 @@ MDM_ACCOUNT="$RPMDMACCOUNT"
 MDM_NAMESPACE=Gateway
 GATEWAY_DOMAINS='$GATEWAYDOMAINS'
 GATEWAY_FEATURES='$GATEWAYFEATURES'
+GATEWAY_FLOW_LOG_PATH='/ctr.log/flows.jsonl'
 RPIMAGE='$RPIMAGE'
 EOF
 
@@ -287,6 +288,7 @@ ExecStart=/usr/bin/docker run \
   -e DBTOKEN_URL \
   -e GATEWAY_DOMAINS \
   -e GATEWAY_FEATURES \
+  -e GATEWAY_FLOW_LOG_PATH \
   -e MDM_ACCOUNT \
   -e MDM_NAMESPACE \
   -m 2g \
@@ -210,6 +210,14 @@ base64 -d <<<"$ADMINAPICABUNDLE" >/etc/aro-rp/admin-ca-bundle.pem
 if [[ -n "$ARMAPICABUNDLE" ]]; then
   base64 -d <<<"$ARMAPICABUNDLE" >/etc/aro-rp/arm-ca-bundle.pem
 fi
+# client certificate presented to the local frontend by the deployer's health
+# gates
+openssl req -x509 -newkey rsa:2048 -nodes -days 3650 \
+  -subj /CN=healthgate \
+  -addext extendedKeyUsage=clientAuth \
+  -keyout /etc/aro-rp/healthgate-client.key \
+  -out /etc/aro-rp/healthgate-client.crt
+chmod 0600 /etc/aro-rp/healthgate-client.key
 chown -R 1000:1000 /etc/aro-rp
 
 echo "configuring mdm service"
@@ -324,39 +324,62 @@ func (d *deployer) deployManagedIdentity(ctx context.Context, resourceGroupName,
 func (d *deployer) deployPreDeploy(ctx context.Context, resourceGroupName, deploymentFile, spIDName, spID string, isCreate bool) error {
 	deploymentName := strings.TrimSuffix(deploymentFile, ".json")
 
-	asset, err := assets.EmbeddedFiles.ReadFile(deploymentFile)
+	deployment, err := d.preDeployDeployment(deploymentFile, spIDName, spID, isCreate)
+	if err != nil {
+		return err
+	}
+
+	err = d.checkWhatIf(ctx, resourceGroupName, deploymentName, deployment)
 	if err != nil {
 		return err
 	}
 
+	d.log.Infof("deploying %s", deploymentName)
+	return d.deployments.CreateOrUpdateAndWait(ctx, resourceGroupName, deploymentName, deployment)
+}
+
+// preDeployDeployment returns the deployment of the given pre-deploy template
+func (d *deployer) preDeployDeployment(deploymentFile, spIDName, spID string, isCreate bool) (mgmtfeatures.Deployment, error) {
+	asset, err := assets.EmbeddedFiles.ReadFile(deploymentFile)
+	if err != nil {
+		return mgmtfeatures.Deployment{}, err
+	}
+
 	var template map[string]interface{}
 	err = json.Unmarshal(asset, &template)
 	if err != nil {
-		return err
+		return mgmtfeatures.Deployment{}, err
 	}
 
-	parameters := d.getParameters(template["parameters"].(map[string]interface{}))
+	parameters := d.preDeployParameters(template)
 	parameters.Parameters["deployNSGs"] = &arm.ParametersParameter{
 		Value: isCreate,
 	}
-	// TODO: ugh
-	if _, ok := template["parameters"].(map[string]interface{})["gatewayResourceGroupName"]; ok {
-		parameters.Parameters["gatewayResourceGroupName"] = &arm.ParametersParameter{
-			Value: d.config.GatewayResourceGroupName,
-		}
-	}
 	parameters.Parameters[spIDName] = &arm.ParametersParameter{
 		Value: spID,
 	}
 
-	d.log.Infof("deploying %s", deploymentName)
-	return d.deployments.CreateOrUpdateAndWait(ctx, resourceGroupName, deploymentName, mgmtfeatures.Deployment{
+	return mgmtfeatures.Deployment{
 		Properties: &mgmtfeatures.DeploymentProperties{
 			Template:   template,
 			Mode:       mgmtfeatures.Incremental,
 			Parameters: parameters.Parameters,
 		},
-	})
+	}, nil
+}
+
+// preDeployParameters returns the pre-deploy template parameters which are
+// derived from the configuration
+func (d *deployer) preDeployParameters(template map[string]interface{}) *arm.Parameters {
+	parameters := d.getParameters(template["parameters"].(map[string]interface{}))
+	// TODO: ugh
+	if _, ok := template["parameters"].(map[string]interface{})["gatewayResourceGroupName"]; ok {
+		parameters.Parameters["gatewayResourceGroupName"] = &arm.ParametersParameter{
+			Value: d.config.GatewayResourceGroupName,
+		}
+	}
+
+	return parameters
 }
 
 func (d *deployer) configureServiceSecrets(ctx context.Context) error {
@@ -28,6 +28,11 @@ func (d *deployer) UpgradeRP(ctx context.Context) error {
 		return err
 	}
 
+	err = d.rpCheckHealthGates(ctx, rpVMSSPrefix+d.version)
+	if err != nil {
+		return err
+	}
+
 	return d.rpRemoveOldScalesets(ctx)
 }
 
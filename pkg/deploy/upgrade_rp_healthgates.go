@@ -0,0 +1,381 @@
+package deploy
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	mgmtcompute "github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-06-01/compute"
+	"github.com/Azure/go-autorest/autorest/to"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const (
+	rpHealthGateFrontendOperations        = "frontend-operations"
+	rpHealthGateFrontendOpenShiftVersions = "frontend-openshiftversions"
+	rpHealthGateFrontendPreflight         = "frontend-preflight"
+	rpHealthGateRPMetrics                 = "rp-metrics"
+	rpHealthGateMonitorReady              = "monitor-ready"
+	rpHealthGatePortalReady               = "portal-ready"
+	rpHealthGateGatewayReady              = "gateway-ready"
+
+	rpHealthGateAPIVersion = "2023-11-22"
+
+	// rpHealthGateClientCertFile and rpHealthGateClientKeyFile hold the
+	// self-signed client certificate which rpVMSS.sh generates on each RP
+	// instance.  The frontend accepts it for reads and preflight requests.
+	rpHealthGateClientCertFile = "/etc/aro-rp/healthgate-client.crt"
+	rpHealthGateClientKeyFile  = "/etc/aro-rp/healthgate-client.key"
+
+	// rpHealthGateDefaultMaxErrors is the number of error entries the RP may
+	// have logged on a new instance before the rp-metrics gate fails, unless
+	// overridden by RPHealthGateMaxErrors
+	rpHealthGateDefaultMaxErrors = 10
+)
+
+// rpHealthGateNames lists the health gates which can be enabled in
+// RPHealthGates, in the order in which they run
+var rpHealthGateNames = []string{
+	rpHealthGateFrontendOperations,
+	rpHealthGateFrontendOpenShiftVersions,
+	rpHealthGateFrontendPreflight,
+	rpHealthGateRPMetrics,
+	rpHealthGateMonitorReady,
+	rpHealthGatePortalReady,
+	rpHealthGateGatewayReady,
+}
+
+// rpHealthGateResult records the outcome of a health gate, per new RP
+// instance where the gate runs on the instance
+type rpHealthGateResult struct {
+	Gate     string `json:"gate"`
+	Instance string `json:"instance,omitempty"`
+	Passed   bool   `json:"passed"`
+	Message  string `json:"message,omitempty"`
+}
+
+// rpDeploymentReport records the health gate results of an RP upgrade and
+// whether the upgrade was rolled back
+type rpDeploymentReport struct {
+	Location   string               `json:"location"`
+	Version    string               `json:"version"`
+	Scaleset   string               `json:"scaleset"`
+	StartTime  time.Time            `json:"startTime"`
+	EndTime    time.Time            `json:"endTime"`
+	Gates      []rpHealthGateResult `json:"gates,omitempty"`
+	RolledBack bool                 `json:"rolledBack"`
+}
+
+func (r *rpDeploymentReport) failed() bool {
+	for _, g := range r.Gates {
+		if !g.Passed {
+			return true
+		}
+	}
+	return false
+}
+
+// rpInstanceHealthGate runs script on a new RP instance and checks its output
+type rpInstanceHealthGate struct {
+	script string
+	check  func(output string) error
+}
+
+// rpCheckHealthGates runs the health gates enabled in RPHealthGates against
+// the new RP scaleset.  If any gate fails, the new scaleset is deleted and the
+// old scalesets are left serving.  The gate results are written to the
+// deployment report.
+func (d *deployer) rpCheckHealthGates(ctx context.Context, vmssName string) error {
+	if len(d.config.Configuration.RPHealthGates) == 0 {
+		return nil
+	}
+
+	report := &rpDeploymentReport{
+		Location:  d.config.Location,
+		Version:   d.version,
+		Scaleset:  vmssName,
+		StartTime: d.now().UTC(),
+	}
+
+	err := d.rpRunHealthGates(ctx, vmssName, report)
+	if err == nil && report.failed() {
+		err = fmt.Errorf("health gates failed for scaleset %s", vmssName)
+	}
+
+	if err != nil {
+		d.log.Printf("rolling back scaleset %s: %v", vmssName, err)
+		d.vmssCleaner.RemoveFailedNewScaleset(ctx, d.config.RPResourceGroupName, vmssName)
+		report.RolledBack = true
+	}
+
+	report.EndTime = d.now().UTC()
+	reportErr := d.writeDeploymentReport(report)
+	if err != nil {
+		return err
+	}
+	return reportErr
+}
+
+func (d *deployer) rpRunHealthGates(ctx context.Context, vmssName string, report *rpDeploymentReport) error {
+	scalesetVMs, err := d.vmssvms.List(ctx, d.config.RPResourceGroupName, vmssName, "", "", "")
+	if err != nil {
+		return err
+	}
+
+	instanceGates := d.rpInstanceHealthGates()
+
+	for _, name := range rpHealthGateNames {
+		if !rpHealthGateEnabled(d.config.Configuration.RPHealthGates, name) {
+			continue
+		}
+
+		d.log.Printf("running health gate %s", name)
+
+		if name == rpHealthGateGatewayReady {
+			report.Gates = append(report.Gates, d.gatewayReadyHealthGate(ctx))
+			continue
+		}
+
+		gate := instanceGates[name]
+		for _, vm := range scalesetVMs {
+			report.Gates = append(report.Gates, d.rpRunInstanceHealthGate(ctx, vmssName, *vm.InstanceID, name, gate))
+		}
+	}
+
+	return nil
+}
+
+// rpRunInstanceHealthGate runs gate on an instance, retrying until it passes
+// or the gate timeout expires
+func (d *deployer) rpRunInstanceHealthGate(ctx context.Context, vmssName, instanceID, name string, gate rpInstanceHealthGate) rpHealthGateResult {
+	result := rpHealthGateResult{
+		Gate:     name,
+		Instance: instanceID,
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, d.healthGateTimeout)
+	defer cancel()
+
+	_ = wait.PollImmediateUntil(d.healthGateInterval, func() (bool, error) {
+		output, err := d.vmssvms.RunCommandAndGetOutput(timeoutCtx, d.config.RPResourceGroupName, vmssName, instanceID, mgmtcompute.RunCommandInput{
+			CommandID: to.StringPtr("RunShellScript"),
+			Script:    &[]string{gate.script},
+		})
+		if err == nil {
+			err = gate.check(runCommandStdout(output))
+		}
+		if err != nil {
+			result.Message = err.Error()
+			d.log.Printf("health gate %s on instance %s: %v", name, instanceID, err)
+			return false, nil
+		}
+
+		result.Passed = true
+		result.Message = ""
+		return true, nil
+	}, timeoutCtx.Done())
+
+	return result
+}
+
+// gatewayReadyHealthGate checks that the gateway scalesets have healthy
+// instances to serve the new RP
+func (d *deployer) gatewayReadyHealthGate(ctx context.Context) rpHealthGateResult {
+	result := rpHealthGateResult{
+		Gate: rpHealthGateGatewayReady,
+	}
+
+	scalesets, err := d.vmss.List(ctx, d.config.GatewayResourceGroupName)
+	if err != nil {
+		result.Message = err.Error()
+		return result
+	}
+
+	var unhealthy []string
+	var healthy int
+	for _, vmss := range scalesets {
+		scalesetVMs, err := d.vmssvms.List(ctx, d.config.GatewayResourceGroupName, *vmss.Name, "", "", "")
+		if err != nil {
+			result.Message = err.Error()
+			return result
+		}
+
+		for _, vm := range scalesetVMs {
+			if d.isVMInstanceHealthy(ctx, d.config.GatewayResourceGroupName, *vmss.Name, *vm.InstanceID) {
+				healthy++
+			} else {
+				unhealthy = append(unhealthy, *vmss.Name+"/"+*vm.InstanceID)
+			}
+		}
+	}
+
+	switch {
+	case len(unhealthy) > 0:
+		result.Message = fmt.Sprintf("unhealthy gateway instances: %s", strings.Join(unhealthy, ", "))
+	case healthy == 0:
+		result.Message = "no gateway instances found"
+	default:
+		result.Passed = true
+	}
+
+	return result
+}
+
+// rpInstanceHealthGates returns the health gates which run on each new RP
+// instance.  The synthetic frontend requests present the instance's health
+// gate client certificate, so they pass the frontend authentication
+// middleware and must be answered successfully by the handlers.
+func (d *deployer) rpInstanceHealthGates() map[string]rpInstanceHealthGate {
+	maxErrors := rpHealthGateDefaultMaxErrors
+	if d.config.Configuration.RPHealthGateMaxErrors != nil {
+		maxErrors = *d.config.Configuration.RPHealthGateMaxErrors
+	}
+
+	var minDequeueRate int
+	if d.config.Configuration.RPHealthGateMinDequeueRate != nil {
+		minDequeueRate = *d.config.Configuration.RPHealthGateMinDequeueRate
+	}
+
+	return map[string]rpInstanceHealthGate{
+		rpHealthGateFrontendOperations: {
+			script: frontendCurlScript("GET", "https://localhost/providers/Microsoft.RedHatOpenShift/operations?api-version="+rpHealthGateAPIVersion),
+			check:  checkFrontendStatus,
+		},
+		rpHealthGateFrontendOpenShiftVersions: {
+			script: frontendCurlScript("GET", fmt.Sprintf("https://localhost/subscriptions/%s/providers/Microsoft.RedHatOpenShift/locations/%s/openshiftversions?api-version=%s", d.config.SubscriptionID, d.config.Location, rpHealthGateAPIVersion)),
+			check:  checkFrontendStatus,
+		},
+		rpHealthGateFrontendPreflight: {
+			// a preflight request with no resources is a no-op
+			script: frontendCurlScript("POST", fmt.Sprintf("https://localhost/subscriptions/%s/resourcegroups/%s/providers/Microsoft.RedHatOpenShift/deployments/healthgate/preflight?api-version=%s", d.config.SubscriptionID, d.config.RPResourceGroupName, rpHealthGateAPIVersion)),
+			check:  checkFrontendStatus,
+		},
+		rpHealthGateRPMetrics: {
+			script: strings.Join([]string{
+				"echo",
+				"$(journalctl -b -o cat COMPONENT=backend MESSAGE=dequeued | wc -l)",
+				"$(journalctl -b -o cat -p err COMPONENT=backend COMPONENT=frontend | wc -l)",
+				"$(cut -d. -f1 /proc/uptime)",
+			}, " "),
+			check: func(output string) error {
+				return checkRPMetrics(output, maxErrors, minDequeueRate)
+			},
+		},
+		rpHealthGateMonitorReady: {
+			script: "systemctl is-active aro-monitor",
+			check: func(output string) error {
+				if strings.TrimSpace(output) != "active" {
+					return fmt.Errorf("aro-monitor is %q", strings.TrimSpace(output))
+				}
+				return nil
+			},
+		},
+		rpHealthGatePortalReady: {
+			script: curlScript("GET", "https://localhost:444/healthz/ready"),
+			check: func(output string) error {
+				return checkStatus(output, 200)
+			},
+		},
+	}
+}
+
+// runCommandStdout returns the stdout section of the output of a RunShellScript
+// command, which looks like "Enable succeeded: \n[stdout]\n...\n[stderr]\n...".
+// Output without a stdout section is returned unchanged.
+func runCommandStdout(output string) string {
+	_, stdout, found := strings.Cut(output, "[stdout]\n")
+	if !found {
+		return output
+	}
+
+	stdout, _, _ = strings.Cut(stdout, "[stderr]")
+	return stdout
+}
+
+func curlScript(method, url string) string {
+	script := fmt.Sprintf("curl -sk -m 30 -o /dev/null -w '%%{http_code}' -X %s '%s'", method, url)
+	if method == "POST" {
+		script += ` -H 'Content-Type: application/json' -d '{"resources":[]}'`
+	}
+	return script
+}
+
+// frontendCurlScript returns a curl script which authenticates to the frontend
+// with the health gate client certificate
+func frontendCurlScript(method, url string) string {
+	return curlScript(method, url) + fmt.Sprintf(" --cert '%s' --key '%s'", rpHealthGateClientCertFile, rpHealthGateClientKeyFile)
+}
+
+// checkFrontendStatus accepts only successful responses: an authentication
+// failure means the health gate client certificate was not accepted and is
+// reported like any other failure
+func checkFrontendStatus(output string) error {
+	return checkStatus(output, 200)
+}
+
+func checkStatus(output string, want int) error {
+	status, err := strconv.Atoi(strings.TrimSpace(output))
+	if err != nil {
+		return fmt.Errorf("unexpected output %q", strings.TrimSpace(output))
+	}
+
+	if status != want {
+		return fmt.Errorf("unexpected status code %d", status)
+	}
+
+	return nil
+}
+
+// checkRPMetrics checks the number of dequeues and errors the RP logged since
+// the instance booted, followed by the instance uptime in seconds
+func checkRPMetrics(output string, maxErrors, minDequeueRate int) error {
+	var dequeues, errors, uptime int
+	_, err := fmt.Sscan(output, &dequeues, &errors, &uptime)
+	if err != nil {
+		return fmt.Errorf("unexpected output %q", strings.TrimSpace(output))
+	}
+
+	if errors > maxErrors {
+		return fmt.Errorf("rp logged %d errors, more than %d", errors, maxErrors)
+	}
+
+	if uptime > 0 {
+		rate := dequeues * 3600 / uptime
+		if rate < minDequeueRate {
+			return fmt.Errorf("rp dequeue rate %d/h, less than %d/h", rate, minDequeueRate)
+		}
+	}
+
+	return nil
+}
+
+func (d *deployer) writeDeploymentReport(report *rpDeploymentReport) error {
+	b, err := json.MarshalIndent(report, "", "    ")
+	if err != nil {
+		return err
+	}
+
+	d.log.Printf("deployment report: %s", string(b))
+
+	if d.config.Configuration.RPHealthGateReportPath == nil {
+		return nil
+	}
+
+	return os.WriteFile(*d.config.Configuration.RPHealthGateReportPath, b, 0666)
+}
+
+func rpHealthGateEnabled(gates []string, name string) bool {
+	for _, gate := range gates {
+		if gate == name {
+			return true
+		}
+	}
+	return false
+}
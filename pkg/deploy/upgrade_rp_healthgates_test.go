@@ -0,0 +1,276 @@
+package deploy
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	mgmtcompute "github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-06-01/compute"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/golang/mock/gomock"
+	"github.com/sirupsen/logrus"
+
+	mock_compute "github.com/Azure/ARO-RP/pkg/util/mocks/azureclient/mgmt/compute"
+	mock_vmsscleaner "github.com/Azure/ARO-RP/pkg/util/mocks/vmsscleaner"
+	utilerror "github.com/Azure/ARO-RP/test/util/error"
+)
+
+func TestRPCheckHealthGates(t *testing.T) {
+	ctx := context.Background()
+
+	const (
+		rpRGName      = "rp-rg"
+		gatewayRGName = "gateway-rg"
+		vmssName      = rpVMSSPrefix + "new"
+	)
+
+	healthyInstanceView := mgmtcompute.VirtualMachineScaleSetVMInstanceView{
+		VMHealth: &mgmtcompute.VirtualMachineHealthStatus{
+			Status: &mgmtcompute.InstanceViewStatus{
+				Code: to.StringPtr("HealthState/healthy"),
+			},
+		},
+	}
+	unhealthyInstanceView := mgmtcompute.VirtualMachineScaleSetVMInstanceView{
+		VMHealth: &mgmtcompute.VirtualMachineHealthStatus{
+			Status: &mgmtcompute.InstanceViewStatus{
+				Code: to.StringPtr("HealthState/unhealthy"),
+			},
+		},
+	}
+
+	// runCommand returns stdout in the format of the RunShellScript output
+	runCommand := func(vmssvms *mock_compute.MockVirtualMachineScaleSetVMsClient, script string, stdout string) *gomock.Call {
+		return vmssvms.EXPECT().RunCommandAndGetOutput(gomock.Any(), rpRGName, vmssName, "0", mgmtcompute.RunCommandInput{
+			CommandID: to.StringPtr("RunShellScript"),
+			Script:    &[]string{script},
+		}).Return("Enable succeeded: \n[stdout]\n"+stdout+"\n[stderr]\n", nil)
+	}
+
+	for _, tt := range []struct {
+		name           string
+		gates          []string
+		mocks          func(*mock_compute.MockVirtualMachineScaleSetsClient, *mock_compute.MockVirtualMachineScaleSetVMsClient, *mock_vmsscleaner.MockInterface)
+		wantErr        string
+		wantReport     bool
+		wantGates      []rpHealthGateResult
+		wantRolledBack bool
+	}{
+		{
+			name: "no gates configured",
+		},
+		{
+			name:  "all gates pass",
+			gates: []string{rpHealthGateFrontendOperations, rpHealthGateRPMetrics, rpHealthGateMonitorReady, rpHealthGateGatewayReady},
+			mocks: func(vmss *mock_compute.MockVirtualMachineScaleSetsClient, vmssvms *mock_compute.MockVirtualMachineScaleSetVMsClient, cleaner *mock_vmsscleaner.MockInterface) {
+				vmssvms.EXPECT().List(gomock.Any(), rpRGName, vmssName, "", "", "").Return([]mgmtcompute.VirtualMachineScaleSetVM{{InstanceID: to.StringPtr("0")}}, nil)
+				runCommand(vmssvms, frontendCurlScript("GET", "https://localhost/providers/Microsoft.RedHatOpenShift/operations?api-version="+rpHealthGateAPIVersion), "200")
+				runCommand(vmssvms, "echo $(journalctl -b -o cat COMPONENT=backend MESSAGE=dequeued | wc -l) $(journalctl -b -o cat -p err COMPONENT=backend COMPONENT=frontend | wc -l) $(cut -d. -f1 /proc/uptime)", "12 1 3600\n")
+				runCommand(vmssvms, "systemctl is-active aro-monitor", "active\n")
+				vmss.EXPECT().List(gomock.Any(), gatewayRGName).Return([]mgmtcompute.VirtualMachineScaleSet{{Name: to.StringPtr(gatewayVMSSPrefix + "new")}}, nil)
+				vmssvms.EXPECT().List(gomock.Any(), gatewayRGName, gatewayVMSSPrefix+"new", "", "", "").Return([]mgmtcompute.VirtualMachineScaleSetVM{{InstanceID: to.StringPtr("0")}}, nil)
+				vmssvms.EXPECT().GetInstanceView(gomock.Any(), gatewayRGName, gatewayVMSSPrefix+"new", "0").Return(healthyInstanceView, nil)
+			},
+			wantReport: true,
+			wantGates: []rpHealthGateResult{
+				{Gate: rpHealthGateFrontendOperations, Instance: "0", Passed: true},
+				{Gate: rpHealthGateRPMetrics, Instance: "0", Passed: true},
+				{Gate: rpHealthGateMonitorReady, Instance: "0", Passed: true},
+				{Gate: rpHealthGateGatewayReady, Passed: true},
+			},
+		},
+		{
+			name:  "failing gates roll back the new scaleset",
+			gates: []string{rpHealthGatePortalReady, rpHealthGateGatewayReady},
+			mocks: func(vmss *mock_compute.MockVirtualMachineScaleSetsClient, vmssvms *mock_compute.MockVirtualMachineScaleSetVMsClient, cleaner *mock_vmsscleaner.MockInterface) {
+				vmssvms.EXPECT().List(gomock.Any(), rpRGName, vmssName, "", "", "").Return([]mgmtcompute.VirtualMachineScaleSetVM{{InstanceID: to.StringPtr("0")}}, nil)
+				runCommand(vmssvms, curlScript("GET", "https://localhost:444/healthz/ready"), "500").MinTimes(1)
+				vmss.EXPECT().List(gomock.Any(), gatewayRGName).Return([]mgmtcompute.VirtualMachineScaleSet{{Name: to.StringPtr(gatewayVMSSPrefix + "new")}}, nil)
+				vmssvms.EXPECT().List(gomock.Any(), gatewayRGName, gatewayVMSSPrefix+"new", "", "", "").Return([]mgmtcompute.VirtualMachineScaleSetVM{{InstanceID: to.StringPtr("0")}}, nil)
+				vmssvms.EXPECT().GetInstanceView(gomock.Any(), gatewayRGName, gatewayVMSSPrefix+"new", "0").Return(unhealthyInstanceView, nil)
+				cleaner.EXPECT().RemoveFailedNewScaleset(gomock.Any(), rpRGName, vmssName).Return(false)
+			},
+			wantErr:    "health gates failed for scaleset " + vmssName,
+			wantReport: true,
+			wantGates: []rpHealthGateResult{
+				{Gate: rpHealthGatePortalReady, Instance: "0", Message: "unexpected status code 500"},
+				{Gate: rpHealthGateGatewayReady, Message: "unhealthy gateway instances: " + gatewayVMSSPrefix + "new/0"},
+			},
+			wantRolledBack: true,
+		},
+		{
+			name:  "frontend handler failures and refused authentication fail the gates",
+			gates: []string{rpHealthGateFrontendOperations, rpHealthGateFrontendPreflight},
+			mocks: func(vmss *mock_compute.MockVirtualMachineScaleSetsClient, vmssvms *mock_compute.MockVirtualMachineScaleSetVMsClient, cleaner *mock_vmsscleaner.MockInterface) {
+				vmssvms.EXPECT().List(gomock.Any(), rpRGName, vmssName, "", "", "").Return([]mgmtcompute.VirtualMachineScaleSetVM{{InstanceID: to.StringPtr("0")}}, nil)
+				runCommand(vmssvms, frontendCurlScript("GET", "https://localhost/providers/Microsoft.RedHatOpenShift/operations?api-version="+rpHealthGateAPIVersion), "500").MinTimes(1)
+				runCommand(vmssvms, frontendCurlScript("POST", "https://localhost/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/"+rpRGName+"/providers/Microsoft.RedHatOpenShift/deployments/healthgate/preflight?api-version="+rpHealthGateAPIVersion), "403").MinTimes(1)
+				cleaner.EXPECT().RemoveFailedNewScaleset(gomock.Any(), rpRGName, vmssName).Return(false)
+			},
+			wantErr:    "health gates failed for scaleset " + vmssName,
+			wantReport: true,
+			wantGates: []rpHealthGateResult{
+				{Gate: rpHealthGateFrontendOperations, Instance: "0", Message: "unexpected status code 500"},
+				{Gate: rpHealthGateFrontendPreflight, Instance: "0", Message: "unexpected status code 403"},
+			},
+			wantRolledBack: true,
+		},
+		{
+			name:  "listing the new instances fails",
+			gates: []string{rpHealthGateMonitorReady},
+			mocks: func(vmss *mock_compute.MockVirtualMachineScaleSetsClient, vmssvms *mock_compute.MockVirtualMachineScaleSetVMsClient, cleaner *mock_vmsscleaner.MockInterface) {
+				vmssvms.EXPECT().List(gomock.Any(), rpRGName, vmssName, "", "", "").Return(nil, errors.New("random error"))
+				cleaner.EXPECT().RemoveFailedNewScaleset(gomock.Any(), rpRGName, vmssName).Return(false)
+			},
+			wantErr:        "random error",
+			wantReport:     true,
+			wantRolledBack: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			vmss := mock_compute.NewMockVirtualMachineScaleSetsClient(controller)
+			vmssvms := mock_compute.NewMockVirtualMachineScaleSetVMsClient(controller)
+			cleaner := mock_vmsscleaner.NewMockInterface(controller)
+			if tt.mocks != nil {
+				tt.mocks(vmss, vmssvms, cleaner)
+			}
+
+			reportPath := filepath.Join(t.TempDir(), "report.json")
+
+			d := &deployer{
+				log:         logrus.NewEntry(logrus.StandardLogger()),
+				vmss:        vmss,
+				vmssvms:     vmssvms,
+				vmssCleaner: cleaner,
+				config: &RPConfig{
+					Location:                 "location",
+					SubscriptionID:           "00000000-0000-0000-0000-000000000000",
+					RPResourceGroupName:      rpRGName,
+					GatewayResourceGroupName: gatewayRGName,
+					Configuration: &Configuration{
+						RPHealthGates:          tt.gates,
+						RPHealthGateReportPath: &reportPath,
+					},
+				},
+				version:            "new",
+				now:                func() time.Time { return time.Unix(0, 0) },
+				healthGateTimeout:  10 * time.Millisecond,
+				healthGateInterval: time.Millisecond,
+			}
+
+			err := d.rpCheckHealthGates(ctx, vmssName)
+			utilerror.AssertErrorMessage(t, err, tt.wantErr)
+
+			b, err := os.ReadFile(reportPath)
+			if !tt.wantReport {
+				if !os.IsNotExist(err) {
+					t.Fatalf("unexpected report: %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var report *rpDeploymentReport
+			err = json.Unmarshal(b, &report)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if report.Scaleset != vmssName || report.Version != "new" || report.Location != "location" {
+				t.Errorf("unexpected report %#v", report)
+			}
+			if !reflect.DeepEqual(report.Gates, tt.wantGates) {
+				t.Errorf("got gates %#v, wanted %#v", report.Gates, tt.wantGates)
+			}
+			if report.RolledBack != tt.wantRolledBack {
+				t.Errorf("got rolledBack %v, wanted %v", report.RolledBack, tt.wantRolledBack)
+			}
+		})
+	}
+}
+
+func TestCheckRPMetrics(t *testing.T) {
+	for _, tt := range []struct {
+		name           string
+		output         string
+		maxErrors      int
+		minDequeueRate int
+		wantErr        string
+	}{
+		{
+			name:   "healthy",
+			output: "12 1 3600\n",
+		},
+		{
+			name:      "too many errors",
+			output:    "12 11 3600",
+			maxErrors: 10,
+			wantErr:   "rp logged 11 errors, more than 10",
+		},
+		{
+			name:           "dequeue rate too low",
+			output:         "5 0 1800",
+			minDequeueRate: 20,
+			wantErr:        "rp dequeue rate 10/h, less than 20/h",
+		},
+		{
+			name:    "unexpected output",
+			output:  "command not found",
+			wantErr: `unexpected output "command not found"`,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			maxErrors := tt.maxErrors
+			if maxErrors == 0 {
+				maxErrors = rpHealthGateDefaultMaxErrors
+			}
+
+			err := checkRPMetrics(tt.output, maxErrors, tt.minDequeueRate)
+			utilerror.AssertErrorMessage(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestRunCommandStdout(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		output string
+		want   string
+	}{
+		{
+			name:   "stdout and stderr",
+			output: "Enable succeeded: \n[stdout]\n12 1 3600\n\n[stderr]\nwarning\n",
+			want:   "12 1 3600\n\n",
+		},
+		{
+			name:   "empty stdout",
+			output: "Enable succeeded: \n[stdout]\n\n[stderr]\n",
+			want:   "\n",
+		},
+		{
+			name:   "no stdout section",
+			output: "403",
+			want:   "403",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got := runCommandStdout(tt.output)
+			if got != tt.want {
+				t.Errorf("got %q, wanted %q", got, tt.want)
+			}
+		})
+	}
+}
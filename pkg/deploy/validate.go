@@ -0,0 +1,497 @@
+package deploy
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/ghodss/yaml"
+
+	"github.com/Azure/ARO-RP/pkg/deploy/assets"
+	"github.com/Azure/ARO-RP/pkg/deploy/generator"
+	"github.com/Azure/ARO-RP/pkg/util/arm"
+	"github.com/Azure/ARO-RP/pkg/util/azureclient/mgmt/features"
+	"github.com/Azure/ARO-RP/pkg/util/azureerrors"
+)
+
+// deployerParameters are the production template parameters which the
+// deployer sets itself rather than taking from the configuration
+var deployerParameters = map[string]struct{}{
+	"dbtokenUrl":                {},
+	"deployNSGs":                {},
+	"gatewayResourceGroupName":  {},
+	"gatewayServicePrincipalId": {},
+	"ipRules":                   {},
+	"location":                  {},
+	"rpImage":                   {},
+	"rpResourceGroupName":       {},
+	"rpServicePrincipalId":      {},
+	"vmssName":                  {},
+}
+
+// productionTemplates are the templates whose parameters are taken from the
+// configuration
+var productionTemplates = []string{
+	generator.FileRPProductionGlobal,
+	generator.FileRPProductionGlobalACRReplication,
+	generator.FileRPProductionPredeploy,
+	generator.FileRPProduction,
+	generator.FileGatewayProductionPredeploy,
+	generator.FileGatewayProduction,
+}
+
+// ConfigValidation is the result of validating a config file
+type ConfigValidation struct {
+	// UnknownKeys are keys which don't correspond to any config field
+	UnknownKeys []string
+	// IgnoredKeys are keys whose values are never used: null values, and
+	// shared configuration keys which every location overrides
+	IgnoredKeys []string
+	// Errors are the problems found with the merged configuration of each
+	// location
+	Errors map[string][]string
+}
+
+// Valid returns true if the config file can be deployed
+func (v *ConfigValidation) Valid() bool {
+	return len(v.UnknownKeys) == 0 && len(v.Errors) == 0
+}
+
+// ValidateConfig type-checks the config file at path, flags its unknown and
+// ignored keys and validates the merged configuration of every location
+// against the production templates
+func ValidateConfig(path string) (*ConfigValidation, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw map[string]interface{}
+	err = json.Unmarshal(b, &raw)
+	if err != nil {
+		return nil, err
+	}
+
+	var config *Config
+	err = json.Unmarshal(b, &config)
+	if err != nil {
+		return nil, err
+	}
+
+	v := &ConfigValidation{
+		UnknownKeys: unknownKeys(raw, reflect.TypeOf(Config{}), ""),
+		IgnoredKeys: ignoredKeys(raw),
+		Errors:      map[string][]string{},
+	}
+
+	locations := map[string]struct{}{}
+	for i, rp := range config.RPs {
+		location := rp.Location
+		if location == "" {
+			location = fmt.Sprintf("rps[%d]", i)
+		}
+
+		var errs []string
+		if _, found := locations[location]; found {
+			// GetConfig only ever uses the first entry for a location
+			location = fmt.Sprintf("%s (rps[%d])", location, i)
+			errs = append(errs, "location is listed more than once")
+		}
+		locations[location] = struct{}{}
+
+		errs = append(errs, validateLocation(rp, config.Configuration)...)
+		sort.Strings(errs)
+		if len(errs) > 0 {
+			v.Errors[location] = errs
+		}
+	}
+
+	return v, nil
+}
+
+// validateLocation validates the merged configuration of a location
+func validateLocation(rp RPConfig, shared *Configuration) (errs []string) {
+	if rp.Location != strings.ToLower(rp.Location) {
+		errs = append(errs, fmt.Sprintf("location %s must be lower case", rp.Location))
+	}
+	for name, value := range map[string]string{
+		"location":                 rp.Location,
+		"subscriptionId":           rp.SubscriptionID,
+		"rpResourceGroupName":      rp.RPResourceGroupName,
+		"gatewayResourceGroupName": rp.GatewayResourceGroupName,
+	} {
+		if value == "" {
+			errs = append(errs, fmt.Sprintf("%s is not set", name))
+		}
+	}
+
+	if rp.Configuration == nil {
+		rp.Configuration = &Configuration{}
+	}
+	if shared == nil {
+		shared = &Configuration{}
+	}
+
+	configuration, err := mergeConfig(rp.Configuration, shared)
+	if err != nil {
+		return append(errs, err.Error())
+	}
+	rp.Configuration = configuration
+
+	if rp.Configuration.SSHPublicKey == nil {
+		// avoid generating a key for each location
+		rp.Configuration.SSHPublicKey = new(string)
+	}
+
+	err = rp.validate()
+	if err != nil {
+		errs = append(errs, err.Error())
+	}
+
+	d := &deployer{
+		config: &rp,
+	}
+
+	for _, file := range productionTemplates {
+		templateParameters, err := readTemplate(file)
+		if err != nil {
+			return append(errs, err.Error())
+		}
+
+		errs = append(errs, validateParameters(file, templateParameters, d.getParameters(templateParameters))...)
+	}
+
+	return errs
+}
+
+// validateParameters checks that the parameters set from the configuration
+// have the types declared by the template, and that no parameter without a
+// default value is left unset
+func validateParameters(file string, templateParameters map[string]interface{}, parameters *arm.Parameters) (errs []string) {
+	for name, p := range templateParameters {
+		wantType, _ := p.(map[string]interface{})["type"].(string)
+		_, hasDefault := p.(map[string]interface{})["defaultValue"]
+
+		parameter, found := parameters.Parameters[name]
+		if !found {
+			if _, found := deployerParameters[name]; !found && !hasDefault {
+				errs = append(errs, fmt.Sprintf("%s: parameter %s is not set", file, name))
+			}
+			continue
+		}
+
+		value, err := normalise(parameter.Value)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: parameter %s: %v", file, name, err))
+			continue
+		}
+
+		if !hasType(value, wantType) {
+			errs = append(errs, fmt.Sprintf("%s: parameter %s should be of type %s", file, name, wantType))
+		}
+	}
+
+	return errs
+}
+
+func hasType(value interface{}, typ string) bool {
+	switch strings.ToLower(typ) {
+	case "string", "securestring":
+		_, ok := value.(string)
+		return ok
+	case "int":
+		f, ok := value.(float64)
+		return ok && f == float64(int64(f))
+	case "bool":
+		_, ok := value.(bool)
+		return ok
+	case "array":
+		_, ok := value.([]interface{})
+		return ok
+	case "object", "secureobject":
+		_, ok := value.(map[string]interface{})
+		return ok
+	}
+	return false
+}
+
+// unknownKeys returns the keys in value which don't correspond to a json
+// field of typ
+func unknownKeys(value interface{}, typ reflect.Type, path string) (keys []string) {
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	switch typ.Kind() {
+	case reflect.Struct:
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+
+		fields := map[string]reflect.Type{}
+		for i := 0; i < typ.NumField(); i++ {
+			fields[strings.SplitN(typ.Field(i).Tag.Get("json"), ",", 2)[0]] = typ.Field(i).Type
+		}
+
+		for key, v := range m {
+			fieldPath := key
+			if path != "" {
+				fieldPath = path + "." + key
+			}
+
+			fieldType, found := fields[key]
+			if !found {
+				keys = append(keys, fieldPath)
+				continue
+			}
+
+			keys = append(keys, unknownKeys(v, fieldType, fieldPath)...)
+		}
+
+	case reflect.Slice:
+		s, ok := value.([]interface{})
+		if !ok {
+			return nil
+		}
+
+		for i, v := range s {
+			keys = append(keys, unknownKeys(v, typ.Elem(), fmt.Sprintf("%s[%d]", path, i))...)
+		}
+	}
+
+	sort.Strings(keys)
+	return keys
+}
+
+// ignoredKeys returns the configuration keys whose values are never used.
+// mergeConfig treats null values as unset, and shared values are only used by
+// locations which don't set them.
+func ignoredKeys(raw map[string]interface{}) (keys []string) {
+	shared, _ := raw["configuration"].(map[string]interface{})
+	rps, _ := raw["rps"].([]interface{})
+
+	overrides := map[string]int{}
+	for i, rp := range rps {
+		rp, _ := rp.(map[string]interface{})
+		configuration, _ := rp["configuration"].(map[string]interface{})
+		for key, value := range configuration {
+			if value == nil {
+				keys = append(keys, fmt.Sprintf("rps[%d].configuration.%s", i, key))
+				continue
+			}
+			overrides[key]++
+		}
+	}
+
+	for key, value := range shared {
+		if value == nil || (len(rps) > 0 && overrides[key] == len(rps)) {
+			keys = append(keys, "configuration."+key)
+		}
+	}
+
+	sort.Strings(keys)
+	return keys
+}
+
+// ParameterDiff is a difference between the parameters of a deployment and
+// the parameters the configuration would deploy
+type ParameterDiff struct {
+	Deployment string
+	Parameter  string
+	Deployed   interface{}
+	Effective  interface{}
+}
+
+func (diff *ParameterDiff) String() string {
+	deployed, _ := json.Marshal(diff.Deployed)
+	effective, _ := json.Marshal(diff.Effective)
+	return fmt.Sprintf("%s: %s: %s -> %s", diff.Deployment, diff.Parameter, string(deployed), string(effective))
+}
+
+// DiffParameters compares the parameters the configuration would deploy
+// against the parameters of the deployments currently in place.  Parameters
+// which depend on the version being deployed or on managed identities are not
+// compared, and neither are secure parameters, whose values ARM doesn't
+// return.
+func (d *deployer) DiffParameters(ctx context.Context) ([]*ParameterDiff, error) {
+	type deployment struct {
+		client        features.DeploymentsClient
+		resourceGroup string
+		name          string
+		file          string
+		parameters    func(map[string]interface{}) *arm.Parameters
+	}
+
+	getParameters := func(template map[string]interface{}) *arm.Parameters {
+		return d.getParameters(template["parameters"].(map[string]interface{}))
+	}
+
+	deployments := []deployment{
+		{d.globaldeployments, *d.config.Configuration.GlobalResourceGroupName, "rp-global-" + d.config.Location, generator.FileRPProductionGlobal, getParameters},
+		{d.deployments, d.config.RPResourceGroupName, strings.TrimSuffix(generator.FileRPProductionPredeploy, ".json"), generator.FileRPProductionPredeploy, d.preDeployParameters},
+		{d.deployments, d.config.GatewayResourceGroupName, strings.TrimSuffix(generator.FileGatewayProductionPredeploy, ".json"), generator.FileGatewayProductionPredeploy, d.preDeployParameters},
+	}
+
+	rpVersions, err := d.deployedVersions(ctx, d.config.RPResourceGroupName, rpVMSSPrefix)
+	if err != nil {
+		return nil, err
+	}
+	for _, version := range rpVersions {
+		deployments = append(deployments, deployment{d.deployments, d.config.RPResourceGroupName, "rp-production-" + version, generator.FileRPProduction, d.rpProductionParameters})
+	}
+
+	gatewayVersions, err := d.deployedVersions(ctx, d.config.GatewayResourceGroupName, gatewayVMSSPrefix)
+	if err != nil {
+		return nil, err
+	}
+	for _, version := range gatewayVersions {
+		deployments = append(deployments, deployment{d.deployments, d.config.GatewayResourceGroupName, "gateway-production-" + version, generator.FileGatewayProduction, d.gatewayProductionParameters})
+	}
+
+	var diffs []*ParameterDiff
+	for _, dep := range deployments {
+		deployed, err := dep.client.Get(ctx, dep.resourceGroup, dep.name)
+		if azureerrors.IsNotFoundError(err) {
+			d.log.Printf("deployment %s not found", dep.name)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		templateParameters, err := readTemplate(dep.file)
+		if err != nil {
+			return nil, err
+		}
+
+		var deployedParameters map[string]interface{}
+		if deployed.Properties != nil {
+			deployedParameters, _ = deployed.Properties.Parameters.(map[string]interface{})
+		}
+
+		deploymentDiffs, err := diffParameters(dep.name, templateParameters, deployedParameters, dep.parameters(map[string]interface{}{"parameters": templateParameters}))
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, deploymentDiffs...)
+	}
+
+	return diffs, nil
+}
+
+// deployedVersions returns the versions of the scalesets with prefix in
+// resourceGroup
+func (d *deployer) deployedVersions(ctx context.Context, resourceGroup, prefix string) ([]string, error) {
+	scalesets, err := d.vmss.List(ctx, resourceGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	var versions []string
+	for _, vmss := range scalesets {
+		if strings.HasPrefix(*vmss.Name, prefix) {
+			versions = append(versions, strings.TrimPrefix(*vmss.Name, prefix))
+		}
+	}
+	sort.Strings(versions)
+
+	return versions, nil
+}
+
+func diffParameters(deploymentName string, templateParameters, deployed map[string]interface{}, effective *arm.Parameters) ([]*ParameterDiff, error) {
+	// ARM parameter names are case insensitive
+	effectiveValues := map[string]interface{}{}
+	for name, p := range effective.Parameters {
+		effectiveValues[strings.ToLower(name)] = p.Value
+	}
+	deployedValues := map[string]map[string]interface{}{}
+	for name, p := range deployed {
+		deployedValues[strings.ToLower(name)], _ = p.(map[string]interface{})
+	}
+
+	var names []string
+	for name := range templateParameters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var diffs []*ParameterDiff
+	for _, name := range names {
+		templateParameter, _ := templateParameters[name].(map[string]interface{})
+		typ, _ := templateParameter["type"].(string)
+		if strings.HasPrefix(strings.ToLower(typ), "secure") {
+			continue
+		}
+
+		effectiveValue, found := effectiveValues[strings.ToLower(name)]
+		if !found {
+			if _, found := deployerParameters[name]; found {
+				continue
+			}
+			effectiveValue = templateParameter["defaultValue"]
+		}
+		effectiveValue, err := normalise(effectiveValue)
+		if err != nil {
+			return nil, err
+		}
+
+		var deployedValue interface{}
+		if p, found := deployedValues[strings.ToLower(name)]; found {
+			deployedValue = p["value"]
+		}
+
+		if !reflect.DeepEqual(deployedValue, effectiveValue) {
+			diffs = append(diffs, &ParameterDiff{
+				Deployment: deploymentName,
+				Parameter:  name,
+				Deployed:   deployedValue,
+				Effective:  effectiveValue,
+			})
+		}
+	}
+
+	return diffs, nil
+}
+
+// normalise round-trips value through JSON so that it can be compared with
+// values returned by ARM
+func normalise(value interface{}) (interface{}, error) {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	var v interface{}
+	err = json.Unmarshal(b, &v)
+	return v, err
+}
+
+// readTemplate returns the parameters of the template file
+func readTemplate(file string) (map[string]interface{}, error) {
+	asset, err := assets.EmbeddedFiles.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	var template map[string]interface{}
+	err = json.Unmarshal(asset, &template)
+	if err != nil {
+		return nil, err
+	}
+
+	parameters, _ := template["parameters"].(map[string]interface{})
+	return parameters, nil
+}
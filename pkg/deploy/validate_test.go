@@ -0,0 +1,309 @@
+package deploy
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	mgmtcompute "github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-06-01/compute"
+	mgmtfeatures "github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-07-01/features"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/ghodss/yaml"
+	"github.com/golang/mock/gomock"
+	"github.com/sirupsen/logrus"
+
+	mock_compute "github.com/Azure/ARO-RP/pkg/util/mocks/azureclient/mgmt/compute"
+	mock_features "github.com/Azure/ARO-RP/pkg/util/mocks/azureclient/mgmt/features"
+	utilerror "github.com/Azure/ARO-RP/test/util/error"
+)
+
+// validateTestConfiguration returns a shared configuration with all its
+// required fields set
+func validateTestConfiguration() map[string]interface{} {
+	configuration := map[string]interface{}{}
+
+	typ := reflect.TypeOf(Configuration{})
+	for i := 0; i < typ.NumField(); i++ {
+		if typ.Field(i).Tag.Get("value") != "required" {
+			continue
+		}
+
+		name := typ.Field(i).Tag.Get("json")
+		name = name[:len(name)-len(",omitempty")]
+
+		switch typ.Field(i).Type.Kind() {
+		case reflect.Slice:
+			configuration[name] = []interface{}{}
+		default:
+			configuration[name] = "value"
+		}
+	}
+
+	// optional in the configuration, but required by the templates
+	configuration["azureSecPackQualysUrl"] = ""
+	configuration["azureSecPackVSATenantId"] = ""
+
+	return configuration
+}
+
+func writeValidateTestConfig(t *testing.T, config map[string]interface{}) string {
+	b, err := yaml.Marshal(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	err = os.WriteFile(path, b, 0666)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return path
+}
+
+func validateTestRP(location string, configuration map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"location":                 location,
+		"subscriptionId":           "00000000-0000-0000-0000-000000000000",
+		"rpResourceGroupName":      "rp-" + location,
+		"gatewayResourceGroupName": "gwy-" + location,
+		"configuration":            configuration,
+	}
+}
+
+func TestValidateConfig(t *testing.T) {
+	for _, tt := range []struct {
+		name            string
+		config          func() map[string]interface{}
+		wantUnknownKeys []string
+		wantIgnoredKeys []string
+		wantErrors      map[string][]string
+		wantErr         string
+	}{
+		{
+			name: "valid",
+			config: func() map[string]interface{} {
+				return map[string]interface{}{
+					"configuration": validateTestConfiguration(),
+					"rps": []interface{}{
+						validateTestRP("eastus", map[string]interface{}{
+							"rpVmssCapacity": 3,
+						}),
+						validateTestRP("westus", nil),
+					},
+				}
+			},
+			wantErrors: map[string][]string{},
+		},
+		{
+			name: "unknown, ignored and invalid keys",
+			config: func() map[string]interface{} {
+				configuration := validateTestConfiguration()
+				configuration["rpVmssCapcity"] = 3
+				configuration["vmSize"] = "Standard_D2s_v3"
+				configuration["rpHealthGates"] = []string{"unknown"}
+				delete(configuration, "keyvaultPrefix")
+
+				return map[string]interface{}{
+					"configuration": configuration,
+					"rps": []interface{}{
+						validateTestRP("eastus", map[string]interface{}{
+							"vmSize": "Standard_D4s_v3",
+							"cosmosDB": map[string]interface{}{
+								"standardProvisionedThroughput": 1000,
+								"portalThroughput":              400,
+							},
+						}),
+						validateTestRP("WestUS", map[string]interface{}{
+							"vmSize":         "Standard_D4s_v3",
+							"fpClientId":     nil,
+							"keyvaultPrefix": "prefix",
+						}),
+						validateTestRP("WestUS", map[string]interface{}{
+							"vmSize":         "Standard_D4s_v3",
+							"keyvaultPrefix": "prefix",
+						}),
+					},
+					"unknown": true,
+				}
+			},
+			wantUnknownKeys: []string{
+				"configuration.rpVmssCapcity",
+				"rps[0].configuration.cosmosDB.portalThroughput",
+				"unknown",
+			},
+			wantIgnoredKeys: []string{
+				"configuration.vmSize",
+				"rps[1].configuration.fpClientId",
+			},
+			wantErrors: map[string][]string{
+				"eastus": {
+					"configuration has missing fields: KeyvaultPrefix",
+					"gateway-production-predeploy.json: parameter keyvaultPrefix is not set",
+					"gateway-production.json: parameter keyvaultPrefix is not set",
+					"rp-production-predeploy.json: parameter keyvaultPrefix is not set",
+					"rp-production.json: parameter keyvaultPrefix is not set",
+				},
+				"WestUS": {
+					`configuration has unknown health gate "unknown"`,
+					"location WestUS must be lower case",
+				},
+				"WestUS (rps[2])": {
+					`configuration has unknown health gate "unknown"`,
+					"location WestUS must be lower case",
+					"location is listed more than once",
+				},
+			},
+		},
+		{
+			name: "type error",
+			config: func() map[string]interface{} {
+				return map[string]interface{}{
+					"configuration": validateTestConfiguration(),
+					"rps": []interface{}{
+						validateTestRP("eastus", map[string]interface{}{
+							"rpVmssCapacity": "3",
+						}),
+					},
+				}
+			},
+			wantErr: "json: cannot unmarshal string into Go struct field .rps.0.configuration.rpVmssCapacity of type int",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			path := writeValidateTestConfig(t, tt.config())
+
+			v, err := ValidateConfig(path)
+			utilerror.AssertErrorMessage(t, err, tt.wantErr)
+			if err != nil {
+				return
+			}
+
+			if !reflect.DeepEqual(v.UnknownKeys, tt.wantUnknownKeys) {
+				t.Errorf("got unknown keys %v, wanted %v", v.UnknownKeys, tt.wantUnknownKeys)
+			}
+			if !reflect.DeepEqual(v.IgnoredKeys, tt.wantIgnoredKeys) {
+				t.Errorf("got ignored keys %v, wanted %v", v.IgnoredKeys, tt.wantIgnoredKeys)
+			}
+			if !reflect.DeepEqual(v.Errors, tt.wantErrors) {
+				t.Errorf("got errors %q, wanted %q", v.Errors, tt.wantErrors)
+			}
+			if v.Valid() != (len(tt.wantUnknownKeys) == 0 && len(tt.wantErrors) == 0) {
+				t.Errorf("got valid %v", v.Valid())
+			}
+		})
+	}
+}
+
+func TestDiffParameters(t *testing.T) {
+	ctx := context.Background()
+
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	globaldeployments := mock_features.NewMockDeploymentsClient(controller)
+	deployments := mock_features.NewMockDeploymentsClient(controller)
+	vmss := mock_compute.NewMockVirtualMachineScaleSetsClient(controller)
+
+	deployedParameters := func(parameters map[string]interface{}) mgmtfeatures.DeploymentExtended {
+		p := map[string]interface{}{}
+		for name, value := range parameters {
+			p[name] = map[string]interface{}{
+				"type":  "String",
+				"value": value,
+			}
+		}
+
+		// ARM returns parameters as decoded JSON
+		b, err := json.Marshal(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var decoded interface{}
+		err = json.Unmarshal(b, &decoded)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return mgmtfeatures.DeploymentExtended{
+			Properties: &mgmtfeatures.DeploymentPropertiesExtended{
+				Parameters: decoded,
+			},
+		}
+	}
+
+	vmss.EXPECT().List(gomock.Any(), "rp-eastus").Return([]mgmtcompute.VirtualMachineScaleSet{{Name: to.StringPtr("rp-lb")}}, nil)
+	vmss.EXPECT().List(gomock.Any(), "gwy-eastus").Return(nil, nil)
+
+	globaldeployments.EXPECT().Get(gomock.Any(), "global", "rp-global-eastus").Return(deployedParameters(map[string]interface{}{
+		"acrLocationOverride":         "",
+		"acrResourceId":               "acr",
+		"clusterParentDomainName":     "old.example.com",
+		"fpServicePrincipalId":        "fp",
+		"gatewayServicePrincipalId":   "gateway",
+		"rpParentDomainName":          "rp.example.com",
+		"rpServicePrincipalId":        "rp",
+		"rpVersionStorageAccountName": "rpversion",
+	}), nil)
+	deployments.EXPECT().Get(gomock.Any(), "rp-eastus", "rp-production-predeploy").Return(deployedParameters(map[string]interface{}{
+		"deployNSGs":                         true,
+		"extraClusterKeyvaultAccessPolicies": []interface{}{},
+		"extraDBTokenKeyvaultAccessPolicies": []interface{}{},
+		"extraPortalKeyvaultAccessPolicies":  []interface{}{},
+		"extraServiceKeyvaultAccessPolicies": []interface{}{},
+		"fpServicePrincipalId":               "fp",
+		"gatewayResourceGroupName":           "gwy-eastus",
+		"keyvaultPrefix":                     "kv",
+		"rpNsgPortalSourceAddressPrefixes":   []interface{}{},
+		"rpServicePrincipalId":               "rp",
+	}), nil)
+	deployments.EXPECT().Get(gomock.Any(), "gwy-eastus", "gateway-production-predeploy").Return(mgmtfeatures.DeploymentExtended{}, autorest.DetailedError{StatusCode: http.StatusNotFound})
+
+	d := &deployer{
+		log:               logrus.NewEntry(logrus.StandardLogger()),
+		globaldeployments: globaldeployments,
+		deployments:       deployments,
+		vmss:              vmss,
+		config: &RPConfig{
+			Location:                 "eastus",
+			RPResourceGroupName:      "rp-eastus",
+			GatewayResourceGroupName: "gwy-eastus",
+			Configuration: &Configuration{
+				ACRResourceID:                    to.StringPtr("acr"),
+				ClusterParentDomainName:          to.StringPtr("new.example.com"),
+				FPServicePrincipalID:             to.StringPtr("fp"),
+				GlobalResourceGroupName:          to.StringPtr("global"),
+				KeyvaultPrefix:                   to.StringPtr("kv"),
+				RPNSGPortalSourceAddressPrefixes: []string{"10.0.0.0/8"},
+				RPParentDomainName:               to.StringPtr("rp.example.com"),
+				RPVersionStorageAccountName:      to.StringPtr("rpversion"),
+			},
+		},
+	}
+
+	diffs, err := d.DiffParameters(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, diff := range diffs {
+		got = append(got, diff.String())
+	}
+
+	want := []string{
+		`rp-global-eastus: clusterParentDomainName: "old.example.com" -> "new.example.com"`,
+		`rp-production-predeploy: rpNsgPortalSourceAddressPrefixes: [] -> ["10.0.0.0/8"]`,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %q, wanted %q", got, want)
+	}
+}
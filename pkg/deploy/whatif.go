@@ -0,0 +1,286 @@
+package deploy
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	mgmtfeatures "github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-07-01/features"
+
+	"github.com/Azure/ARO-RP/pkg/deploy/generator"
+)
+
+// ChangeSummary categorises the changes a deployment would make to the
+// resources in its resource group
+type ChangeSummary struct {
+	Deployment string   `json:"deployment"`
+	Create     []string `json:"create,omitempty"`
+	Modify     []string `json:"modify,omitempty"`
+	Delete     []string `json:"delete,omitempty"`
+	// DeleteProperties lists the properties the deployment would remove from
+	// modified resources.  In Incremental mode, removing a child resource
+	// defined inline (e.g. a subnet or a security rule) shows up as a property
+	// deletion rather than as a resource deletion.
+	DeleteProperties []PropertyDelete `json:"deleteProperties,omitempty"`
+	Ignore           []string         `json:"ignore,omitempty"`
+	Unchanged        int              `json:"unchanged,omitempty"`
+}
+
+// PropertyDelete is a property the deployment would remove from a resource
+type PropertyDelete struct {
+	ResourceID string `json:"resourceId"`
+	Path       string `json:"path"`
+}
+
+func (p PropertyDelete) String() string {
+	return fmt.Sprintf("%s (%s)", p.ResourceID, p.Path)
+}
+
+func (s *ChangeSummary) String() string {
+	return fmt.Sprintf("%s: %d to create, %d to modify, %d to delete, %d properties to delete, %d ignored, %d unchanged",
+		s.Deployment, len(s.Create), len(s.Modify), len(s.Delete), len(s.DeleteProperties), len(s.Ignore), s.Unchanged)
+}
+
+// UnexpectedDeletes returns the resources and properties the deployment would
+// delete which match none of the allowed patterns.  Patterns are path.Match
+// patterns matched case-insensitively against resource IDs; a pattern matching
+// a resource also allows deleting its properties.
+func (s *ChangeSummary) UnexpectedDeletes(allowed []string) []string {
+	var unexpected []string
+
+	for _, id := range s.Delete {
+		if !deleteAllowed(allowed, id) {
+			unexpected = append(unexpected, id)
+		}
+	}
+
+	for _, p := range s.DeleteProperties {
+		if !deleteAllowed(allowed, p.ResourceID) {
+			unexpected = append(unexpected, p.String())
+		}
+	}
+
+	return unexpected
+}
+
+func deleteAllowed(allowed []string, id string) bool {
+	for _, pattern := range allowed {
+		if ok, _ := path.Match(strings.ToLower(pattern), strings.ToLower(id)); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// addPropertyDeletes records the property deletions in the given what-if
+// property changes, recursing into nested changes
+func (s *ChangeSummary) addPropertyDeletes(id, parent string, delta *[]mgmtfeatures.WhatIfPropertyChange) {
+	if delta == nil {
+		return
+	}
+
+	for _, change := range *delta {
+		p := propertyPath(parent, change.Path)
+
+		if change.PropertyChangeType == mgmtfeatures.PropertyChangeTypeDelete {
+			s.DeleteProperties = append(s.DeleteProperties, PropertyDelete{ResourceID: id, Path: p})
+			continue
+		}
+
+		s.addPropertyDeletes(id, p, change.Children)
+	}
+}
+
+// propertyPath returns the full path of a nested what-if property change.
+// Array elements are reported by index, relative to the array.
+func propertyPath(parent string, p *string) string {
+	if p == nil {
+		return parent
+	}
+
+	switch {
+	case parent == "":
+		return *p
+	case strings.Trim(*p, "0123456789") == "":
+		return parent + "[" + *p + "]"
+	default:
+		return parent + "." + *p
+	}
+}
+
+func newChangeSummary(deploymentName string, result *mgmtfeatures.WhatIfOperationResult) (*ChangeSummary, error) {
+	if result.Error != nil {
+		return nil, fmt.Errorf("what-if of deployment %s failed: %s: %s", deploymentName, *result.Error.Code, *result.Error.Message)
+	}
+
+	s := &ChangeSummary{
+		Deployment: deploymentName,
+	}
+
+	if result.WhatIfOperationProperties == nil || result.Changes == nil {
+		return s, nil
+	}
+
+	for _, change := range *result.Changes {
+		id := *change.ResourceID
+
+		switch change.ChangeType {
+		case mgmtfeatures.Create:
+			s.Create = append(s.Create, id)
+		case mgmtfeatures.Modify, mgmtfeatures.Deploy:
+			s.Modify = append(s.Modify, id)
+			s.addPropertyDeletes(id, "", change.Delta)
+		case mgmtfeatures.Delete:
+			s.Delete = append(s.Delete, id)
+		case mgmtfeatures.Ignore:
+			s.Ignore = append(s.Ignore, id)
+		case mgmtfeatures.NoChange:
+			s.Unchanged++
+		default:
+			return nil, fmt.Errorf("what-if of deployment %s returned unknown change type %q for %s", deploymentName, change.ChangeType, id)
+		}
+	}
+
+	return s, nil
+}
+
+// whatIf asks ARM which changes the deployment would make
+func (d *deployer) whatIf(ctx context.Context, rgName, deploymentName string, deployment mgmtfeatures.Deployment) (*ChangeSummary, error) {
+	d.log.Printf("previewing %s", deploymentName)
+	result, err := d.deployments.WhatIfAndWait(ctx, rgName, deploymentName, mgmtfeatures.DeploymentWhatIf{
+		Properties: &mgmtfeatures.DeploymentWhatIfProperties{
+			Template:   deployment.Properties.Template,
+			Parameters: deployment.Properties.Parameters,
+			Mode:       deployment.Properties.Mode,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return newChangeSummary(deploymentName, &result)
+}
+
+// checkWhatIf previews the deployment if enabled and refuses to proceed if it
+// would delete resources or properties of resources which aren't explicitly
+// allowed to be deleted
+func (d *deployer) checkWhatIf(ctx context.Context, rgName, deploymentName string, deployment mgmtfeatures.Deployment) error {
+	if d.config.Configuration.WhatIfEnabled == nil || !*d.config.Configuration.WhatIfEnabled {
+		return nil
+	}
+
+	summary, err := d.whatIf(ctx, rgName, deploymentName, deployment)
+	if err != nil {
+		return err
+	}
+
+	d.log.Print(summary)
+
+	unexpected := summary.UnexpectedDeletes(d.config.Configuration.WhatIfAllowedDeletes)
+	if len(unexpected) > 0 {
+		return fmt.Errorf("deployment %s would delete %s; add them to whatIfAllowedDeletes to proceed", deploymentName, strings.Join(unexpected, ", "))
+	}
+
+	return nil
+}
+
+type previewDeployment struct {
+	resourceGroupName string
+	deploymentName    string
+	deployment        mgmtfeatures.Deployment
+}
+
+// previewDeployments returns the predeploy, rp-production and
+// gateway-production deployments of the current version, in deployment order
+func (d *deployer) previewDeployments(rpServicePrincipalID, gatewayServicePrincipalID string, isCreate bool) ([]*previewDeployment, error) {
+	gatewayPreDeploy, err := d.preDeployDeployment(generator.FileGatewayProductionPredeploy, "gatewayServicePrincipalId", gatewayServicePrincipalID, isCreate)
+	if err != nil {
+		return nil, err
+	}
+
+	rpPreDeploy, err := d.preDeployDeployment(generator.FileRPProductionPredeploy, "rpServicePrincipalId", rpServicePrincipalID, isCreate)
+	if err != nil {
+		return nil, err
+	}
+
+	rp, err := d.rpProductionDeployment(rpServicePrincipalID, gatewayServicePrincipalID)
+	if err != nil {
+		return nil, err
+	}
+
+	gateway, err := d.gatewayProductionDeployment(rpServicePrincipalID, gatewayServicePrincipalID)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*previewDeployment{
+		{
+			resourceGroupName: d.config.GatewayResourceGroupName,
+			deploymentName:    strings.TrimSuffix(generator.FileGatewayProductionPredeploy, ".json"),
+			deployment:        gatewayPreDeploy,
+		},
+		{
+			resourceGroupName: d.config.RPResourceGroupName,
+			deploymentName:    strings.TrimSuffix(generator.FileRPProductionPredeploy, ".json"),
+			deployment:        rpPreDeploy,
+		},
+		{
+			resourceGroupName: d.config.RPResourceGroupName,
+			deploymentName:    "rp-production-" + d.version,
+			deployment:        rp,
+		},
+		{
+			resourceGroupName: d.config.GatewayResourceGroupName,
+			deploymentName:    "gateway-production-" + d.version,
+			deployment:        gateway,
+		},
+	}, nil
+}
+
+// Preview returns the changes the predeploy, rp-production and
+// gateway-production deployments of the current version would make, as
+// reported by ARM what-if
+func (d *deployer) Preview(ctx context.Context) ([]*ChangeSummary, error) {
+	rpMSI, err := d.userassignedidentities.Get(ctx, d.config.RPResourceGroupName, "aro-rp-"+d.config.Location)
+	if err != nil {
+		return nil, err
+	}
+
+	gwMSI, err := d.userassignedidentities.Get(ctx, d.config.GatewayResourceGroupName, "aro-gateway-"+d.config.Location)
+	if err != nil {
+		return nil, err
+	}
+
+	// same as PreDeploy
+	isCreate := false
+	_, err = d.deployments.Get(ctx, d.config.GatewayResourceGroupName, strings.TrimSuffix(generator.FileGatewayProductionPredeploy, ".json"))
+	if isDeploymentNotFoundError(err) {
+		isCreate = true
+		err = nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	deployments, err := d.previewDeployments(rpMSI.PrincipalID.String(), gwMSI.PrincipalID.String(), isCreate)
+	if err != nil {
+		return nil, err
+	}
+
+	summaries := make([]*ChangeSummary, 0, len(deployments))
+	for _, deployment := range deployments {
+		summary, err := d.whatIf(ctx, deployment.resourceGroupName, deployment.deploymentName, deployment.deployment)
+		if err != nil {
+			return nil, err
+		}
+
+		summaries = append(summaries, summary)
+	}
+
+	return summaries, nil
+}
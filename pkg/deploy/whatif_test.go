@@ -0,0 +1,195 @@
+package deploy
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	mgmtfeatures "github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-07-01/features"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/golang/mock/gomock"
+	"github.com/sirupsen/logrus"
+
+	mock_features "github.com/Azure/ARO-RP/pkg/util/mocks/azureclient/mgmt/features"
+	utilerror "github.com/Azure/ARO-RP/test/util/error"
+)
+
+func TestCheckWhatIf(t *testing.T) {
+	ctx := context.Background()
+
+	const (
+		rgName         = "rp-rg"
+		deploymentName = "rp-production-version"
+		vmssID         = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rp-rg/providers/Microsoft.Compute/virtualMachineScaleSets/rp-vmss-old"
+		nsgID          = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rp-rg/providers/Microsoft.Network/networkSecurityGroups/rp-nsg"
+		vnetID         = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rp-rg/providers/Microsoft.Network/virtualNetworks/rp-vnet"
+	)
+
+	// removing an inline subnet in Incremental mode modifies the vnet
+	removeSubnet := mgmtfeatures.WhatIfChange{
+		ResourceID: to.StringPtr(vnetID),
+		ChangeType: mgmtfeatures.Modify,
+		Delta: &[]mgmtfeatures.WhatIfPropertyChange{
+			{
+				Path:               to.StringPtr("properties.addressSpace.addressPrefixes"),
+				PropertyChangeType: mgmtfeatures.PropertyChangeTypeModify,
+			},
+			{
+				Path:               to.StringPtr("properties.subnets"),
+				PropertyChangeType: mgmtfeatures.PropertyChangeTypeArray,
+				Children: &[]mgmtfeatures.WhatIfPropertyChange{
+					{
+						Path:               to.StringPtr("0"),
+						PropertyChangeType: mgmtfeatures.PropertyChangeTypeModify,
+					},
+					{
+						Path:               to.StringPtr("1"),
+						PropertyChangeType: mgmtfeatures.PropertyChangeTypeDelete,
+					},
+				},
+			},
+		},
+	}
+
+	deployment := mgmtfeatures.Deployment{
+		Properties: &mgmtfeatures.DeploymentProperties{
+			Template:   map[string]interface{}{},
+			Mode:       mgmtfeatures.Incremental,
+			Parameters: map[string]interface{}{},
+		},
+	}
+
+	result := func(changes ...mgmtfeatures.WhatIfChange) mgmtfeatures.WhatIfOperationResult {
+		return mgmtfeatures.WhatIfOperationResult{
+			WhatIfOperationProperties: &mgmtfeatures.WhatIfOperationProperties{
+				Changes: &changes,
+			},
+		}
+	}
+
+	for _, tt := range []struct {
+		name           string
+		enabled        *bool
+		allowedDeletes []string
+		mocks          func(*mock_features.MockDeploymentsClient)
+		wantErr        string
+	}{
+		{
+			name: "disabled",
+		},
+		{
+			name:    "no deletes",
+			enabled: to.BoolPtr(true),
+			mocks: func(deployments *mock_features.MockDeploymentsClient) {
+				deployments.EXPECT().WhatIfAndWait(gomock.Any(), rgName, deploymentName, gomock.Any()).Return(result(
+					mgmtfeatures.WhatIfChange{ResourceID: to.StringPtr(vmssID), ChangeType: mgmtfeatures.Create},
+					mgmtfeatures.WhatIfChange{ResourceID: to.StringPtr(nsgID), ChangeType: mgmtfeatures.NoChange},
+				), nil)
+			},
+		},
+		{
+			name:           "allowed deletes",
+			enabled:        to.BoolPtr(true),
+			allowedDeletes: []string{"/subscriptions/*/resourceGroups/rp-rg/providers/Microsoft.Compute/virtualMachineScaleSets/rp-vmss-*"},
+			mocks: func(deployments *mock_features.MockDeploymentsClient) {
+				deployments.EXPECT().WhatIfAndWait(gomock.Any(), rgName, deploymentName, gomock.Any()).Return(result(
+					mgmtfeatures.WhatIfChange{ResourceID: to.StringPtr(vmssID), ChangeType: mgmtfeatures.Delete},
+				), nil)
+			},
+		},
+		{
+			name:           "unexpected deletes",
+			enabled:        to.BoolPtr(true),
+			allowedDeletes: []string{"/subscriptions/*/resourceGroups/rp-rg/providers/Microsoft.Compute/virtualMachineScaleSets/rp-vmss-*"},
+			mocks: func(deployments *mock_features.MockDeploymentsClient) {
+				deployments.EXPECT().WhatIfAndWait(gomock.Any(), rgName, deploymentName, gomock.Any()).Return(result(
+					mgmtfeatures.WhatIfChange{ResourceID: to.StringPtr(vmssID), ChangeType: mgmtfeatures.Delete},
+					mgmtfeatures.WhatIfChange{ResourceID: to.StringPtr(nsgID), ChangeType: mgmtfeatures.Delete},
+				), nil)
+			},
+			wantErr: "deployment " + deploymentName + " would delete " + nsgID + "; add them to whatIfAllowedDeletes to proceed",
+		},
+		{
+			name:    "modify without property deletes",
+			enabled: to.BoolPtr(true),
+			mocks: func(deployments *mock_features.MockDeploymentsClient) {
+				deployments.EXPECT().WhatIfAndWait(gomock.Any(), rgName, deploymentName, gomock.Any()).Return(result(
+					mgmtfeatures.WhatIfChange{
+						ResourceID: to.StringPtr(nsgID),
+						ChangeType: mgmtfeatures.Modify,
+						Delta: &[]mgmtfeatures.WhatIfPropertyChange{
+							{
+								Path:               to.StringPtr("properties.securityRules"),
+								PropertyChangeType: mgmtfeatures.PropertyChangeTypeCreate,
+							},
+						},
+					},
+				), nil)
+			},
+		},
+		{
+			name:           "allowed property deletes",
+			enabled:        to.BoolPtr(true),
+			allowedDeletes: []string{"/subscriptions/*/resourceGroups/rp-rg/providers/Microsoft.Network/virtualNetworks/rp-vnet"},
+			mocks: func(deployments *mock_features.MockDeploymentsClient) {
+				deployments.EXPECT().WhatIfAndWait(gomock.Any(), rgName, deploymentName, gomock.Any()).Return(result(removeSubnet), nil)
+			},
+		},
+		{
+			name:    "unexpected property deletes",
+			enabled: to.BoolPtr(true),
+			mocks: func(deployments *mock_features.MockDeploymentsClient) {
+				deployments.EXPECT().WhatIfAndWait(gomock.Any(), rgName, deploymentName, gomock.Any()).Return(result(removeSubnet), nil)
+			},
+			wantErr: "deployment " + deploymentName + " would delete " + vnetID + " (properties.subnets[1]); add them to whatIfAllowedDeletes to proceed",
+		},
+		{
+			name:    "what-if fails",
+			enabled: to.BoolPtr(true),
+			mocks: func(deployments *mock_features.MockDeploymentsClient) {
+				deployments.EXPECT().WhatIfAndWait(gomock.Any(), rgName, deploymentName, gomock.Any()).Return(mgmtfeatures.WhatIfOperationResult{
+					Error: &mgmtfeatures.ErrorResponse{
+						Code:    to.StringPtr("InvalidTemplate"),
+						Message: to.StringPtr("bad template"),
+					},
+				}, nil)
+			},
+			wantErr: "what-if of deployment " + deploymentName + " failed: InvalidTemplate: bad template",
+		},
+		{
+			name:    "what-if errors",
+			enabled: to.BoolPtr(true),
+			mocks: func(deployments *mock_features.MockDeploymentsClient) {
+				deployments.EXPECT().WhatIfAndWait(gomock.Any(), rgName, deploymentName, gomock.Any()).Return(mgmtfeatures.WhatIfOperationResult{}, errors.New("random error"))
+			},
+			wantErr: "random error",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			deployments := mock_features.NewMockDeploymentsClient(controller)
+			if tt.mocks != nil {
+				tt.mocks(deployments)
+			}
+
+			d := &deployer{
+				log:         logrus.NewEntry(logrus.StandardLogger()),
+				deployments: deployments,
+				config: &RPConfig{
+					Configuration: &Configuration{
+						WhatIfEnabled:        tt.enabled,
+						WhatIfAllowedDeletes: tt.allowedDeletes,
+					},
+				},
+			}
+
+			err := d.checkWhatIf(ctx, rgName, deploymentName, deployment)
+			utilerror.AssertErrorMessage(t, err, tt.wantErr)
+		})
+	}
+}
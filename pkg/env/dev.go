@@ -58,6 +58,7 @@ func newDev(ctx context.Context, log *logrus.Entry, component ServiceComponent)
 func (d *dev) InitializeAuthorizers() error {
 	d.armClientAuthorizer = clientauthorizer.NewAll()
 	d.adminClientAuthorizer = clientauthorizer.NewAll()
+	d.healthGateClientAuthorizer = clientauthorizer.NewAll()
 	return nil
 }
 
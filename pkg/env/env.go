@@ -37,26 +37,27 @@ const (
 )
 
 const (
-	RPDevARMSecretName               = "dev-arm"
-	RPFirstPartySecretName           = "rp-firstparty"
-	RPServerSecretName               = "rp-server"
-	ClusterLoggingSecretName         = "cluster-mdsd"
-	EncryptionSecretName             = "encryption-key"
-	EncryptionSecretV2Name           = "encryption-key-v2"
-	FrontendEncryptionSecretName     = "fe-encryption-key"
-	FrontendEncryptionSecretV2Name   = "fe-encryption-key-v2"
-	DBTokenServerSecretName          = "dbtoken-server"
-	PortalServerSecretName           = "portal-server"
-	PortalServerClientSecretName     = "portal-client"
-	PortalServerSessionKeySecretName = "portal-session-key"
-	PortalServerSSHKeySecretName     = "portal-sshkey"
-	ClusterKeyvaultSuffix            = "-cls"
-	DBTokenKeyvaultSuffix            = "-dbt"
-	GatewayKeyvaultSuffix            = "-gwy"
-	PortalKeyvaultSuffix             = "-por"
-	ServiceKeyvaultSuffix            = "-svc"
-	RPPrivateEndpointPrefix          = "rp-pe-"
-	ProxyHostName                    = "PROXY_HOSTNAME"
+	RPDevARMSecretName                 = "dev-arm"
+	RPFirstPartySecretName             = "rp-firstparty"
+	RPServerSecretName                 = "rp-server"
+	ClusterLoggingSecretName           = "cluster-mdsd"
+	EncryptionSecretName               = "encryption-key"
+	EncryptionSecretV2Name             = "encryption-key-v2"
+	FrontendEncryptionSecretName       = "fe-encryption-key"
+	FrontendEncryptionSecretV2Name     = "fe-encryption-key-v2"
+	DBTokenServerSecretName            = "dbtoken-server"
+	PortalServerSecretName             = "portal-server"
+	PortalServerClientSecretName       = "portal-client"
+	PortalServerSessionKeySecretName   = "portal-session-key"
+	PortalServerSSHKeySecretName       = "portal-sshkey"
+	OpenShiftVersionsSigningSecretName = "openshift-versions-signing-key"
+	ClusterKeyvaultSuffix              = "-cls"
+	DBTokenKeyvaultSuffix              = "-dbt"
+	GatewayKeyvaultSuffix              = "-gwy"
+	PortalKeyvaultSuffix               = "-por"
+	ServiceKeyvaultSuffix              = "-svc"
+	RPPrivateEndpointPrefix            = "rp-pe-"
+	ProxyHostName                      = "PROXY_HOSTNAME"
 )
 
 // Interface is clunky and somewhat legacy and only used in the RP codebase (not
@@ -75,6 +76,7 @@ type Interface interface {
 	InitializeAuthorizers() error
 	ArmClientAuthorizer() clientauthorizer.ClientAuthorizer
 	AdminClientAuthorizer() clientauthorizer.ClientAuthorizer
+	HealthGateClientAuthorizer() clientauthorizer.ClientAuthorizer
 	ClusterGenevaLoggingAccount() string
 	ClusterGenevaLoggingConfigVersion() string
 	ClusterGenevaLoggingEnvironment() string
@@ -41,8 +41,9 @@ type prod struct {
 
 	liveConfig liveconfig.Manager
 
-	armClientAuthorizer   clientauthorizer.ClientAuthorizer
-	adminClientAuthorizer clientauthorizer.ClientAuthorizer
+	armClientAuthorizer        clientauthorizer.ClientAuthorizer
+	adminClientAuthorizer      clientauthorizer.ClientAuthorizer
+	healthGateClientAuthorizer clientauthorizer.ClientAuthorizer
 
 	acrDomain string
 	vmskus    map[string]*mgmtcompute.ResourceSku
@@ -242,6 +243,20 @@ func (p *prod) InitializeAuthorizers() error {
 	}
 
 	p.adminClientAuthorizer = adminClientAuthorizer
+
+	// the health gate client certificate is self-signed and generated on each
+	// RP VM at boot; the deployer's health gates present it to the local
+	// frontend
+	healthGateClientAuthorizer, err := clientauthorizer.NewSubjectNameAndIssuer(
+		p.log,
+		"/etc/aro-rp/healthgate-client.crt",
+		"healthgate",
+	)
+	if err != nil {
+		return err
+	}
+
+	p.healthGateClientAuthorizer = healthGateClientAuthorizer
 	return nil
 }
 
@@ -253,6 +268,10 @@ func (p *prod) AdminClientAuthorizer() clientauthorizer.ClientAuthorizer {
 	return p.adminClientAuthorizer
 }
 
+func (p *prod) HealthGateClientAuthorizer() clientauthorizer.ClientAuthorizer {
+	return p.healthGateClientAuthorizer
+}
+
 func (p *prod) ACRResourceID() string {
 	return os.Getenv("ACR_RESOURCE_ID")
 }
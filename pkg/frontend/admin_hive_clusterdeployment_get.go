@@ -32,7 +32,7 @@ func (f *frontend) getAdminHiveClusterDeployment(w http.ResponseWriter, r *http.
 
 func (f *frontend) _getAdminHiveClusterDeployment(ctx context.Context, resourceID string) ([]byte, error) {
 	// we have to check if the frontend has a valid clustermanager since hive is not everywhere.
-	if f.hiveClusterManager == nil {
+	if f.hiveShardManager == nil {
 		return nil, api.NewCloudError(http.StatusInternalServerError, api.CloudErrorCodeInternalServerError, "", "hive is not enabled")
 	}
 
@@ -45,7 +45,12 @@ func (f *frontend) _getAdminHiveClusterDeployment(ctx context.Context, resourceI
 		return nil, api.NewCloudError(http.StatusNoContent, api.CloudErrorCodeResourceNotFound, "", "cluster is not managed by hive")
 	}
 
-	cd, err := f.hiveClusterManager.GetClusterDeployment(ctx, doc)
+	hr, err := f.hiveShardManager.ForCluster(ctx, doc)
+	if err != nil {
+		return nil, api.NewCloudError(http.StatusInternalServerError, api.CloudErrorCodeInternalServerError, "", err.Error())
+	}
+
+	cd, err := hr.GetClusterDeployment(ctx, doc)
 	if err != nil {
 		return nil, api.NewCloudError(http.StatusNotFound, api.CloudErrorCodeNotFound, "", "cluster deployment not found")
 	}
@@ -532,10 +532,11 @@ func TestAdminEtcdCertificateRenew(t *testing.T) {
 				ti.log,
 				ti.env,
 				ti.asyncOperationsDatabase,
-				ti.clusterManagerDatabase,
+				ti.clusterManagerDatabase, ti.gatewayDatabase,
 				ti.openShiftClustersDatabase,
 				ti.subscriptionsDatabase,
 				nil,
+				nil,
 				api.APIs,
 				&noop.Noop{},
 				&noop.Noop{},
@@ -749,10 +750,11 @@ func TestAdminEtcdCertificateRecovery(t *testing.T) {
 				ti.log,
 				ti.env,
 				ti.asyncOperationsDatabase,
-				ti.clusterManagerDatabase,
+				ti.clusterManagerDatabase, ti.gatewayDatabase,
 				ti.openShiftClustersDatabase,
 				ti.subscriptionsDatabase,
 				nil,
+				nil,
 				api.APIs,
 				&noop.Noop{},
 				&noop.Noop{},
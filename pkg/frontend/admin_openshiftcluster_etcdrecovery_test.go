@@ -163,10 +163,11 @@ func TestAdminEtcdRecovery(t *testing.T) {
 				ti.log,
 				ti.env,
 				ti.asyncOperationsDatabase,
-				ti.clusterManagerDatabase,
+				ti.clusterManagerDatabase, ti.gatewayDatabase,
 				ti.openShiftClustersDatabase,
 				ti.subscriptionsDatabase,
 				nil,
+				nil,
 				api.APIs,
 				&noop.Noop{},
 				&noop.Noop{},
@@ -0,0 +1,119 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/api/admin"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+	"github.com/Azure/ARO-RP/pkg/frontend/middleware"
+)
+
+func (f *frontend) getAdminOpenShiftClusterGatewayAllowList(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := ctx.Value(middleware.ContextKeyLog).(*logrus.Entry)
+	r.URL.Path = filepath.Dir(r.URL.Path)
+
+	b, err := f._getAdminOpenShiftClusterGatewayAllowList(ctx, r)
+
+	adminReply(log, w, nil, b, err)
+}
+
+func (f *frontend) _getAdminOpenShiftClusterGatewayAllowList(ctx context.Context, r *http.Request) ([]byte, error) {
+	converter := f.apis[admin.APIVersion].GatewayAllowListConverter
+
+	linkID, err := f.gatewayLinkID(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err := f.dbGateway.Get(ctx, linkID)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.MarshalIndent(converter.ToExternal(doc.Gateway), "", "    ")
+}
+
+// putAdminOpenShiftClusterGatewayAllowList replaces the cluster's gateway
+// allow list.  The gateway picks up the change through its change feed.
+func (f *frontend) putAdminOpenShiftClusterGatewayAllowList(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := ctx.Value(middleware.ContextKeyLog).(*logrus.Entry)
+	r.URL.Path = filepath.Dir(r.URL.Path)
+
+	body := r.Context().Value(middleware.ContextKeyBody).([]byte)
+	if len(body) == 0 || !json.Valid(body) {
+		api.WriteError(w, http.StatusBadRequest, api.CloudErrorCodeInvalidRequestContent, "", "The request content was invalid and could not be deserialized.")
+		return
+	}
+
+	var ext *admin.GatewayAllowList
+	err := json.Unmarshal(body, &ext)
+	if err != nil {
+		api.WriteError(w, http.StatusBadRequest, api.CloudErrorCodeInvalidRequestContent, "", "The request content could not be deserialized: "+err.Error())
+		return
+	}
+
+	b, err := f._putAdminOpenShiftClusterGatewayAllowList(ctx, log, r, ext)
+
+	adminReply(log, w, nil, b, err)
+}
+
+func (f *frontend) _putAdminOpenShiftClusterGatewayAllowList(ctx context.Context, log *logrus.Entry, r *http.Request, ext *admin.GatewayAllowList) ([]byte, error) {
+	converter := f.apis[admin.APIVersion].GatewayAllowListConverter
+	staticValidator := f.apis[admin.APIVersion].GatewayAllowListStaticValidator
+
+	err := staticValidator.Static(ext)
+	if err != nil {
+		return nil, err
+	}
+
+	linkID, err := f.gatewayLinkID(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err := f.dbGateway.Patch(ctx, linkID, func(doc *api.GatewayDocument) error {
+		converter.ToInternal(ext, doc.Gateway)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("gateway allow list updated to %d entries", len(doc.Gateway.AllowList))
+
+	return json.MarshalIndent(converter.ToExternal(doc.Gateway), "", "    ")
+}
+
+// gatewayLinkID returns the ID of the gateway record of a gateway-enabled
+// cluster
+func (f *frontend) gatewayLinkID(ctx context.Context, r *http.Request) (string, error) {
+	resourceID := strings.TrimPrefix(r.URL.Path, "/admin")
+
+	doc, err := f.dbOpenShiftClusters.Get(ctx, resourceID)
+	switch {
+	case cosmosdb.IsErrorStatusCode(err, http.StatusNotFound):
+		return "", api.NewCloudError(http.StatusNotFound, api.CloudErrorCodeResourceNotFound, "", "The Resource '%s/%s' under resource group '%s' was not found.", chi.URLParam(r, "resourceType"), chi.URLParam(r, "resourceName"), chi.URLParam(r, "resourceGroupName"))
+	case err != nil:
+		return "", err
+	}
+
+	if !doc.OpenShiftCluster.Properties.FeatureProfile.GatewayEnabled ||
+		doc.OpenShiftCluster.Properties.NetworkProfile.GatewayPrivateLinkID == "" {
+		return "", api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeRequestNotAllowed, "", "The cluster is not gateway-enabled.")
+	}
+
+	return doc.OpenShiftCluster.Properties.NetworkProfile.GatewayPrivateLinkID, nil
+}
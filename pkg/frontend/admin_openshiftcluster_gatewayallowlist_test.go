@@ -0,0 +1,184 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/api/admin"
+	"github.com/Azure/ARO-RP/pkg/metrics/noop"
+	"github.com/Azure/ARO-RP/pkg/util/cmp"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+)
+
+func TestAdminGatewayAllowList(t *testing.T) {
+	mockSubID := "00000000-0000-0000-0000-000000000000"
+	resourceID := testdatabase.GetResourcePath(mockSubID, "resourceName")
+	linkID := "123456"
+
+	ctx := context.Background()
+
+	type test struct {
+		name           string
+		method         string
+		gatewayEnabled bool
+		allowList      []api.GatewayAllowListEntry
+		body           *admin.GatewayAllowList
+		wantAllowList  []api.GatewayAllowListEntry
+		wantStatusCode int
+		wantResponse   *admin.GatewayAllowList
+		wantError      string
+	}
+
+	for _, tt := range []*test{
+		{
+			name:           "get allow list",
+			method:         http.MethodGet,
+			gatewayEnabled: true,
+			allowList: []api.GatewayAllowListEntry{
+				{Host: "api.example.com"},
+				{Host: "registry.example.net", Port: 5000},
+			},
+			wantAllowList: []api.GatewayAllowListEntry{
+				{Host: "api.example.com"},
+				{Host: "registry.example.net", Port: 5000},
+			},
+			wantStatusCode: http.StatusOK,
+			wantResponse: &admin.GatewayAllowList{
+				Entries: []admin.GatewayAllowListEntry{
+					{Host: "api.example.com"},
+					{Host: "registry.example.net", Port: 5000},
+				},
+			},
+		},
+		{
+			name:           "get empty allow list",
+			method:         http.MethodGet,
+			gatewayEnabled: true,
+			wantStatusCode: http.StatusOK,
+			wantResponse: &admin.GatewayAllowList{
+				Entries: []admin.GatewayAllowListEntry{},
+			},
+		},
+		{
+			name:           "put replaces allow list",
+			method:         http.MethodPut,
+			gatewayEnabled: true,
+			allowList: []api.GatewayAllowListEntry{
+				{Host: "api.example.com"},
+			},
+			body: &admin.GatewayAllowList{
+				Entries: []admin.GatewayAllowListEntry{
+					{Host: "*.Example.org"},
+				},
+			},
+			wantAllowList: []api.GatewayAllowListEntry{
+				{Host: "*.example.org"},
+			},
+			wantStatusCode: http.StatusOK,
+			wantResponse: &admin.GatewayAllowList{
+				Entries: []admin.GatewayAllowListEntry{
+					{Host: "*.example.org"},
+				},
+			},
+		},
+		{
+			name:           "put invalid allow list",
+			method:         http.MethodPut,
+			gatewayEnabled: true,
+			allowList: []api.GatewayAllowListEntry{
+				{Host: "api.example.com"},
+			},
+			body: &admin.GatewayAllowList{
+				Entries: []admin.GatewayAllowListEntry{
+					{Host: "*.org"},
+				},
+			},
+			wantAllowList: []api.GatewayAllowListEntry{
+				{Host: "api.example.com"},
+			},
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      "400: InvalidParameter: entries[0].host: The provided host '*.org' is invalid: it must be a host name or a '*.'-prefixed domain with at least two labels.",
+		},
+		{
+			name:           "cluster is not gateway-enabled",
+			method:         http.MethodPut,
+			body:           &admin.GatewayAllowList{},
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      "400: RequestNotAllowed: : The cluster is not gateway-enabled.",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ti := newTestInfra(t).WithOpenShiftClusters().WithGateway()
+			defer ti.done()
+
+			err := ti.buildFixtures(func(f *testdatabase.Fixture) {
+				doc := &api.OpenShiftClusterDocument{
+					Key: strings.ToLower(resourceID),
+					OpenShiftCluster: &api.OpenShiftCluster{
+						ID: resourceID,
+						Properties: api.OpenShiftClusterProperties{
+							FeatureProfile: api.FeatureProfile{
+								GatewayEnabled: tt.gatewayEnabled,
+							},
+						},
+					},
+				}
+				if tt.gatewayEnabled {
+					doc.OpenShiftCluster.Properties.NetworkProfile.GatewayPrivateLinkID = linkID
+
+					f.AddGatewayDocuments(&api.GatewayDocument{
+						ID: linkID,
+						Gateway: &api.Gateway{
+							ID:        resourceID,
+							AllowList: tt.allowList,
+						},
+					})
+				}
+				f.AddOpenShiftClusterDocuments(doc)
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			f, err := NewFrontend(ctx, ti.audit, ti.log, ti.env, ti.asyncOperationsDatabase, ti.clusterManagerDatabase, ti.gatewayDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, nil, nil, api.APIs, &noop.Noop{}, &noop.Noop{}, nil, nil, nil, nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			go f.Run(ctx, nil, nil)
+
+			resp, b, err := ti.request(tt.method,
+				fmt.Sprintf("https://server/admin%s/gatewayallowlist", resourceID),
+				http.Header{
+					"Content-Type": []string{"application/json"},
+				}, tt.body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = validateResponse(resp, b, tt.wantStatusCode, tt.wantError, tt.wantResponse)
+			if err != nil {
+				t.Error(err)
+			}
+
+			if tt.gatewayEnabled {
+				doc, err := ti.gatewayDatabase.Get(ctx, linkID)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if !reflect.DeepEqual(doc.Gateway.AllowList, tt.wantAllowList) {
+					t.Error(cmp.Diff(doc.Gateway.AllowList, tt.wantAllowList))
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+	"github.com/Azure/ARO-RP/pkg/frontend/middleware"
+	"github.com/Azure/ARO-RP/pkg/hive"
+)
+
+var errHiveShardUnchanged = errors.New("cluster is already on the requested Hive shard")
+
+func (f *frontend) postAdminOpenShiftClusterHiveShard(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := ctx.Value(middleware.ContextKeyLog).(*logrus.Entry)
+	r.URL.Path = filepath.Dir(r.URL.Path)
+
+	err := f._postAdminOpenShiftClusterHiveShard(ctx, log, r)
+
+	adminReply(log, w, nil, nil, err)
+}
+
+// _postAdminOpenShiftClusterHiveShard starts a HiveShardMove admin update,
+// which moves a cluster's ClusterDeployment and related resources to another
+// Hive shard under the backend lease. The ClusterDeployment is created with
+// PreserveOnDelete set, so removing it from the old shard does not
+// deprovision the cluster.
+func (f *frontend) _postAdminOpenShiftClusterHiveShard(ctx context.Context, log *logrus.Entry, r *http.Request) error {
+	if f.hiveShardManager == nil {
+		return api.NewCloudError(http.StatusInternalServerError, api.CloudErrorCodeInternalServerError, "", "hive is not enabled")
+	}
+
+	resourceID := strings.TrimPrefix(r.URL.Path, "/admin")
+
+	shardCount, err := f.hiveShardManager.ShardCount(ctx)
+	if err != nil {
+		return err
+	}
+
+	shard, err := strconv.Atoi(r.URL.Query().Get("shard"))
+	if err != nil || shard < 1 || shard > shardCount {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "", "The provided shard '%s' is invalid.", r.URL.Query().Get("shard"))
+	}
+
+	doc, err := f.dbOpenShiftClusters.Get(ctx, resourceID)
+	switch {
+	case cosmosdb.IsErrorStatusCode(err, http.StatusNotFound):
+		return api.NewCloudError(http.StatusNotFound, api.CloudErrorCodeResourceNotFound, "", "The Resource '%s/%s' under resource group '%s' was not found.", chi.URLParam(r, "resourceType"), chi.URLParam(r, "resourceName"), chi.URLParam(r, "resourceGroupName"))
+	case err != nil:
+		return err
+	}
+
+	if doc.OpenShiftCluster.Properties.HiveProfile.Namespace == "" {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeRequestNotAllowed, "", "cluster is not managed by hive")
+	}
+
+	// the backend may have picked the cluster up since it was read, so the
+	// state is checked in the patch, which reruns on a conflicting update
+	_, err = f.dbOpenShiftClusters.Patch(ctx, doc.Key, func(doc *api.OpenShiftClusterDocument) error {
+		if doc.OpenShiftCluster.Properties.ProvisioningState != api.ProvisioningStateSucceeded {
+			return api.NewCloudError(http.StatusConflict, api.CloudErrorCodeRequestNotAllowed, "", "Request is not allowed in provisioningState '%s'.", doc.OpenShiftCluster.Properties.ProvisioningState)
+		}
+
+		if hive.ShardIndex(doc) == shard {
+			return errHiveShardUnchanged
+		}
+
+		log.Printf("moving cluster from Hive shard %d to %d", hive.ShardIndex(doc), shard)
+
+		doc.OpenShiftCluster.Properties.MaintenanceTask = api.MaintenanceTaskHiveShardMove
+		doc.OpenShiftCluster.Properties.HiveProfile.TargetShardIndex = shard
+		adminUpdateProvisioningState(doc)
+		return nil
+	})
+	if err == errHiveShardUnchanged {
+		return nil
+	}
+
+	return err
+}
@@ -0,0 +1,161 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/go-test/deep"
+	"github.com/golang/mock/gomock"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/metrics/noop"
+	mock_hive "github.com/Azure/ARO-RP/pkg/util/mocks/hive"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+)
+
+func TestAdminHiveShard(t *testing.T) {
+	mockSubID := "00000000-0000-0000-0000-000000000000"
+	mockTenantID := "00000000-0000-0000-0000-000000000000"
+	resourceID := testdatabase.GetResourcePath(mockSubID, "resourceName")
+
+	ctx := context.Background()
+
+	type test struct {
+		name              string
+		shard             string
+		provisioningState api.ProvisioningState
+		shardIndex        int
+		mocks             func(*mock_hive.MockShardManager)
+		wantDoc           func(*api.OpenShiftClusterDocument)
+		wantStatusCode    int
+		wantError         string
+	}
+
+	for _, tt := range []*test{
+		{
+			name:              "cluster is moved to another shard",
+			shard:             "2",
+			provisioningState: api.ProvisioningStateSucceeded,
+			mocks: func(sm *mock_hive.MockShardManager) {
+				sm.EXPECT().ShardCount(gomock.Any()).Return(2, nil)
+			},
+			wantDoc: func(doc *api.OpenShiftClusterDocument) {
+				doc.OpenShiftCluster.Properties.ProvisioningState = api.ProvisioningStateAdminUpdating
+				doc.OpenShiftCluster.Properties.LastProvisioningState = api.ProvisioningStateSucceeded
+				doc.OpenShiftCluster.Properties.MaintenanceTask = api.MaintenanceTaskHiveShardMove
+				doc.OpenShiftCluster.Properties.MaintenanceState = api.MaintenanceStateUnplanned
+				doc.OpenShiftCluster.Properties.HiveProfile.TargetShardIndex = 2
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:              "cluster already on requested shard",
+			shard:             "2",
+			shardIndex:        2,
+			provisioningState: api.ProvisioningStateSucceeded,
+			mocks: func(sm *mock_hive.MockShardManager) {
+				sm.EXPECT().ShardCount(gomock.Any()).Return(2, nil)
+			},
+			wantDoc:        func(doc *api.OpenShiftClusterDocument) {},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:              "shard out of range",
+			shard:             "3",
+			provisioningState: api.ProvisioningStateSucceeded,
+			mocks: func(sm *mock_hive.MockShardManager) {
+				sm.EXPECT().ShardCount(gomock.Any()).Return(2, nil)
+			},
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      "400: InvalidParameter: : The provided shard '3' is invalid.",
+		},
+		{
+			name:              "cluster is not in a terminal state",
+			shard:             "2",
+			provisioningState: api.ProvisioningStateUpdating,
+			mocks: func(sm *mock_hive.MockShardManager) {
+				sm.EXPECT().ShardCount(gomock.Any()).Return(2, nil)
+			},
+			wantStatusCode: http.StatusConflict,
+			wantError:      "409: RequestNotAllowed: : Request is not allowed in provisioningState 'Updating'.",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ti := newTestInfra(t).WithOpenShiftClusters().WithSubscriptions()
+			defer ti.done()
+
+			sm := mock_hive.NewMockShardManager(ti.controller)
+			tt.mocks(sm)
+
+			doc := &api.OpenShiftClusterDocument{
+				Key: strings.ToLower(resourceID),
+				OpenShiftCluster: &api.OpenShiftCluster{
+					ID: resourceID,
+					Properties: api.OpenShiftClusterProperties{
+						ProvisioningState: tt.provisioningState,
+						HiveProfile: api.HiveProfile{
+							Namespace:  "aro-00000000-0000-0000-0000-000000000000",
+							ShardIndex: tt.shardIndex,
+						},
+					},
+				},
+			}
+
+			err := ti.buildFixtures(func(f *testdatabase.Fixture) {
+				f.AddOpenShiftClusterDocuments(doc)
+
+				f.AddSubscriptionDocuments(&api.SubscriptionDocument{
+					ID: mockSubID,
+					Subscription: &api.Subscription{
+						State: api.SubscriptionStateRegistered,
+						Properties: &api.SubscriptionProperties{
+							TenantID: mockTenantID,
+						},
+					},
+				})
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			f, err := NewFrontend(ctx, ti.audit, ti.log, ti.env, ti.asyncOperationsDatabase, ti.clusterManagerDatabase, ti.gatewayDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, nil, nil, api.APIs, &noop.Noop{}, &noop.Noop{}, nil, sm, nil, nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			go f.Run(ctx, nil, nil)
+
+			resp, b, err := ti.request(http.MethodPost,
+				fmt.Sprintf("https://server/admin%s/hiveshard?shard=%s", resourceID, tt.shard),
+				nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = validateResponse(resp, b, tt.wantStatusCode, tt.wantError, nil)
+			if err != nil {
+				t.Error(err)
+			}
+
+			if tt.wantDoc != nil {
+				tt.wantDoc(doc)
+
+				got, err := ti.openShiftClustersDatabase.Get(ctx, strings.ToLower(resourceID))
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				got.ETag = ""
+				for _, diff := range deep.Equal(got, doc) {
+					t.Error(diff)
+				}
+			}
+		})
+	}
+}
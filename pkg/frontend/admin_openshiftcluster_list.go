@@ -5,11 +5,15 @@ package frontend
 
 import (
 	"net/http"
+	"net/url"
 	"path/filepath"
+	"strings"
 
 	"github.com/sirupsen/logrus"
 
+	"github.com/Azure/ARO-RP/pkg/api"
 	"github.com/Azure/ARO-RP/pkg/api/admin"
+	"github.com/Azure/ARO-RP/pkg/database"
 	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
 	"github.com/Azure/ARO-RP/pkg/frontend/middleware"
 )
@@ -19,9 +23,92 @@ func (f *frontend) getAdminOpenShiftClusters(w http.ResponseWriter, r *http.Requ
 	log := ctx.Value(middleware.ContextKeyLog).(*logrus.Entry)
 	r.URL.Path = filepath.Dir(r.URL.Path)
 
+	filter, err := parseAdminOpenShiftClusterFilter(r.URL.Query())
+	if err != nil {
+		adminReply(log, w, nil, nil, err)
+		return
+	}
+
+	// projected documents lack the credentials needed for enrichment
+	enrich := filter == nil || len(filter.Select) == 0
+
 	b, err := f._getOpenShiftClusters(ctx, log, r, f.apis[admin.APIVersion].OpenShiftClusterConverter, func(skipToken string) (cosmosdb.OpenShiftClusterDocumentIterator, error) {
-		return f.dbOpenShiftClusters.List(skipToken), nil
-	})
+		if filter == nil {
+			return f.dbOpenShiftClusters.List(skipToken), nil
+		}
+		return f.dbOpenShiftClusters.ListByFilter(filter, skipToken)
+	}, enrich)
 
 	adminReply(log, w, nil, b, err)
 }
+
+// parseAdminOpenShiftClusterFilter builds a filter from the admin listing's
+// query parameters:
+//
+//   - <field>=<value>[,<value>...] matches any of the given values
+//   - $orderby=<field> [asc|desc] sorts the results
+//   - $select=<field>[,<field>...] returns only the given fields
+//
+// It returns nil if none are set.
+func parseAdminOpenShiftClusterFilter(q url.Values) (*database.OpenShiftClusterFilter, error) {
+	filter := &database.OpenShiftClusterFilter{
+		Match: map[string][]string{},
+	}
+	var isSet bool
+
+	for key, values := range q {
+		field, ok := database.OpenShiftClusterFields[key]
+		if !ok {
+			continue
+		}
+		if !field.Filterable {
+			return nil, api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, key, "The field '%s' cannot be filtered on.", key)
+		}
+
+		for _, value := range values {
+			for _, v := range strings.Split(value, ",") {
+				if v = strings.TrimSpace(v); v != "" {
+					filter.Match[key] = append(filter.Match[key], v)
+				}
+			}
+		}
+		if len(filter.Match[key]) == 0 {
+			return nil, api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, key, "The field '%s' must be given at least one value.", key)
+		}
+		isSet = true
+	}
+
+	if orderBy := q.Get("$orderby"); orderBy != "" {
+		parts := strings.Fields(orderBy)
+		if len(parts) > 2 || (len(parts) == 2 && !strings.EqualFold(parts[1], "asc") && !strings.EqualFold(parts[1], "desc")) {
+			return nil, api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "$orderby", "The provided $orderby '%s' is invalid.", orderBy)
+		}
+		if !database.OpenShiftClusterFields[parts[0]].Sortable {
+			return nil, api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "$orderby", "The field '%s' cannot be sorted on.", parts[0])
+		}
+		if filter.SubscriptionID() == "" {
+			return nil, api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "$orderby", "Sorting requires filtering on a single subscriptionId.")
+		}
+
+		filter.OrderBy = parts[0]
+		filter.Descending = len(parts) == 2 && strings.EqualFold(parts[1], "desc")
+		isSet = true
+	}
+
+	if sel := q.Get("$select"); sel != "" {
+		for _, name := range strings.Split(sel, ",") {
+			name = strings.TrimSpace(name)
+			if _, ok := database.OpenShiftClusterFields[name]; !ok {
+				return nil, api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "$select", "The field '%s' cannot be selected.", name)
+			}
+			filter.Select = append(filter.Select, name)
+		}
+		isSet = true
+	}
+
+	if !isSet {
+		return nil, nil
+	}
+
+	return filter, nil
+}
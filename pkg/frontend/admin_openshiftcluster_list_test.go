@@ -7,14 +7,19 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"path/filepath"
 	"reflect"
 	"strings"
 	"testing"
 
+	"github.com/golang/mock/gomock"
+
 	"github.com/Azure/ARO-RP/pkg/api"
 	"github.com/Azure/ARO-RP/pkg/api/admin"
+	"github.com/Azure/ARO-RP/pkg/database"
 	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
 	"github.com/Azure/ARO-RP/pkg/metrics/noop"
+	mock_clusterdata "github.com/Azure/ARO-RP/pkg/util/mocks/clusterdata"
 	testdatabase "github.com/Azure/ARO-RP/test/database"
 )
 
@@ -118,7 +123,7 @@ func TestAdminListOpenShiftCluster(t *testing.T) {
 				ti.openShiftClustersClient.SetError(tt.throwsError)
 			}
 
-			f, err := NewFrontend(ctx, ti.audit, ti.log, ti.env, ti.asyncOperationsDatabase, ti.clusterManagerDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, nil, api.APIs, &noop.Noop{}, &noop.Noop{}, aead, nil, nil, nil, ti.enricher)
+			f, err := NewFrontend(ctx, ti.audit, ti.log, ti.env, ti.asyncOperationsDatabase, ti.clusterManagerDatabase, ti.gatewayDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, nil, nil, api.APIs, &noop.Noop{}, &noop.Noop{}, aead, nil, nil, nil, ti.enricher)
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -164,3 +169,169 @@ func TestAdminListOpenShiftCluster(t *testing.T) {
 		})
 	}
 }
+
+func TestAdminListOpenShiftClusterFiltered(t *testing.T) {
+	ctx := context.Background()
+
+	mockSubID := "00000000-0000-0000-0000-000000000000"
+	otherMockSubID := "00000000-0000-0000-0000-000000000001"
+
+	type test struct {
+		name           string
+		query          string
+		wantEnrich     bool
+		wantStatusCode int
+		wantResponse   *admin.OpenShiftClusterList
+		wantError      string
+	}
+
+	for _, tt := range []*test{
+		{
+			name:           "filter by version and location",
+			query:          "version=4.12.25,4.13.0&location=eastus",
+			wantEnrich:     true,
+			wantStatusCode: http.StatusOK,
+			wantResponse: &admin.OpenShiftClusterList{
+				OpenShiftClusters: []*admin.OpenShiftCluster{
+					{
+						ID:       testdatabase.GetResourcePath(mockSubID, "resourceName1"),
+						Name:     "resourceName1",
+						Type:     "Microsoft.RedHatOpenShift/openShiftClusters",
+						Location: "eastus",
+						Properties: admin.OpenShiftClusterProperties{
+							ProvisioningState: admin.ProvisioningStateSucceeded,
+							ClusterProfile: admin.ClusterProfile{
+								Version: "4.12.25",
+								Domain:  "domain1",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:           "sort and select within a subscription",
+			query:          "subscriptionId=" + mockSubID + "&$orderby=version%20desc&$select=version",
+			wantStatusCode: http.StatusOK,
+			wantResponse: &admin.OpenShiftClusterList{
+				OpenShiftClusters: []*admin.OpenShiftCluster{
+					{
+						ID:   testdatabase.GetResourcePath(mockSubID, "resourceName2"),
+						Name: "resourceName2",
+						Type: "Microsoft.RedHatOpenShift/openShiftClusters",
+						Properties: admin.OpenShiftClusterProperties{
+							ClusterProfile: admin.ClusterProfile{
+								Version: "4.13.0",
+							},
+						},
+					},
+					{
+						ID:   testdatabase.GetResourcePath(mockSubID, "resourceName1"),
+						Name: "resourceName1",
+						Type: "Microsoft.RedHatOpenShift/openShiftClusters",
+						Properties: admin.OpenShiftClusterProperties{
+							ClusterProfile: admin.ClusterProfile{
+								Version: "4.12.25",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:           "field cannot be filtered on",
+			query:          "domain=domain1",
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      "400: InvalidParameter: domain: The field 'domain' cannot be filtered on.",
+		},
+		{
+			name:           "secure fields cannot be selected",
+			query:          "$select=version,pullSecret",
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      "400: InvalidParameter: $select: The field 'pullSecret' cannot be selected.",
+		},
+		{
+			name:           "sorting across subscriptions",
+			query:          "$orderby=name",
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      "400: InvalidParameter: $orderby: Sorting requires filtering on a single subscriptionId.",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ti := newTestInfra(t)
+			defer ti.done()
+
+			dbc, err := database.NewEmbeddedDatabaseClient(ti.log, filepath.Join(t.TempDir(), "aro.db"), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			dbOpenShiftClusters, err := database.NewOpenShiftClusters(ctx, dbc, "ARO")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, oc := range []struct {
+				subscriptionID string
+				name           string
+				location       string
+				version        string
+			}{
+				{mockSubID, "resourceName1", "eastus", "4.12.25"},
+				{mockSubID, "resourceName2", "westus", "4.13.0"},
+				{otherMockSubID, "resourceName3", "eastus", "4.11.44"},
+			} {
+				_, err = dbOpenShiftClusters.Create(ctx, &api.OpenShiftClusterDocument{
+					ID:  dbOpenShiftClusters.NewUUID(),
+					Key: strings.ToLower(testdatabase.GetResourcePath(oc.subscriptionID, oc.name)),
+					OpenShiftCluster: &api.OpenShiftCluster{
+						ID:       testdatabase.GetResourcePath(oc.subscriptionID, oc.name),
+						Name:     oc.name,
+						Type:     "Microsoft.RedHatOpenShift/openShiftClusters",
+						Location: oc.location,
+						Properties: api.OpenShiftClusterProperties{
+							ProvisioningState: api.ProvisioningStateSucceeded,
+							ClusterProfile: api.ClusterProfile{
+								PullSecret: "{}",
+								Domain:     "domain" + oc.name[len(oc.name)-1:],
+								Version:    oc.version,
+							},
+							ServicePrincipalProfile: api.ServicePrincipalProfile{
+								ClientSecret: "clientSecret",
+							},
+						},
+					},
+				})
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			enricher := mock_clusterdata.NewMockBestEffortEnricher(ti.controller)
+			if tt.wantEnrich {
+				enricher.EXPECT().Enrich(gomock.Any(), gomock.Any(), gomock.Any())
+			}
+
+			f, err := NewFrontend(ctx, ti.audit, ti.log, ti.env, ti.asyncOperationsDatabase, ti.clusterManagerDatabase, ti.gatewayDatabase, dbOpenShiftClusters, ti.subscriptionsDatabase, nil, nil, api.APIs, &noop.Noop{}, &noop.Noop{}, testdatabase.NewFakeAEAD(), nil, nil, nil, enricher)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			go f.Run(ctx, nil, nil)
+
+			resp, b, err := ti.request(http.MethodGet,
+				"https://server/admin/providers/Microsoft.RedHatOpenShift/openShiftClusters?"+tt.query,
+				http.Header{
+					"Referer": []string{"https://mockrefererhost/"},
+				}, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = validateResponse(resp, b, tt.wantStatusCode, tt.wantError, tt.wantResponse)
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/api/validate"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+	"github.com/Azure/ARO-RP/pkg/frontend/middleware"
+	"github.com/Azure/ARO-RP/pkg/util/version"
+)
+
+func (f *frontend) postAdminOpenShiftClusterUpgrade(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := ctx.Value(middleware.ContextKeyLog).(*logrus.Entry)
+	r.URL.Path = filepath.Dir(r.URL.Path)
+
+	err := f._postAdminOpenShiftClusterUpgrade(ctx, r)
+
+	adminReply(log, w, nil, nil, err)
+}
+
+// _postAdminOpenShiftClusterUpgrade queues an upgrade of the cluster to the
+// requested OpenShift version. The backend runs the pre-upgrade health gates,
+// sets the ClusterVersion desired update and tracks progress in
+// properties.upgrade.
+func (f *frontend) _postAdminOpenShiftClusterUpgrade(ctx context.Context, r *http.Request) error {
+	resourceID := strings.TrimPrefix(r.URL.Path, "/admin")
+	desiredVersion := r.URL.Query().Get("version")
+
+	f.mu.RLock()
+	_, ok := f.enabledOcpVersions[desiredVersion]
+	f.mu.RUnlock()
+
+	if !ok || !validate.RxInstallVersion.MatchString(desiredVersion) {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "version", "The requested OpenShift version '%s' is invalid.", desiredVersion)
+	}
+
+	doc, err := f.dbOpenShiftClusters.Get(ctx, resourceID)
+	switch {
+	case cosmosdb.IsErrorStatusCode(err, http.StatusNotFound):
+		return api.NewCloudError(http.StatusNotFound, api.CloudErrorCodeResourceNotFound, "", "The Resource '%s/%s' under resource group '%s' was not found.", chi.URLParam(r, "resourceType"), chi.URLParam(r, "resourceName"), chi.URLParam(r, "resourceGroupName"))
+	case err != nil:
+		return err
+	}
+
+	if doc.OpenShiftCluster.Properties.ProvisioningState != api.ProvisioningStateSucceeded {
+		return api.NewCloudError(http.StatusConflict, api.CloudErrorCodeRequestNotAllowed, "", "Request is not allowed in provisioningState '%s'.", doc.OpenShiftCluster.Properties.ProvisioningState)
+	}
+
+	if doc.OpenShiftCluster.Properties.SubscriptionSuspension.IsSuspended() {
+		return api.NewCloudError(http.StatusConflict, api.CloudErrorCodeRequestNotAllowed, "", "Request is not allowed on a suspended cluster.")
+	}
+
+	from, err := version.ParseVersion(doc.OpenShiftCluster.Properties.ClusterProfile.Version)
+	if err != nil {
+		return err
+	}
+
+	to, err := version.ParseVersion(desiredVersion)
+	if err != nil {
+		return err
+	}
+
+	// the backend checks the upgrade graph of the cluster before starting the
+	// upgrade, but fail early on the static rules
+	err = version.ValidateUpgradeVersions(from, to)
+	if err != nil {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "version", "%s.", err)
+	}
+
+	_, err = f.dbOpenShiftClusters.Patch(ctx, doc.Key, func(doc *api.OpenShiftClusterDocument) error {
+		doc.OpenShiftCluster.Properties.MaintenanceTask = api.MaintenanceTaskUpgrade
+		doc.OpenShiftCluster.Properties.Upgrade = &api.Upgrade{
+			DesiredVersion: desiredVersion,
+			Phase:          api.UpgradePhasePending,
+			StartedAt:      f.now().UTC(),
+		}
+		adminUpdateProvisioningState(doc)
+		return nil
+	})
+
+	return err
+}
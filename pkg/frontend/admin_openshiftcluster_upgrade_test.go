@@ -0,0 +1,170 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-test/deep"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/metrics/noop"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+)
+
+func TestAdminUpgrade(t *testing.T) {
+	mockSubID := "00000000-0000-0000-0000-000000000000"
+	mockTenantID := "00000000-0000-0000-0000-000000000000"
+	resourceID := testdatabase.GetResourcePath(mockSubID, "resourceName")
+
+	ctx := context.Background()
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	type test struct {
+		name              string
+		version           string
+		clusterVersion    string
+		provisioningState api.ProvisioningState
+		suspension        *api.SubscriptionSuspension
+		wantUpgrade       *api.Upgrade
+		wantStatusCode    int
+		wantError         string
+	}
+
+	for _, tt := range []*test{
+		{
+			name:              "upgrade is queued",
+			version:           "4.12.25",
+			clusterVersion:    "4.11.44",
+			provisioningState: api.ProvisioningStateSucceeded,
+			wantUpgrade: &api.Upgrade{
+				DesiredVersion: "4.12.25",
+				Phase:          api.UpgradePhasePending,
+				StartedAt:      now,
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:              "version is not enabled",
+			version:           "4.13.0",
+			clusterVersion:    "4.12.25",
+			provisioningState: api.ProvisioningStateSucceeded,
+			wantStatusCode:    http.StatusBadRequest,
+			wantError:         "400: InvalidParameter: version: The requested OpenShift version '4.13.0' is invalid.",
+		},
+		{
+			name:              "minor versions cannot be skipped",
+			version:           "4.12.25",
+			clusterVersion:    "4.10.67",
+			provisioningState: api.ProvisioningStateSucceeded,
+			wantStatusCode:    http.StatusBadRequest,
+			wantError:         "400: InvalidParameter: version: cannot upgrade from 4.10.67 to 4.12.25: minor versions cannot be skipped.",
+		},
+		{
+			name:              "downgrades are not allowed",
+			version:           "4.11.44",
+			clusterVersion:    "4.12.25",
+			provisioningState: api.ProvisioningStateSucceeded,
+			wantStatusCode:    http.StatusBadRequest,
+			wantError:         "400: InvalidParameter: version: cannot upgrade from 4.12.25 to 4.11.44: target is not newer.",
+		},
+		{
+			name:              "cluster is not in a terminal state",
+			version:           "4.12.25",
+			clusterVersion:    "4.11.44",
+			provisioningState: api.ProvisioningStateAdminUpdating,
+			wantStatusCode:    http.StatusConflict,
+			wantError:         "409: RequestNotAllowed: : Request is not allowed in provisioningState 'AdminUpdating'.",
+		},
+		{
+			name:              "cluster is suspended",
+			version:           "4.12.25",
+			clusterVersion:    "4.11.44",
+			provisioningState: api.ProvisioningStateSucceeded,
+			suspension:        &api.SubscriptionSuspension{State: api.SubscriptionSuspensionStateSuspended},
+			wantStatusCode:    http.StatusConflict,
+			wantError:         "409: RequestNotAllowed: : Request is not allowed on a suspended cluster.",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ti := newTestInfra(t).WithOpenShiftClusters().WithSubscriptions()
+			defer ti.done()
+
+			err := ti.buildFixtures(func(f *testdatabase.Fixture) {
+				f.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+					Key: strings.ToLower(resourceID),
+					OpenShiftCluster: &api.OpenShiftCluster{
+						ID: resourceID,
+						Properties: api.OpenShiftClusterProperties{
+							ProvisioningState: tt.provisioningState,
+							ClusterProfile: api.ClusterProfile{
+								Version: tt.clusterVersion,
+							},
+							SubscriptionSuspension: tt.suspension,
+						},
+					},
+				})
+
+				f.AddSubscriptionDocuments(&api.SubscriptionDocument{
+					ID: mockSubID,
+					Subscription: &api.Subscription{
+						State: api.SubscriptionStateRegistered,
+						Properties: &api.SubscriptionProperties{
+							TenantID: mockTenantID,
+						},
+					},
+				})
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			f, err := NewFrontend(ctx, ti.audit, ti.log, ti.env, ti.asyncOperationsDatabase, ti.clusterManagerDatabase, ti.gatewayDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, nil, nil, api.APIs, &noop.Noop{}, &noop.Noop{}, nil, nil, nil, nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			f.now = func() time.Time { return now }
+			f.enabledOcpVersions = map[string]*api.OpenShiftVersion{
+				"4.11.44": {},
+				"4.12.25": {},
+			}
+
+			go f.Run(ctx, nil, nil)
+
+			resp, b, err := ti.request(http.MethodPost,
+				fmt.Sprintf("https://server/admin%s/upgrade?version=%s", resourceID, tt.version),
+				nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = validateResponse(resp, b, tt.wantStatusCode, tt.wantError, nil)
+			if err != nil {
+				t.Error(err)
+			}
+
+			if tt.wantUpgrade != nil {
+				doc, err := ti.openShiftClustersDatabase.Get(ctx, strings.ToLower(resourceID))
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if doc.OpenShiftCluster.Properties.ProvisioningState != api.ProvisioningStateAdminUpdating {
+					t.Error(doc.OpenShiftCluster.Properties.ProvisioningState)
+				}
+				if doc.OpenShiftCluster.Properties.MaintenanceTask != api.MaintenanceTaskUpgrade {
+					t.Error(doc.OpenShiftCluster.Properties.MaintenanceTask)
+				}
+				for _, diff := range deep.Equal(doc.OpenShiftCluster.Properties.Upgrade, tt.wantUpgrade) {
+					t.Error(diff)
+				}
+			}
+		})
+	}
+}
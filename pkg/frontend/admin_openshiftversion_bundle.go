@@ -0,0 +1,217 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/coreos/go-semver/semver"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/api/admin"
+	"github.com/Azure/ARO-RP/pkg/env"
+	"github.com/Azure/ARO-RP/pkg/frontend/middleware"
+)
+
+// getAdminOpenShiftVersionsExport returns the whole version catalogue as a
+// bundle signed with the shared versions signing key, suitable for importing
+// into another region
+func (f *frontend) getAdminOpenShiftVersionsExport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := ctx.Value(middleware.ContextKeyLog).(*logrus.Entry)
+	r.URL.Path = filepath.Dir(r.URL.Path)
+
+	b, err := f._getAdminOpenShiftVersionsExport(ctx)
+	adminReply(log, w, nil, b, err)
+}
+
+func (f *frontend) _getAdminOpenShiftVersionsExport(ctx context.Context) ([]byte, error) {
+	converter := f.apis[admin.APIVersion].OpenShiftVersionConverter
+
+	docs, err := f.dbOpenShiftVersions.ListAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var vers []*api.OpenShiftVersion
+	if docs != nil {
+		for _, doc := range docs.OpenShiftVersionDocuments {
+			vers = append(vers, doc.OpenShiftVersion)
+		}
+	}
+
+	sort.Slice(vers, func(i, j int) bool {
+		return semver.New(vers[i].Properties.Version).LessThan(*semver.New(vers[j].Properties.Version))
+	})
+
+	bundle := &admin.OpenShiftVersionBundle{
+		ExportedAt:        f.now().UTC(),
+		OpenShiftVersions: converter.ToExternalList(vers).(*admin.OpenShiftVersionList).OpenShiftVersions,
+	}
+
+	key, err := f.env.ServiceKeyvault().GetBase64Secret(ctx, env.OpenShiftVersionsSigningSecretName, "")
+	if err != nil {
+		return nil, err
+	}
+
+	bundle.Signature, err = signOpenShiftVersionBundle(key, bundle)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.MarshalIndent(bundle, "", "    ")
+}
+
+// postAdminOpenShiftVersionsImport verifies a bundle produced by
+// getAdminOpenShiftVersionsExport and creates or updates every version in it.
+// Versions which are not in the bundle are left untouched.  Nothing is written
+// unless every version in the bundle validates, and the bundle was exported
+// after the last bundle which was imported.
+func (f *frontend) postAdminOpenShiftVersionsImport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := ctx.Value(middleware.ContextKeyLog).(*logrus.Entry)
+	r.URL.Path = filepath.Dir(r.URL.Path)
+
+	body := r.Context().Value(middleware.ContextKeyBody).([]byte)
+	if len(body) == 0 || !json.Valid(body) {
+		api.WriteError(w, http.StatusBadRequest, api.CloudErrorCodeInvalidRequestContent, "", "The request content was invalid and could not be deserialized.")
+		return
+	}
+
+	var bundle *admin.OpenShiftVersionBundle
+	err := json.Unmarshal(body, &bundle)
+	if err != nil {
+		api.WriteError(w, http.StatusBadRequest, api.CloudErrorCodeInvalidRequestContent, "", "The request content could not be deserialized: "+err.Error())
+		return
+	}
+
+	b, err := f._postAdminOpenShiftVersionsImport(ctx, bundle)
+	adminReply(log, w, nil, b, err)
+}
+
+func (f *frontend) _postAdminOpenShiftVersionsImport(ctx context.Context, bundle *admin.OpenShiftVersionBundle) ([]byte, error) {
+	converter := f.apis[admin.APIVersion].OpenShiftVersionConverter
+
+	keys, err := f.env.ServiceKeyvault().GetBase64Secrets(ctx, env.OpenShiftVersionsSigningSecretName)
+	if err != nil {
+		return nil, err
+	}
+
+	valid, err := verifyOpenShiftVersionBundle(keys, bundle)
+	if err != nil {
+		return nil, err
+	}
+	if !valid {
+		return nil, api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "signature", "The bundle signature is invalid.")
+	}
+
+	docs, err := f.dbOpenShiftVersions.ListAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	existing := map[string]*api.OpenShiftVersionDocument{}
+	var lastImport time.Time
+	if docs != nil {
+		for _, doc := range docs.OpenShiftVersionDocuments {
+			existing[doc.OpenShiftVersion.Properties.Version] = doc
+			if doc.BundleExportedAt != nil && doc.BundleExportedAt.After(lastImport) {
+				lastImport = *doc.BundleExportedAt
+			}
+		}
+	}
+
+	// a validly signed bundle stays valid forever, so refuse to replay the
+	// last bundle or an earlier one, which would roll the catalogue back
+	if !bundle.ExportedAt.After(lastImport) {
+		return nil, api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "exportedAt", "The bundle must be exported after the last imported bundle, which was exported at %s.", lastImport.UTC().Format(time.RFC3339))
+	}
+
+	seen := map[string]struct{}{}
+	for i, ext := range bundle.OpenShiftVersions {
+		if ext == nil {
+			return nil, api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, fmt.Sprintf("value[%d]", i), "Must be provided")
+		}
+
+		if _, ok := seen[ext.Properties.Version]; ok {
+			return nil, api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, fmt.Sprintf("value[%d].properties.version", i), "The version '%s' is duplicated.", ext.Properties.Version)
+		}
+		seen[ext.Properties.Version] = struct{}{}
+
+		err = f.validateOpenShiftVersionPut(existing[ext.Properties.Version], ext)
+		if cloudErr, ok := err.(*api.CloudError); ok {
+			cloudErr.Target = fmt.Sprintf("value[%d].%s", i, cloudErr.Target)
+			return nil, cloudErr
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	vers := make([]*api.OpenShiftVersion, 0, len(bundle.OpenShiftVersions))
+	for _, ext := range bundle.OpenShiftVersions {
+		doc, err := f.putOpenShiftVersion(ctx, existing[ext.Properties.Version], ext, &bundle.ExportedAt)
+		if err != nil {
+			return nil, err
+		}
+		vers = append(vers, doc.OpenShiftVersion)
+	}
+
+	return json.MarshalIndent(converter.ToExternalList(vers), "", "    ")
+}
+
+// signOpenShiftVersionBundle returns the base64 encoded HMAC-SHA256 of the
+// bundle's JSON encoding with its signature cleared
+func signOpenShiftVersionBundle(key []byte, bundle *admin.OpenShiftVersionBundle) (string, error) {
+	unsigned := *bundle
+	unsigned.Signature = ""
+
+	b, err := json.Marshal(&unsigned)
+	if err != nil {
+		return "", err
+	}
+
+	h := hmac.New(sha256.New, key)
+	h.Write(b)
+
+	return base64.StdEncoding.EncodeToString(h.Sum(nil)), nil
+}
+
+// verifyOpenShiftVersionBundle returns true if the bundle is signed with any of
+// the given keys, so that bundles remain importable while the signing key is
+// rotated
+func verifyOpenShiftVersionBundle(keys [][]byte, bundle *admin.OpenShiftVersionBundle) (bool, error) {
+	signature, err := base64.StdEncoding.DecodeString(bundle.Signature)
+	if err != nil || len(signature) == 0 {
+		return false, nil
+	}
+
+	for _, key := range keys {
+		expected, err := signOpenShiftVersionBundle(key, bundle)
+		if err != nil {
+			return false, err
+		}
+
+		b, err := base64.StdEncoding.DecodeString(expected)
+		if err != nil {
+			return false, err
+		}
+
+		if hmac.Equal(b, signature) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
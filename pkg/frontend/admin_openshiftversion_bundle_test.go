@@ -0,0 +1,325 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/api/admin"
+	"github.com/Azure/ARO-RP/pkg/env"
+	"github.com/Azure/ARO-RP/pkg/metrics/noop"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+)
+
+func TestOpenShiftVersionsExport(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	gaDate := now.AddDate(0, -1, 0)
+	key := []byte("key")
+
+	ti := newTestInfra(t).WithOpenShiftVersions()
+	defer ti.done()
+
+	err := ti.buildFixtures(func(f *testdatabase.Fixture) {
+		f.AddOpenShiftVersionDocuments(
+			&api.OpenShiftVersionDocument{
+				OpenShiftVersion: &api.OpenShiftVersion{
+					Properties: api.OpenShiftVersionProperties{
+						Version:           "4.10.20",
+						Enabled:           true,
+						OpenShiftPullspec: "a:a/b",
+						InstallerPullspec: "b:b/c",
+						UpgradeOnly:       true,
+					},
+				},
+			},
+			&api.OpenShiftVersionDocument{
+				OpenShiftVersion: &api.OpenShiftVersion{
+					Properties: api.OpenShiftVersionProperties{
+						Version:           "4.10.3",
+						Enabled:           true,
+						OpenShiftPullspec: "c:c/d",
+						InstallerPullspec: "d:d/e",
+						Channel:           "stable-4.10",
+						GADate:            &gaDate,
+					},
+				},
+			},
+		)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ti.keyvault.EXPECT().GetBase64Secret(gomock.Any(), env.OpenShiftVersionsSigningSecretName, "").Return(key, nil)
+
+	f, err := NewFrontend(ctx, ti.audit, ti.log, ti.env, nil, nil, nil, nil, nil, ti.openShiftVersionsDatabase, nil, api.APIs, &noop.Noop{}, &noop.Noop{}, nil, nil, nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.now = func() time.Time { return now }
+
+	go f.Run(ctx, nil, nil)
+
+	resp, b, err := ti.request(http.MethodGet, "https://server/admin/versions/export", nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &admin.OpenShiftVersionBundle{
+		ExportedAt: now,
+		OpenShiftVersions: []*admin.OpenShiftVersion{
+			{
+				Properties: admin.OpenShiftVersionProperties{
+					Version:           "4.10.3",
+					Enabled:           true,
+					OpenShiftPullspec: "c:c/d",
+					InstallerPullspec: "d:d/e",
+					Channel:           "stable-4.10",
+					GADate:            &gaDate,
+				},
+			},
+			{
+				Properties: admin.OpenShiftVersionProperties{
+					Version:           "4.10.20",
+					Enabled:           true,
+					OpenShiftPullspec: "a:a/b",
+					InstallerPullspec: "b:b/c",
+					UpgradeOnly:       true,
+				},
+			},
+		},
+	}
+	want.Signature, err = signOpenShiftVersionBundle(key, want)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = validateResponse(resp, b, http.StatusOK, "", want)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestOpenShiftVersionsImport(t *testing.T) {
+	ctx := context.Background()
+	key, oldKey := []byte("key"), []byte("old-key")
+	exportedAt := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	lastImport := exportedAt.AddDate(0, -1, 0)
+
+	newBundle := func() *admin.OpenShiftVersionBundle {
+		return &admin.OpenShiftVersionBundle{
+			ExportedAt: exportedAt,
+			OpenShiftVersions: []*admin.OpenShiftVersion{
+				{
+					Properties: admin.OpenShiftVersionProperties{
+						Version:           "4.10.0",
+						Enabled:           true,
+						OpenShiftPullspec: "c:c/d",
+						InstallerPullspec: "d:d/e",
+						Channel:           "stable-4.10",
+					},
+				},
+				{
+					Properties: admin.OpenShiftVersionProperties{
+						Version:           "4.10.1",
+						Enabled:           true,
+						OpenShiftPullspec: "e:e/f",
+						InstallerPullspec: "f:f/g",
+						UpgradeOnly:       true,
+					},
+				},
+			},
+		}
+	}
+
+	type test struct {
+		name           string
+		bundle         func() *admin.OpenShiftVersionBundle
+		signingKey     []byte
+		lastImport     *time.Time
+		wantStatusCode int
+		wantResponse   *admin.OpenShiftVersionList
+		wantError      string
+		wantDocuments  []*api.OpenShiftVersionDocument
+	}
+
+	for _, tt := range []*test{
+		{
+			name:           "bundle signed with a previous key is imported",
+			bundle:         newBundle,
+			signingKey:     oldKey,
+			lastImport:     &lastImport,
+			wantStatusCode: http.StatusOK,
+			wantResponse: &admin.OpenShiftVersionList{
+				OpenShiftVersions: []*admin.OpenShiftVersion{
+					{
+						Properties: admin.OpenShiftVersionProperties{
+							Version:           "4.10.0",
+							Enabled:           true,
+							OpenShiftPullspec: "c:c/d",
+							InstallerPullspec: "d:d/e",
+							Channel:           "stable-4.10",
+						},
+					},
+					{
+						Properties: admin.OpenShiftVersionProperties{
+							Version:           "4.10.1",
+							Enabled:           true,
+							OpenShiftPullspec: "e:e/f",
+							InstallerPullspec: "f:f/g",
+							UpgradeOnly:       true,
+						},
+					},
+				},
+			},
+			wantDocuments: []*api.OpenShiftVersionDocument{
+				{
+					ID:               "07070707-0707-0707-0707-070707070001",
+					BundleExportedAt: &exportedAt,
+					OpenShiftVersion: &api.OpenShiftVersion{
+						Properties: api.OpenShiftVersionProperties{
+							Version:           "4.10.0",
+							Enabled:           true,
+							Default:           true,
+							OpenShiftPullspec: "c:c/d",
+							InstallerPullspec: "d:d/e",
+							Channel:           "stable-4.10",
+						},
+					},
+				},
+				{
+					ID:               "07070707-0707-0707-0707-070707070002",
+					BundleExportedAt: &exportedAt,
+					OpenShiftVersion: &api.OpenShiftVersion{
+						Properties: api.OpenShiftVersionProperties{
+							Version:           "4.10.1",
+							Enabled:           true,
+							OpenShiftPullspec: "e:e/f",
+							InstallerPullspec: "f:f/g",
+							UpgradeOnly:       true,
+						},
+					},
+				},
+			},
+		},
+		{
+			name:           "bundle signed with an unknown key is rejected",
+			bundle:         newBundle,
+			signingKey:     []byte("other-key"),
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      "400: InvalidParameter: signature: The bundle signature is invalid.",
+		},
+		{
+			name:           "bundle which was already imported is rejected",
+			bundle:         newBundle,
+			signingKey:     key,
+			lastImport:     &exportedAt,
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      "400: InvalidParameter: exportedAt: The bundle must be exported after the last imported bundle, which was exported at 2023-06-01T00:00:00Z.",
+		},
+		{
+			name: "bundle older than the last import is rejected",
+			bundle: func() *admin.OpenShiftVersionBundle {
+				bundle := newBundle()
+				bundle.ExportedAt = lastImport.AddDate(0, 0, -1)
+				return bundle
+			},
+			signingKey:     key,
+			lastImport:     &lastImport,
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      "400: InvalidParameter: exportedAt: The bundle must be exported after the last imported bundle, which was exported at 2023-05-01T00:00:00Z.",
+		},
+		{
+			name: "bundle which is invalid is not imported",
+			bundle: func() *admin.OpenShiftVersionBundle {
+				bundle := newBundle()
+				bundle.OpenShiftVersions[1].Properties.Channel = "latest"
+				return bundle
+			},
+			signingKey:     key,
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      "400: InvalidParameter: value[1].properties.channel: The provided channel 'latest' is invalid.",
+		},
+		{
+			name: "bundle cannot make the default version uninstallable",
+			bundle: func() *admin.OpenShiftVersionBundle {
+				bundle := newBundle()
+				bundle.OpenShiftVersions[0].Properties.LifecycleState = admin.OpenShiftVersionLifecycleStateDeprecated
+				return bundle
+			},
+			signingKey:     key,
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      "400: InvalidParameter: value[0].properties.lifecycleState: You cannot deprecate the default installation version.",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ti := newTestInfra(t).WithOpenShiftVersions()
+			defer ti.done()
+
+			existing := &api.OpenShiftVersionDocument{
+				BundleExportedAt: tt.lastImport,
+				OpenShiftVersion: &api.OpenShiftVersion{
+					Properties: api.OpenShiftVersionProperties{
+						Version:           "4.10.0",
+						Enabled:           true,
+						Default:           true,
+						OpenShiftPullspec: "a:a/b",
+						InstallerPullspec: "b:b/c",
+					},
+				},
+			}
+
+			err := ti.buildFixtures(func(f *testdatabase.Fixture) {
+				f.AddOpenShiftVersionDocuments(existing)
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			ti.keyvault.EXPECT().GetBase64Secrets(gomock.Any(), env.OpenShiftVersionsSigningSecretName).Return([][]byte{key, oldKey}, nil)
+
+			bundle := tt.bundle()
+			bundle.Signature, err = signOpenShiftVersionBundle(tt.signingKey, bundle)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			f, err := NewFrontend(ctx, ti.audit, ti.log, ti.env, nil, nil, nil, nil, nil, ti.openShiftVersionsDatabase, nil, api.APIs, &noop.Noop{}, &noop.Noop{}, nil, nil, nil, nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			go f.Run(ctx, nil, nil)
+
+			resp, b, err := ti.request(http.MethodPost, "https://server/admin/versions/import",
+				http.Header{
+					"Content-Type": []string{"application/json"},
+				}, bundle)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = validateResponse(resp, b, tt.wantStatusCode, tt.wantError, tt.wantResponse)
+			if err != nil {
+				t.Error(err)
+			}
+
+			if tt.wantDocuments == nil {
+				existing.ID = "07070707-0707-0707-0707-070707070001"
+				tt.wantDocuments = []*api.OpenShiftVersionDocument{existing}
+			}
+			ti.checker.AddOpenShiftVersionDocuments(tt.wantDocuments...)
+			for _, err := range ti.checker.CheckOpenShiftVersions(ti.openShiftVersionsClient) {
+				t.Error(err)
+			}
+		})
+	}
+}
@@ -4,9 +4,11 @@ package frontend
 // Licensed under the Apache License 2.0.
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"path/filepath"
+	"time"
 
 	"github.com/sirupsen/logrus"
 
@@ -21,7 +23,6 @@ func (f *frontend) putAdminOpenShiftVersion(w http.ResponseWriter, r *http.Reque
 	r.URL.Path = filepath.Dir(r.URL.Path)
 
 	converter := f.apis[admin.APIVersion].OpenShiftVersionConverter
-	staticValidator := f.apis[admin.APIVersion].OpenShiftVersionStaticValidator
 
 	body := r.Context().Value(middleware.ContextKeyBody).([]byte)
 	if len(body) == 0 || !json.Valid(body) {
@@ -46,53 +47,94 @@ func (f *frontend) putAdminOpenShiftVersion(w http.ResponseWriter, r *http.Reque
 	if docs != nil {
 		for _, doc := range docs.OpenShiftVersionDocuments {
 			if doc.OpenShiftVersion.Properties.Version == ext.Properties.Version {
-				// prevent disabling of the default installation version
-				if doc.OpenShiftVersion.Properties.Default && !ext.Properties.Enabled {
-					api.WriteError(w, http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "properties.enabled", "You cannot disable the default installation version.")
-					return
-				}
 				versionDoc = doc
 				break
 			}
 		}
 	}
 
+	isCreate := versionDoc == nil
+	versionDoc, err = f.putOpenShiftVersion(ctx, versionDoc, ext, nil)
+	if err != nil {
+		if _, ok := err.(*api.CloudError); !ok {
+			err = api.NewCloudError(http.StatusInternalServerError, api.CloudErrorCodeInternalServerError, "", "Internal server error.")
+		}
+		adminReply(log, w, nil, []byte{}, err)
+		return
+	}
+
+	b, err := json.MarshalIndent(converter.ToExternal(versionDoc.OpenShiftVersion), "", "    ")
+	if err == nil {
+		if isCreate {
+			err = statusCodeError(http.StatusCreated)
+		}
+	}
+	adminReply(log, w, nil, b, err)
+}
+
+// validateOpenShiftVersionPut validates ext as a replacement for versionDoc,
+// which is nil if the version does not exist yet
+func (f *frontend) validateOpenShiftVersionPut(versionDoc *api.OpenShiftVersionDocument, ext *admin.OpenShiftVersion) error {
+	staticValidator := f.apis[admin.APIVersion].OpenShiftVersionStaticValidator
+
+	if versionDoc == nil {
+		return staticValidator.Static(ext, nil)
+	}
+
+	err := validateDefaultOpenShiftVersionChange(versionDoc.OpenShiftVersion, ext)
+	if err != nil {
+		return err
+	}
+
+	return staticValidator.Static(ext, versionDoc.OpenShiftVersion)
+}
+
+// putOpenShiftVersion validates ext and writes it to versionDoc, creating a
+// new document if versionDoc is nil.  Validation failures are returned as
+// *api.CloudError.  bundleExportedAt is recorded on the document if the
+// version is imported from a bundle.
+func (f *frontend) putOpenShiftVersion(ctx context.Context, versionDoc *api.OpenShiftVersionDocument, ext *admin.OpenShiftVersion, bundleExportedAt *time.Time) (*api.OpenShiftVersionDocument, error) {
+	converter := f.apis[admin.APIVersion].OpenShiftVersionConverter
+
+	err := f.validateOpenShiftVersionPut(versionDoc, ext)
+	if err != nil {
+		return nil, err
+	}
+
 	isCreate := versionDoc == nil
 	if isCreate {
-		err = staticValidator.Static(ext, nil)
 		versionDoc = &api.OpenShiftVersionDocument{
 			ID:               f.dbOpenShiftVersions.NewUUID(),
 			OpenShiftVersion: &api.OpenShiftVersion{},
 		}
-	} else {
-		err = staticValidator.Static(ext, versionDoc.OpenShiftVersion)
-	}
-	if err != nil {
-		adminReply(log, w, nil, []byte{}, err)
-		return
 	}
 
 	converter.ToInternal(ext, versionDoc.OpenShiftVersion)
+	if bundleExportedAt != nil {
+		versionDoc.BundleExportedAt = bundleExportedAt
+	}
 
 	if isCreate {
-		versionDoc, err = f.dbOpenShiftVersions.Create(ctx, versionDoc)
-		if err != nil {
-			api.WriteError(w, http.StatusInternalServerError, api.CloudErrorCodeInternalServerError, "", "Internal server error.")
-			return
-		}
-	} else {
-		versionDoc, err = f.dbOpenShiftVersions.Update(ctx, versionDoc)
-		if err != nil {
-			api.WriteError(w, http.StatusInternalServerError, api.CloudErrorCodeInternalServerError, "", "Internal server error.")
-			return
-		}
+		return f.dbOpenShiftVersions.Create(ctx, versionDoc)
 	}
+	return f.dbOpenShiftVersions.Update(ctx, versionDoc)
+}
 
-	b, err := json.MarshalIndent(converter.ToExternal(versionDoc.OpenShiftVersion), "", "    ")
-	if err == nil {
-		if isCreate {
-			err = statusCodeError(http.StatusCreated)
-		}
+// validateDefaultOpenShiftVersionChange prevents the default installation
+// version from being made uninstallable
+func validateDefaultOpenShiftVersionChange(current *api.OpenShiftVersion, ext *admin.OpenShiftVersion) error {
+	if !current.Properties.Default {
+		return nil
 	}
-	adminReply(log, w, nil, b, err)
+
+	switch {
+	case !ext.Properties.Enabled:
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "properties.enabled", "You cannot disable the default installation version.")
+	case ext.Properties.UpgradeOnly:
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "properties.upgradeOnly", "You cannot make the default installation version upgrade only.")
+	case ext.Properties.LifecycleState == admin.OpenShiftVersionLifecycleStateDeprecated:
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "properties.lifecycleState", "You cannot deprecate the default installation version.")
+	}
+
+	return nil
 }
@@ -256,6 +256,87 @@ func TestOpenShiftVersionPut(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "can not make default install version upgrade only",
+			fixture: func(f *testdatabase.Fixture) {
+				f.AddOpenShiftVersionDocuments(
+					&api.OpenShiftVersionDocument{
+						OpenShiftVersion: &api.OpenShiftVersion{
+							Properties: api.OpenShiftVersionProperties{
+								Version:           "4.10.0",
+								Enabled:           true,
+								Default:           true,
+								OpenShiftPullspec: "a:a/b",
+							},
+						},
+					},
+				)
+			},
+			body: &admin.OpenShiftVersion{
+				Properties: admin.OpenShiftVersionProperties{
+					Version:           "4.10.0",
+					Enabled:           true,
+					UpgradeOnly:       true,
+					OpenShiftPullspec: "c:c/d",
+					InstallerPullspec: "d:d/e",
+				},
+			},
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      "400: InvalidParameter: properties.upgradeOnly: You cannot make the default installation version upgrade only.",
+		},
+		{
+			name: "updating lifecycle of known version",
+			fixture: func(f *testdatabase.Fixture) {
+				f.AddOpenShiftVersionDocuments(
+					&api.OpenShiftVersionDocument{
+						OpenShiftVersion: &api.OpenShiftVersion{
+							Properties: api.OpenShiftVersionProperties{
+								Version:           "4.10.0",
+								Enabled:           true,
+								OpenShiftPullspec: "a:a/b",
+								InstallerPullspec: "d:d/e",
+							},
+						},
+					},
+				)
+			},
+			body: &admin.OpenShiftVersion{
+				Properties: admin.OpenShiftVersionProperties{
+					Version:           "4.10.0",
+					Enabled:           true,
+					OpenShiftPullspec: "a:a/b",
+					InstallerPullspec: "d:d/e",
+					Channel:           "stable-4.10",
+					LifecycleState:    admin.OpenShiftVersionLifecycleStateDeprecated,
+				},
+			},
+			wantStatusCode: http.StatusOK,
+			wantResponse: &admin.OpenShiftVersion{
+				Properties: admin.OpenShiftVersionProperties{
+					Version:           "4.10.0",
+					Enabled:           true,
+					OpenShiftPullspec: "a:a/b",
+					InstallerPullspec: "d:d/e",
+					Channel:           "stable-4.10",
+					LifecycleState:    admin.OpenShiftVersionLifecycleStateDeprecated,
+				},
+			},
+			wantDocuments: []*api.OpenShiftVersionDocument{
+				{
+					ID: "07070707-0707-0707-0707-070707070001",
+					OpenShiftVersion: &api.OpenShiftVersion{
+						Properties: api.OpenShiftVersionProperties{
+							Version:           "4.10.0",
+							Enabled:           true,
+							OpenShiftPullspec: "a:a/b",
+							InstallerPullspec: "d:d/e",
+							Channel:           "stable-4.10",
+							LifecycleState:    api.OpenShiftVersionLifecycleStateDeprecated,
+						},
+					},
+				},
+			},
+		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
 			ti := newTestInfra(t).WithOpenShiftVersions()
@@ -266,7 +347,7 @@ func TestOpenShiftVersionPut(t *testing.T) {
 				t.Fatal(err)
 			}
 
-			f, err := NewFrontend(ctx, ti.audit, ti.log, ti.env, nil, nil, nil, nil, ti.openShiftVersionsDatabase, api.APIs, &noop.Noop{}, &noop.Noop{}, nil, nil, nil, nil, nil)
+			f, err := NewFrontend(ctx, ti.audit, ti.log, ti.env, nil, nil, nil, nil, nil, ti.openShiftVersionsDatabase, nil, api.APIs, &noop.Noop{}, &noop.Noop{}, nil, nil, nil, nil, nil)
 			if err != nil {
 				t.Fatal(err)
 			}
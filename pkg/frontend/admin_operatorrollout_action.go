@@ -0,0 +1,100 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/api/admin"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+	"github.com/Azure/ARO-RP/pkg/frontend/middleware"
+)
+
+// postAdminOperatorRolloutAction halts, resumes or cancels an operator
+// rollout.  Resuming a rollout retries the updates of its degraded clusters.
+func (f *frontend) postAdminOperatorRolloutAction(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := ctx.Value(middleware.ContextKeyLog).(*logrus.Entry)
+	r.URL.Path = filepath.Dir(r.URL.Path)
+
+	b, err := f._postAdminOperatorRolloutAction(ctx, chi.URLParam(r, "operatorRolloutId"), chi.URLParam(r, "action"))
+	adminReply(log, w, nil, b, err)
+}
+
+func (f *frontend) _postAdminOperatorRolloutAction(ctx context.Context, id, action string) ([]byte, error) {
+	converter := f.apis[admin.APIVersion].OperatorRolloutConverter
+
+	var mutate func(*api.OperatorRolloutProperties) error
+	switch action {
+	case "halt":
+		mutate = func(p *api.OperatorRolloutProperties) error {
+			if p.State != api.OperatorRolloutStateProgressing {
+				return rolloutActionNotAllowed(action, p.State)
+			}
+			p.State = api.OperatorRolloutStateHalted
+			p.HaltReason = "Halted by an administrator."
+			return nil
+		}
+
+	case "resume":
+		mutate = func(p *api.OperatorRolloutProperties) error {
+			if p.State != api.OperatorRolloutStateHalted {
+				return rolloutActionNotAllowed(action, p.State)
+			}
+			for i := range p.Waves {
+				for j := range p.Waves[i].Clusters {
+					c := &p.Waves[i].Clusters[j]
+					if c.State == api.OperatorRolloutClusterStateDegraded {
+						c.State = api.OperatorRolloutClusterStatePending
+						c.Error = ""
+					}
+				}
+			}
+			p.State = api.OperatorRolloutStateProgressing
+			p.HaltReason = ""
+			return nil
+		}
+
+	case "cancel":
+		mutate = func(p *api.OperatorRolloutProperties) error {
+			if p.State.IsTerminal() {
+				return rolloutActionNotAllowed(action, p.State)
+			}
+			p.State = api.OperatorRolloutStateCancelled
+			return nil
+		}
+
+	default:
+		return nil, api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "action", "The provided action '%s' is invalid.", action)
+	}
+
+	doc, err := f.dbOperatorRollouts.Patch(ctx, id, func(doc *api.OperatorRolloutDocument) error {
+		err := mutate(&doc.OperatorRollout.Properties)
+		if err != nil {
+			return err
+		}
+		doc.OperatorRollout.Properties.LastUpdateTime = time.Now().UTC()
+		return nil
+	})
+	switch {
+	case cosmosdb.IsErrorStatusCode(err, http.StatusNotFound):
+		return nil, api.NewCloudError(http.StatusNotFound, api.CloudErrorCodeNotFound, "", "The operator rollout '%s' could not be found.", id)
+	case err != nil:
+		return nil, err
+	}
+
+	return json.MarshalIndent(converter.ToExternal(doc.OperatorRollout), "", "    ")
+}
+
+func rolloutActionNotAllowed(action string, state api.OperatorRolloutState) error {
+	return api.NewCloudError(http.StatusConflict, api.CloudErrorCodeRequestNotAllowed, "", "Cannot %s an operator rollout in state '%s'.", action, state)
+}
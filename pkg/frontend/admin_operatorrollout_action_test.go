@@ -0,0 +1,130 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/metrics/noop"
+)
+
+func TestAdminOperatorRolloutAction(t *testing.T) {
+	ctx := context.Background()
+
+	const id = "00000000-0000-0000-0000-000000000000"
+
+	for _, tt := range []struct {
+		name             string
+		state            api.OperatorRolloutState
+		action           string
+		wantStatusCode   int
+		wantError        string
+		wantState        api.OperatorRolloutState
+		wantClusterState api.OperatorRolloutClusterState
+	}{
+		{
+			name:             "halt",
+			state:            api.OperatorRolloutStateProgressing,
+			action:           "halt",
+			wantStatusCode:   http.StatusOK,
+			wantState:        api.OperatorRolloutStateHalted,
+			wantClusterState: api.OperatorRolloutClusterStateDegraded,
+		},
+		{
+			name:             "resume retries degraded clusters",
+			state:            api.OperatorRolloutStateHalted,
+			action:           "resume",
+			wantStatusCode:   http.StatusOK,
+			wantState:        api.OperatorRolloutStateProgressing,
+			wantClusterState: api.OperatorRolloutClusterStatePending,
+		},
+		{
+			name:             "cancel",
+			state:            api.OperatorRolloutStateHalted,
+			action:           "cancel",
+			wantStatusCode:   http.StatusOK,
+			wantState:        api.OperatorRolloutStateCancelled,
+			wantClusterState: api.OperatorRolloutClusterStateDegraded,
+		},
+		{
+			name:           "cannot resume a progressing rollout",
+			state:          api.OperatorRolloutStateProgressing,
+			action:         "resume",
+			wantStatusCode: http.StatusConflict,
+			wantError:      "409: RequestNotAllowed: : Cannot resume an operator rollout in state 'Progressing'.",
+		},
+		{
+			name:           "invalid action",
+			state:          api.OperatorRolloutStateProgressing,
+			action:         "restart",
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      "400: InvalidParameter: action: The provided action 'restart' is invalid.",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ti := newTestInfra(t).WithOperatorRollouts()
+			defer ti.done()
+
+			_, err := ti.operatorRolloutsDatabase.Create(ctx, &api.OperatorRolloutDocument{
+				ID: id,
+				OperatorRollout: &api.OperatorRollout{
+					ID: id,
+					Properties: api.OperatorRolloutProperties{
+						State: tt.state,
+						Waves: []api.OperatorRolloutWave{
+							{
+								Percentage: 100,
+								State:      api.OperatorRolloutWaveStateProgressing,
+								Clusters: []api.OperatorRolloutCluster{
+									{Key: "/subscriptions/sub/resourcegroups/rg/providers/microsoft.redhatopenshift/openshiftclusters/cluster", State: api.OperatorRolloutClusterStateDegraded, Error: "failed"},
+								},
+							},
+						},
+					},
+				},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			f, err := NewFrontend(ctx, ti.audit, ti.log, ti.env, nil, nil, nil, nil, nil, nil, ti.operatorRolloutsDatabase, api.APIs, &noop.Noop{}, &noop.Noop{}, nil, nil, nil, nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			go f.Run(ctx, nil, nil)
+
+			resp, b, err := ti.request(http.MethodPost, "https://server/admin/operatorrollouts/"+id+"/"+tt.action, nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if tt.wantError != "" {
+				err = validateResponse(resp, b, tt.wantStatusCode, tt.wantError, nil)
+				if err != nil {
+					t.Error(err)
+				}
+				return
+			}
+
+			if resp.StatusCode != tt.wantStatusCode {
+				t.Fatalf("unexpected status code %d, wanted %d: %s", resp.StatusCode, tt.wantStatusCode, string(b))
+			}
+
+			doc, err := ti.operatorRolloutsDatabase.Get(ctx, id)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if doc.OperatorRollout.Properties.State != tt.wantState {
+				t.Errorf("got state %s, want %s", doc.OperatorRollout.Properties.State, tt.wantState)
+			}
+			if state := doc.OperatorRollout.Properties.Waves[0].Clusters[0].State; state != tt.wantClusterState {
+				t.Errorf("got cluster state %s, want %s", state, tt.wantClusterState)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+	"sort"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/api/admin"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+	"github.com/Azure/ARO-RP/pkg/frontend/middleware"
+)
+
+func (f *frontend) getAdminOperatorRollouts(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := ctx.Value(middleware.ContextKeyLog).(*logrus.Entry)
+	r.URL.Path = filepath.Dir(r.URL.Path)
+
+	converter := f.apis[admin.APIVersion].OperatorRolloutConverter
+
+	docs, err := f.dbOperatorRollouts.ListAll(ctx)
+	if err != nil {
+		api.WriteError(w, http.StatusInternalServerError, api.CloudErrorCodeInternalServerError, "", "Internal server error.")
+		return
+	}
+
+	var rollouts []*api.OperatorRollout
+	if docs != nil {
+		for _, doc := range docs.OperatorRolloutDocuments {
+			rollouts = append(rollouts, doc.OperatorRollout)
+		}
+	}
+
+	// newest first
+	sort.Slice(rollouts, func(i, j int) bool {
+		return rollouts[i].Properties.CreatedAt.After(rollouts[j].Properties.CreatedAt)
+	})
+
+	b, err := json.MarshalIndent(converter.ToExternalList(rollouts), "", "    ")
+	adminReply(log, w, nil, b, err)
+}
+
+func (f *frontend) getAdminOperatorRollout(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := ctx.Value(middleware.ContextKeyLog).(*logrus.Entry)
+	r.URL.Path = filepath.Dir(r.URL.Path)
+
+	b, err := f._getAdminOperatorRollout(ctx, chi.URLParam(r, "operatorRolloutId"))
+	adminReply(log, w, nil, b, err)
+}
+
+func (f *frontend) _getAdminOperatorRollout(ctx context.Context, id string) ([]byte, error) {
+	converter := f.apis[admin.APIVersion].OperatorRolloutConverter
+
+	doc, err := f.dbOperatorRollouts.Get(ctx, id)
+	switch {
+	case cosmosdb.IsErrorStatusCode(err, http.StatusNotFound):
+		return nil, api.NewCloudError(http.StatusNotFound, api.CloudErrorCodeNotFound, "", "The operator rollout '%s' could not be found.", id)
+	case err != nil:
+		return nil, err
+	}
+
+	return json.MarshalIndent(converter.ToExternal(doc.OperatorRollout), "", "    ")
+}
@@ -0,0 +1,94 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/api/admin"
+	"github.com/Azure/ARO-RP/pkg/frontend/middleware"
+	"github.com/Azure/ARO-RP/pkg/util/version"
+)
+
+// putAdminOperatorRollout starts a rollout of the operator of this RP.  Only
+// one rollout may be in flight at a time.
+func (f *frontend) putAdminOperatorRollout(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := ctx.Value(middleware.ContextKeyLog).(*logrus.Entry)
+	r.URL.Path = filepath.Dir(r.URL.Path)
+
+	body := r.Context().Value(middleware.ContextKeyBody).([]byte)
+	if len(body) == 0 || !json.Valid(body) {
+		api.WriteError(w, http.StatusBadRequest, api.CloudErrorCodeInvalidRequestContent, "", "The request content was invalid and could not be deserialized.")
+		return
+	}
+
+	var ext *admin.OperatorRollout
+	err := json.Unmarshal(body, &ext)
+	if err != nil {
+		api.WriteError(w, http.StatusBadRequest, api.CloudErrorCodeInvalidRequestContent, "", "The request content could not be deserialized: "+err.Error())
+		return
+	}
+
+	b, err := f._putAdminOperatorRollout(ctx, ext)
+	if err == nil {
+		err = statusCodeError(http.StatusCreated)
+	}
+	adminReply(log, w, nil, b, err)
+}
+
+func (f *frontend) _putAdminOperatorRollout(ctx context.Context, ext *admin.OperatorRollout) ([]byte, error) {
+	converter := f.apis[admin.APIVersion].OperatorRolloutConverter
+	staticValidator := f.apis[admin.APIVersion].OperatorRolloutStaticValidator
+
+	err := staticValidator.Static(ext)
+	if err != nil {
+		return nil, err
+	}
+
+	docs, err := f.dbOperatorRollouts.ListAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if docs != nil {
+		for _, doc := range docs.OperatorRolloutDocuments {
+			if !doc.OperatorRollout.Properties.State.IsTerminal() {
+				return nil, api.NewCloudError(http.StatusConflict, api.CloudErrorCodeRequestNotAllowed, "", "The operator rollout '%s' is in state '%s'. Cancel it before starting a new rollout.", doc.ID, doc.OperatorRollout.Properties.State)
+			}
+		}
+	}
+
+	now := time.Now().UTC()
+	id := f.dbOperatorRollouts.NewUUID()
+
+	doc := &api.OperatorRolloutDocument{
+		ID: id,
+		OperatorRollout: &api.OperatorRollout{
+			ID: id,
+			Properties: api.OperatorRolloutProperties{
+				Version:        version.GitCommit,
+				State:          api.OperatorRolloutStateProgressing,
+				CreatedAt:      now,
+				LastUpdateTime: now,
+			},
+		},
+	}
+
+	converter.ToInternal(ext, doc.OperatorRollout)
+
+	doc, err = f.dbOperatorRollouts.Create(ctx, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.MarshalIndent(converter.ToExternal(doc.OperatorRollout), "", "    ")
+}
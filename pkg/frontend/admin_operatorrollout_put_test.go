@@ -0,0 +1,135 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/api/admin"
+	"github.com/Azure/ARO-RP/pkg/metrics/noop"
+	"github.com/Azure/ARO-RP/pkg/util/version"
+)
+
+func TestAdminOperatorRolloutPut(t *testing.T) {
+	ctx := context.Background()
+
+	for _, tt := range []struct {
+		name           string
+		existing       *api.OperatorRolloutDocument
+		body           *admin.OperatorRollout
+		wantStatusCode int
+		wantError      string
+	}{
+		{
+			name: "rollout is started",
+			existing: &api.OperatorRolloutDocument{
+				ID: "00000000-0000-0000-0000-000000000000",
+				OperatorRollout: &api.OperatorRollout{
+					Properties: api.OperatorRolloutProperties{State: api.OperatorRolloutStateCompleted},
+				},
+			},
+			body: &admin.OperatorRollout{
+				Properties: admin.OperatorRolloutProperties{
+					Waves: []admin.OperatorRolloutWave{
+						{Name: "canary", Subscriptions: []string{"00000000-0000-0000-0000-000000000000"}},
+						{Name: "rest", Percentage: 100},
+					},
+				},
+			},
+			wantStatusCode: http.StatusCreated,
+		},
+		{
+			name: "invalid rollout",
+			body: &admin.OperatorRollout{
+				Properties: admin.OperatorRolloutProperties{
+					Waves: []admin.OperatorRolloutWave{{Percentage: 50}},
+				},
+			},
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      "400: InvalidParameter: properties.waves: The last percentage wave must update 100% of the clusters.",
+		},
+		{
+			name: "rollout already in flight",
+			existing: &api.OperatorRolloutDocument{
+				ID: "00000000-0000-0000-0000-000000000000",
+				OperatorRollout: &api.OperatorRollout{
+					Properties: api.OperatorRolloutProperties{State: api.OperatorRolloutStateHalted},
+				},
+			},
+			body: &admin.OperatorRollout{
+				Properties: admin.OperatorRolloutProperties{
+					Waves: []admin.OperatorRolloutWave{{Percentage: 100}},
+				},
+			},
+			wantStatusCode: http.StatusConflict,
+			wantError:      "409: RequestNotAllowed: : The operator rollout '00000000-0000-0000-0000-000000000000' is in state 'Halted'. Cancel it before starting a new rollout.",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ti := newTestInfra(t).WithOperatorRollouts()
+			defer ti.done()
+
+			if tt.existing != nil {
+				_, err := ti.operatorRolloutsDatabase.Create(ctx, tt.existing)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			f, err := NewFrontend(ctx, ti.audit, ti.log, ti.env, nil, nil, nil, nil, nil, nil, ti.operatorRolloutsDatabase, api.APIs, &noop.Noop{}, &noop.Noop{}, nil, nil, nil, nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			go f.Run(ctx, nil, nil)
+
+			resp, b, err := ti.request(http.MethodPut, "https://server/admin/operatorrollouts",
+				http.Header{
+					"Content-Type": []string{"application/json"},
+				}, tt.body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if tt.wantError != "" {
+				err = validateResponse(resp, b, tt.wantStatusCode, tt.wantError, nil)
+				if err != nil {
+					t.Error(err)
+				}
+				return
+			}
+
+			if resp.StatusCode != tt.wantStatusCode {
+				t.Fatalf("unexpected status code %d, wanted %d: %s", resp.StatusCode, tt.wantStatusCode, string(b))
+			}
+
+			docs, err := ti.operatorRolloutsDatabase.ListAll(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var started *api.OperatorRollout
+			for _, doc := range docs.OperatorRolloutDocuments {
+				if doc.OperatorRollout.Properties.State == api.OperatorRolloutStateProgressing {
+					started = doc.OperatorRollout
+				}
+			}
+			if started == nil {
+				t.Fatal("rollout not started")
+			}
+			if started.Properties.Version != version.GitCommit {
+				t.Errorf("got version %q", started.Properties.Version)
+			}
+			if started.Properties.MaxDegradedPercent != 10 {
+				t.Errorf("got maxDegradedPercent %d", started.Properties.MaxDegradedPercent)
+			}
+			if len(started.Properties.Waves) != 2 || started.Properties.Waves[0].State != api.OperatorRolloutWaveStatePending {
+				t.Errorf("got waves %#v", started.Properties.Waves)
+			}
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package adminactions
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/util/certinventory"
+)
+
+func (k *kubeActions) CertificateInventory(ctx context.Context, doc *api.OpenShiftClusterDocument) (*api.CertificateInventory, error) {
+	return certinventory.List(ctx, k.kubecli, doc)
+}
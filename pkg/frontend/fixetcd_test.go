@@ -519,10 +519,11 @@ func TestFixEtcd(t *testing.T) {
 				ti.log,
 				ti.env,
 				ti.asyncOperationsDatabase,
-				ti.clusterManagerDatabase,
+				ti.clusterManagerDatabase, ti.gatewayDatabase,
 				ti.openShiftClustersDatabase,
 				ti.subscriptionsDatabase,
 				nil,
+				nil,
 				api.APIs,
 				&noop.Noop{},
 				&noop.Noop{},
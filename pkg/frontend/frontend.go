@@ -59,9 +59,11 @@ type frontend struct {
 
 	dbAsyncOperations             database.AsyncOperations
 	dbClusterManagerConfiguration database.ClusterManagerConfigurations
+	dbGateway                     database.Gateway
 	dbOpenShiftClusters           database.OpenShiftClusters
 	dbSubscriptions               database.Subscriptions
 	dbOpenShiftVersions           database.OpenShiftVersions
+	dbOperatorRollouts            database.OperatorRollouts
 
 	defaultOcpVersion  string // always enabled
 	enabledOcpVersions map[string]*api.OpenShiftVersion
@@ -72,7 +74,7 @@ type frontend struct {
 
 	aead encryption.AEAD
 
-	hiveClusterManager  hive.ClusterManager
+	hiveShardManager    hive.ShardManager
 	kubeActionsFactory  kubeActionsFactory
 	azureActionsFactory azureActionsFactory
 
@@ -114,14 +116,16 @@ func NewFrontend(ctx context.Context,
 	_env env.Interface,
 	dbAsyncOperations database.AsyncOperations,
 	dbClusterManagerConfiguration database.ClusterManagerConfigurations,
+	dbGateway database.Gateway,
 	dbOpenShiftClusters database.OpenShiftClusters,
 	dbSubscriptions database.Subscriptions,
 	dbOpenShiftVersions database.OpenShiftVersions,
+	dbOperatorRollouts database.OperatorRollouts,
 	apis map[string]*api.Version,
 	m metrics.Emitter,
 	clusterm metrics.Emitter,
 	aead encryption.AEAD,
-	hiveClusterManager hive.ClusterManager,
+	hiveShardManager hive.ShardManager,
 	kubeActionsFactory kubeActionsFactory,
 	azureActionsFactory azureActionsFactory,
 	enricher clusterdata.BestEffortEnricher,
@@ -145,19 +149,22 @@ func NewFrontend(ctx context.Context,
 			Apis:     api.APIs,
 		},
 		authMiddleware: middleware.AuthMiddleware{
-			AdminAuth: _env.AdminClientAuthorizer(),
-			ArmAuth:   _env.ArmClientAuthorizer(),
+			AdminAuth:      _env.AdminClientAuthorizer(),
+			ArmAuth:        _env.ArmClientAuthorizer(),
+			HealthGateAuth: _env.HealthGateClientAuthorizer(),
 		},
 		dbAsyncOperations:             dbAsyncOperations,
 		dbClusterManagerConfiguration: dbClusterManagerConfiguration,
+		dbGateway:                     dbGateway,
 		dbOpenShiftClusters:           dbOpenShiftClusters,
 		dbSubscriptions:               dbSubscriptions,
 		dbOpenShiftVersions:           dbOpenShiftVersions,
+		dbOperatorRollouts:            dbOperatorRollouts,
 		apis:                          apis,
 		m:                             middleware.MetricsMiddleware{Emitter: m},
 		maintenanceMiddleware:         middleware.MaintenanceMiddleware{Emitter: clusterm},
 		aead:                          aead,
-		hiveClusterManager:            hiveClusterManager,
+		hiveShardManager:              hiveShardManager,
 		kubeActionsFactory:            kubeActionsFactory,
 		azureActionsFactory:           azureActionsFactory,
 
@@ -287,6 +294,14 @@ func (f *frontend) chiAuthenticatedRoutes(router chi.Router) {
 		r.Route("/versions", func(r chi.Router) {
 			r.Get("/", f.getAdminOpenShiftVersions)
 			r.Put("/", f.putAdminOpenShiftVersion)
+			r.Get("/export", f.getAdminOpenShiftVersionsExport)
+			r.Post("/import", f.postAdminOpenShiftVersionsImport)
+		})
+		r.Route("/operatorrollouts", func(r chi.Router) {
+			r.Get("/", f.getAdminOperatorRollouts)
+			r.Put("/", f.putAdminOperatorRollout)
+			r.Get("/{operatorRolloutId}", f.getAdminOperatorRollout)
+			r.Post("/{operatorRolloutId}/{action}", f.postAdminOperatorRolloutAction)
 		})
 		r.Get("/supportedvmsizes", f.supportedvmsizes)
 
@@ -311,6 +326,10 @@ func (f *frontend) chiAuthenticatedRoutes(router chi.Router) {
 
 				r.Get("/clusterdeployment", f.getAdminHiveClusterDeployment)
 
+				r.Post("/hiveshard", f.postAdminOpenShiftClusterHiveShard)
+
+				r.Post("/upgrade", f.postAdminOpenShiftClusterUpgrade)
+
 				r.With(f.maintenanceMiddleware.UnplannedMaintenanceSignal).Post("/redeployvm", f.postAdminOpenShiftClusterRedeployVM)
 
 				r.With(f.maintenanceMiddleware.UnplannedMaintenanceSignal).Post("/stopvm", f.postAdminOpenShiftClusterStopVM)
@@ -330,6 +349,11 @@ func (f *frontend) chiAuthenticatedRoutes(router chi.Router) {
 
 				r.With(f.maintenanceMiddleware.UnplannedMaintenanceSignal).Post("/etcdcertificaterenew", f.postAdminOpenShiftClusterEtcdCertificateRenew)
 				r.With(f.maintenanceMiddleware.UnplannedMaintenanceSignal).Post("/deletemanagedresource", f.postAdminOpenShiftDeleteManagedResource)
+
+				r.Get("/gatewayallowlist", f.getAdminOpenShiftClusterGatewayAllowList)
+				r.Put("/gatewayallowlist", f.putAdminOpenShiftClusterGatewayAllowList)
+
+				r.Get("/certificates", f.getAdminOpenShiftClusterCertificates)
 			})
 		})
 
@@ -15,19 +15,23 @@ import (
 type AuthMiddleware struct {
 	AdminAuth clientauthorizer.ClientAuthorizer
 	ArmAuth   clientauthorizer.ClientAuthorizer
+
+	// HealthGateAuth authorizes the deployer's health gate requests, which
+	// are limited to reads and preflight validation
+	HealthGateAuth clientauthorizer.ClientAuthorizer
 }
 
 func (a AuthMiddleware) Authenticate(h http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		apiVersion := r.URL.Query().Get(api.APIVersionKey)
-		var clientAuthorizer clientauthorizer.ClientAuthorizer
+		var authorized bool
 		if apiVersion == admin.APIVersion || strings.HasPrefix(r.URL.Path, "/admin") {
-			clientAuthorizer = a.AdminAuth
+			authorized = a.AdminAuth.IsAuthorized(r.TLS)
 		} else {
-			clientAuthorizer = a.ArmAuth
+			authorized = a.ArmAuth.IsAuthorized(r.TLS) || a.isHealthGateAuthorized(r)
 		}
 
-		if !clientAuthorizer.IsAuthorized(r.TLS) {
+		if !authorized {
 			api.WriteError(w, http.StatusForbidden, api.CloudErrorCodeForbidden, "", "Forbidden.")
 			return
 		}
@@ -35,3 +39,18 @@ func (a AuthMiddleware) Authenticate(h http.Handler) http.Handler {
 		h.ServeHTTP(w, r)
 	})
 }
+
+func (a AuthMiddleware) isHealthGateAuthorized(r *http.Request) bool {
+	if a.HealthGateAuth == nil {
+		return false
+	}
+
+	switch {
+	case r.Method == http.MethodGet:
+	case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/preflight"):
+	default:
+		return false
+	}
+
+	return a.HealthGateAuth.IsAuthorized(r.TLS)
+}
@@ -0,0 +1,131 @@
+package middleware
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Azure/ARO-RP/pkg/util/clientauthorizer"
+	utiltls "github.com/Azure/ARO-RP/pkg/util/tls"
+)
+
+func TestAuthenticate(t *testing.T) {
+	_, armcerts, err := utiltls.GenerateKeyAndCertificate("arm", nil, nil, false, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, admincerts, err := utiltls.GenerateKeyAndCertificate("admin", nil, nil, false, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, healthgatecerts, err := utiltls.GenerateKeyAndCertificate("healthgate", nil, nil, false, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a := AuthMiddleware{
+		AdminAuth:      clientauthorizer.NewOne(admincerts[0].Raw),
+		ArmAuth:        clientauthorizer.NewOne(armcerts[0].Raw),
+		HealthGateAuth: clientauthorizer.NewOne(healthgatecerts[0].Raw),
+	}
+
+	for _, tt := range []struct {
+		name           string
+		method         string
+		url            string
+		cert           *x509.Certificate
+		wantStatusCode int
+	}{
+		{
+			name:           "arm certificate",
+			method:         http.MethodPut,
+			url:            "/subscriptions/sub/resourcegroups/rg/providers/Microsoft.RedHatOpenShift/openShiftClusters/cluster?api-version=2023-11-22",
+			cert:           armcerts[0],
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "admin certificate on arm url",
+			method:         http.MethodGet,
+			url:            "/providers/Microsoft.RedHatOpenShift/operations?api-version=2023-11-22",
+			cert:           admincerts[0],
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "admin certificate on admin url",
+			method:         http.MethodGet,
+			url:            "/admin/versions",
+			cert:           admincerts[0],
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "no certificate",
+			method:         http.MethodGet,
+			url:            "/providers/Microsoft.RedHatOpenShift/operations?api-version=2023-11-22",
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "health gate certificate on get",
+			method:         http.MethodGet,
+			url:            "/providers/Microsoft.RedHatOpenShift/operations?api-version=2023-11-22",
+			cert:           healthgatecerts[0],
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "health gate certificate on preflight",
+			method:         http.MethodPost,
+			url:            "/subscriptions/sub/resourcegroups/rg/providers/Microsoft.RedHatOpenShift/deployments/healthgate/preflight?api-version=2023-11-22",
+			cert:           healthgatecerts[0],
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "health gate certificate on put",
+			method:         http.MethodPut,
+			url:            "/subscriptions/sub/resourcegroups/rg/providers/Microsoft.RedHatOpenShift/openShiftClusters/cluster?api-version=2023-11-22",
+			cert:           healthgatecerts[0],
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "health gate certificate on other post",
+			method:         http.MethodPost,
+			url:            "/subscriptions/sub/resourcegroups/rg/providers/Microsoft.RedHatOpenShift/openShiftClusters/cluster/listcredentials?api-version=2023-11-22",
+			cert:           healthgatecerts[0],
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "health gate certificate on admin url",
+			method:         http.MethodGet,
+			url:            "/admin/versions",
+			cert:           healthgatecerts[0],
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "health gate certificate with admin api version",
+			method:         http.MethodGet,
+			url:            "/providers/Microsoft.RedHatOpenShift/operations?api-version=admin",
+			cert:           healthgatecerts[0],
+			wantStatusCode: http.StatusForbidden,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(tt.method, tt.url, nil)
+			r.TLS = &tls.ConnectionState{}
+			if tt.cert != nil {
+				r.TLS.PeerCertificates = []*x509.Certificate{tt.cert}
+			}
+			w := httptest.NewRecorder()
+
+			a.Authenticate(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})).ServeHTTP(w, r)
+
+			if w.Code != tt.wantStatusCode {
+				t.Error(w.Code)
+			}
+		})
+	}
+}
@@ -32,11 +32,11 @@ func (f *frontend) getOpenShiftClusters(w http.ResponseWriter, r *http.Request)
 		}
 
 		return f.dbOpenShiftClusters.ListByPrefix(subscriptionId, prefix, skipToken)
-	})
+	}, true)
 	reply(log, w, nil, b, err)
 }
 
-func (f *frontend) _getOpenShiftClusters(ctx context.Context, log *logrus.Entry, r *http.Request, converter api.OpenShiftClusterConverter, lister func(string) (cosmosdb.OpenShiftClusterDocumentIterator, error)) ([]byte, error) {
+func (f *frontend) _getOpenShiftClusters(ctx context.Context, log *logrus.Entry, r *http.Request, converter api.OpenShiftClusterConverter, lister func(string) (cosmosdb.OpenShiftClusterDocumentIterator, error), enrich bool) ([]byte, error) {
 	skipToken, err := f.parseSkipToken(r.URL.String())
 	if err != nil {
 		return nil, err
@@ -59,10 +59,12 @@ func (f *frontend) _getOpenShiftClusters(ctx context.Context, log *logrus.Entry,
 		}
 	}
 
-	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
-	defer cancel()
+	if enrich {
+		timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		defer cancel()
 
-	f.clusterEnricher.Enrich(timeoutCtx, log, ocs...)
+		f.clusterEnricher.Enrich(timeoutCtx, log, ocs...)
+	}
 
 	for i := range ocs {
 		ocs[i].Properties.ClusterProfile.PullSecret = ""
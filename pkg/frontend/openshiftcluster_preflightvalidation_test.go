@@ -104,6 +104,86 @@ func TestPreflightValidation(t *testing.T) {
 				Status: api.ValidationStatusSucceeded,
 			},
 		},
+		{
+			name: "Failed Preflight upgrade only version",
+			fixture: func(f *testdatabase.Fixture) {
+				f.AddSubscriptionDocuments(&api.SubscriptionDocument{
+					ID: mockSubID,
+					Subscription: &api.Subscription{
+						State: api.SubscriptionStateRegistered,
+						Properties: &api.SubscriptionProperties{
+							TenantID: "11111111-1111-1111-1111-111111111111",
+						},
+					},
+				})
+			},
+			preflightRequest: func() *api.PreflightRequest {
+				return &api.PreflightRequest{
+					Resources: []json.RawMessage{
+						[]byte(`
+								{
+									"apiVersion": "2022-04-01",
+									"id": "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourcename/providers/Microsoft.RedHatOpenShift/openShiftClusters/resourceName",
+									"name": "resourceName",
+									"type": "microsoft.redhatopenshift/openshiftclusters",
+									"location": "eastus",
+									"properties": {
+										"clusterProfile": {
+										  "domain": "example.aroapp.io",
+										  "resourceGroupId": "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourcenameTest",
+										  "fipsValidatedModules": "Enabled",
+										  "version": "4.10.1"
+										},
+										"consoleProfile": {},
+										"servicePrincipalProfile": {
+										  "clientId": "00000000-0000-0000-1111-000000000000",
+										  "clientSecret": "00000000-0000-0000-0000-000000000000"
+										},
+										"networkProfile": {
+										  "podCidr": "10.128.0.0/14",
+										  "serviceCidr": "172.30.0.0/16"
+										},
+										"masterProfile": {
+										  "vmSize": "Standard_D32s_v3",
+										  "subnetId": "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/ms-eastus/providers/Microsoft.Network/virtualNetworks/dev-vnet/subnets/CARO2-master",
+										  "encryptionAtHost": "Enabled",
+										  "diskEncryptionSetId": "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/ms-eastus/providers/Microsoft.Compute/diskEncryptionSets/ms-eastus-disk-encryption-set"
+										},
+										"workerProfiles": [
+										  {
+											"name": "worker",
+											"vmSize": "Standard_D32s_v3",
+											"diskSizeGB": 128,
+											"subnetId": "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/ms-eastus/providers/Microsoft.Network/virtualNetworks/dev-vnet/subnets/CARO2-worker",
+											"count": 3,
+											"encryptionAtHost": "Enabled",
+											"diskEncryptionSetId": "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/ms-eastus/providers/Microsoft.Compute/diskEncryptionSets/ms-eastus-disk-encryption-set"
+										  }
+										],
+										"apiserverProfile": {
+										  "visibility": "Public"
+										},
+										"ingressProfiles": [
+										  {
+											"name": "default",
+											"visibility": "Public"
+										  }
+										]
+									  }
+								}
+						`),
+					},
+				}
+			},
+			wantStatusCode: http.StatusOK,
+			wantResponse: &api.ValidationResult{
+				Status: api.ValidationStatusFailed,
+				Error: &api.ManagementErrorWithDetails{
+					Code:    to.StringPtr("InvalidParameter"),
+					Message: to.StringPtr("400: InvalidParameter: properties.clusterProfile.version: The requested OpenShift version '4.10.1' is invalid."),
+				},
+			},
+		},
 		{
 			name: "Failed Preflight Static",
 			fixture: func(f *testdatabase.Fixture) {
@@ -158,7 +238,7 @@ func TestPreflightValidation(t *testing.T) {
 				t.Fatal(err)
 			}
 
-			f, err := NewFrontend(ctx, ti.audit, ti.log, ti.env, ti.asyncOperationsDatabase, ti.clusterManagerDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, ti.openShiftVersionsDatabase, api.APIs, &noop.Noop{}, &noop.Noop{}, nil, nil, nil, nil, nil)
+			f, err := NewFrontend(ctx, ti.audit, ti.log, ti.env, ti.asyncOperationsDatabase, ti.clusterManagerDatabase, ti.gatewayDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, ti.openShiftVersionsDatabase, nil, api.APIs, &noop.Noop{}, &noop.Noop{}, nil, nil, nil, nil, nil)
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -173,6 +253,12 @@ func TestPreflightValidation(t *testing.T) {
 						Version: f.defaultOcpVersion,
 					},
 				},
+				"4.10.1": {
+					Properties: api.OpenShiftVersionProperties{
+						Version:     "4.10.1",
+						UpgradeOnly: true,
+					},
+				},
 			}
 			f.mu.Unlock()
 
@@ -171,9 +171,27 @@ func (f *frontend) _putOrPatchOpenShiftCluster(ctx context.Context, log *logrus.
 	}
 
 	oldID, oldName, oldType, oldSystemData := doc.OpenShiftCluster.ID, doc.OpenShiftCluster.Name, doc.OpenShiftCluster.Type, doc.OpenShiftCluster.SystemData
+	oldWorkerProfiles := append([]api.WorkerProfile(nil), doc.OpenShiftCluster.Properties.WorkerProfiles...)
+	enrichedWorkerProfiles := doc.OpenShiftCluster.Properties.WorkerProfilesStatus
 	converter.ToInternal(ext, doc.OpenShiftCluster)
 	doc.OpenShiftCluster.ID, doc.OpenShiftCluster.Name, doc.OpenShiftCluster.Type, doc.OpenShiftCluster.SystemData = oldID, oldName, oldType, oldSystemData
 
+	if !isCreate {
+		err = validateAutoscaledWorkerProfiles(doc.OpenShiftCluster, oldWorkerProfiles, enrichedWorkerProfiles)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if !isCreate && workerProfilesChanged(oldWorkerProfiles, doc.OpenShiftCluster.Properties.WorkerProfiles) {
+		err = f.validateWorkerProfilesUpdate(ctx, subscription, doc.OpenShiftCluster, oldWorkerProfiles)
+		if err != nil {
+			return nil, err
+		}
+
+		doc.OpenShiftCluster.Properties.WorkerProfilesUpdating = true
+	}
+
 	// This will update systemData from the values in the header. Old values, which
 	// is not provided in the header must be preserved
 	f.systemDataClusterDocEnricher(doc, systemData)
@@ -300,6 +318,63 @@ func (f *frontend) ValidateNewCluster(ctx context.Context, subscription *api.Sub
 	return nil
 }
 
+// validateWorkerProfilesUpdate checks that the VM sizes and quota needed to
+// scale or resize the worker profiles of an existing cluster are available
+func (f *frontend) validateWorkerProfilesUpdate(ctx context.Context, subscription *api.SubscriptionDocument, cluster *api.OpenShiftCluster, current []api.WorkerProfile) error {
+	err := f.skuValidator.ValidateWorkerProfilesVMSku(ctx, f.env.Environment(), f.env, subscription.ID, subscription.Subscription.Properties.TenantID, cluster, current)
+	if err != nil {
+		return err
+	}
+
+	return f.quotaValidator.ValidateWorkerProfilesQuota(ctx, f.env.Environment(), f.env, subscription.ID, subscription.Subscription.Properties.TenantID, cluster, current)
+}
+
+// validateAutoscaledWorkerProfiles stops worker profile updates from fighting
+// the cluster autoscaler over the worker machinesets, whatever the API version
+// of the request.  The count of a worker profile can't be changed while any of
+// its machinesets (named after the profile) is autoscaled, and the replicas of
+// each autoscaled machineset, as enriched from the cluster, must be within its
+// limits.
+func validateAutoscaledWorkerProfiles(oc *api.OpenShiftCluster, current, enriched []api.WorkerProfile) error {
+	if oc.Properties.AutoscalerProfile == nil {
+		return nil
+	}
+
+	for _, awp := range oc.Properties.AutoscalerProfile.WorkerProfiles {
+		for _, wp := range oc.Properties.WorkerProfiles {
+			cwp := findWorkerProfile(current, wp.Name)
+			if cwp != nil && cwp.Count != wp.Count &&
+				strings.HasPrefix(awp.Name, oc.Properties.InfraID+"-"+wp.Name+"-") {
+				return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodePropertyChangeNotAllowed, "properties.workerProfiles['"+wp.Name+"'].count", "The worker count cannot be changed while machineset '%s' is autoscaled.", awp.Name)
+			}
+		}
+
+		ewp := findWorkerProfile(enriched, awp.Name)
+		if ewp != nil && (ewp.Count < awp.MinCount || ewp.Count > awp.MaxCount) {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "properties.autoscalerProfile.workerProfiles['"+awp.Name+"']", "The provided minCount '%d' and maxCount '%d' are invalid: the machineset has %d replicas.", awp.MinCount, awp.MaxCount, ewp.Count)
+		}
+	}
+
+	return nil
+}
+
+// workerProfilesChanged returns true if the size or count of any worker
+// profile differs between current and wps
+func workerProfilesChanged(current, wps []api.WorkerProfile) bool {
+	for _, wp := range wps {
+		cwp := findWorkerProfile(current, wp.Name)
+		if cwp == nil {
+			continue
+		}
+
+		if cwp.VMSize != wp.VMSize || cwp.Count != wp.Count {
+			return true
+		}
+	}
+
+	return false
+}
+
 // setUpdateProvisioningState Sets either the admin update or update provisioning state
 func setUpdateProvisioningState(doc *api.OpenShiftClusterDocument, apiVersion string) {
 	switch apiVersion {
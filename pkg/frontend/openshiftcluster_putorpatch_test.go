@@ -26,6 +26,7 @@ import (
 	mock_frontend "github.com/Azure/ARO-RP/pkg/util/mocks/frontend"
 	"github.com/Azure/ARO-RP/pkg/util/version"
 	testdatabase "github.com/Azure/ARO-RP/test/database"
+	utilerror "github.com/Azure/ARO-RP/test/util/error"
 )
 
 type dummyOpenShiftClusterValidator struct{}
@@ -1727,7 +1728,7 @@ func TestPutOrPatchOpenShiftClusterAdminAPI(t *testing.T) {
 				t.Fatal(err)
 			}
 
-			f, err := NewFrontend(ctx, ti.audit, ti.log, ti.env, ti.asyncOperationsDatabase, ti.clusterManagerDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, nil, apis, &noop.Noop{}, &noop.Noop{}, nil, nil, nil, nil, ti.enricher)
+			f, err := NewFrontend(ctx, ti.audit, ti.log, ti.env, ti.asyncOperationsDatabase, ti.clusterManagerDatabase, ti.gatewayDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, nil, nil, apis, &noop.Noop{}, &noop.Noop{}, nil, nil, nil, nil, ti.enricher)
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -2474,6 +2475,220 @@ func TestPutOrPatchOpenShiftCluster(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "patch a cluster to scale its workers",
+			request: func(oc *v20200430.OpenShiftCluster) {
+				oc.Properties.WorkerProfiles = []v20200430.WorkerProfile{{Name: "worker", VMSize: v20200430.VMSizeStandardD4sV3, Count: 5}}
+			},
+			isPatch: true,
+			fixture: func(f *testdatabase.Fixture) {
+				f.AddSubscriptionDocuments(&api.SubscriptionDocument{
+					ID: mockSubID,
+					Subscription: &api.Subscription{
+						State: api.SubscriptionStateRegistered,
+						Properties: &api.SubscriptionProperties{
+							TenantID: "11111111-1111-1111-1111-111111111111",
+						},
+					},
+				})
+				f.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+					Key: strings.ToLower(testdatabase.GetResourcePath(mockSubID, "resourceName")),
+					OpenShiftCluster: &api.OpenShiftCluster{
+						ID:   testdatabase.GetResourcePath(mockSubID, "resourceName"),
+						Name: "resourceName",
+						Type: "Microsoft.RedHatOpenShift/openShiftClusters",
+						Properties: api.OpenShiftClusterProperties{
+							ProvisioningState: api.ProvisioningStateSucceeded,
+							WorkerProfiles: []api.WorkerProfile{
+								{
+									Name:             "worker",
+									VMSize:           api.VMSizeStandardD4sV3,
+									Count:            3,
+									EncryptionAtHost: api.EncryptionAtHostDisabled,
+								},
+							},
+							NetworkProfile: api.NetworkProfile{
+								SoftwareDefinedNetwork: api.SoftwareDefinedNetworkOpenShiftSDN,
+								OutboundType:           api.OutboundTypeLoadbalancer,
+							},
+							MasterProfile: api.MasterProfile{
+								EncryptionAtHost: api.EncryptionAtHostDisabled,
+							},
+							OperatorFlags: api.OperatorFlags{},
+						},
+					},
+				})
+			},
+			wantSystemDataEnriched: true,
+			wantDocuments: func(c *testdatabase.Checker) {
+				c.AddAsyncOperationDocuments(&api.AsyncOperationDocument{
+					OpenShiftClusterKey: strings.ToLower(testdatabase.GetResourcePath(mockSubID, "resourceName")),
+					AsyncOperation: &api.AsyncOperation{
+						InitialProvisioningState: api.ProvisioningStateUpdating,
+						ProvisioningState:        api.ProvisioningStateUpdating,
+					},
+				})
+				c.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+					Key: strings.ToLower(testdatabase.GetResourcePath(mockSubID, "resourceName")),
+					OpenShiftCluster: &api.OpenShiftCluster{
+						ID:   testdatabase.GetResourcePath(mockSubID, "resourceName"),
+						Name: "resourceName",
+						Type: "Microsoft.RedHatOpenShift/openShiftClusters",
+						Properties: api.OpenShiftClusterProperties{
+							ProvisioningState:     api.ProvisioningStateUpdating,
+							LastProvisioningState: api.ProvisioningStateSucceeded,
+							ClusterProfile: api.ClusterProfile{
+								FipsValidatedModules: api.FipsValidatedModulesDisabled,
+							},
+							WorkerProfiles: []api.WorkerProfile{
+								{
+									Name:             "worker",
+									VMSize:           api.VMSizeStandardD4sV3,
+									Count:            5,
+									EncryptionAtHost: api.EncryptionAtHostDisabled,
+								},
+							},
+							WorkerProfilesUpdating: true,
+							NetworkProfile: api.NetworkProfile{
+								SoftwareDefinedNetwork: api.SoftwareDefinedNetworkOpenShiftSDN,
+								OutboundType:           api.OutboundTypeLoadbalancer,
+								PreconfiguredNSG:       api.PreconfiguredNSGDisabled,
+								LoadBalancerProfile: &api.LoadBalancerProfile{
+									ManagedOutboundIPs: &api.ManagedOutboundIPs{
+										Count: 1,
+									},
+								},
+							},
+							MasterProfile: api.MasterProfile{
+								EncryptionAtHost: api.EncryptionAtHostDisabled,
+							},
+							OperatorFlags: api.OperatorFlags{},
+						},
+					},
+				})
+			},
+			wantEnriched:   []string{testdatabase.GetResourcePath(mockSubID, "resourceName")},
+			wantAsync:      true,
+			wantStatusCode: http.StatusOK,
+			wantResponse: &v20200430.OpenShiftCluster{
+				ID:   testdatabase.GetResourcePath(mockSubID, "resourceName"),
+				Name: "resourceName",
+				Type: "Microsoft.RedHatOpenShift/openShiftClusters",
+				Properties: v20200430.OpenShiftClusterProperties{
+					ProvisioningState: v20200430.ProvisioningStateUpdating,
+					WorkerProfiles:    []v20200430.WorkerProfile{{Name: "worker", VMSize: v20200430.VMSizeStandardD4sV3, Count: 5}},
+				},
+			},
+		},
+		{
+			name: "patch a cluster to scale its workers beyond quota",
+			request: func(oc *v20200430.OpenShiftCluster) {
+				oc.Properties.WorkerProfiles = []v20200430.WorkerProfile{{Name: "worker", VMSize: v20200430.VMSizeStandardD4sV3, Count: 50}}
+			},
+			isPatch: true,
+			fixture: func(f *testdatabase.Fixture) {
+				f.AddSubscriptionDocuments(&api.SubscriptionDocument{
+					ID: mockSubID,
+					Subscription: &api.Subscription{
+						State: api.SubscriptionStateRegistered,
+						Properties: &api.SubscriptionProperties{
+							TenantID: "11111111-1111-1111-1111-111111111111",
+						},
+					},
+				})
+				f.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+					Key: strings.ToLower(testdatabase.GetResourcePath(mockSubID, "resourceName")),
+					OpenShiftCluster: &api.OpenShiftCluster{
+						ID:   testdatabase.GetResourcePath(mockSubID, "resourceName"),
+						Name: "resourceName",
+						Type: "Microsoft.RedHatOpenShift/openShiftClusters",
+						Properties: api.OpenShiftClusterProperties{
+							ProvisioningState: api.ProvisioningStateSucceeded,
+							WorkerProfiles: []api.WorkerProfile{
+								{
+									Name:             "worker",
+									VMSize:           api.VMSizeStandardD4sV3,
+									Count:            3,
+									EncryptionAtHost: api.EncryptionAtHostDisabled,
+								},
+							},
+							NetworkProfile: api.NetworkProfile{
+								SoftwareDefinedNetwork: api.SoftwareDefinedNetworkOpenShiftSDN,
+								OutboundType:           api.OutboundTypeLoadbalancer,
+							},
+							MasterProfile: api.MasterProfile{
+								EncryptionAtHost: api.EncryptionAtHostDisabled,
+							},
+							OperatorFlags: api.OperatorFlags{},
+						},
+					},
+				})
+			},
+			quotaValidatorError:    api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeResourceQuotaExceeded, "", "Resource quota of cores exceeded. Maximum allowed: 20, Current in use: 12, Additional requested: 188."),
+			wantSystemDataEnriched: false,
+			wantEnriched:           []string{testdatabase.GetResourcePath(mockSubID, "resourceName")},
+			wantStatusCode:         http.StatusBadRequest,
+			wantError:              "400: ResourceQuotaExceeded: : Resource quota of cores exceeded. Maximum allowed: 20, Current in use: 12, Additional requested: 188.",
+		},
+		{
+			name: "patch a cluster to scale autoscaled workers",
+			request: func(oc *v20200430.OpenShiftCluster) {
+				oc.Properties.WorkerProfiles = []v20200430.WorkerProfile{{Name: "worker", VMSize: v20200430.VMSizeStandardD4sV3, Count: 5}}
+			},
+			isPatch: true,
+			fixture: func(f *testdatabase.Fixture) {
+				f.AddSubscriptionDocuments(&api.SubscriptionDocument{
+					ID: mockSubID,
+					Subscription: &api.Subscription{
+						State: api.SubscriptionStateRegistered,
+						Properties: &api.SubscriptionProperties{
+							TenantID: "11111111-1111-1111-1111-111111111111",
+						},
+					},
+				})
+				f.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+					Key: strings.ToLower(testdatabase.GetResourcePath(mockSubID, "resourceName")),
+					OpenShiftCluster: &api.OpenShiftCluster{
+						ID:   testdatabase.GetResourcePath(mockSubID, "resourceName"),
+						Name: "resourceName",
+						Type: "Microsoft.RedHatOpenShift/openShiftClusters",
+						Properties: api.OpenShiftClusterProperties{
+							ProvisioningState: api.ProvisioningStateSucceeded,
+							InfraID:           "infra",
+							WorkerProfiles: []api.WorkerProfile{
+								{
+									Name:             "worker",
+									VMSize:           api.VMSizeStandardD4sV3,
+									Count:            3,
+									EncryptionAtHost: api.EncryptionAtHostDisabled,
+								},
+							},
+							AutoscalerProfile: &api.AutoscalerProfile{
+								WorkerProfiles: []api.AutoscalerWorkerProfile{
+									{
+										Name:     "infra-worker-eastus1",
+										MinCount: 1,
+										MaxCount: 5,
+									},
+								},
+							},
+							NetworkProfile: api.NetworkProfile{
+								SoftwareDefinedNetwork: api.SoftwareDefinedNetworkOpenShiftSDN,
+								OutboundType:           api.OutboundTypeLoadbalancer,
+							},
+							MasterProfile: api.MasterProfile{
+								EncryptionAtHost: api.EncryptionAtHostDisabled,
+							},
+							OperatorFlags: api.OperatorFlags{},
+						},
+					},
+				})
+			},
+			wantSystemDataEnriched: false,
+			wantEnriched:           []string{testdatabase.GetResourcePath(mockSubID, "resourceName")},
+			wantStatusCode:         http.StatusBadRequest,
+			wantError:              "400: PropertyChangeNotAllowed: properties.workerProfiles['worker'].count: The worker count cannot be changed while machineset 'infra-worker-eastus1' is autoscaled.",
+		},
 		{
 			name: "patch a cluster from failed during update",
 			request: func(oc *v20200430.OpenShiftCluster) {
@@ -2783,10 +2998,12 @@ func TestPutOrPatchOpenShiftCluster(t *testing.T) {
 
 			mockQuotaValidator := mock_frontend.NewMockQuotaValidator(controller)
 			mockQuotaValidator.EXPECT().ValidateQuota(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(tt.quotaValidatorError).AnyTimes()
+			mockQuotaValidator.EXPECT().ValidateWorkerProfilesQuota(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(tt.quotaValidatorError).AnyTimes()
 
 			mockSkuValidator := mock_frontend.NewMockSkuValidator(controller)
 
 			mockSkuValidator.EXPECT().ValidateVMSku(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(tt.skuValidatorError).AnyTimes()
+			mockSkuValidator.EXPECT().ValidateWorkerProfilesVMSku(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(tt.skuValidatorError).AnyTimes()
 			mockProvidersValidator := mock_frontend.NewMockProvidersValidator(controller)
 			mockProvidersValidator.EXPECT().ValidateProviders(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(tt.providersValidatorError).AnyTimes()
 
@@ -2795,7 +3012,7 @@ func TestPutOrPatchOpenShiftCluster(t *testing.T) {
 				t.Fatal(err)
 			}
 
-			f, err := NewFrontend(ctx, ti.audit, ti.log, ti.env, ti.asyncOperationsDatabase, ti.clusterManagerDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, ti.openShiftVersionsDatabase, apis, &noop.Noop{}, &noop.Noop{}, nil, nil, nil, nil, ti.enricher)
+			f, err := NewFrontend(ctx, ti.audit, ti.log, ti.env, ti.asyncOperationsDatabase, ti.clusterManagerDatabase, ti.gatewayDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, ti.openShiftVersionsDatabase, nil, apis, &noop.Noop{}, &noop.Noop{}, nil, nil, nil, nil, ti.enricher)
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -3128,7 +3345,7 @@ func TestPutOrPatchOpenShiftClusterValidated(t *testing.T) {
 				t.Fatal(err)
 			}
 
-			f, err := NewFrontend(ctx, ti.audit, ti.log, ti.env, ti.asyncOperationsDatabase, ti.clusterManagerDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, ti.openShiftVersionsDatabase, api.APIs, &noop.Noop{}, &noop.Noop{}, nil, nil, nil, nil, ti.enricher)
+			f, err := NewFrontend(ctx, ti.audit, ti.log, ti.env, ti.asyncOperationsDatabase, ti.clusterManagerDatabase, ti.gatewayDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, ti.openShiftVersionsDatabase, nil, api.APIs, &noop.Noop{}, &noop.Noop{}, nil, nil, nil, nil, ti.enricher)
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -3300,3 +3517,75 @@ func TestEnrichClusterSystemData(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateAutoscaledWorkerProfiles(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		modify   func(*api.OpenShiftCluster)
+		enriched []api.WorkerProfile
+		wantErr  string
+	}{
+		{
+			name: "not autoscaled",
+			modify: func(oc *api.OpenShiftCluster) {
+				oc.Properties.AutoscalerProfile = nil
+				oc.Properties.WorkerProfiles[0].Count = 5
+			},
+		},
+		{
+			name: "autoscaled, count unchanged",
+			enriched: []api.WorkerProfile{
+				{Name: "infra-worker-eastus1", Count: 2},
+			},
+		},
+		{
+			name: "autoscaled, count changed",
+			modify: func(oc *api.OpenShiftCluster) {
+				oc.Properties.WorkerProfiles[0].Count = 5
+			},
+			wantErr: "400: PropertyChangeNotAllowed: properties.workerProfiles['worker'].count: The worker count cannot be changed while machineset 'infra-worker-eastus1' is autoscaled.",
+		},
+		{
+			name: "customer machineset autoscaled, count changed",
+			modify: func(oc *api.OpenShiftCluster) {
+				oc.Properties.AutoscalerProfile.WorkerProfiles[0].Name = "infra-gpu-eastus1"
+				oc.Properties.WorkerProfiles[0].Count = 5
+			},
+		},
+		{
+			name: "machineset replicas below minCount",
+			enriched: []api.WorkerProfile{
+				{Name: "infra-worker-eastus1", Count: 0},
+			},
+			wantErr: "400: InvalidParameter: properties.autoscalerProfile.workerProfiles['infra-worker-eastus1']: The provided minCount '1' and maxCount '3' are invalid: the machineset has 0 replicas.",
+		},
+		{
+			name: "machineset replicas above maxCount",
+			enriched: []api.WorkerProfile{
+				{Name: "infra-worker-eastus1", Count: 4},
+			},
+			wantErr: "400: InvalidParameter: properties.autoscalerProfile.workerProfiles['infra-worker-eastus1']: The provided minCount '1' and maxCount '3' are invalid: the machineset has 4 replicas.",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			current := []api.WorkerProfile{{Name: "worker", Count: 3}}
+			oc := &api.OpenShiftCluster{
+				Properties: api.OpenShiftClusterProperties{
+					InfraID:        "infra",
+					WorkerProfiles: []api.WorkerProfile{{Name: "worker", Count: 3}},
+					AutoscalerProfile: &api.AutoscalerProfile{
+						WorkerProfiles: []api.AutoscalerWorkerProfile{
+							{Name: "infra-worker-eastus1", MinCount: 1, MaxCount: 3},
+						},
+					},
+				},
+			}
+			if tt.modify != nil {
+				tt.modify(oc)
+			}
+
+			err := validateAutoscaledWorkerProfiles(oc, current, tt.enriched)
+			utilerror.AssertErrorMessage(t, err, tt.wantErr)
+		})
+	}
+}
@@ -25,19 +25,24 @@ func (f *frontend) listInstallVersions(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	versions := f.getEnabledInstallVersions(ctx)
+	versions := f.getInstallableVersions(ctx)
 	converter := f.apis[apiVersion].OpenShiftVersionConverter
 
 	b, err := json.MarshalIndent(converter.ToExternalList(versions), "", "    ")
 	reply(log, w, nil, b, err)
 }
 
-func (f *frontend) getEnabledInstallVersions(ctx context.Context) []*api.OpenShiftVersion {
+// getInstallableVersions returns the enabled versions which may currently be
+// used for new installs
+func (f *frontend) getInstallableVersions(ctx context.Context) []*api.OpenShiftVersion {
 	versions := make([]*api.OpenShiftVersion, 0)
+	now := f.now()
 
 	f.mu.RLock()
 	for _, v := range f.enabledOcpVersions {
-		versions = append(versions, v)
+		if v.IsInstallable(now) {
+			versions = append(versions, v)
+		}
 	}
 	f.mu.RUnlock()
 
@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/coreos/go-semver/semver"
 
@@ -22,6 +23,9 @@ func TestListInstallVersions(t *testing.T) {
 	mockSubID := "00000000-0000-0000-0000-000000000000"
 	method := http.MethodGet
 	ctx := context.Background()
+	now := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	gaDate := now.AddDate(0, -6, 0)
+	endOfSupportDate := now.AddDate(0, 6, 0)
 
 	type test struct {
 		name           string
@@ -67,6 +71,59 @@ func TestListInstallVersions(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "omit versions which are not installable",
+			changeFeed: map[string]*api.OpenShiftVersion{
+				"4.11.5": {
+					Properties: api.OpenShiftVersionProperties{
+						Version:          "4.11.5",
+						Enabled:          true,
+						Default:          true,
+						GADate:           &gaDate,
+						EndOfSupportDate: &endOfSupportDate,
+					},
+				},
+				"4.10.40": {
+					Properties: api.OpenShiftVersionProperties{
+						Version:     "4.10.40",
+						Enabled:     true,
+						UpgradeOnly: true,
+					},
+				},
+				"4.10.41": {
+					Properties: api.OpenShiftVersionProperties{
+						Version:        "4.10.41",
+						Enabled:        true,
+						LifecycleState: api.OpenShiftVersionLifecycleStateDeprecated,
+					},
+				},
+				"4.9.0": {
+					Properties: api.OpenShiftVersionProperties{
+						Version:          "4.9.0",
+						Enabled:          true,
+						EndOfSupportDate: &gaDate,
+					},
+				},
+				"4.12.0": {
+					Properties: api.OpenShiftVersionProperties{
+						Version: "4.12.0",
+						Enabled: true,
+						GADate:  &endOfSupportDate,
+					},
+				},
+			},
+			apiVersion:     "2022-09-04",
+			wantStatusCode: http.StatusOK,
+			wantResponse: v20220904.OpenShiftVersionList{
+				OpenShiftVersions: []*v20220904.OpenShiftVersion{
+					{
+						Properties: v20220904.OpenShiftVersionProperties{
+							Version: "4.11.5",
+						},
+					},
+				},
+			},
+		},
 		{
 			name:           "api does not exist",
 			apiVersion:     "invalid",
@@ -78,11 +135,13 @@ func TestListInstallVersions(t *testing.T) {
 			ti := newTestInfra(t).WithSubscriptions().WithOpenShiftVersions()
 			defer ti.done()
 
-			frontend, err := NewFrontend(ctx, ti.audit, ti.log, ti.env, nil, nil, nil, nil, ti.openShiftVersionsDatabase, api.APIs, &noop.Noop{}, &noop.Noop{}, nil, nil, nil, nil, nil)
+			frontend, err := NewFrontend(ctx, ti.audit, ti.log, ti.env, nil, nil, nil, nil, nil, ti.openShiftVersionsDatabase, nil, api.APIs, &noop.Noop{}, &noop.Noop{}, nil, nil, nil, nil, nil)
 			if err != nil {
 				t.Fatal(err)
 			}
 
+			frontend.now = func() time.Time { return now }
+
 			go frontend.Run(ctx, nil, nil)
 
 			frontend.mu.Lock()
@@ -197,3 +197,100 @@ func TestValidateQuota(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateWorkerProfilesQuota(t *testing.T) {
+	ctx := context.Background()
+
+	current := []api.WorkerProfile{
+		{
+			Name:   "worker",
+			VMSize: api.VMSizeStandardD4sV3,
+			Count:  3,
+		},
+	}
+
+	usages := []mgmtcompute.Usage{
+		{
+			Name: &mgmtcompute.UsageName{
+				Value: to.StringPtr("cores"),
+			},
+			CurrentValue: to.Int32Ptr(100),
+			Limit:        to.Int64Ptr(116),
+		},
+	}
+
+	for _, tt := range []struct {
+		name    string
+		worker  api.WorkerProfile
+		mocks   func(*mock_compute.MockUsageClient)
+		wantErr string
+	}{
+		{
+			name:   "unchanged",
+			worker: current[0],
+		},
+		{
+			name: "scale down skips quota lookup",
+			worker: api.WorkerProfile{
+				Name:   "worker",
+				VMSize: api.VMSizeStandardD4sV3,
+				Count:  2,
+			},
+		},
+		{
+			name: "scale up within quota",
+			worker: api.WorkerProfile{
+				Name:   "worker",
+				VMSize: api.VMSizeStandardD4sV3,
+				Count:  7,
+			},
+			mocks: func(cuc *mock_compute.MockUsageClient) {
+				cuc.EXPECT().List(ctx, "ocLocation").Return(usages, nil)
+			},
+		},
+		{
+			name: "scale up beyond quota",
+			worker: api.WorkerProfile{
+				Name:   "worker",
+				VMSize: api.VMSizeStandardD4sV3,
+				Count:  8,
+			},
+			mocks: func(cuc *mock_compute.MockUsageClient) {
+				cuc.EXPECT().List(ctx, "ocLocation").Return(usages, nil)
+			},
+			wantErr: "400: ResourceQuotaExceeded: : Resource quota of cores exceeded. Maximum allowed: 116, Current in use: 100, Additional requested: 20.",
+		},
+		{
+			name: "resize requires quota for all new machines",
+			worker: api.WorkerProfile{
+				Name:   "worker",
+				VMSize: api.VMSizeStandardD8sV3,
+				Count:  3,
+			},
+			mocks: func(cuc *mock_compute.MockUsageClient) {
+				cuc.EXPECT().List(ctx, "ocLocation").Return(usages, nil)
+			},
+			wantErr: "400: ResourceQuotaExceeded: : Resource quota of cores exceeded. Maximum allowed: 116, Current in use: 100, Additional requested: 24.",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			computeUsageClient := mock_compute.NewMockUsageClient(controller)
+			if tt.mocks != nil {
+				tt.mocks(computeUsageClient)
+			}
+
+			oc := &api.OpenShiftCluster{
+				Location: "ocLocation",
+				Properties: api.OpenShiftClusterProperties{
+					WorkerProfiles: []api.WorkerProfile{tt.worker},
+				},
+			}
+
+			err := validateWorkerProfilesQuota(ctx, oc, current, computeUsageClient)
+			utilerror.AssertErrorMessage(t, err, tt.wantErr)
+		})
+	}
+}
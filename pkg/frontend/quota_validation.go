@@ -17,6 +17,7 @@ import (
 
 type QuotaValidator interface {
 	ValidateQuota(ctx context.Context, azEnv *azureclient.AROEnvironment, environment env.Interface, subscriptionID, tenantID string, oc *api.OpenShiftCluster) error
+	ValidateWorkerProfilesQuota(ctx context.Context, azEnv *azureclient.AROEnvironment, environment env.Interface, subscriptionID, tenantID string, oc *api.OpenShiftCluster, current []api.WorkerProfile) error
 }
 
 type quotaValidator struct{}
@@ -71,13 +72,58 @@ func validateQuota(ctx context.Context, oc *api.OpenShiftCluster, spNetworkUsage
 	//Public IP Addresses minimum requirement: 2 for ARM template deployment and 1 for kube-controller-manager
 	requiredResources["PublicIPAddresses"] = 3
 
-	//check requirements vs. usage
+	return checkQuota(ctx, oc.Location, requiredResources, spNetworkUsage, spComputeUsage)
+}
+
+// ValidateWorkerProfilesQuota checks usage quotas vs. the additional resources
+// required to scale or resize the worker profiles of an existing cluster from
+// current to those in oc
+func (q quotaValidator) ValidateWorkerProfilesQuota(ctx context.Context, azEnv *azureclient.AROEnvironment, environment env.Interface, subscriptionID, tenantID string, oc *api.OpenShiftCluster, current []api.WorkerProfile) error {
+	fpAuthorizer, err := environment.FPAuthorizer(tenantID, environment.Environment().ResourceManagerScope)
+	if err != nil {
+		return err
+	}
+
+	spComputeUsage := compute.NewUsageClient(azEnv, subscriptionID, fpAuthorizer)
+
+	return validateWorkerProfilesQuota(ctx, oc, current, spComputeUsage)
+}
+
+func validateWorkerProfilesQuota(ctx context.Context, oc *api.OpenShiftCluster, current []api.WorkerProfile, spComputeUsage compute.UsageClient) error {
+	requiredResources := map[string]int{}
+
+	for _, w := range oc.Properties.WorkerProfiles {
+		cw := findWorkerProfile(current, w.Name)
+
+		var err error
+		switch {
+		case cw == nil || cw.VMSize != w.VMSize:
+			// the old machines' quota is only released as they are
+			// replaced, so require quota for all of the new machines
+			err = addRequiredResources(requiredResources, w.VMSize, w.Count)
+		case w.Count > cw.Count:
+			err = addRequiredResources(requiredResources, w.VMSize, w.Count-cw.Count)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(requiredResources) == 0 {
+		return nil
+	}
+
+	return checkQuota(ctx, oc.Location, requiredResources, nil, spComputeUsage)
+}
 
+// checkQuota checks requirements vs. usage.  spNetworkUsage may be nil if no
+// network resources are required.
+func checkQuota(ctx context.Context, location string, requiredResources map[string]int, spNetworkUsage network.UsageClient, spComputeUsage compute.UsageClient) error {
 	// we're only checking the limits returned by the Usage API and ignoring usage limits missing from the results
 	// rationale:
 	// 1. if the Usage API doesn't send a limit because a resource is no longer limited, RP will continue cluster creation without impact
 	// 2. if the Usage API doesn't send a limit that is still enforced, cluster creation will fail on the backend and we will get an error in the RP logs
-	computeUsages, err := spComputeUsage.List(ctx, oc.Location)
+	computeUsages, err := spComputeUsage.List(ctx, location)
 	if err != nil {
 		return err
 	}
@@ -89,7 +135,11 @@ func validateQuota(ctx context.Context, oc *api.OpenShiftCluster, spNetworkUsage
 		}
 	}
 
-	netUsages, err := spNetworkUsage.List(ctx, oc.Location)
+	if spNetworkUsage == nil {
+		return nil
+	}
+
+	netUsages, err := spNetworkUsage.List(ctx, location)
 	if err != nil {
 		return err
 	}
@@ -103,3 +153,13 @@ func validateQuota(ctx context.Context, oc *api.OpenShiftCluster, spNetworkUsage
 
 	return nil
 }
+
+func findWorkerProfile(workerProfiles []api.WorkerProfile, name string) *api.WorkerProfile {
+	for i := range workerProfiles {
+		if workerProfiles[i].Name == name {
+			return &workerProfiles[i]
+		}
+	}
+
+	return nil
+}
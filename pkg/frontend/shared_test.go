@@ -63,6 +63,7 @@ type testInfra struct {
 	l          net.Listener
 	cli        *http.Client
 	enricher   *mock_clusterdata.MockBestEffortEnricher
+	keyvault   *mock_keyvault.MockManager
 	audit      *logrus.Entry
 	log        *logrus.Entry
 	fixture    *testdatabase.Fixture
@@ -76,10 +77,14 @@ type testInfra struct {
 	billingDatabase           database.Billing
 	clusterManagerClient      *cosmosdb.FakeClusterManagerConfigurationDocumentClient
 	clusterManagerDatabase    database.ClusterManagerConfigurations
+	gatewayClient             *cosmosdb.FakeGatewayDocumentClient
+	gatewayDatabase           database.Gateway
 	subscriptionsClient       *cosmosdb.FakeSubscriptionDocumentClient
 	subscriptionsDatabase     database.Subscriptions
 	openShiftVersionsClient   *cosmosdb.FakeOpenShiftVersionDocumentClient
 	openShiftVersionsDatabase database.OpenShiftVersions
+	operatorRolloutsClient    *cosmosdb.FakeOperatorRolloutDocumentClient
+	operatorRolloutsDatabase  database.OperatorRollouts
 }
 
 func newTestInfra(t *testing.T) *testInfra {
@@ -105,6 +110,7 @@ func newTestInfraWithFeatures(t *testing.T, features map[env.Feature]bool) *test
 	_env.EXPECT().ServiceKeyvault().AnyTimes().Return(keyvault)
 	_env.EXPECT().ArmClientAuthorizer().AnyTimes().Return(clientauthorizer.NewOne(clientcerts[0].Raw))
 	_env.EXPECT().AdminClientAuthorizer().AnyTimes().Return(clientauthorizer.NewOne(clientcerts[0].Raw))
+	_env.EXPECT().HealthGateClientAuthorizer().AnyTimes().Return(clientauthorizer.NewOne(nil))
 	_env.EXPECT().Domain().AnyTimes().Return("aro.example")
 	_env.EXPECT().Listen().AnyTimes().Return(l, nil)
 	for f, val := range features {
@@ -127,6 +133,7 @@ func newTestInfraWithFeatures(t *testing.T, features map[env.Feature]bool) *test
 		controller: controller,
 		l:          l,
 		enricher:   enricherMock,
+		keyvault:   keyvault,
 		fixture:    fixture,
 		checker:    checker,
 		audit:      auditEntry,
@@ -179,6 +186,17 @@ func (ti *testInfra) WithOpenShiftVersions() *testInfra {
 	return ti
 }
 
+func (ti *testInfra) WithOperatorRollouts() *testInfra {
+	ti.operatorRolloutsDatabase, ti.operatorRolloutsClient = testdatabase.NewFakeOperatorRollouts(deterministicuuid.NewTestUUIDGenerator(deterministicuuid.OPERATOR_ROLLOUTS))
+	return ti
+}
+
+func (ti *testInfra) WithGateway() *testInfra {
+	ti.gatewayDatabase, ti.gatewayClient = testdatabase.NewFakeGateway()
+	ti.fixture.WithGateway(ti.gatewayDatabase)
+	return ti
+}
+
 func (ti *testInfra) WithClusterManagerConfigurations() *testInfra {
 	ti.clusterManagerDatabase, ti.clusterManagerClient = testdatabase.NewFakeClusterManager()
 	ti.fixture.WithClusterManagerConfigurations(ti.clusterManagerDatabase)
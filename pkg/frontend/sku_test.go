@@ -234,3 +234,81 @@ func TestValidateVMSku(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateWorkerProfilesVMSku(t *testing.T) {
+	ctx := context.Background()
+
+	current := []api.WorkerProfile{
+		{
+			Name:   "worker",
+			VMSize: api.VMSizeStandardD4sV3,
+			Count:  3,
+		},
+	}
+
+	for _, tt := range []struct {
+		name    string
+		vmSize  api.VMSize
+		mocks   func(*mock_compute.MockResourceSkusClient)
+		wantErr string
+	}{
+		{
+			name:   "size unchanged skips sku lookup",
+			vmSize: api.VMSizeStandardD4sV3,
+		},
+		{
+			name:   "new size is available",
+			vmSize: api.VMSizeStandardD8sV3,
+			mocks: func(resourceSkusClient *mock_compute.MockResourceSkusClient) {
+				resourceSkusClient.EXPECT().
+					List(gomock.Any(), "location eq eastus").
+					Return([]mgmtcompute.ResourceSku{
+						{
+							Name:         to.StringPtr(string(api.VMSizeStandardD8sV3)),
+							Locations:    &[]string{"eastus"},
+							LocationInfo: &[]mgmtcompute.ResourceSkuLocationInfo{{Zones: &[]string{"1", "2", "3"}}},
+							Restrictions: &[]mgmtcompute.ResourceSkuRestrictions{},
+							Capabilities: &[]mgmtcompute.ResourceSkuCapabilities{},
+							ResourceType: to.StringPtr("virtualMachines"),
+						},
+					}, nil)
+			},
+		},
+		{
+			name:   "new size is unavailable",
+			vmSize: api.VMSizeStandardD8sV3,
+			mocks: func(resourceSkusClient *mock_compute.MockResourceSkusClient) {
+				resourceSkusClient.EXPECT().
+					List(gomock.Any(), "location eq eastus").
+					Return(nil, nil)
+			},
+			wantErr: "400: InvalidParameter: properties.workerProfiles[0].VMSize: The selected SKU 'Standard_D8s_v3' is unavailable in region 'eastus'",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			resourceSkusClient := mock_compute.NewMockResourceSkusClient(controller)
+			if tt.mocks != nil {
+				tt.mocks(resourceSkusClient)
+			}
+
+			oc := &api.OpenShiftCluster{
+				Location: "eastus",
+				Properties: api.OpenShiftClusterProperties{
+					WorkerProfiles: []api.WorkerProfile{
+						{
+							Name:   "worker",
+							VMSize: tt.vmSize,
+							Count:  3,
+						},
+					},
+				},
+			}
+
+			err := validateWorkerProfilesVMSku(ctx, oc, current, resourceSkusClient)
+			utilerror.AssertErrorMessage(t, err, tt.wantErr)
+		})
+	}
+}
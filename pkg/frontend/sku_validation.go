@@ -19,6 +19,7 @@ import (
 
 type SkuValidator interface {
 	ValidateVMSku(ctx context.Context, azEnv *azureclient.AROEnvironment, environment env.Interface, subscriptionID, tenantID string, oc *api.OpenShiftCluster) error
+	ValidateWorkerProfilesVMSku(ctx context.Context, azEnv *azureclient.AROEnvironment, environment env.Interface, subscriptionID, tenantID string, oc *api.OpenShiftCluster, current []api.WorkerProfile) error
 }
 
 type skuValidator struct{}
@@ -68,6 +69,44 @@ func validateVMSku(ctx context.Context, oc *api.OpenShiftCluster, resourceSkusCl
 	return nil
 }
 
+func (s skuValidator) ValidateWorkerProfilesVMSku(ctx context.Context, azEnv *azureclient.AROEnvironment, environment env.Interface, subscriptionID, tenantID string, oc *api.OpenShiftCluster, current []api.WorkerProfile) error {
+	fpAuthorizer, err := environment.FPAuthorizer(tenantID, environment.Environment().ResourceManagerScope)
+	if err != nil {
+		return err
+	}
+	resourceSkusClient := compute.NewResourceSkusClient(azEnv, subscriptionID, fpAuthorizer)
+
+	return validateWorkerProfilesVMSku(ctx, oc, current, resourceSkusClient)
+}
+
+// validateWorkerProfilesVMSku ensures that the VM sizes of worker profiles
+// which are being resized are available for use in the cluster's region.
+func validateWorkerProfilesVMSku(ctx context.Context, oc *api.OpenShiftCluster, current []api.WorkerProfile, resourceSkusClient compute.ResourceSkusClient) error {
+	var filteredSkus map[string]*mgmtcompute.ResourceSku
+
+	for i, workerprofile := range oc.Properties.WorkerProfiles {
+		if cw := findWorkerProfile(current, workerprofile.Name); cw != nil && cw.VMSize == workerprofile.VMSize {
+			continue
+		}
+
+		if filteredSkus == nil {
+			skus, err := resourceSkusClient.List(ctx, fmt.Sprintf("location eq %s", oc.Location))
+			if err != nil {
+				return err
+			}
+
+			filteredSkus = computeskus.FilterVMSizes(skus, oc.Location)
+		}
+
+		err := checkSKUAvailability(filteredSkus, oc.Location, fmt.Sprintf("properties.workerProfiles[%d].VMSize", i), string(workerprofile.VMSize))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func checkSKUAvailability(skus map[string]*mgmtcompute.ResourceSku, location, path, vmsize string) error {
 	// Ensure desired sku exists in target region
 	if skus[vmsize] == nil {
@@ -79,6 +79,14 @@ func (f *frontend) _putSubscription(ctx context.Context, r *http.Request) ([]byt
 		return nil, api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidSubscriptionState, "", "Request is not allowed in subscription in state '%s'.", oldState)
 	}
 
+	// the backend deallocates the clusters of suspended subscriptions and
+	// restarts them when the subscription is registered again
+	if oldState != "" && oldState != doc.Subscription.State &&
+		(doc.Subscription.State == api.SubscriptionStateSuspended ||
+			doc.Subscription.State == api.SubscriptionStateRegistered) {
+		doc.LifecyclePending = true
+	}
+
 	if doc.Subscription.Properties != nil &&
 		doc.Subscription.Properties.AccountOwner != nil &&
 		doc.Subscription.Properties.AccountOwner.Email != "" {
@@ -150,7 +150,8 @@ func TestPutSubscription(t *testing.T) {
 				})
 			},
 			wantDbDoc: &api.SubscriptionDocument{
-				ID: mockSubID,
+				ID:               mockSubID,
+				LifecyclePending: true,
 				Subscription: &api.Subscription{
 					State:      api.SubscriptionStateSuspended,
 					Properties: &api.SubscriptionProperties{TenantID: "changed"},
@@ -197,7 +198,32 @@ func TestPutSubscription(t *testing.T) {
 				})
 			},
 			wantDbDoc: &api.SubscriptionDocument{
-				ID: mockSubID,
+				ID:               mockSubID,
+				LifecyclePending: true,
+				Subscription: &api.Subscription{
+					State:      api.SubscriptionStateRegistered,
+					Properties: &api.SubscriptionProperties{TenantID: "changed"},
+				},
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name: "update an existing subscription - suspended state is registered again",
+			request: func(sub *api.Subscription) {
+				sub.State = api.SubscriptionStateRegistered
+				sub.Properties = &api.SubscriptionProperties{TenantID: "changed"}
+			},
+			fixture: func(f *testdatabase.Fixture) {
+				f.AddSubscriptionDocuments(&api.SubscriptionDocument{
+					ID: mockSubID,
+					Subscription: &api.Subscription{
+						State: api.SubscriptionStateSuspended,
+					},
+				})
+			},
+			wantDbDoc: &api.SubscriptionDocument{
+				ID:               mockSubID,
+				LifecyclePending: true,
 				Subscription: &api.Subscription{
 					State:      api.SubscriptionStateRegistered,
 					Properties: &api.SubscriptionProperties{TenantID: "changed"},
@@ -205,6 +231,29 @@ func TestPutSubscription(t *testing.T) {
 			},
 			wantStatusCode: http.StatusOK,
 		},
+		{
+			name: "update an existing subscription - unchanged suspended state",
+			request: func(sub *api.Subscription) {
+				sub.State = api.SubscriptionStateSuspended
+				sub.Properties = &api.SubscriptionProperties{TenantID: "changed"}
+			},
+			fixture: func(f *testdatabase.Fixture) {
+				f.AddSubscriptionDocuments(&api.SubscriptionDocument{
+					ID: mockSubID,
+					Subscription: &api.Subscription{
+						State: api.SubscriptionStateSuspended,
+					},
+				})
+			},
+			wantDbDoc: &api.SubscriptionDocument{
+				ID: mockSubID,
+				Subscription: &api.Subscription{
+					State:      api.SubscriptionStateSuspended,
+					Properties: &api.SubscriptionProperties{TenantID: "changed"},
+				},
+			},
+			wantStatusCode: http.StatusOK,
+		},
 		{
 			name: "update an existing subscription - deleted state",
 			request: func(sub *api.Subscription) {
@@ -244,7 +293,7 @@ func TestPutSubscription(t *testing.T) {
 				t.Fatal(err)
 			}
 
-			f, err := NewFrontend(ctx, ti.audit, ti.log, ti.env, ti.asyncOperationsDatabase, ti.clusterManagerDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, nil, api.APIs, &noop.Noop{}, &noop.Noop{}, nil, nil, nil, nil, nil)
+			f, err := NewFrontend(ctx, ti.audit, ti.log, ti.env, ti.asyncOperationsDatabase, ti.clusterManagerDatabase, ti.gatewayDatabase, ti.openShiftClustersDatabase, ti.subscriptionsDatabase, nil, nil, api.APIs, &noop.Noop{}, &noop.Noop{}, nil, nil, nil, nil, nil)
 			if err != nil {
 				t.Fatal(err)
 			}
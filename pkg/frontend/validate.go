@@ -209,10 +209,10 @@ func (f *frontend) validateInstallVersion(ctx context.Context, oc *api.OpenShift
 	if oc.Properties.ClusterProfile.Version == "" {
 		oc.Properties.ClusterProfile.Version = f.defaultOcpVersion
 	}
-	_, ok := f.enabledOcpVersions[oc.Properties.ClusterProfile.Version]
+	v, ok := f.enabledOcpVersions[oc.Properties.ClusterProfile.Version]
 	f.mu.RUnlock()
 
-	if !ok || !validate.RxInstallVersion.MatchString(oc.Properties.ClusterProfile.Version) {
+	if !ok || !v.IsInstallable(f.now()) || !validate.RxInstallVersion.MatchString(oc.Properties.ClusterProfile.Version) {
 		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "properties.clusterProfile.version", "The requested OpenShift version '%s' is invalid.", oc.Properties.ClusterProfile.Version)
 	}
 
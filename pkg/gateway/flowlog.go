@@ -0,0 +1,196 @@
+package gateway
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"encoding/json"
+	"io"
+	"math/rand"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/util/recover"
+)
+
+const (
+	flowDecisionAllowed = "allowed"
+	flowDecisionDenied  = "denied"
+
+	// deniedReportInterval is how often the denied hostnames report is logged
+	deniedReportInterval = time.Hour
+
+	// deniedReportTopN is the number of hostnames reported per cluster
+	deniedReportTopN = 10
+
+	// deniedMaxHosts bounds the number of hostnames counted per cluster
+	// between reports.  Connections to further hostnames are counted as
+	// deniedOtherHost.
+	deniedMaxHosts  = 1000
+	deniedOtherHost = "(other)"
+)
+
+// FlowLogConfig configures the gateway flow log.
+type FlowLogConfig struct {
+	// Path is the JSON Lines file which flow records are written to.  If it is
+	// empty, flow records are not written, but denied connections are still
+	// reported.
+	Path string
+
+	// SamplePercent is the percentage of allowed connections which are
+	// recorded.  Denied connections are always recorded.
+	SamplePercent int
+
+	// MaxSizeBytes is the size at which the file is rotated, and MaxBackups
+	// the number of rotated files which are kept.
+	MaxSizeBytes int64
+	MaxBackups   int
+}
+
+// flowRecord is a record of a connection through the gateway.  Bytes in are
+// those sent by the cluster, bytes out those sent to it.
+type flowRecord struct {
+	Time       time.Time `json:"time"`
+	ResourceID string    `json:"resourceId,omitempty"`
+	LinkID     string    `json:"linkId,omitempty"`
+	Host       string    `json:"host"`
+	Port       string    `json:"port,omitempty"`
+	Protocol   string    `json:"protocol"`
+	Decision   string    `json:"decision"`
+	BytesIn    int64     `json:"bytesIn"`
+	BytesOut   int64     `json:"bytesOut"`
+	DurationMs int64     `json:"durationMs"`
+}
+
+// deniedHost is the number of denied connections to a host in a report
+type deniedHost struct {
+	Host  string `json:"host"`
+	Count int64  `json:"count"`
+}
+
+// flowLog writes sampled flow records and counts the denied connections per
+// cluster and hostname, so that the hostnames customers are missing can be
+// reported.
+type flowLog struct {
+	log *logrus.Entry
+
+	sink          io.Writer
+	samplePercent int
+	rand          func() int
+
+	mu     sync.Mutex
+	denied map[string]map[string]int64
+}
+
+func newFlowLog(log *logrus.Entry, config FlowLogConfig) (*flowLog, error) {
+	fl := &flowLog{
+		log:           log,
+		samplePercent: config.SamplePercent,
+		rand:          func() int { return rand.Intn(100) },
+		denied:        map[string]map[string]int64{},
+	}
+
+	if config.Path != "" {
+		sink, err := newRotatingFile(config.Path, config.MaxSizeBytes, config.MaxBackups)
+		if err != nil {
+			return nil, err
+		}
+		fl.sink = sink
+	}
+
+	return fl, nil
+}
+
+// record counts denied connections and writes the record if it is sampled
+func (fl *flowLog) record(r *flowRecord) {
+	r.Host = strings.ToLower(r.Host)
+
+	if r.Decision == flowDecisionDenied {
+		fl.mu.Lock()
+		hosts := fl.denied[r.ResourceID]
+		if hosts == nil {
+			hosts = map[string]int64{}
+			fl.denied[r.ResourceID] = hosts
+		}
+
+		host := r.Host
+		if _, ok := hosts[host]; !ok && len(hosts) >= deniedMaxHosts {
+			host = deniedOtherHost
+		}
+		hosts[host]++
+		fl.mu.Unlock()
+	}
+
+	if fl.sink == nil ||
+		(r.Decision == flowDecisionAllowed && fl.rand() >= fl.samplePercent) {
+		return
+	}
+
+	b, err := json.Marshal(r)
+	if err != nil {
+		fl.log.Error(err)
+		return
+	}
+
+	_, err = fl.sink.Write(append(b, '\n'))
+	if err != nil {
+		fl.log.Error(err)
+	}
+}
+
+// deniedReport returns the n most denied hostnames of each cluster since the
+// last report and resets the counts
+func (fl *flowLog) deniedReport(n int) map[string][]deniedHost {
+	fl.mu.Lock()
+	denied := fl.denied
+	fl.denied = map[string]map[string]int64{}
+	fl.mu.Unlock()
+
+	report := make(map[string][]deniedHost, len(denied))
+	for resourceID, hosts := range denied {
+		top := make([]deniedHost, 0, len(hosts))
+		for host, count := range hosts {
+			top = append(top, deniedHost{Host: host, Count: count})
+		}
+
+		sort.Slice(top, func(i, j int) bool {
+			if top[i].Count != top[j].Count {
+				return top[i].Count > top[j].Count
+			}
+			return top[i].Host < top[j].Host
+		})
+
+		if len(top) > n {
+			top = top[:n]
+		}
+
+		report[resourceID] = top
+	}
+
+	return report
+}
+
+// reportDenied periodically logs the most denied hostnames of each cluster
+func (g *gateway) reportDenied() {
+	defer recover.Panic(g.log)
+
+	t := time.NewTicker(deniedReportInterval)
+	defer t.Stop()
+
+	for range t.C {
+		g._reportDenied()
+	}
+}
+
+func (g *gateway) _reportDenied() {
+	for resourceID, top := range g.flowLog.deniedReport(deniedReportTopN) {
+		g.accessLog.WithFields(logrus.Fields{
+			"resource_id":  resourceID,
+			"denied_hosts": top,
+		}).Print("denied hosts report")
+	}
+}
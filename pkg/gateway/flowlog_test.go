@@ -0,0 +1,153 @@
+package gateway
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/go-test/deep"
+	"github.com/sirupsen/logrus"
+)
+
+func TestFlowLogRecord(t *testing.T) {
+	for _, tt := range []struct {
+		name          string
+		samplePercent int
+		rand          int
+		decision      string
+		wantWritten   bool
+	}{
+		{
+			name:          "allowed, sampled",
+			samplePercent: 10,
+			rand:          9,
+			decision:      flowDecisionAllowed,
+			wantWritten:   true,
+		},
+		{
+			name:          "allowed, not sampled",
+			samplePercent: 10,
+			rand:          10,
+			decision:      flowDecisionAllowed,
+		},
+		{
+			name:        "denied, always written",
+			rand:        99,
+			decision:    flowDecisionDenied,
+			wantWritten: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			fl := &flowLog{
+				log:           logrus.NewEntry(logrus.StandardLogger()),
+				sink:          buf,
+				samplePercent: tt.samplePercent,
+				rand:          func() int { return tt.rand },
+				denied:        map[string]map[string]int64{},
+			}
+
+			fl.record(&flowRecord{
+				ResourceID: "/subscriptions/sub/resourcegroups/rg/providers/microsoft.redhatopenshift/openshiftclusters/cluster",
+				LinkID:     "1",
+				Host:       "Example.COM",
+				Port:       "443",
+				Protocol:   "https",
+				Decision:   tt.decision,
+			})
+
+			if !tt.wantWritten {
+				if buf.Len() != 0 {
+					t.Errorf("unexpected record %q", buf.String())
+				}
+				return
+			}
+
+			if !strings.HasSuffix(buf.String(), "\n") {
+				t.Errorf("record %q is not newline terminated", buf.String())
+			}
+
+			var r flowRecord
+			err := json.Unmarshal(buf.Bytes(), &r)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if r.Host != "example.com" {
+				t.Error(r.Host)
+			}
+			if r.Decision != tt.decision {
+				t.Error(r.Decision)
+			}
+		})
+	}
+}
+
+func TestFlowLogDeniedReport(t *testing.T) {
+	fl, err := newFlowLog(logrus.NewEntry(logrus.StandardLogger()), FlowLogConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, r := range []flowRecord{
+		{ResourceID: "cluster1", Host: "b.example.com", Decision: flowDecisionDenied},
+		{ResourceID: "cluster1", Host: "a.example.com", Decision: flowDecisionDenied},
+		{ResourceID: "cluster1", Host: "C.example.com", Decision: flowDecisionDenied},
+		{ResourceID: "cluster1", Host: "c.example.com", Decision: flowDecisionDenied},
+		{ResourceID: "cluster1", Host: "d.example.com", Decision: flowDecisionAllowed},
+		{ResourceID: "cluster2", Host: "a.example.com", Decision: flowDecisionDenied},
+	} {
+		r := r
+		fl.record(&r)
+	}
+
+	report := fl.deniedReport(2)
+
+	for _, l := range deep.Equal(report, map[string][]deniedHost{
+		"cluster1": {
+			{Host: "c.example.com", Count: 2},
+			{Host: "a.example.com", Count: 1},
+		},
+		"cluster2": {
+			{Host: "a.example.com", Count: 1},
+		},
+	}) {
+		t.Error(l)
+	}
+
+	report = fl.deniedReport(2)
+	if !reflect.DeepEqual(report, map[string][]deniedHost{}) {
+		t.Errorf("counts were not reset: %v", report)
+	}
+}
+
+func TestFlowLogDeniedMaxHosts(t *testing.T) {
+	fl, err := newFlowLog(logrus.NewEntry(logrus.StandardLogger()), FlowLogConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < deniedMaxHosts+5; i++ {
+		fl.record(&flowRecord{ResourceID: "cluster1", Host: fmt.Sprintf("%04d.example.com", i), Decision: flowDecisionDenied})
+	}
+
+	// hosts already counted keep their own count
+	fl.record(&flowRecord{ResourceID: "cluster1", Host: "0000.example.com", Decision: flowDecisionDenied})
+
+	report := fl.deniedReport(2)
+
+	for _, l := range deep.Equal(report, map[string][]deniedHost{
+		"cluster1": {
+			{Host: deniedOtherHost, Count: 5},
+			{Host: "0000.example.com", Count: 2},
+		},
+	}) {
+		t.Error(l)
+	}
+}
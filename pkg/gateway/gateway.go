@@ -70,6 +70,7 @@ type gateway struct {
 	healthServer *http.Server
 
 	allowList map[string]struct{}
+	flowLog   *flowLog
 
 	m                metrics.Emitter
 	httpConnections  int64
@@ -90,7 +91,7 @@ const SocketSize = 65536
 
 // TODO: may one day want to limit gateway readiness on # active connections
 
-func NewGateway(ctx context.Context, env env.Core, baseLog, accessLog *logrus.Entry, dbGateway database.Gateway, httpsl, httpl, httpHealthl net.Listener, acrResourceID, gatewayDomains string, m metrics.Emitter) (Runnable, error) {
+func NewGateway(ctx context.Context, env env.Core, baseLog, accessLog *logrus.Entry, dbGateway database.Gateway, httpsl, httpl, httpHealthl net.Listener, acrResourceID, gatewayDomains string, m metrics.Emitter, flowLogConfig FlowLogConfig) (Runnable, error) {
 	var domains []string
 	if gatewayDomains != "" {
 		domains = strings.Split(gatewayDomains, ",")
@@ -129,6 +130,11 @@ func NewGateway(ctx context.Context, env env.Core, baseLog, accessLog *logrus.En
 		allowList[strings.ToLower(domain)] = struct{}{}
 	}
 
+	flowLog, err := newFlowLog(baseLog, flowLogConfig)
+	if err != nil {
+		return nil, err
+	}
+
 	g := &gateway{
 		env:       env,
 		log:       baseLog,
@@ -171,6 +177,7 @@ func NewGateway(ctx context.Context, env env.Core, baseLog, accessLog *logrus.En
 		},
 
 		allowList: allowList,
+		flowLog:   flowLog,
 		m:         m,
 	}
 
@@ -194,6 +201,7 @@ func (g *gateway) Run(ctx context.Context, done chan<- struct{}) {
 	go g.changefeed(ctx)
 
 	go g.emitMetrics()
+	go g.reportDenied()
 	go heartbeat.EmitHeartbeat(g.log, g.m, "gateway.heartbeat", nil, g.isReady)
 
 	go func() {
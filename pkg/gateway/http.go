@@ -19,6 +19,7 @@ import (
 // header will indicate where the incoming connection wants to be connected to.
 func (g *gateway) handleConnect(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
+	start := time.Now()
 
 	conn, ok := ctx.Value(contextKeyConnection).(*proxyproto.Conn)
 	if !ok {
@@ -33,7 +34,7 @@ func (g *gateway) handleConnect(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	clusterResourceID, isAllowed, err := g.isAllowed(conn, host)
+	linkID, clusterResourceID, isAllowed, err := g.isAllowed(conn, host, port)
 	if err != nil {
 		g.log.Error(err)
 		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
@@ -43,7 +44,21 @@ func (g *gateway) handleConnect(w http.ResponseWriter, r *http.Request) {
 	log := utillog.EnrichWithResourceID(g.accessLog, clusterResourceID)
 	log = log.WithField("hostname", host)
 
-	if !isAllowed || port != "443" {
+	flow := &flowRecord{
+		Time:       start.UTC(),
+		ResourceID: clusterResourceID,
+		LinkID:     linkID,
+		Host:       host,
+		Port:       port,
+		Protocol:   "http",
+		Decision:   flowDecisionDenied,
+	}
+	defer func() {
+		flow.DurationMs = time.Since(start).Milliseconds()
+		g.flowLog.record(flow)
+	}()
+
+	if !isAllowed {
 		log.Print("access denied")
 		g.m.EmitGauge("gateway.connections", 1, map[string]string{
 			"protocol": "http",
@@ -59,10 +74,12 @@ func (g *gateway) handleConnect(w http.ResponseWriter, r *http.Request) {
 		"action":   "allowed",
 	})
 
+	flow.Decision = flowDecisionAllowed
+
 	atomic.AddInt64(&g.httpConnections, 1)
 	defer atomic.AddInt64(&g.httpConnections, -1)
 
-	proxy.Proxy(g.log, w, r, SocketSize)
+	flow.BytesIn, flow.BytesOut = proxy.Proxy(g.log, w, r, SocketSize)
 }
 
 func (g *gateway) checkReady(w http.ResponseWriter, r *http.Request) {
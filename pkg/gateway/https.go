@@ -10,6 +10,7 @@ import (
 	"io"
 	"net"
 	"sync/atomic"
+	"time"
 
 	"github.com/pires/go-proxyproto"
 
@@ -22,6 +23,8 @@ func (g *gateway) handleHTTPS(ctx context.Context, _c net.Conn) {
 	defer recover.Panic(g.log)
 	defer _c.Close()
 
+	start := time.Now()
+
 	// 1. Sniff the incoming connection SNI to determine where it wants to go.
 	// We return syntheticError to abandon the TLS handshake as soon as we've
 	// discovered the server name
@@ -58,7 +61,7 @@ func (g *gateway) handleHTTPS(ctx context.Context, _c net.Conn) {
 	}
 
 	// 2. Determine if we allow the connection.
-	clusterResourceID, isAllowed, err := g.isAllowed(conn, serverName)
+	linkID, clusterResourceID, isAllowed, err := g.isAllowed(conn, serverName, "443")
 	if err != nil {
 		g.log.Error(err)
 		return
@@ -67,6 +70,20 @@ func (g *gateway) handleHTTPS(ctx context.Context, _c net.Conn) {
 	log := utillog.EnrichWithResourceID(g.accessLog, clusterResourceID)
 	log = log.WithField("hostname", serverName)
 
+	flow := &flowRecord{
+		Time:       start.UTC(),
+		ResourceID: clusterResourceID,
+		LinkID:     linkID,
+		Host:       serverName,
+		Port:       "443",
+		Protocol:   "https",
+		Decision:   flowDecisionDenied,
+	}
+	defer func() {
+		flow.DurationMs = time.Since(start).Milliseconds()
+		g.flowLog.record(flow)
+	}()
+
 	if !isAllowed {
 		log.Print("access denied")
 		g.m.EmitGauge("gateway.connections", 1, map[string]string{
@@ -82,6 +99,8 @@ func (g *gateway) handleHTTPS(ctx context.Context, _c net.Conn) {
 		"action":   "allowed",
 	})
 
+	flow.Decision = flowDecisionAllowed
+
 	atomic.AddInt64(&g.httpsConnections, 1)
 	defer atomic.AddInt64(&g.httpsConnections, -1)
 
@@ -102,7 +121,7 @@ func (g *gateway) handleHTTPS(ctx context.Context, _c net.Conn) {
 			_ = conn.Raw().(*net.TCPConn).CloseWrite()
 		}()
 
-		_, _ = io.Copy(c1, c2)
+		flow.BytesOut, _ = io.Copy(c1, c2)
 	}()
 
 	func() {
@@ -110,7 +129,7 @@ func (g *gateway) handleHTTPS(ctx context.Context, _c net.Conn) {
 			_ = c2.(*net.TCPConn).CloseWrite()
 		}()
 
-		_, _ = io.Copy(c2, c1)
+		flow.BytesIn, _ = io.Copy(c2, c1)
 	}()
 
 	<-ch
@@ -11,6 +11,8 @@ import (
 	"strings"
 
 	"github.com/pires/go-proxyproto"
+
+	"github.com/Azure/ARO-RP/pkg/api"
 )
 
 const (
@@ -22,19 +24,20 @@ const (
 // header injected on the front of the TCP stream by PLS.  It uses this to do a
 // lookup of the gateway collection record in the in-memory cache (this is
 // populated by the Cosmos DB change feed).  It then makes a decision about
-// whether to allow the connection based on a static allow list and the
-// additional hostnames in the gateway record. It returns the cluster ID and
-// deny/allow decision.
-func (g *gateway) isAllowed(conn *proxyproto.Conn, host string) (string, bool, error) {
+// whether to allow the connection based on a static allow list, the
+// additional hostnames in the gateway record and the gateway record's own
+// allow list. It returns the link ID, cluster ID and deny/allow decision.
+func (g *gateway) isAllowed(conn *proxyproto.Conn, host, port string) (string, string, bool, error) {
 	linkID, err := linkID(conn)
 	if err != nil {
-		return "", false, err
+		return "", "", false, err
 	}
 
-	return g.gatewayVerification(host, linkID)
+	clusterResourceID, isAllowed, err := g.gatewayVerification(host, port, linkID)
+	return linkID, clusterResourceID, isAllowed, err
 }
 
-func (g *gateway) gatewayVerification(host, linkID string) (string, bool, error) {
+func (g *gateway) gatewayVerification(host, port, linkID string) (string, bool, error) {
 	g.mu.RLock()
 	gateway := g.gateways[linkID]
 	g.mu.RUnlock()
@@ -54,6 +57,14 @@ func (g *gateway) gatewayVerification(host, linkID string) (string, bool, error)
 		})
 	}
 
+	if isAllowListed(gateway.AllowList, host, port) {
+		return gateway.ID, true, nil
+	}
+
+	if port != "443" {
+		return gateway.ID, false, nil
+	}
+
 	if _, found := g.allowList[strings.ToLower(host)]; found {
 		return gateway.ID, true, nil
 	}
@@ -64,6 +75,38 @@ func (g *gateway) gatewayVerification(host, linkID string) (string, bool, error)
 		nil
 }
 
+// isAllowListed returns true if host and port match an entry of a gateway
+// record's allow list.  Entries match the host exactly or, if prefixed with
+// "*.", match any subdomain of the given domain.  Entries without a port
+// match port 443.
+func isAllowListed(allowList []api.GatewayAllowListEntry, host, port string) bool {
+	if host == "" {
+		return false
+	}
+
+	host = strings.ToLower(host)
+
+	for _, e := range allowList {
+		p := e.Port
+		if p == 0 {
+			p = 443
+		}
+		if strconv.Itoa(p) != port {
+			continue
+		}
+
+		if domain := strings.TrimPrefix(e.Host, "*"); domain != e.Host {
+			if strings.HasSuffix(host, domain) {
+				return true
+			}
+		} else if host == e.Host {
+			return true
+		}
+	}
+
+	return false
+}
+
 // linkID retrieves the private endpoint link ID from the haproxy binary
 // protocol header injected on the front of the TCP stream by PLS.  See
 // https://docs.microsoft.com/en-us/azure/private-link/private-link-service-overview#getting-connection-information-using-tcp-proxy-v2
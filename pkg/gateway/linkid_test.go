@@ -20,6 +20,7 @@ func TestGatewayVerification(t *testing.T) {
 	for _, tt := range []struct {
 		name          string
 		host          string
+		port          string
 		idParam       string
 		wantId        string
 		wantIsAllowed bool
@@ -49,6 +50,73 @@ func TestGatewayVerification(t *testing.T) {
 			wantIsAllowed: true,
 			allowList:     map[string]struct{}{"redhat.com": {}},
 		},
+		{
+			name:          "allowlist requires port 443",
+			host:          "redhat.com",
+			port:          "8443",
+			idParam:       "2",
+			wantId:        "2",
+			wantIsAllowed: false,
+			allowList:     map[string]struct{}{"redhat.com": {}},
+		},
+		{
+			name:          "storage account requires port 443",
+			host:          "account1.blob.storageEndpointSuffix",
+			port:          "80",
+			idParam:       "1",
+			wantId:        "1",
+			wantIsAllowed: false,
+		},
+		{
+			name:          "accepted gateway allow list exact host",
+			host:          "API.example.com",
+			idParam:       "3",
+			wantId:        "3",
+			wantIsAllowed: true,
+		},
+		{
+			name:          "accepted gateway allow list wildcard",
+			host:          "mirror.example.org",
+			idParam:       "3",
+			wantId:        "3",
+			wantIsAllowed: true,
+		},
+		{
+			name:          "gateway allow list wildcard does not match domain",
+			host:          "example.org",
+			idParam:       "3",
+			wantId:        "3",
+			wantIsAllowed: false,
+		},
+		{
+			name:          "gateway allow list wildcard does not match partial label",
+			host:          "notexample.org",
+			idParam:       "3",
+			wantId:        "3",
+			wantIsAllowed: false,
+		},
+		{
+			name:          "accepted gateway allow list port",
+			host:          "registry.example.net",
+			port:          "5000",
+			idParam:       "3",
+			wantId:        "3",
+			wantIsAllowed: true,
+		},
+		{
+			name:          "gateway allow list port mismatch",
+			host:          "registry.example.net",
+			idParam:       "3",
+			wantId:        "3",
+			wantIsAllowed: false,
+		},
+		{
+			name:          "gateway allow list of another cluster",
+			host:          "api.example.com",
+			idParam:       "1",
+			wantId:        "1",
+			wantIsAllowed: false,
+		},
 		{
 			name:          "middle part not valid",
 			host:          "account1.notblob.storageEndpointSuffix",
@@ -90,8 +158,13 @@ func TestGatewayVerification(t *testing.T) {
 			defer mockController.Finish()
 
 			gatewayMap := map[string]*api.Gateway{
-				"1":        {ID: "1", StorageSuffix: "suffix-1", ImageRegistryStorageAccountName: "account1"},
-				"2":        {ID: "2", StorageSuffix: "suffix-2", ImageRegistryStorageAccountName: "account2"},
+				"1": {ID: "1", StorageSuffix: "suffix-1", ImageRegistryStorageAccountName: "account1"},
+				"2": {ID: "2", StorageSuffix: "suffix-2", ImageRegistryStorageAccountName: "account2"},
+				"3": {ID: "3", StorageSuffix: "suffix-3", ImageRegistryStorageAccountName: "account3", AllowList: []api.GatewayAllowListEntry{
+					{Host: "api.example.com"},
+					{Host: "*.example.org"},
+					{Host: "registry.example.net", Port: 5000},
+				}},
 				"deleting": {ID: "deleting", StorageSuffix: "suffix-5", ImageRegistryStorageAccountName: "account5", Deleting: true},
 			}
 
@@ -118,7 +191,12 @@ func TestGatewayVerification(t *testing.T) {
 				allowList: tt.allowList,
 			}
 
-			gatewayID, isAllowed, err := gateway.gatewayVerification(tt.host, tt.idParam)
+			port := tt.port
+			if port == "" {
+				port = "443"
+			}
+
+			gatewayID, isAllowed, err := gateway.gatewayVerification(tt.host, port, tt.idParam)
 
 			if gatewayID != tt.wantId {
 				t.Error(gatewayID)
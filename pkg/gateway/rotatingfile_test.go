@@ -0,0 +1,76 @@
+package gateway
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRotatingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "flows.jsonl")
+
+	rf, err := newRotatingFile(path, 4, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, s := range []string{"aa", "bb", "cc", "dd", "ee"} {
+		_, err = rf.Write([]byte(s))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for name, want := range map[string]string{
+		path:        "ee",
+		path + ".1": "ccdd",
+		path + ".2": "aabb",
+	} {
+		b, err := os.ReadFile(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(b) != want {
+			t.Errorf("%s: got %q, want %q", name, string(b), want)
+		}
+	}
+
+	_, err = os.Stat(path + ".3")
+	if !os.IsNotExist(err) {
+		t.Errorf("unexpected backup %s.3", path)
+	}
+}
+
+func TestRotatingFileRenameFails(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "flows.jsonl")
+
+	// the file can't be renamed over a directory
+	err := os.Mkdir(path+".1", 0750)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rf, err := newRotatingFile(path, 4, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, s := range []string{"aa", "bb", "cc", "dd"} {
+		_, err = rf.Write([]byte(s))
+	}
+	if err == nil {
+		t.Error("expected the rotation error")
+	}
+
+	// records are still appended to the original file
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != "aabbccdd" {
+		t.Errorf("got %q", string(b))
+	}
+}
@@ -0,0 +1,224 @@
+package hive
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/database"
+	"github.com/Azure/ARO-RP/pkg/env"
+)
+
+// DefaultShard is the Hive shard used for clusters which were placed before
+// the shard index was persisted on the cluster document.
+const DefaultShard = 1
+
+// ShardIndex returns the Hive shard the cluster is assigned to.
+func ShardIndex(doc *api.OpenShiftClusterDocument) int {
+	if doc.OpenShiftCluster.Properties.HiveProfile.ShardIndex == 0 {
+		return DefaultShard
+	}
+
+	return doc.OpenShiftCluster.Properties.HiveProfile.ShardIndex
+}
+
+// PickShard returns the least loaded of shardCount Hive shards, measured by
+// the number of cluster documents assigned to each. Ties go to the lowest
+// shard index.
+func PickShard(ctx context.Context, dbOpenShiftClusters database.OpenShiftClusters, shardCount int) (int, error) {
+	loads, err := shardLoads(ctx, dbOpenShiftClusters, shardCount)
+	if err != nil {
+		return 0, err
+	}
+
+	return leastLoaded(loads), nil
+}
+
+// placeShardAttempts bounds how often PlaceShard places a cluster.
+const placeShardAttempts = 3
+
+// placeShardJitter is the upper bound of the random delay before PlaceShard
+// places a cluster again, so that racing backends don't pick the same shard
+// a second time.
+var placeShardJitter = 10 * time.Second
+
+// PlaceShard picks the least loaded shard and persists it by calling place.
+// Picking and placing is not atomic, so backends placing clusters at the same
+// time can all pick the same shard: after placing, the shards are counted
+// again and the cluster is placed again if its shard ended up more than one
+// cluster above the least loaded one.
+func PlaceShard(ctx context.Context, dbOpenShiftClusters database.OpenShiftClusters, shardCount int, place func(shard int) error) (int, error) {
+	shard, err := PickShard(ctx, dbOpenShiftClusters, shardCount)
+	if err != nil {
+		return 0, err
+	}
+
+	for attempt := 1; ; attempt++ {
+		err = place(shard)
+		if err != nil {
+			return 0, err
+		}
+
+		if attempt == placeShardAttempts {
+			return shard, nil
+		}
+
+		least, overloaded, err := overloadedShard(ctx, dbOpenShiftClusters, shardCount, shard)
+		if err != nil || !overloaded {
+			return shard, err
+		}
+
+		// give the racing backends a chance to move first, then check again
+		if placeShardJitter > 0 {
+			select {
+			case <-time.After(time.Duration(rand.Int63n(int64(placeShardJitter)))):
+			case <-ctx.Done():
+				return shard, ctx.Err()
+			}
+		}
+
+		least, overloaded, err = overloadedShard(ctx, dbOpenShiftClusters, shardCount, shard)
+		if err != nil || !overloaded {
+			return shard, err
+		}
+
+		shard = least
+	}
+}
+
+// overloadedShard returns whether the cluster placed on shard should rather
+// be on the returned least loaded shard.
+func overloadedShard(ctx context.Context, dbOpenShiftClusters database.OpenShiftClusters, shardCount, shard int) (int, bool, error) {
+	loads, err := shardLoads(ctx, dbOpenShiftClusters, shardCount)
+	if err != nil {
+		return 0, false, err
+	}
+
+	// the cluster itself counts towards its shard
+	loads[shard-1]--
+
+	least := leastLoaded(loads)
+	return least, loads[shard-1] > loads[least-1], nil
+}
+
+// shardLoads returns the number of cluster documents assigned to each of
+// shardCount Hive shards, indexed from 0.
+func shardLoads(ctx context.Context, dbOpenShiftClusters database.OpenShiftClusters, shardCount int) ([]int, error) {
+	if shardCount < 1 {
+		return nil, fmt.Errorf("invalid Hive shard count %d", shardCount)
+	}
+
+	loads := make([]int, shardCount)
+	for i := range loads {
+		count, err := dbOpenShiftClusters.CountByHiveShard(ctx, i+1)
+		if err != nil {
+			return nil, err
+		}
+
+		loads[i] = count
+	}
+
+	return loads, nil
+}
+
+func leastLoaded(loads []int) int {
+	shard := 1
+	for i, load := range loads {
+		if load < loads[shard-1] {
+			shard = i + 1
+		}
+	}
+
+	return shard
+}
+
+// ShardManager hands out the ClusterManager for a given Hive shard, creating
+// and caching it on first use.
+type ShardManager interface {
+	// ShardCount returns the number of Hive shards available for placement.
+	ShardCount(ctx context.Context) (int, error)
+	// ForShard returns the ClusterManager for the given shard.
+	ForShard(ctx context.Context, shard int) (ClusterManager, error)
+	// ForCluster returns the ClusterManager for the shard the cluster is
+	// assigned to.
+	ForCluster(ctx context.Context, doc *api.OpenShiftClusterDocument) (ClusterManager, error)
+}
+
+type shardManager struct {
+	log *logrus.Entry
+	env env.Interface
+
+	mu       sync.Mutex
+	managers map[int]ClusterManager
+}
+
+// NewFromEnv can return a nil ShardManager when hive features are disabled. This exists to support regions where we don't have hive,
+// and we do not want to restrict the frontend from starting up successfully.
+// It has the caveat of requiring a nil check on any operations performed with the returned ShardManager
+// until this conditional return is removed (we have hive everywhere).
+func NewFromEnv(ctx context.Context, log *logrus.Entry, env env.Interface) (ShardManager, error) {
+	adoptByHive, err := env.LiveConfig().AdoptByHive(ctx)
+	if err != nil {
+		return nil, err
+	}
+	installViaHive, err := env.LiveConfig().InstallViaHive(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !adoptByHive && !installViaHive {
+		log.Infof("hive is disabled, skipping creation of ShardManager")
+		return nil, nil
+	}
+
+	return NewShardManager(log, env), nil
+}
+
+// NewShardManager returns a ShardManager which resolves shards through the
+// environment's live config.
+func NewShardManager(log *logrus.Entry, env env.Interface) ShardManager {
+	return &shardManager{
+		log: log,
+		env: env,
+
+		managers: map[int]ClusterManager{},
+	}
+}
+
+func (sm *shardManager) ShardCount(ctx context.Context) (int, error) {
+	return sm.env.LiveConfig().HiveShardCount(ctx)
+}
+
+func (sm *shardManager) ForShard(ctx context.Context, shard int) (ClusterManager, error) {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+
+	if hr, ok := sm.managers[shard]; ok {
+		return hr, nil
+	}
+
+	hiveRestConfig, err := sm.env.LiveConfig().HiveRestConfig(ctx, shard)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting RESTConfig for Hive shard %d: %w", shard, err)
+	}
+
+	hr, err := NewFromConfig(sm.log, sm.env, hiveRestConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	sm.managers[shard] = hr
+
+	return hr, nil
+}
+
+func (sm *shardManager) ForCluster(ctx context.Context, doc *api.OpenShiftClusterDocument) (ClusterManager, error) {
+	return sm.ForShard(ctx, ShardIndex(doc))
+}
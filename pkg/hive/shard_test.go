@@ -0,0 +1,224 @@
+package hive
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+)
+
+func TestPickShard(t *testing.T) {
+	ctx := context.Background()
+
+	for _, tt := range []struct {
+		name       string
+		shardCount int
+		shards     []int
+		want       int
+		wantErr    string
+	}{
+		{
+			name:       "no clusters picks the first shard",
+			shardCount: 3,
+			want:       1,
+		},
+		{
+			name:       "unset shard index counts towards the default shard",
+			shardCount: 2,
+			shards:     []int{0, 2},
+			want:       1,
+		},
+		{
+			name:       "least loaded shard is picked",
+			shardCount: 3,
+			shards:     []int{0, 1, 2, 3, 3},
+			want:       2,
+		},
+		{
+			name:       "invalid shard count",
+			shardCount: 0,
+			wantErr:    "invalid Hive shard count 0",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			dbOpenShiftClusters, _ := testdatabase.NewFakeOpenShiftClusters()
+			fixture := testdatabase.NewFixture().WithOpenShiftClusters(dbOpenShiftClusters)
+
+			for i, shard := range tt.shards {
+				resourceID := fmt.Sprintf("/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/resourceGroup/providers/Microsoft.RedHatOpenShift/openShiftClusters/cluster%d", i)
+				fixture.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+					Key: strings.ToLower(resourceID),
+					OpenShiftCluster: &api.OpenShiftCluster{
+						ID: resourceID,
+						Properties: api.OpenShiftClusterProperties{
+							HiveProfile: api.HiveProfile{
+								ShardIndex: shard,
+							},
+						},
+					},
+				})
+			}
+
+			err := fixture.Create()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			shard, err := PickShard(ctx, dbOpenShiftClusters, tt.shardCount)
+			if err != nil && err.Error() != tt.wantErr ||
+				err == nil && tt.wantErr != "" {
+				t.Fatal(err)
+			}
+
+			if shard != tt.want {
+				t.Error(shard)
+			}
+		})
+	}
+}
+
+func TestPlaceShard(t *testing.T) {
+	ctx := context.Background()
+
+	placeShardJitter = 0
+
+	key := "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/resourcegroup/providers/microsoft.redhatopenshift/openshiftclusters/resourcename"
+
+	for _, tt := range []struct {
+		name       string
+		shardCount int
+		racing     int
+		placeErr   error
+		want       int
+		wantPlaced []int
+		wantErr    string
+	}{
+		{
+			name:       "cluster stays on the picked shard",
+			shardCount: 2,
+			want:       2,
+			wantPlaced: []int{2},
+		},
+		{
+			name:       "cluster is moved off a shard picked by a racing backend",
+			shardCount: 2,
+			racing:     2,
+			want:       1,
+			wantPlaced: []int{2, 1},
+		},
+		{
+			name:       "placement error",
+			shardCount: 2,
+			placeErr:   fmt.Errorf("lost lease"),
+			wantPlaced: []int{2},
+			wantErr:    "lost lease",
+		},
+		{
+			name:       "invalid shard count",
+			shardCount: 0,
+			wantErr:    "invalid Hive shard count 0",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			dbOpenShiftClusters, _ := testdatabase.NewFakeOpenShiftClusters()
+			fixture := testdatabase.NewFixture().WithOpenShiftClusters(dbOpenShiftClusters)
+
+			// until it is placed, the cluster counts towards the default
+			// shard, so the second shard is picked
+			fixture.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+				Key: key,
+				OpenShiftCluster: &api.OpenShiftCluster{
+					ID: key,
+				},
+			})
+
+			err := fixture.Create()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var placed []int
+			shard, err := PlaceShard(ctx, dbOpenShiftClusters, tt.shardCount, func(shard int) error {
+				placed = append(placed, shard)
+				if tt.placeErr != nil {
+					return tt.placeErr
+				}
+
+				// a racing backend places its cluster on the same shard
+				if tt.racing != 0 && len(placed) == 1 {
+					racingKey := "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/resourcegroup/providers/microsoft.redhatopenshift/openshiftclusters/racing"
+					_, err := dbOpenShiftClusters.Create(ctx, &api.OpenShiftClusterDocument{
+						ID:  dbOpenShiftClusters.NewUUID(),
+						Key: racingKey,
+						OpenShiftCluster: &api.OpenShiftCluster{
+							ID: racingKey,
+							Properties: api.OpenShiftClusterProperties{
+								HiveProfile: api.HiveProfile{
+									ShardIndex: tt.racing,
+								},
+							},
+						},
+					})
+					if err != nil {
+						return err
+					}
+				}
+
+				_, err := dbOpenShiftClusters.Patch(ctx, key, func(doc *api.OpenShiftClusterDocument) error {
+					doc.OpenShiftCluster.Properties.HiveProfile.ShardIndex = shard
+					return nil
+				})
+				return err
+			})
+			if err != nil && err.Error() != tt.wantErr ||
+				err == nil && tt.wantErr != "" {
+				t.Fatal(err)
+			}
+
+			if shard != tt.want {
+				t.Error(shard)
+			}
+
+			if !reflect.DeepEqual(placed, tt.wantPlaced) {
+				t.Error(placed)
+			}
+		})
+	}
+}
+
+func TestShardIndex(t *testing.T) {
+	for _, tt := range []struct {
+		shardIndex int
+		want       int
+	}{
+		{
+			shardIndex: 0,
+			want:       DefaultShard,
+		},
+		{
+			shardIndex: 3,
+			want:       3,
+		},
+	} {
+		doc := &api.OpenShiftClusterDocument{
+			OpenShiftCluster: &api.OpenShiftCluster{
+				Properties: api.OpenShiftClusterProperties{
+					HiveProfile: api.HiveProfile{
+						ShardIndex: tt.shardIndex,
+					},
+				},
+			},
+		}
+
+		if got := ShardIndex(doc); got != tt.want {
+			t.Errorf("got %d, want %d", got, tt.want)
+		}
+	}
+}
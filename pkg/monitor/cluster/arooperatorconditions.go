@@ -5,27 +5,19 @@ package cluster
 
 import (
 	"context"
-	"strings"
 
 	operatorv1 "github.com/openshift/api/operator/v1"
 	"github.com/sirupsen/logrus"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/util/conditions"
 )
 
 const (
 	operatorConditionsMetricsTopic = "arooperator.conditions"
 )
 
-var aroOperatorConditionsExpected = map[string]operatorv1.ConditionStatus{
-	arov1alpha1.InternetReachableFromMaster: operatorv1.ConditionTrue,
-	arov1alpha1.InternetReachableFromWorker: operatorv1.ConditionTrue,
-	arov1alpha1.ServicePrincipalValid:       operatorv1.ConditionTrue,
-	arov1alpha1.DefaultIngressCertificate:   operatorv1.ConditionTrue,
-	arov1alpha1.MachineValid:                operatorv1.ConditionTrue,
-}
-
 func (mon *Monitor) emitAroOperatorConditions(ctx context.Context) error {
 	cluster, err := mon.arocli.AroV1alpha1().Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
 	if err != nil {
@@ -33,14 +25,7 @@ func (mon *Monitor) emitAroOperatorConditions(ctx context.Context) error {
 	}
 
 	for _, c := range cluster.Status.Conditions {
-		if aroOperatorConditionsExpected[c.Type] == c.Status {
-			continue
-		}
-
-		// filter out expected conditions (available=true, progressing=false, degraded=false)
-		if (strings.HasSuffix(c.Type, "ControllerAvailable") && c.Status == operatorv1.ConditionTrue) ||
-			(strings.HasSuffix(c.Type, "ControllerProgressing") && c.Status == operatorv1.ConditionFalse) ||
-			(strings.HasSuffix(c.Type, "ControllerDegraded") && c.Status == operatorv1.ConditionFalse) {
+		if conditions.IsExpected(c) {
 			continue
 		}
 
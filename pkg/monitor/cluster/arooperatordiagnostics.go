@@ -0,0 +1,71 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	pkgoperator "github.com/Azure/ARO-RP/pkg/operator"
+	"github.com/Azure/ARO-RP/pkg/operator/diagnostics"
+)
+
+// emitAroOperatorDiagnostics scrapes the diagnostics endpoint of each running
+// ARO operator pod and emits the reconcile telemetry of its controllers
+func (mon *Monitor) emitAroOperatorDiagnostics(ctx context.Context) error {
+	pods, err := mon.cli.CoreV1().Pods(pkgoperator.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: "app in (aro-operator-master, aro-operator-worker)",
+	})
+	if err != nil {
+		return err
+	}
+
+	// keep going if a pod can't be scraped, e.g. because it is terminating
+	var lastErr error
+	for _, pod := range pods.Items {
+		if pod.Status.Phase != corev1.PodRunning {
+			continue
+		}
+
+		b, err := mon.cli.CoreV1().Pods(pod.Namespace).ProxyGet("http", pod.Name, strconv.Itoa(diagnostics.Port), diagnostics.Path, nil).DoRaw(ctx)
+		if err != nil {
+			mon.log.Warnf("failed to scrape diagnostics of pod %s: %s", pod.Name, err)
+			lastErr = err
+			continue
+		}
+
+		var d *diagnostics.Diagnostics
+		err = json.Unmarshal(b, &d)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		for _, c := range d.Controllers {
+			enabled := "unknown"
+			if c.Enabled != nil {
+				enabled = strconv.FormatBool(*c.Enabled)
+			}
+
+			dims := map[string]string{
+				"role":       d.Role,
+				"controller": c.Name,
+				"enabled":    enabled,
+				"result":     c.LastResult,
+			}
+
+			mon.emitGauge("arooperator.controller.errorstreak", int64(c.ErrorStreak), dims)
+			if c.LastSuccessTime != nil {
+				mon.emitGauge("arooperator.controller.lastsuccess.age", int64(time.Since(*c.LastSuccessTime).Seconds()), dims)
+			}
+		}
+	}
+
+	return lastErr
+}
@@ -0,0 +1,139 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	restclient "k8s.io/client-go/rest"
+	ktesting "k8s.io/client-go/testing"
+
+	"github.com/Azure/ARO-RP/pkg/operator/diagnostics"
+	utillog "github.com/Azure/ARO-RP/pkg/util/log"
+	mock_metrics "github.com/Azure/ARO-RP/pkg/util/mocks/metrics"
+)
+
+type fakeResponseWrapper struct {
+	b   []byte
+	err error
+}
+
+func (w *fakeResponseWrapper) DoRaw(context.Context) ([]byte, error) {
+	return w.b, w.err
+}
+
+func (w *fakeResponseWrapper) Stream(context.Context) (io.ReadCloser, error) {
+	return nil, errors.New("not implemented")
+}
+
+func TestEmitAroOperatorDiagnostics(t *testing.T) {
+	ctx := context.Background()
+
+	operatorPod := func(name, app string, phase corev1.PodPhase) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "openshift-azure-operator",
+				Labels: map[string]string{
+					"app": app,
+				},
+			},
+			Status: corev1.PodStatus{
+				Phase: phase,
+			},
+		}
+	}
+
+	cli := fake.NewSimpleClientset(
+		operatorPod("aro-operator-master-1", "aro-operator-master", corev1.PodRunning),
+		operatorPod("aro-operator-master-0", "aro-operator-master", corev1.PodPending), // not scraped - not running
+		operatorPod("aro-operator-worker-0", "aro-operator-worker", corev1.PodRunning),
+		operatorPod("other", "other", corev1.PodRunning), // not scraped - not an operator
+	)
+
+	enabled := true
+	lastSuccess := time.Now().Add(-time.Hour)
+	responses := map[string]*diagnostics.Diagnostics{
+		"aro-operator-master-1": {
+			Role: "master",
+			Controllers: []*diagnostics.ControllerStatus{
+				{
+					Name:            "Machine",
+					Enabled:         &enabled,
+					LastResult:      diagnostics.ResultError,
+					LastSuccessTime: &lastSuccess,
+					ErrorStreak:     3,
+				},
+			},
+		},
+		"aro-operator-worker-0": {
+			Role: "worker",
+			Controllers: []*diagnostics.ControllerStatus{
+				{
+					Name: "InternetChecker",
+				},
+			},
+		},
+	}
+
+	var scraped []string
+	cli.PrependProxyReactor("pods", func(action ktesting.Action) (bool, restclient.ResponseWrapper, error) {
+		a := action.(ktesting.ProxyGetAction)
+		if a.GetPort() != "8081" || a.GetPath() != "/diagnostics" {
+			t.Errorf("unexpected proxy request %s:%s", a.GetPort(), a.GetPath())
+		}
+		scraped = append(scraped, a.GetName())
+
+		b, err := json.Marshal(responses[a.GetName()])
+		return true, &fakeResponseWrapper{b: b, err: err}, nil
+	})
+
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	m := mock_metrics.NewMockEmitter(controller)
+
+	mon := &Monitor{
+		cli: cli,
+		m:   m,
+		log: utillog.GetLogger(),
+	}
+
+	masterDims := map[string]string{
+		"role":       "master",
+		"controller": "Machine",
+		"enabled":    "true",
+		"result":     "Error",
+	}
+	m.EXPECT().EmitGauge("arooperator.controller.errorstreak", int64(3), masterDims)
+	m.EXPECT().EmitGauge("arooperator.controller.lastsuccess.age", gomock.Any(), masterDims).Do(func(_ string, age int64, _ map[string]string) {
+		if age < 3600 || age > 3660 {
+			t.Errorf("unexpected age %d", age)
+		}
+	})
+	m.EXPECT().EmitGauge("arooperator.controller.errorstreak", int64(0), map[string]string{
+		"role":       "worker",
+		"controller": "InternetChecker",
+		"enabled":    "unknown",
+		"result":     "",
+	})
+
+	err := mon.emitAroOperatorDiagnostics(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(scraped) != 2 {
+		t.Errorf("scraped %v", scraped)
+	}
+}
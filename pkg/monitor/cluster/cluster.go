@@ -190,6 +190,7 @@ func (mon *Monitor) Monitor(ctx context.Context) (errs []error) {
 	for _, f := range []func(context.Context) error{
 		mon.emitAroOperatorHeartbeat,
 		mon.emitAroOperatorConditions,
+		mon.emitAroOperatorDiagnostics,
 		mon.emitNSGReconciliation,
 		mon.emitClusterOperatorConditions,
 		mon.emitClusterOperatorVersions,
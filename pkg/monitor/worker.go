@@ -15,6 +15,7 @@ import (
 	"k8s.io/client-go/rest"
 
 	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/hive"
 	"github.com/Azure/ARO-RP/pkg/monitor/azure/nsg"
 	"github.com/Azure/ARO-RP/pkg/monitor/cluster"
 	"github.com/Azure/ARO-RP/pkg/monitor/dimension"
@@ -115,10 +116,7 @@ func (mon *monitor) changefeed(ctx context.Context, baseLog *logrus.Entry, stop
 							fps == api.ProvisioningStateDeleting):
 					mon.deleteDoc(doc)
 				default:
-					// in the future we will have the shard index set on the api.OpenShiftClusterDocument
-					// but for now we simply select Hive (AKS) shard 1
-					// e.g. shard := mon.hiveShardConfigs[doc.shardIndex]
-					shard := 1
+					shard := hive.ShardIndex(doc)
 
 					_, exists := mon.getHiveShardConfig(shard)
 					if !exists {
@@ -247,9 +245,7 @@ func (mon *monitor) workOne(ctx context.Context, log *logrus.Entry, doc *api.Ope
 		return
 	}
 
-	// once sharding is implemented, we will have the shard set on the api.OpenShiftClusterDocument
-	// e.g. shard := mon.hiveShardConfigs[doc.shardIndex]
-	shard := 1
+	shard := hive.ShardIndex(doc)
 	hiveRestConfig, exists := mon.getHiveShardConfig(shard)
 	if !exists {
 		log.Warnf("no hiveShardConfigs set for shard %d", shard)
@@ -8,6 +8,7 @@ import (
 
 	operatorv1 "github.com/openshift/api/operator/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 type BannerContent string
@@ -29,6 +30,8 @@ const (
 	DefaultIngressCertificate = "DefaultIngressCertificate"
 	DefaultClusterDNS         = "DefaultClusterDNS"
 	GuardRailsStatus          = "GuardRailsStatus"
+
+	ManagedResourceDrift = "ManagedResourceDrift"
 )
 
 // AllConditionTypes is a operator conditions currently in use, any condition not in this list is not
@@ -43,6 +46,7 @@ func AllConditionTypes() []string {
 		DefaultIngressCertificate,
 		DefaultClusterDNS,
 		GuardRailsStatus,
+		ManagedResourceDrift,
 	}
 }
 
@@ -116,6 +120,26 @@ type ClusterSpec struct {
 	Banner                   Banner              `json:"banner,omitempty"`
 	ServiceSubnets           []string            `json:"serviceSubnets,omitempty"`
 
+	// Autoscaler defines the ClusterAutoscaler and MachineAutoscalers the ARO
+	// operator deploys.  If nil, the ARO operator manages no autoscaling.
+	Autoscaler *AutoscalerSpec `json:"autoscaler,omitempty"`
+
+	// Certificates defines the customer provided API server and ingress
+	// certificates the ARO operator installs and renews.  Certificates which
+	// are not set are not managed by the ARO operator.
+	Certificates *CertificatesSpec `json:"certificates,omitempty"`
+
+	// MachineHealthChecks defines the MachineHealthChecks the ARO operator
+	// deploys for worker machines.  If empty, a single default
+	// MachineHealthCheck covers all worker machines.  Unlike the rest of the
+	// spec this is not set by the RP.
+	MachineHealthChecks []MachineHealthCheckPolicy `json:"machineHealthChecks,omitempty"`
+
+	// StorageAccounts defines customer exceptions to the network rules the
+	// ARO operator reconciles on the cluster storage accounts.  Unlike the
+	// rest of the spec this is not set by the RP.
+	StorageAccounts StorageAccountsSpec `json:"storageAccounts,omitempty"`
+
 	// OperatorFlags defines feature gates for the ARO Operator
 	OperatorFlags OperatorFlags `json:"operatorflags,omitempty"`
 }
@@ -125,11 +149,207 @@ type Banner struct {
 	Content BannerContent `json:"content,omitempty"`
 }
 
+// AutoscalerSpec defines the cluster autoscaler configuration set through the
+// RP autoscalerProfile
+type AutoscalerSpec struct {
+	ScaleDown *AutoscalerScaleDownSpec `json:"scaleDown,omitempty"`
+
+	// MachineSets are the worker machinesets to autoscale
+	MachineSets []MachineAutoscalerSpec `json:"machineSets,omitempty"`
+}
+
+// AutoscalerScaleDownSpec defines the scale down settings of the
+// ClusterAutoscaler.  Unset fields take the ClusterAutoscaler defaults.
+type AutoscalerScaleDownSpec struct {
+	Enabled              bool   `json:"enabled"`
+	DelayAfterAdd        string `json:"delayAfterAdd,omitempty"`
+	UnneededTime         string `json:"unneededTime,omitempty"`
+	UtilizationThreshold string `json:"utilizationThreshold,omitempty"`
+}
+
+// MachineAutoscalerSpec defines a MachineAutoscaler for the machineset Name
+type MachineAutoscalerSpec struct {
+	Name        string `json:"name"`
+	MinReplicas int32  `json:"minReplicas"`
+	MaxReplicas int32  `json:"maxReplicas"`
+}
+
+// AutoscalerState is the state of an AutoscalerStatus
+type AutoscalerState string
+
+const (
+	AutoscalerStateSucceeded AutoscalerState = "Succeeded"
+	AutoscalerStateFailed    AutoscalerState = "Failed"
+)
+
+// AutoscalerStatus is the observed state of the autoscaler configuration.  It
+// is reported back to the customer through the RP autoscalerProfileStatus.
+type AutoscalerStatus struct {
+	State   AutoscalerState `json:"state"`
+	Message string          `json:"message,omitempty"`
+}
+
+// CertificatesSpec defines the customer provided certificates set through the
+// RP certificateProfile
+type CertificatesSpec struct {
+	APIServer *CertificateSourceSpec `json:"apiServer,omitempty"`
+	Ingress   *CertificateSourceSpec `json:"ingress,omitempty"`
+}
+
+// CertificateSourceSpec defines where a certificate comes from.  Exactly one
+// of KeyVaultSecretID and ACME is set.
+type CertificateSourceSpec struct {
+	// KeyVaultSecretID is the ID of a customer key vault secret holding a PEM
+	// or PKCS#12 certificate and private key
+	KeyVaultSecretID string `json:"keyVaultSecretId,omitempty"`
+
+	// ACME is an ACME issuer validating the cluster domain with DNS-01
+	// challenges
+	ACME *ACMEIssuerSpec `json:"acme,omitempty"`
+}
+
+// ACMEIssuerSpec defines an ACME issuer.  DNSZoneID is the Azure DNS zone in
+// which the challenge records are created.
+type ACMEIssuerSpec struct {
+	DirectoryURL string `json:"directoryUrl"`
+	Email        string `json:"email,omitempty"`
+	DNSZoneID    string `json:"dnsZoneId"`
+}
+
+// MachineHealthCheckPolicy defines a MachineHealthCheck for the machines
+// matched by Selector
+type MachineHealthCheckPolicy struct {
+	// Name is appended to the name of the rendered MachineHealthCheck
+	// +kubebuilder:validation:Pattern:=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +kubebuilder:validation:MaxLength:=40
+	Name string `json:"name"`
+
+	// Selector selects the machines the policy applies to, usually by their
+	// machine.openshift.io/cluster-api-machineset label
+	Selector metav1.LabelSelector `json:"selector"`
+
+	// UnhealthyTimeout is how long a node may be not ready before its
+	// machine is remediated.  Defaults to 15m.
+	UnhealthyTimeout *metav1.Duration `json:"unhealthyTimeout,omitempty"`
+
+	// NodeStartupTimeout is how long a machine may take to join the cluster
+	// before it is remediated.  Defaults to 25m.
+	NodeStartupTimeout *metav1.Duration `json:"nodeStartupTimeout,omitempty"`
+
+	// MaxUnhealthy is the number or percentage of unhealthy machines above
+	// which remediation stops.  Defaults to 1.
+	// +kubebuilder:validation:XIntOrString
+	// +kubebuilder:validation:Pattern:=`^((100|[0-9]{1,2})%|[0-9]+)$`
+	MaxUnhealthy *intstr.IntOrString `json:"maxUnhealthy,omitempty"`
+
+	// PauseWindows are recurring windows during which remediation is paused
+	PauseWindows []MachineHealthCheckPauseWindow `json:"pauseWindows,omitempty"`
+}
+
+// MachineHealthCheckPauseWindow is a recurring window during which
+// MachineHealthCheck remediation is paused
+type MachineHealthCheckPauseWindow struct {
+	// Days are the days of the week the window starts on.  Defaults to every
+	// day.
+	Days []Weekday `json:"days,omitempty"`
+
+	// Start is the UTC time of day the window starts at, in HH:MM format
+	// +kubebuilder:validation:Pattern:=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	Start string `json:"start"`
+
+	// Duration is the length of the window
+	Duration metav1.Duration `json:"duration"`
+}
+
+// StorageAccountsSpec defines customer exceptions to the network rules of the
+// cluster and image registry storage accounts
+type StorageAccountsSpec struct {
+	// ExtraAllowedSubnets are the resource IDs of subnets, besides the cluster
+	// subnets, which are allowed to access the storage accounts
+	ExtraAllowedSubnets []string `json:"extraAllowedSubnets,omitempty"`
+
+	// IPRules are the public IP addresses or CIDR ranges which are allowed to
+	// access the storage accounts
+	IPRules []string `json:"ipRules,omitempty"`
+
+	// PrivateEndpointOnly removes all virtual network and IP rules other than
+	// the RP's from the storage accounts and denies access other than through
+	// private endpoints.  It is only applied to accounts with an approved
+	// private endpoint connection, which must be set up by the customer
+	PrivateEndpointOnly bool `json:"privateEndpointOnly,omitempty"`
+}
+
+// StorageAccountStatus is the observed state of the network rules of a cluster
+// storage account
+type StorageAccountStatus struct {
+	Name                string   `json:"name"`
+	PrivateEndpointOnly bool     `json:"privateEndpointOnly,omitempty"`
+	VirtualNetworkRules []string `json:"virtualNetworkRules,omitempty"`
+	IPRules             []string `json:"ipRules,omitempty"`
+
+	// CustomerRules are the rules added because they are declared in
+	// spec.storageAccounts.  They are removed once no longer declared.
+	CustomerRules []string `json:"customerRules,omitempty"`
+
+	// RecentChanges are the latest rule changes made by the operator, oldest
+	// first
+	RecentChanges []StorageAccountRuleChange `json:"recentChanges,omitempty"`
+}
+
+// StorageAccountRuleChange records a change made by the operator to the
+// network rules of a storage account
+type StorageAccountRuleChange struct {
+	Time   metav1.Time                    `json:"time"`
+	Action StorageAccountRuleChangeAction `json:"action"`
+	Type   StorageAccountRuleType         `json:"type"`
+	Rule   string                         `json:"rule"`
+	Reason string                         `json:"reason"`
+}
+
+// StorageAccountRuleChangeAction is the action of a StorageAccountRuleChange
+type StorageAccountRuleChangeAction string
+
+const (
+	StorageAccountRuleAdded   StorageAccountRuleChangeAction = "Added"
+	StorageAccountRuleRemoved StorageAccountRuleChangeAction = "Removed"
+	StorageAccountRuleSet     StorageAccountRuleChangeAction = "Set"
+)
+
+// StorageAccountRuleType is the type of rule of a StorageAccountRuleChange
+type StorageAccountRuleType string
+
+const (
+	StorageAccountRuleTypeVirtualNetwork StorageAccountRuleType = "VirtualNetwork"
+	StorageAccountRuleTypeIP             StorageAccountRuleType = "IP"
+	StorageAccountRuleTypeDefaultAction  StorageAccountRuleType = "DefaultAction"
+)
+
+// Weekday is a day of the week, e.g. Monday
+// +kubebuilder:validation:Enum=Sunday;Monday;Tuesday;Wednesday;Thursday;Friday;Saturday
+type Weekday string
+
+// MachineHealthCheckStatus is the observed state of the MachineHealthCheck
+// rendered for a MachineHealthCheckPolicy
+type MachineHealthCheckStatus struct {
+	Name             string `json:"name"`
+	ExpectedMachines int    `json:"expectedMachines"`
+	CurrentHealthy   int    `json:"currentHealthy"`
+
+	// Remediating is the number of unhealthy machines which are being, or
+	// are waiting to be, remediated
+	Remediating         int   `json:"remediating"`
+	RemediationsAllowed int32 `json:"remediationsAllowed"`
+	Paused              bool  `json:"paused,omitempty"`
+}
+
 // ClusterStatus defines the observed state of Cluster
 type ClusterStatus struct {
-	OperatorVersion   string                         `json:"operatorVersion,omitempty"`
-	Conditions        []operatorv1.OperatorCondition `json:"conditions,omitempty"`
-	RedHatKeysPresent []string                       `json:"redHatKeysPresent,omitempty"`
+	OperatorVersion     string                         `json:"operatorVersion,omitempty"`
+	Conditions          []operatorv1.OperatorCondition `json:"conditions,omitempty"`
+	RedHatKeysPresent   []string                       `json:"redHatKeysPresent,omitempty"`
+	MachineHealthChecks []MachineHealthCheckStatus     `json:"machineHealthChecks,omitempty"`
+	StorageAccounts     []StorageAccountStatus         `json:"storageAccounts,omitempty"`
+	Autoscaler          *AutoscalerStatus              `json:"autoscaler,omitempty"`
 }
 
 // Cluster is the Schema for the clusters API
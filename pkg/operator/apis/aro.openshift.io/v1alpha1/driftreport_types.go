@@ -0,0 +1,68 @@
+package v1alpha1
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	SingletonDriftReportName = "cluster"
+)
+
+// DriftedResource summarises the corrections made by the operator to a
+// resource which it manages
+type DriftedResource struct {
+	GroupKind string `json:"groupKind"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+
+	// Corrections is the number of times the resource has been corrected
+	// since the operator started
+	Corrections int `json:"corrections"`
+	// RecentCorrections is the number of times the resource has been
+	// corrected within the last hour
+	RecentCorrections int `json:"recentCorrections"`
+
+	// LastFields are the fields which were changed by the last correction
+	LastFields []string `json:"lastFields,omitempty"`
+	// LastManager is the field manager which changed the resource before the
+	// last correction
+	LastManager string `json:"lastManager,omitempty"`
+
+	FirstCorrected metav1.Time `json:"firstCorrected"`
+	LastCorrected  metav1.Time `json:"lastCorrected"`
+}
+
+// DriftReportStatus defines the observed drift of the resources managed by the
+// operator
+type DriftReportStatus struct {
+	Resources []DriftedResource `json:"resources,omitempty"`
+}
+
+// DriftReport is the Schema for the driftreports API
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type DriftReport struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Status DriftReportStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DriftReportList contains a list of DriftReport
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type DriftReportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DriftReport `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DriftReport{}, &DriftReportList{})
+}
@@ -0,0 +1,43 @@
+package v1alpha1
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GuardrailsPolicySpec defines how a guardrails policy is enforced
+type GuardrailsPolicySpec struct {
+	// Enforcement is the gatekeeper enforcement action of the policy: deny
+	// rejects violating requests, warn admits them with a warning and dryrun
+	// only reports them in the audit
+	// +kubebuilder:validation:Enum=deny;dryrun;warn
+	Enforcement string `json:"enforcement"`
+}
+
+// GuardrailsPolicy selects the enforcement of the guardrails policy of the
+// same name, and takes precedence over its enforcement operator flag
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type GuardrailsPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec GuardrailsPolicySpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GuardrailsPolicyList contains a list of GuardrailsPolicy
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type GuardrailsPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GuardrailsPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&GuardrailsPolicy{}, &GuardrailsPolicyList{})
+}
@@ -0,0 +1,355 @@
+package autoscaler
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	machinev1beta1 "github.com/openshift/api/machine/v1beta1"
+	"github.com/sirupsen/logrus"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/base"
+	"github.com/Azure/ARO-RP/pkg/operator/diagnostics"
+)
+
+const (
+	ControllerName = "Autoscaler"
+
+	// autoscalerLabel marks the autoscaler objects managed by ARO
+	autoscalerLabel = "aro.openshift.io/autoscaler"
+
+	clusterAutoscalerName = "default"
+	machineSetsNamespace  = "openshift-machine-api"
+
+	// minWorkerReplicas matches the MachineSet controller, which scales the
+	// worker machinesets back up if they have fewer replicas in total
+	minWorkerReplicas = 2
+)
+
+var (
+	clusterAutoscalerGVK = schema.GroupVersionKind{Group: "autoscaling.openshift.io", Version: "v1", Kind: "ClusterAutoscaler"}
+	machineAutoscalerGVK = schema.GroupVersionKind{Group: "autoscaling.openshift.io", Version: "v1beta1", Kind: "MachineAutoscaler"}
+)
+
+type Reconciler struct {
+	base.AROController
+}
+
+func NewReconciler(log *logrus.Entry, client client.Client) *Reconciler {
+	return &Reconciler{
+		AROController: base.AROController{
+			Log:    log,
+			Client: client,
+			Name:   ControllerName,
+		},
+	}
+}
+
+// Reconcile ensures the ClusterAutoscaler and MachineAutoscalers requested in
+// spec.autoscaler and reports the outcome in status.autoscaler
+func (r *Reconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
+	instance, err := r.GetCluster(ctx)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if !instance.Spec.OperatorFlags.GetSimpleBoolean(operator.AutoscalerEnabled) {
+		r.Log.Debug("controller is disabled")
+		return reconcile.Result{}, nil
+	}
+
+	r.Log.Debug("running")
+
+	status, err := r.reconcileAutoscalers(ctx, instance)
+	if err == nil {
+		err = r.reconcileStatus(ctx, instance, status)
+	}
+	if err != nil {
+		r.Log.Error(err)
+		r.SetDegraded(ctx, err)
+
+		return reconcile.Result{}, err
+	}
+
+	r.ClearConditions(ctx)
+	return reconcile.Result{}, nil
+}
+
+// reconcileAutoscalers returns the status to report.  Problems with the
+// configuration requested by the customer are reported in the status; other
+// errors are returned so that the reconcile is retried.
+func (r *Reconciler) reconcileAutoscalers(ctx context.Context, instance *arov1alpha1.Cluster) (*arov1alpha1.AutoscalerStatus, error) {
+	if instance.Spec.Autoscaler == nil {
+		return nil, r.removeAutoscalers(ctx, nil)
+	}
+
+	message, err := r.validateMachineSets(ctx, instance.Spec.Autoscaler)
+	if err != nil {
+		return nil, err
+	}
+	if message != "" {
+		return failed(message), nil
+	}
+
+	desired := []*unstructured.Unstructured{clusterAutoscaler(instance.Spec.Autoscaler.ScaleDown)}
+	for i := range instance.Spec.Autoscaler.MachineSets {
+		desired = append(desired, machineAutoscaler(&instance.Spec.Autoscaler.MachineSets[i]))
+	}
+
+	for _, u := range desired {
+		message, err := r.ensure(ctx, instance, u)
+		if err != nil {
+			return nil, err
+		}
+		if message != "" {
+			return failed(message), nil
+		}
+	}
+
+	err = r.removeAutoscalers(ctx, instance.Spec.Autoscaler)
+	if err != nil {
+		return nil, err
+	}
+
+	return &arov1alpha1.AutoscalerStatus{
+		State: arov1alpha1.AutoscalerStateSucceeded,
+	}, nil
+}
+
+// validateMachineSets checks that the autoscaled machinesets are existing
+// worker machinesets and that their minimums keep enough worker replicas
+func (r *Reconciler) validateMachineSets(ctx context.Context, spec *arov1alpha1.AutoscalerSpec) (string, error) {
+	machinesets := &machinev1beta1.MachineSetList{}
+	err := r.Client.List(ctx, machinesets, client.InNamespace(machineSetsNamespace), client.MatchingLabels{
+		"machine.openshift.io/cluster-api-machine-role": "worker",
+	})
+	if err != nil {
+		return "", err
+	}
+
+	replicas := map[string]int32{}
+	for _, machineset := range machinesets.Items {
+		replicas[machineset.Name] = 0
+		if machineset.Spec.Replicas != nil {
+			replicas[machineset.Name] = *machineset.Spec.Replicas
+		}
+	}
+
+	for _, ms := range spec.MachineSets {
+		if _, found := replicas[ms.Name]; !found {
+			return fmt.Sprintf("worker machineset %q not found", ms.Name), nil
+		}
+		replicas[ms.Name] = ms.MinReplicas
+	}
+
+	var total int32
+	for _, n := range replicas {
+		total += n
+	}
+
+	if len(spec.MachineSets) > 0 && total < minWorkerReplicas {
+		return fmt.Sprintf("the worker machinesets must keep at least %d replicas in total, but the autoscaler minimums allow %d", minWorkerReplicas, total), nil
+	}
+
+	return "", nil
+}
+
+// ensure creates or updates an autoscaler object.  It returns a message if
+// the object exists but isn't managed by ARO.
+func (r *Reconciler) ensure(ctx context.Context, instance *arov1alpha1.Cluster, desired *unstructured.Unstructured) (string, error) {
+	err := controllerutil.SetControllerReference(instance, desired, scheme.Scheme)
+	if err != nil {
+		return "", err
+	}
+
+	existing := &unstructured.Unstructured{}
+	existing.SetGroupVersionKind(desired.GroupVersionKind())
+	err = r.Client.Get(ctx, client.ObjectKeyFromObject(desired), existing)
+	if kerrors.IsNotFound(err) {
+		r.Log.Infof("creating %s %s", desired.GetKind(), desired.GetName())
+		return "", r.Client.Create(ctx, desired)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if existing.GetLabels()[autoscalerLabel] != "true" {
+		return fmt.Sprintf("%s %q already exists and is not managed by ARO", desired.GetKind(), desired.GetName()), nil
+	}
+
+	if reflect.DeepEqual(existing.Object["spec"], desired.Object["spec"]) {
+		return "", nil
+	}
+
+	r.Log.Infof("updating %s %s", desired.GetKind(), desired.GetName())
+	existing.Object["spec"] = desired.Object["spec"]
+	return "", r.Client.Update(ctx, existing)
+}
+
+// removeAutoscalers removes the ARO managed MachineAutoscalers which are not
+// in spec, and the ARO managed ClusterAutoscaler if spec is nil
+func (r *Reconciler) removeAutoscalers(ctx context.Context, spec *arov1alpha1.AutoscalerSpec) error {
+	desired := map[string]bool{}
+	if spec != nil {
+		for _, ms := range spec.MachineSets {
+			desired[ms.Name] = true
+		}
+	}
+
+	machineAutoscalers := &unstructured.UnstructuredList{}
+	machineAutoscalers.SetGroupVersionKind(machineAutoscalerGVK.GroupVersion().WithKind(machineAutoscalerGVK.Kind + "List"))
+	err := r.Client.List(ctx, machineAutoscalers, client.InNamespace(machineSetsNamespace), client.MatchingLabels{autoscalerLabel: "true"})
+	if err != nil {
+		return err
+	}
+
+	for i := range machineAutoscalers.Items {
+		if desired[machineAutoscalers.Items[i].GetName()] {
+			continue
+		}
+
+		r.Log.Infof("deleting MachineAutoscaler %s", machineAutoscalers.Items[i].GetName())
+		err = r.Client.Delete(ctx, &machineAutoscalers.Items[i])
+		if client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+
+	if spec != nil {
+		return nil
+	}
+
+	existing := &unstructured.Unstructured{}
+	existing.SetGroupVersionKind(clusterAutoscalerGVK)
+	err = r.Client.Get(ctx, client.ObjectKey{Name: clusterAutoscalerName}, existing)
+	if kerrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if existing.GetLabels()[autoscalerLabel] != "true" {
+		return nil
+	}
+
+	r.Log.Infof("deleting ClusterAutoscaler %s", clusterAutoscalerName)
+	return client.IgnoreNotFound(r.Client.Delete(ctx, existing))
+}
+
+func (r *Reconciler) reconcileStatus(ctx context.Context, instance *arov1alpha1.Cluster, status *arov1alpha1.AutoscalerStatus) error {
+	if reflect.DeepEqual(instance.Status.Autoscaler, status) {
+		return nil
+	}
+
+	instance.Status.Autoscaler = status
+	return r.Client.Status().Update(ctx, instance)
+}
+
+func failed(message string) *arov1alpha1.AutoscalerStatus {
+	return &arov1alpha1.AutoscalerStatus{
+		State:   arov1alpha1.AutoscalerStateFailed,
+		Message: message,
+	}
+}
+
+func clusterAutoscaler(scaleDown *arov1alpha1.AutoscalerScaleDownSpec) *unstructured.Unstructured {
+	spec := map[string]interface{}{}
+
+	if scaleDown != nil {
+		sd := map[string]interface{}{
+			"enabled": scaleDown.Enabled,
+		}
+		if scaleDown.DelayAfterAdd != "" {
+			sd["delayAfterAdd"] = scaleDown.DelayAfterAdd
+		}
+		if scaleDown.UnneededTime != "" {
+			sd["unneededTime"] = scaleDown.UnneededTime
+		}
+		if scaleDown.UtilizationThreshold != "" {
+			sd["utilizationThreshold"] = scaleDown.UtilizationThreshold
+		}
+		spec["scaleDown"] = sd
+	}
+
+	u := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"spec": spec,
+		},
+	}
+	u.SetGroupVersionKind(clusterAutoscalerGVK)
+	u.SetName(clusterAutoscalerName)
+	u.SetLabels(map[string]string{autoscalerLabel: "true"})
+
+	return u
+}
+
+func machineAutoscaler(ms *arov1alpha1.MachineAutoscalerSpec) *unstructured.Unstructured {
+	// integers are int64 to compare equal to the objects read back from the
+	// API server
+	u := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"spec": map[string]interface{}{
+				"minReplicas": int64(ms.MinReplicas),
+				"maxReplicas": int64(ms.MaxReplicas),
+				"scaleTargetRef": map[string]interface{}{
+					"apiVersion": machinev1beta1.GroupVersion.String(),
+					"kind":       "MachineSet",
+					"name":       ms.Name,
+				},
+			},
+		},
+	}
+	u.SetGroupVersionKind(machineAutoscalerGVK)
+	u.SetNamespace(machineSetsNamespace)
+	u.SetName(ms.Name)
+	u.SetLabels(map[string]string{autoscalerLabel: "true"})
+
+	return u
+}
+
+// SetupWithManager watches the ARO Cluster resource, the autoscaler objects it
+// owns and the worker machinesets
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	aroClusterPredicate := predicate.NewPredicateFuncs(func(o client.Object) bool {
+		return strings.EqualFold(arov1alpha1.SingletonClusterName, o.GetName())
+	})
+	workerMachineSetPredicate := predicate.NewPredicateFuncs(func(o client.Object) bool {
+		return strings.EqualFold("worker", o.GetLabels()["machine.openshift.io/cluster-api-machine-role"])
+	})
+
+	clusterAutoscaler := &unstructured.Unstructured{}
+	clusterAutoscaler.SetGroupVersionKind(clusterAutoscalerGVK)
+	machineAutoscaler := &unstructured.Unstructured{}
+	machineAutoscaler.SetGroupVersionKind(machineAutoscalerGVK)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&arov1alpha1.Cluster{}, builder.WithPredicates(aroClusterPredicate)).
+		Named(ControllerName).
+		Owns(clusterAutoscaler).
+		Owns(machineAutoscaler).
+		Watches(
+			&source.Kind{Type: &machinev1beta1.MachineSet{}},
+			&handler.EnqueueRequestForObject{},
+			builder.WithPredicates(workerMachineSetPredicate),
+		).
+		Complete(diagnostics.Wrap(ControllerName, operator.AutoscalerEnabled, r))
+}
@@ -0,0 +1,227 @@
+package autoscaler
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest/to"
+	machinev1beta1 "github.com/openshift/api/machine/v1beta1"
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/util/cmp"
+	_ "github.com/Azure/ARO-RP/pkg/util/scheme"
+	utilerror "github.com/Azure/ARO-RP/test/util/error"
+)
+
+func TestReconciler(t *testing.T) {
+	fakeMachineSet := func(name string, replicas int32) *machinev1beta1.MachineSet {
+		return &machinev1beta1.MachineSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: machineSetsNamespace,
+				Labels: map[string]string{
+					"machine.openshift.io/cluster-api-machine-role": "worker",
+				},
+			},
+			Spec: machinev1beta1.MachineSetSpec{
+				Replicas: to.Int32Ptr(replicas),
+			},
+		}
+	}
+
+	unmanaged := func(u *unstructured.Unstructured) *unstructured.Unstructured {
+		u.SetLabels(nil)
+		return u
+	}
+
+	scaleDown := &arov1alpha1.AutoscalerScaleDownSpec{
+		Enabled:       true,
+		DelayAfterAdd: "10m",
+	}
+
+	for _, tt := range []struct {
+		name                   string
+		flag                   string
+		spec                   *arov1alpha1.AutoscalerSpec
+		objects                []client.Object
+		wantClusterAutoscaler  *unstructured.Unstructured
+		wantMachineAutoscalers []string
+		wantStatus             *arov1alpha1.AutoscalerStatus
+	}{
+		{
+			name:    "controller disabled",
+			flag:    operator.FlagFalse,
+			spec:    &arov1alpha1.AutoscalerSpec{ScaleDown: scaleDown},
+			objects: []client.Object{fakeMachineSet("worker-1", 2)},
+		},
+		{
+			name:    "no autoscaler requested",
+			flag:    operator.FlagTrue,
+			objects: []client.Object{fakeMachineSet("worker-1", 2)},
+		},
+		{
+			name: "autoscalers are created",
+			flag: operator.FlagTrue,
+			spec: &arov1alpha1.AutoscalerSpec{
+				ScaleDown: scaleDown,
+				MachineSets: []arov1alpha1.MachineAutoscalerSpec{
+					{Name: "worker-1", MinReplicas: 1, MaxReplicas: 5},
+				},
+			},
+			objects:                []client.Object{fakeMachineSet("worker-1", 2), fakeMachineSet("worker-2", 1)},
+			wantClusterAutoscaler:  clusterAutoscaler(scaleDown),
+			wantMachineAutoscalers: []string{"worker-1"},
+			wantStatus:             &arov1alpha1.AutoscalerStatus{State: arov1alpha1.AutoscalerStateSucceeded},
+		},
+		{
+			name: "stale machine autoscaler is removed, unmanaged one is kept",
+			flag: operator.FlagTrue,
+			spec: &arov1alpha1.AutoscalerSpec{
+				MachineSets: []arov1alpha1.MachineAutoscalerSpec{
+					{Name: "worker-1", MinReplicas: 2, MaxReplicas: 5},
+				},
+			},
+			objects: []client.Object{
+				fakeMachineSet("worker-1", 2),
+				fakeMachineSet("worker-2", 1),
+				fakeMachineSet("worker-3", 1),
+				machineAutoscaler(&arov1alpha1.MachineAutoscalerSpec{Name: "worker-2", MinReplicas: 1, MaxReplicas: 3}),
+				unmanaged(machineAutoscaler(&arov1alpha1.MachineAutoscalerSpec{Name: "worker-3", MinReplicas: 1, MaxReplicas: 3})),
+			},
+			wantClusterAutoscaler:  clusterAutoscaler(nil),
+			wantMachineAutoscalers: []string{"worker-1", "worker-3"},
+			wantStatus:             &arov1alpha1.AutoscalerStatus{State: arov1alpha1.AutoscalerStateSucceeded},
+		},
+		{
+			name: "unknown machineset",
+			flag: operator.FlagTrue,
+			spec: &arov1alpha1.AutoscalerSpec{
+				MachineSets: []arov1alpha1.MachineAutoscalerSpec{
+					{Name: "worker-9", MinReplicas: 2, MaxReplicas: 5},
+				},
+			},
+			objects: []client.Object{fakeMachineSet("worker-1", 2)},
+			wantStatus: &arov1alpha1.AutoscalerStatus{
+				State:   arov1alpha1.AutoscalerStateFailed,
+				Message: `worker machineset "worker-9" not found`,
+			},
+		},
+		{
+			name: "minimums too low",
+			flag: operator.FlagTrue,
+			spec: &arov1alpha1.AutoscalerSpec{
+				MachineSets: []arov1alpha1.MachineAutoscalerSpec{
+					{Name: "worker-1", MinReplicas: 0, MaxReplicas: 5},
+				},
+			},
+			objects: []client.Object{fakeMachineSet("worker-1", 2), fakeMachineSet("worker-2", 1)},
+			wantStatus: &arov1alpha1.AutoscalerStatus{
+				State:   arov1alpha1.AutoscalerStateFailed,
+				Message: "the worker machinesets must keep at least 2 replicas in total, but the autoscaler minimums allow 1",
+			},
+		},
+		{
+			name: "customer created cluster autoscaler",
+			flag: operator.FlagTrue,
+			spec: &arov1alpha1.AutoscalerSpec{ScaleDown: scaleDown},
+			objects: []client.Object{
+				fakeMachineSet("worker-1", 2),
+				unmanaged(clusterAutoscaler(nil)),
+			},
+			wantClusterAutoscaler: unmanaged(clusterAutoscaler(nil)),
+			wantStatus: &arov1alpha1.AutoscalerStatus{
+				State:   arov1alpha1.AutoscalerStateFailed,
+				Message: `ClusterAutoscaler "default" already exists and is not managed by ARO`,
+			},
+		},
+		{
+			name: "autoscaler unset removes managed autoscalers",
+			flag: operator.FlagTrue,
+			objects: []client.Object{
+				fakeMachineSet("worker-1", 2),
+				clusterAutoscaler(scaleDown),
+				machineAutoscaler(&arov1alpha1.MachineAutoscalerSpec{Name: "worker-1", MinReplicas: 1, MaxReplicas: 3}),
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			instance := &arov1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: arov1alpha1.SingletonClusterName,
+				},
+				Spec: arov1alpha1.ClusterSpec{
+					OperatorFlags: arov1alpha1.OperatorFlags{
+						operator.AutoscalerEnabled: tt.flag,
+					},
+					Autoscaler: tt.spec,
+				},
+			}
+
+			client := ctrlfake.NewClientBuilder().
+				WithObjects(instance).
+				WithObjects(tt.objects...).
+				Build()
+
+			r := NewReconciler(logrus.NewEntry(logrus.StandardLogger()), client)
+
+			_, err := r.Reconcile(ctx, ctrl.Request{})
+			utilerror.AssertErrorMessage(t, err, "")
+
+			cluster, err := r.GetCluster(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(cluster.Status.Autoscaler, tt.wantStatus) {
+				t.Error(cmp.Diff(cluster.Status.Autoscaler, tt.wantStatus))
+			}
+
+			clusterAutoscalers := &unstructured.UnstructuredList{}
+			clusterAutoscalers.SetGroupVersionKind(clusterAutoscalerGVK.GroupVersion().WithKind(clusterAutoscalerGVK.Kind + "List"))
+			err = client.List(ctx, clusterAutoscalers)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if tt.wantClusterAutoscaler == nil {
+				if len(clusterAutoscalers.Items) != 0 {
+					t.Errorf("unexpected ClusterAutoscalers: %v", clusterAutoscalers.Items)
+				}
+			} else {
+				if len(clusterAutoscalers.Items) != 1 {
+					t.Fatalf("expected one ClusterAutoscaler, got %d", len(clusterAutoscalers.Items))
+				}
+				if !reflect.DeepEqual(clusterAutoscalers.Items[0].Object["spec"], tt.wantClusterAutoscaler.Object["spec"]) {
+					t.Error(cmp.Diff(clusterAutoscalers.Items[0].Object["spec"], tt.wantClusterAutoscaler.Object["spec"]))
+				}
+			}
+
+			machineAutoscalers := &unstructured.UnstructuredList{}
+			machineAutoscalers.SetGroupVersionKind(machineAutoscalerGVK.GroupVersion().WithKind(machineAutoscalerGVK.Kind + "List"))
+			err = client.List(ctx, machineAutoscalers)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var names []string
+			for _, ma := range machineAutoscalers.Items {
+				names = append(names, ma.GetName())
+			}
+			if !reflect.DeepEqual(names, tt.wantMachineAutoscalers) {
+				t.Error(cmp.Diff(names, tt.wantMachineAutoscalers))
+			}
+		})
+	}
+}
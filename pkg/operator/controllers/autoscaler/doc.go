@@ -0,0 +1,29 @@
+package autoscaler
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+/*
+
+The controller in this package reconciles the cluster autoscaler configuration
+which customers set through the RP autoscalerProfile.  The RP copies the
+profile into spec.autoscaler of the ARO Cluster resource.
+
+When spec.autoscaler is set, the controller ensures the "default"
+ClusterAutoscaler with the requested scale down settings and one
+MachineAutoscaler, named after its machineset, for each autoscaled worker
+machineset.  ARO managed autoscaler objects are labelled
+aro.openshift.io/autoscaler; MachineAutoscalers which are no longer requested
+are removed, and all of them are removed once spec.autoscaler is unset.
+Autoscaler objects created directly on the cluster by the customer are never
+modified or removed.
+
+The outcome is reported in status.autoscaler, which the RP returns to the
+customer as autoscalerProfileStatus.  The state is Failed, with a message, if
+the configuration can't be applied, e.g. because a machineset doesn't exist or
+a customer created ClusterAutoscaler is in the way.
+
+aro.autoscaler.enabled:
+- When set to false, the controller will noop and not perform any further action
+
+*/
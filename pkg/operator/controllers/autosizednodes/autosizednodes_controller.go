@@ -21,6 +21,7 @@ import (
 
 	"github.com/Azure/ARO-RP/pkg/operator"
 	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/diagnostics"
 )
 
 type Reconciler struct {
@@ -114,7 +115,7 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return b.
 		Named(ControllerName).
 		Owns(&mcv1.KubeletConfig{}).
-		Complete(r)
+		Complete(diagnostics.Wrap(ControllerName, operator.AutosizedNodesEnabled, r))
 }
 
 func makeConfig() mcv1.KubeletConfig {
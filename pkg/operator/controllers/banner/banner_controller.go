@@ -19,6 +19,7 @@ import (
 
 	"github.com/Azure/ARO-RP/pkg/operator"
 	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/diagnostics"
 )
 
 const (
@@ -72,5 +73,5 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 		// watching ConsoleNotifications in case a user edits it
 		Watches(&source.Kind{Type: &consolev1.ConsoleNotification{}}, &handler.EnqueueRequestForObject{}, builder.WithPredicates(aroBannerPredicate)).
 		Named(ControllerName).
-		Complete(r)
+		Complete(diagnostics.Wrap(ControllerName, operator.BannerEnabled, r))
 }
@@ -0,0 +1,239 @@
+package certificates
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	mgmtdns "github.com/Azure/azure-sdk-for-go/services/dns/mgmt/2018-05-01/dns"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/crypto/acme"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/util/azureclient/mgmt/dns"
+	utilpem "github.com/Azure/ARO-RP/pkg/util/pem"
+)
+
+const (
+	// acmeAccountSecretName holds the private key of the ACME account
+	acmeAccountSecretName = "aro-acme-account"
+
+	// issueTimeout bounds the time spent waiting for ACME authorizations and
+	// orders
+	issueTimeout = 10 * time.Minute
+)
+
+// acmeSource issues certificates from an ACME issuer, answering DNS-01
+// challenges with TXT records in the customer's Azure DNS zone
+type acmeSource struct {
+	log    *logrus.Entry
+	client client.Client
+
+	spec       *arov1alpha1.ACMEIssuerSpec
+	recordSets dns.RecordSetsClient
+	zone       azure.Resource
+}
+
+func (s *acmeSource) Certificate(ctx context.Context, dnsNames []string) (*rsa.PrivateKey, []*x509.Certificate, error) {
+	ctx, cancel := context.WithTimeout(ctx, issueTimeout)
+	defer cancel()
+
+	accountKey, err := s.accountKey(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &acme.Client{
+		Key:          accountKey,
+		DirectoryURL: s.spec.DirectoryURL,
+		UserAgent:    "aro-operator",
+	}
+
+	account := &acme.Account{}
+	if s.spec.Email != "" {
+		account.Contact = []string{"mailto:" + s.spec.Email}
+	}
+
+	_, err = c.Register(ctx, account, acme.AcceptTOS)
+	if err != nil && !errors.Is(err, acme.ErrAccountAlreadyExists) {
+		return nil, nil, err
+	}
+
+	order, err := c.AuthorizeOrder(ctx, acme.DomainIDs(dnsNames...))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, url := range order.AuthzURLs {
+		err = s.authorize(ctx, c, url)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	order, err = c.WaitOrder(ctx, order.URI)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:  pkix.Name{CommonName: dnsNames[0]},
+		DNSNames: dnsNames,
+	}, key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	der, _, err := c.CreateOrderCert(ctx, order.FinalizeURL, csr, true)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	certs := make([]*x509.Certificate, 0, len(der))
+	for _, b := range der {
+		cert, err := x509.ParseCertificate(b)
+		if err != nil {
+			return nil, nil, err
+		}
+		certs = append(certs, cert)
+	}
+
+	return key, certs, nil
+}
+
+// authorize answers the DNS-01 challenge of an authorization
+func (s *acmeSource) authorize(ctx context.Context, c *acme.Client, url string) error {
+	authz, err := c.GetAuthorization(ctx, url)
+	if err != nil {
+		return err
+	}
+
+	if authz.Status == acme.StatusValid {
+		return nil
+	}
+
+	var challenge *acme.Challenge
+	for _, ch := range authz.Challenges {
+		if ch.Type == "dns-01" {
+			challenge = ch
+			break
+		}
+	}
+	if challenge == nil {
+		return fmt.Errorf("no dns-01 challenge offered for %s", authz.Identifier.Value)
+	}
+
+	value, err := c.DNS01ChallengeRecord(challenge.Token)
+	if err != nil {
+		return err
+	}
+
+	name, err := challengeRecordName(authz.Identifier.Value, s.zone.ResourceName)
+	if err != nil {
+		return err
+	}
+
+	s.log.Infof("creating TXT record %s in DNS zone %s", name, s.zone.ResourceName)
+	_, err = s.recordSets.CreateOrUpdate(ctx, s.zone.ResourceGroup, s.zone.ResourceName, name, mgmtdns.TXT, mgmtdns.RecordSet{
+		RecordSetProperties: &mgmtdns.RecordSetProperties{
+			TTL: to.Int64Ptr(60),
+			TxtRecords: &[]mgmtdns.TxtRecord{
+				{
+					Value: &[]string{value},
+				},
+			},
+		},
+	}, "", "")
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		_, err := s.recordSets.Delete(ctx, s.zone.ResourceGroup, s.zone.ResourceName, name, mgmtdns.TXT, "")
+		if err != nil {
+			s.log.Warnf("failed to delete TXT record %s: %s", name, err)
+		}
+	}()
+
+	_, err = c.Accept(ctx, challenge)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.WaitAuthorization(ctx, authz.URI)
+	return err
+}
+
+// accountKey returns the ACME account key, creating it on first use
+func (s *acmeSource) accountKey(ctx context.Context) (*rsa.PrivateKey, error) {
+	secret := &corev1.Secret{}
+	err := s.client.Get(ctx, types.NamespacedName{Namespace: operator.Namespace, Name: acmeAccountSecretName}, secret)
+	if err == nil {
+		return utilpem.ParseFirstPrivateKey(secret.Data[corev1.TLSPrivateKeyKey])
+	}
+	if !kerrors.IsNotFound(err) {
+		return nil, err
+	}
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.client.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      acmeAccountSecretName,
+			Namespace: operator.Namespace,
+		},
+		Data: map[string][]byte{
+			corev1.TLSPrivateKeyKey: pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: b}),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
+
+// challengeRecordName returns the name of the TXT record answering the DNS-01
+// challenge for identifier, relative to zone.  Wildcard identifiers are
+// validated on their base domain.
+func challengeRecordName(identifier, zone string) (string, error) {
+	fqdn := "_acme-challenge." + strings.TrimPrefix(strings.ToLower(identifier), "*.")
+	zone = strings.ToLower(zone)
+
+	if !strings.HasSuffix(fqdn, "."+zone) {
+		return "", fmt.Errorf("%s is not in DNS zone %s", identifier, zone)
+	}
+
+	return strings.TrimSuffix(fqdn, "."+zone), nil
+}
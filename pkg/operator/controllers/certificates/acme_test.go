@@ -0,0 +1,54 @@
+package certificates
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"testing"
+
+	utilerror "github.com/Azure/ARO-RP/test/util/error"
+)
+
+func TestChallengeRecordName(t *testing.T) {
+	for _, tt := range []struct {
+		name       string
+		identifier string
+		zone       string
+		want       string
+		wantErr    string
+	}{
+		{
+			name:       "name in parent zone",
+			identifier: "api.cluster.example.com",
+			zone:       "example.com",
+			want:       "_acme-challenge.api.cluster",
+		},
+		{
+			name:       "wildcard name",
+			identifier: "*.apps.cluster.example.com",
+			zone:       "cluster.example.com",
+			want:       "_acme-challenge.apps",
+		},
+		{
+			name:       "case is ignored",
+			identifier: "API.Cluster.Example.com",
+			zone:       "Example.COM",
+			want:       "_acme-challenge.api.cluster",
+		},
+		{
+			name:       "name outside zone",
+			identifier: "api.cluster.example.com",
+			zone:       "other.com",
+			wantErr:    "api.cluster.example.com is not in DNS zone other.com",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := challengeRecordName(tt.identifier, tt.zone)
+			utilerror.AssertErrorMessage(t, err, tt.wantErr)
+
+			if got != tt.want {
+				t.Errorf("got %q, wanted %q", got, tt.want)
+			}
+		})
+	}
+}
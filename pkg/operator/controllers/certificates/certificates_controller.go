@@ -0,0 +1,504 @@
+package certificates
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+	configv1 "github.com/openshift/api/config/v1"
+	operatorv1 "github.com/openshift/api/operator/v1"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/base"
+	"github.com/Azure/ARO-RP/pkg/operator/diagnostics"
+	"github.com/Azure/ARO-RP/pkg/util/azureclient"
+	"github.com/Azure/ARO-RP/pkg/util/azureclient/keyvault"
+	"github.com/Azure/ARO-RP/pkg/util/azureclient/mgmt/dns"
+	"github.com/Azure/ARO-RP/pkg/util/clusterauthorizer"
+	utilpem "github.com/Azure/ARO-RP/pkg/util/pem"
+)
+
+const (
+	ControllerName = "Certificates"
+
+	// sourceAnnotation records the certificate source a secret was written
+	// from, so that a certificate is issued again when the source changes
+	sourceAnnotation = "aro.openshift.io/certificate-source"
+
+	// APIServerSecretName and IngressSecretName are the secrets holding the
+	// installed certificates, in the openshift-config and openshift-ingress
+	// namespaces respectively
+	APIServerSecretName = "aro-custom-apiserver-certificate"
+	IngressSecretName   = "aro-custom-ingress-certificate"
+
+	// renewBefore is how long before expiry ACME certificates are renewed
+	renewBefore = 30 * 24 * time.Hour
+
+	// resyncInterval is how often key vault secrets are re-read and ACME
+	// certificates are checked for renewal
+	resyncInterval = time.Hour
+
+	// retryInterval is how long to wait after a failure.  Failures aren't
+	// retried with the usual backoff to stay within ACME rate limits.
+	retryInterval = 10 * time.Minute
+)
+
+// source returns a certificate and private key for a set of DNS names
+type source interface {
+	Certificate(ctx context.Context, dnsNames []string) (*rsa.PrivateKey, []*x509.Certificate, error)
+}
+
+// target is a certificate which the cluster serves
+type target struct {
+	name       string
+	spec       *arov1alpha1.CertificateSourceSpec
+	namespace  string
+	secretName string
+	dnsNames   []string
+	install    func(context.Context) error
+	uninstall  func(context.Context) error
+}
+
+type Reconciler struct {
+	base.AROController
+
+	newSource func(context.Context, *arov1alpha1.Cluster, *arov1alpha1.CertificateSourceSpec) (source, error)
+	now       func() time.Time
+}
+
+func NewReconciler(log *logrus.Entry, client client.Client) *Reconciler {
+	r := &Reconciler{
+		AROController: base.AROController{
+			Log:    log,
+			Client: client,
+			Name:   ControllerName,
+		},
+		now: time.Now,
+	}
+	r.newSource = r.newAzureSource
+
+	return r
+}
+
+// Reconcile installs the certificates requested in spec.certificates and
+// renews them, and removes the certificates which are no longer requested
+func (r *Reconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
+	instance, err := r.GetCluster(ctx)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if !instance.Spec.OperatorFlags.GetSimpleBoolean(operator.CertificatesEnabled) {
+		r.Log.Debug("controller is disabled")
+		return reconcile.Result{}, nil
+	}
+
+	r.Log.Debug("running")
+
+	for _, t := range r.targets(instance) {
+		if t.spec == nil {
+			err = r.removeCertificate(ctx, t)
+		} else {
+			err = r.reconcileCertificate(ctx, instance, t)
+		}
+		if err != nil {
+			err = fmt.Errorf("%s certificate: %w", t.name, err)
+			r.Log.Error(err)
+			r.SetDegraded(ctx, err)
+
+			return reconcile.Result{RequeueAfter: retryInterval}, nil
+		}
+	}
+
+	r.ClearConditions(ctx)
+
+	if instance.Spec.Certificates == nil {
+		return reconcile.Result{}, nil
+	}
+
+	return reconcile.Result{RequeueAfter: resyncInterval}, nil
+}
+
+func (r *Reconciler) targets(instance *arov1alpha1.Cluster) []target {
+	apiServerName := "api." + instance.Spec.Domain
+
+	certificates := instance.Spec.Certificates
+	if certificates == nil {
+		certificates = &arov1alpha1.CertificatesSpec{}
+	}
+
+	return []target{
+		{
+			name:       "API server",
+			spec:       certificates.APIServer,
+			namespace:  "openshift-config",
+			secretName: APIServerSecretName,
+			dnsNames:   []string{apiServerName},
+			install: func(ctx context.Context) error {
+				return r.installAPIServerCertificate(ctx, apiServerName)
+			},
+			uninstall: r.uninstallAPIServerCertificate,
+		},
+		{
+			name:       "ingress",
+			spec:       certificates.Ingress,
+			namespace:  "openshift-ingress",
+			secretName: IngressSecretName,
+			dnsNames:   []string{"*.apps." + instance.Spec.Domain},
+			install:    r.installIngressCertificate,
+			uninstall:  r.uninstallIngressCertificate,
+		},
+	}
+}
+
+// removeCertificate stops serving the certificate of a target which is no
+// longer requested and deletes its secret, which would no longer be renewed
+func (r *Reconciler) removeCertificate(ctx context.Context, t target) error {
+	err := t.uninstall(ctx)
+	if err != nil {
+		return err
+	}
+
+	secret := &corev1.Secret{}
+	secret.Name = t.secretName
+	secret.Namespace = t.namespace
+
+	err = r.Client.Delete(ctx, secret)
+	if err == nil {
+		r.Log.Infof("removed %s certificate", t.name)
+	}
+
+	return client.IgnoreNotFound(err)
+}
+
+// reconcileCertificate ensures that the secret of a target holds a valid
+// certificate from its source and that the certificate is installed
+func (r *Reconciler) reconcileCertificate(ctx context.Context, instance *arov1alpha1.Cluster, t target) error {
+	hash, err := sourceHash(t)
+	if err != nil {
+		return err
+	}
+
+	secret := &corev1.Secret{}
+	err = r.Client.Get(ctx, types.NamespacedName{Namespace: t.namespace, Name: t.secretName}, secret)
+	if err != nil && !kerrors.IsNotFound(err) {
+		return err
+	}
+
+	var current *x509.Certificate
+	if err == nil && secret.Annotations[sourceAnnotation] == hash {
+		// an unparseable certificate is replaced
+		current, _ = utilpem.ParseFirstCertificate(secret.Data[corev1.TLSCertKey])
+	}
+
+	// key vault secrets are always re-read as the customer may have renewed
+	// the certificate in the key vault
+	if t.spec.ACME == nil || current == nil || current.NotAfter.Sub(r.now()) < renewBefore {
+		src, err := r.newSource(ctx, instance, t.spec)
+		if err != nil {
+			return err
+		}
+
+		key, certs, err := src.Certificate(ctx, t.dnsNames)
+		if err != nil {
+			return err
+		}
+
+		err = validateCertificate(key, certs, t.dnsNames, r.now())
+		if err != nil {
+			return err
+		}
+
+		if current == nil || !current.Equal(certs[0]) {
+			r.Log.Infof("installing %s certificate expiring at %s", t.name, certs[0].NotAfter.Format(time.RFC3339))
+
+			err = r.ensureSecret(ctx, t, hash, key, certs)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return t.install(ctx)
+}
+
+func (r *Reconciler) ensureSecret(ctx context.Context, t target, hash string, key *rsa.PrivateKey, certs []*x509.Certificate) error {
+	b, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return err
+	}
+
+	var cb []byte
+	for _, cert := range certs {
+		cb = append(cb, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})...)
+	}
+
+	secret := &corev1.Secret{}
+	secret.Name = t.secretName
+	secret.Namespace = t.namespace
+
+	_, err = controllerutil.CreateOrUpdate(ctx, r.Client, secret, func() error {
+		if secret.Annotations == nil {
+			secret.Annotations = map[string]string{}
+		}
+		secret.Annotations[sourceAnnotation] = hash
+		secret.Type = corev1.SecretTypeTLS
+		secret.Data = map[string][]byte{
+			corev1.TLSCertKey:       cb,
+			corev1.TLSPrivateKeyKey: pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: b}),
+		}
+		return nil
+	})
+	return err
+}
+
+// installAPIServerCertificate serves the certificate on the api name only.  The
+// RP, portal and monitor keep connecting with the api-int name, which is
+// served with the certificate of the cluster's internal CA that their
+// kubeconfigs trust.
+func (r *Reconciler) installAPIServerCertificate(ctx context.Context, name string) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		apiserver := &configv1.APIServer{}
+		err := r.Client.Get(ctx, types.NamespacedName{Name: "cluster"}, apiserver)
+		if err != nil {
+			return err
+		}
+
+		want := configv1.APIServerNamedServingCert{
+			Names: []string{name},
+			ServingCertificate: configv1.SecretNameReference{
+				Name: APIServerSecretName,
+			},
+		}
+
+		// replace any other certificate for the name
+		namedCertificates := []configv1.APIServerNamedServingCert{want}
+		for _, nc := range apiserver.Spec.ServingCerts.NamedCertificates {
+			if !containsName(nc.Names, name) {
+				namedCertificates = append(namedCertificates, nc)
+			}
+		}
+
+		if reflect.DeepEqual(apiserver.Spec.ServingCerts.NamedCertificates, namedCertificates) {
+			return nil
+		}
+
+		apiserver.Spec.ServingCerts.NamedCertificates = namedCertificates
+		return r.Client.Update(ctx, apiserver)
+	})
+}
+
+// uninstallAPIServerCertificate removes the named certificates referencing the
+// secret of this controller.  The API server then serves its default
+// certificate on the api name again.
+func (r *Reconciler) uninstallAPIServerCertificate(ctx context.Context) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		apiserver := &configv1.APIServer{}
+		err := r.Client.Get(ctx, types.NamespacedName{Name: "cluster"}, apiserver)
+		if err != nil {
+			return err
+		}
+
+		var namedCertificates []configv1.APIServerNamedServingCert
+		for _, nc := range apiserver.Spec.ServingCerts.NamedCertificates {
+			if nc.ServingCertificate.Name != APIServerSecretName {
+				namedCertificates = append(namedCertificates, nc)
+			}
+		}
+
+		if len(namedCertificates) == len(apiserver.Spec.ServingCerts.NamedCertificates) {
+			return nil
+		}
+
+		apiserver.Spec.ServingCerts.NamedCertificates = namedCertificates
+		return r.Client.Update(ctx, apiserver)
+	})
+}
+
+func (r *Reconciler) installIngressCertificate(ctx context.Context) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		ic := &operatorv1.IngressController{}
+		err := r.Client.Get(ctx, types.NamespacedName{Namespace: "openshift-ingress-operator", Name: "default"}, ic)
+		if err != nil {
+			return err
+		}
+
+		if ic.Spec.DefaultCertificate != nil && ic.Spec.DefaultCertificate.Name == IngressSecretName {
+			return nil
+		}
+
+		ic.Spec.DefaultCertificate = &corev1.LocalObjectReference{
+			Name: IngressSecretName,
+		}
+
+		return r.Client.Update(ctx, ic)
+	})
+}
+
+// uninstallIngressCertificate resets the default certificate of the default
+// IngressController if it references the secret of this controller
+func (r *Reconciler) uninstallIngressCertificate(ctx context.Context) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		ic := &operatorv1.IngressController{}
+		err := r.Client.Get(ctx, types.NamespacedName{Namespace: "openshift-ingress-operator", Name: "default"}, ic)
+		if err != nil {
+			return err
+		}
+
+		if ic.Spec.DefaultCertificate == nil || ic.Spec.DefaultCertificate.Name != IngressSecretName {
+			return nil
+		}
+
+		ic.Spec.DefaultCertificate = nil
+
+		return r.Client.Update(ctx, ic)
+	})
+}
+
+// newAzureSource returns the source of a certificate, accessed with the
+// cluster service principal
+func (r *Reconciler) newAzureSource(ctx context.Context, instance *arov1alpha1.Cluster, spec *arov1alpha1.CertificateSourceSpec) (source, error) {
+	azEnv, err := azureclient.EnvironmentFromName(instance.Spec.AZEnvironment)
+	if err != nil {
+		return nil, err
+	}
+
+	azRefreshAuthorizer, err := clusterauthorizer.NewAzRefreshableAuthorizer(r.Log, &azEnv, r.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case spec.KeyVaultSecretID != "":
+		authorizer, err := azRefreshAuthorizer.NewKeyVaultRefreshableAuthorizerToken(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return &keyVaultSource{
+			kv:       keyvault.New(authorizer),
+			secretID: spec.KeyVaultSecretID,
+		}, nil
+
+	case spec.ACME != nil:
+		zone, err := azure.ParseResourceID(spec.ACME.DNSZoneID)
+		if err != nil {
+			return nil, err
+		}
+
+		authorizer, err := azRefreshAuthorizer.NewRefreshableAuthorizerToken(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return &acmeSource{
+			log:        r.Log,
+			client:     r.Client,
+			spec:       spec.ACME,
+			recordSets: dns.NewRecordSetsClient(&azEnv, zone.SubscriptionID, authorizer),
+			zone:       zone,
+		}, nil
+	}
+
+	return nil, errors.New("no certificate source is set")
+}
+
+// validateCertificate checks that the leaf certificate matches the key, covers
+// the DNS names and hasn't expired
+func validateCertificate(key *rsa.PrivateKey, certs []*x509.Certificate, dnsNames []string, now time.Time) error {
+	if key == nil || len(certs) == 0 {
+		return errors.New("the certificate or private key is missing")
+	}
+
+	if !key.PublicKey.Equal(certs[0].PublicKey) {
+		return errors.New("the private key does not match the certificate")
+	}
+
+	for _, name := range dnsNames {
+		if !certificateCovers(certs[0], name) {
+			return fmt.Errorf("the certificate is not valid for %s", name)
+		}
+	}
+
+	if now.After(certs[0].NotAfter) {
+		return fmt.Errorf("the certificate expired at %s", certs[0].NotAfter.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+func certificateCovers(cert *x509.Certificate, name string) bool {
+	// VerifyHostname doesn't accept wildcard names
+	if strings.HasPrefix(name, "*.") {
+		for _, n := range cert.DNSNames {
+			if strings.EqualFold(n, name) {
+				return true
+			}
+		}
+		return false
+	}
+
+	return cert.VerifyHostname(name) == nil
+}
+
+func containsName(names []string, name string) bool {
+	for _, n := range names {
+		if strings.EqualFold(n, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func sourceHash(t target) (string, error) {
+	b, err := json.Marshal(struct {
+		Spec     *arov1alpha1.CertificateSourceSpec `json:"spec"`
+		DNSNames []string                           `json:"dnsNames"`
+	}{
+		Spec:     t.spec,
+		DNSNames: t.dnsNames,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	h := sha256.Sum256(b)
+	return hex.EncodeToString(h[:]), nil
+}
+
+// SetupWithManager setup our manager
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	aroClusterPredicate := predicate.NewPredicateFuncs(func(o client.Object) bool {
+		return o.GetName() == arov1alpha1.SingletonClusterName
+	})
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&arov1alpha1.Cluster{}, builder.WithPredicates(aroClusterPredicate)).
+		Named(ControllerName).
+		Complete(diagnostics.Wrap(ControllerName, operator.CertificatesEnabled, r))
+}
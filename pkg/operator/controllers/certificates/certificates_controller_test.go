@@ -0,0 +1,546 @@
+package certificates
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	configv1 "github.com/openshift/api/config/v1"
+	operatorv1 "github.com/openshift/api/operator/v1"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/util/cmp"
+	_ "github.com/Azure/ARO-RP/pkg/util/scheme"
+	utiltls "github.com/Azure/ARO-RP/pkg/util/tls"
+	utilerror "github.com/Azure/ARO-RP/test/util/error"
+)
+
+type fakeCertificate struct {
+	key   *rsa.PrivateKey
+	certs []*x509.Certificate
+}
+
+// fakeSource returns certificates keyed by the first requested DNS name
+type fakeSource struct {
+	certificates map[string]fakeCertificate
+	calls        int
+}
+
+func (s *fakeSource) Certificate(ctx context.Context, dnsNames []string) (*rsa.PrivateKey, []*x509.Certificate, error) {
+	s.calls++
+
+	c, found := s.certificates[dnsNames[0]]
+	if !found {
+		return nil, nil, errors.New("unexpected certificate request")
+	}
+
+	return c.key, c.certs, nil
+}
+
+func TestReconciler(t *testing.T) {
+	now := time.Now()
+
+	generate := func(name string, notAfter time.Time) fakeCertificate {
+		key, certs, err := utiltls.GenerateTestKeyAndCertificate(name, nil, nil, false, false, func(template *x509.Certificate) {
+			template.NotAfter = notAfter
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return fakeCertificate{key: key, certs: certs}
+	}
+
+	apiServerCertificate := generate("api.cluster.example.com", now.AddDate(0, 6, 0))
+	ingressCertificate := generate("*.apps.cluster.example.com", now.AddDate(0, 6, 0))
+	renewedIngressCertificate := generate("*.apps.cluster.example.com", now.AddDate(0, 9, 0))
+	expiringIngressCertificate := generate("*.apps.cluster.example.com", now.AddDate(0, 0, 7))
+	wrongNameCertificate := generate("*.apps.other.example.com", now.AddDate(0, 6, 0))
+
+	keyVaultSpec := func(name string) *arov1alpha1.CertificateSourceSpec {
+		return &arov1alpha1.CertificateSourceSpec{
+			KeyVaultSecretID: "https://vault.vault.azure.net/secrets/" + name,
+		}
+	}
+
+	acmeSpec := &arov1alpha1.CertificateSourceSpec{
+		ACME: &arov1alpha1.ACMEIssuerSpec{
+			DirectoryURL: "https://acme.example.com/directory",
+			DNSZoneID:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/dns/providers/Microsoft.Network/dnszones/example.com",
+		},
+	}
+
+	// ingressSecret returns a secret as written for the ingress target of
+	// spec
+	ingressSecret := func(spec *arov1alpha1.CertificateSourceSpec, c fakeCertificate) *corev1.Secret {
+		hash, err := sourceHash(target{spec: spec, dnsNames: []string{"*.apps.cluster.example.com"}})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		b, err := x509.MarshalPKCS8PrivateKey(c.key)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      IngressSecretName,
+				Namespace: "openshift-ingress",
+				Annotations: map[string]string{
+					sourceAnnotation: hash,
+				},
+			},
+			Type: corev1.SecretTypeTLS,
+			Data: map[string][]byte{
+				corev1.TLSCertKey:       pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: c.certs[0].Raw}),
+				corev1.TLSPrivateKeyKey: pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: b}),
+			},
+		}
+	}
+
+	// apiServerWithCertificate returns the cluster APIServer serving the
+	// installed certificate on the api name, besides another certificate
+	apiServerWithCertificate := func() *configv1.APIServer {
+		return &configv1.APIServer{
+			ObjectMeta: metav1.ObjectMeta{Name: "cluster"},
+			Spec: configv1.APIServerSpec{
+				ServingCerts: configv1.APIServerServingCerts{
+					NamedCertificates: []configv1.APIServerNamedServingCert{
+						{
+							Names:              []string{"api.cluster.example.com"},
+							ServingCertificate: configv1.SecretNameReference{Name: APIServerSecretName},
+						},
+						{
+							Names:              []string{"other.example.com"},
+							ServingCertificate: configv1.SecretNameReference{Name: "other"},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	apiServerSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      APIServerSecretName,
+			Namespace: "openshift-config",
+		},
+		Type: corev1.SecretTypeTLS,
+	}
+
+	for _, tt := range []struct {
+		name                     string
+		flag                     string
+		spec                     *arov1alpha1.CertificatesSpec
+		defaultCertificate       *corev1.LocalObjectReference
+		objects                  []client.Object
+		certificates             map[string]fakeCertificate
+		wantCalls                int
+		wantAPIServerCertificate *x509.Certificate
+		wantIngressCertificate   *x509.Certificate
+		wantNamedCertificates    []configv1.APIServerNamedServingCert
+		wantDefaultCertificate   *corev1.LocalObjectReference
+		wantRequeueAfter         time.Duration
+		wantDegraded             string
+	}{
+		{
+			name: "controller disabled",
+			flag: operator.FlagFalse,
+			spec: &arov1alpha1.CertificatesSpec{
+				Ingress: keyVaultSpec("ingress"),
+			},
+			certificates: map[string]fakeCertificate{
+				"*.apps.cluster.example.com": ingressCertificate,
+			},
+		},
+		{
+			name: "no certificates requested",
+			flag: operator.FlagTrue,
+		},
+		{
+			name:               "cleared certificates are removed",
+			flag:               operator.FlagTrue,
+			defaultCertificate: &corev1.LocalObjectReference{Name: IngressSecretName},
+			objects: []client.Object{
+				apiServerWithCertificate(),
+				apiServerSecret,
+				ingressSecret(keyVaultSpec("ingress"), ingressCertificate),
+			},
+			wantNamedCertificates: []configv1.APIServerNamedServingCert{
+				{
+					Names:              []string{"other.example.com"},
+					ServingCertificate: configv1.SecretNameReference{Name: "other"},
+				},
+			},
+		},
+		{
+			name: "cleared ingress certificate is removed",
+			flag: operator.FlagTrue,
+			spec: &arov1alpha1.CertificatesSpec{
+				APIServer: keyVaultSpec("apiserver"),
+			},
+			defaultCertificate: &corev1.LocalObjectReference{Name: IngressSecretName},
+			objects: []client.Object{
+				apiServerWithCertificate(),
+				ingressSecret(keyVaultSpec("ingress"), ingressCertificate),
+			},
+			certificates: map[string]fakeCertificate{
+				"api.cluster.example.com": apiServerCertificate,
+			},
+			wantCalls:                1,
+			wantAPIServerCertificate: apiServerCertificate.certs[0],
+			wantNamedCertificates: []configv1.APIServerNamedServingCert{
+				{
+					Names:              []string{"api.cluster.example.com"},
+					ServingCertificate: configv1.SecretNameReference{Name: APIServerSecretName},
+				},
+				{
+					Names:              []string{"other.example.com"},
+					ServingCertificate: configv1.SecretNameReference{Name: "other"},
+				},
+			},
+			wantRequeueAfter: resyncInterval,
+		},
+		{
+			name:                   "default certificate of the customer is kept",
+			flag:                   operator.FlagTrue,
+			defaultCertificate:     &corev1.LocalObjectReference{Name: "customer"},
+			wantDefaultCertificate: &corev1.LocalObjectReference{Name: "customer"},
+		},
+		{
+			name: "key vault certificates are installed",
+			flag: operator.FlagTrue,
+			spec: &arov1alpha1.CertificatesSpec{
+				APIServer: keyVaultSpec("apiserver"),
+				Ingress:   keyVaultSpec("ingress"),
+			},
+			objects: []client.Object{
+				&configv1.APIServer{
+					ObjectMeta: metav1.ObjectMeta{Name: "cluster"},
+					Spec: configv1.APIServerSpec{
+						ServingCerts: configv1.APIServerServingCerts{
+							NamedCertificates: []configv1.APIServerNamedServingCert{
+								{
+									Names:              []string{"api.cluster.example.com"},
+									ServingCertificate: configv1.SecretNameReference{Name: "old"},
+								},
+								{
+									Names:              []string{"other.example.com"},
+									ServingCertificate: configv1.SecretNameReference{Name: "other"},
+								},
+							},
+						},
+					},
+				},
+			},
+			certificates: map[string]fakeCertificate{
+				"api.cluster.example.com":    apiServerCertificate,
+				"*.apps.cluster.example.com": ingressCertificate,
+			},
+			wantCalls:                2,
+			wantAPIServerCertificate: apiServerCertificate.certs[0],
+			wantIngressCertificate:   ingressCertificate.certs[0],
+			wantNamedCertificates: []configv1.APIServerNamedServingCert{
+				{
+					Names:              []string{"api.cluster.example.com"},
+					ServingCertificate: configv1.SecretNameReference{Name: APIServerSecretName},
+				},
+				{
+					Names:              []string{"other.example.com"},
+					ServingCertificate: configv1.SecretNameReference{Name: "other"},
+				},
+			},
+			wantDefaultCertificate: &corev1.LocalObjectReference{Name: IngressSecretName},
+			wantRequeueAfter:       resyncInterval,
+		},
+		{
+			name: "renewed key vault certificate is picked up",
+			flag: operator.FlagTrue,
+			spec: &arov1alpha1.CertificatesSpec{
+				Ingress: keyVaultSpec("ingress"),
+			},
+			objects: []client.Object{
+				ingressSecret(keyVaultSpec("ingress"), ingressCertificate),
+			},
+			certificates: map[string]fakeCertificate{
+				"*.apps.cluster.example.com": renewedIngressCertificate,
+			},
+			wantCalls:              1,
+			wantIngressCertificate: renewedIngressCertificate.certs[0],
+			wantDefaultCertificate: &corev1.LocalObjectReference{Name: IngressSecretName},
+			wantRequeueAfter:       resyncInterval,
+		},
+		{
+			name: "valid ACME certificate is not issued again",
+			flag: operator.FlagTrue,
+			spec: &arov1alpha1.CertificatesSpec{
+				Ingress: acmeSpec,
+			},
+			objects: []client.Object{
+				ingressSecret(acmeSpec, ingressCertificate),
+			},
+			wantIngressCertificate: ingressCertificate.certs[0],
+			wantDefaultCertificate: &corev1.LocalObjectReference{Name: IngressSecretName},
+			wantRequeueAfter:       resyncInterval,
+		},
+		{
+			name: "expiring ACME certificate is renewed",
+			flag: operator.FlagTrue,
+			spec: &arov1alpha1.CertificatesSpec{
+				Ingress: acmeSpec,
+			},
+			objects: []client.Object{
+				ingressSecret(acmeSpec, expiringIngressCertificate),
+			},
+			certificates: map[string]fakeCertificate{
+				"*.apps.cluster.example.com": renewedIngressCertificate,
+			},
+			wantCalls:              1,
+			wantIngressCertificate: renewedIngressCertificate.certs[0],
+			wantDefaultCertificate: &corev1.LocalObjectReference{Name: IngressSecretName},
+			wantRequeueAfter:       resyncInterval,
+		},
+		{
+			name: "ACME certificate is issued again when the issuer changes",
+			flag: operator.FlagTrue,
+			spec: &arov1alpha1.CertificatesSpec{
+				Ingress: acmeSpec,
+			},
+			objects: []client.Object{
+				ingressSecret(keyVaultSpec("ingress"), ingressCertificate),
+			},
+			certificates: map[string]fakeCertificate{
+				"*.apps.cluster.example.com": renewedIngressCertificate,
+			},
+			wantCalls:              1,
+			wantIngressCertificate: renewedIngressCertificate.certs[0],
+			wantDefaultCertificate: &corev1.LocalObjectReference{Name: IngressSecretName},
+			wantRequeueAfter:       resyncInterval,
+		},
+		{
+			name: "certificate for the wrong name is rejected",
+			flag: operator.FlagTrue,
+			spec: &arov1alpha1.CertificatesSpec{
+				Ingress: keyVaultSpec("ingress"),
+			},
+			certificates: map[string]fakeCertificate{
+				"*.apps.cluster.example.com": wrongNameCertificate,
+			},
+			wantCalls:        1,
+			wantRequeueAfter: retryInterval,
+			wantDegraded:     "ingress certificate: the certificate is not valid for *.apps.cluster.example.com",
+		},
+		{
+			name: "source failure is reported",
+			flag: operator.FlagTrue,
+			spec: &arov1alpha1.CertificatesSpec{
+				APIServer: keyVaultSpec("apiserver"),
+			},
+			wantCalls:        1,
+			wantRequeueAfter: retryInterval,
+			wantDegraded:     "API server certificate: unexpected certificate request",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			instance := &arov1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: arov1alpha1.SingletonClusterName,
+				},
+				Spec: arov1alpha1.ClusterSpec{
+					Domain: "cluster.example.com",
+					OperatorFlags: arov1alpha1.OperatorFlags{
+						operator.CertificatesEnabled: tt.flag,
+					},
+					Certificates: tt.spec,
+				},
+			}
+
+			objects := []client.Object{
+				instance,
+				&operatorv1.IngressController{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "default",
+						Namespace: "openshift-ingress-operator",
+					},
+					Spec: operatorv1.IngressControllerSpec{
+						DefaultCertificate: tt.defaultCertificate,
+					},
+				},
+			}
+			if !containsAPIServer(tt.objects) {
+				objects = append(objects, &configv1.APIServer{
+					ObjectMeta: metav1.ObjectMeta{Name: "cluster"},
+				})
+			}
+
+			client := ctrlfake.NewClientBuilder().
+				WithObjects(objects...).
+				WithObjects(tt.objects...).
+				Build()
+
+			src := &fakeSource{certificates: tt.certificates}
+
+			r := NewReconciler(logrus.NewEntry(logrus.StandardLogger()), client)
+			r.now = func() time.Time { return now }
+			r.newSource = func(context.Context, *arov1alpha1.Cluster, *arov1alpha1.CertificateSourceSpec) (source, error) {
+				return src, nil
+			}
+
+			result, err := r.Reconcile(ctx, ctrl.Request{})
+			utilerror.AssertErrorMessage(t, err, "")
+
+			if result.RequeueAfter != tt.wantRequeueAfter {
+				t.Errorf("got RequeueAfter %s, wanted %s", result.RequeueAfter, tt.wantRequeueAfter)
+			}
+
+			if src.calls != tt.wantCalls {
+				t.Errorf("got %d certificate requests, wanted %d", src.calls, tt.wantCalls)
+			}
+
+			cluster, err := r.GetCluster(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var degraded string
+			for _, c := range cluster.Status.Conditions {
+				if c.Type == ControllerName+"Controller"+operatorv1.OperatorStatusTypeDegraded && c.Status == operatorv1.ConditionTrue {
+					degraded = c.Message
+				}
+			}
+			if degraded != tt.wantDegraded {
+				t.Errorf("got Degraded %q, wanted %q", degraded, tt.wantDegraded)
+			}
+
+			assertSecretCertificate(ctx, t, client, "openshift-config", APIServerSecretName, tt.wantAPIServerCertificate)
+			assertSecretCertificate(ctx, t, client, "openshift-ingress", IngressSecretName, tt.wantIngressCertificate)
+
+			apiserver := &configv1.APIServer{}
+			err = client.Get(ctx, types.NamespacedName{Name: "cluster"}, apiserver)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.wantNamedCertificates != nil && !reflect.DeepEqual(apiserver.Spec.ServingCerts.NamedCertificates, tt.wantNamedCertificates) {
+				t.Error(cmp.Diff(apiserver.Spec.ServingCerts.NamedCertificates, tt.wantNamedCertificates))
+			}
+
+			ic := &operatorv1.IngressController{}
+			err = client.Get(ctx, types.NamespacedName{Namespace: "openshift-ingress-operator", Name: "default"}, ic)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(ic.Spec.DefaultCertificate, tt.wantDefaultCertificate) {
+				t.Error(cmp.Diff(ic.Spec.DefaultCertificate, tt.wantDefaultCertificate))
+			}
+		})
+	}
+}
+
+func containsAPIServer(objects []client.Object) bool {
+	for _, o := range objects {
+		if _, ok := o.(*configv1.APIServer); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func assertSecretCertificate(ctx context.Context, t *testing.T, c client.Client, namespace, name string, want *x509.Certificate) {
+	t.Helper()
+
+	secret := &corev1.Secret{}
+	err := c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, secret)
+	if kerrors.IsNotFound(err) {
+		if want != nil {
+			t.Errorf("secret %s/%s not found", namespace, name)
+		}
+		return
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want == nil {
+		t.Errorf("unexpected secret %s/%s", namespace, name)
+		return
+	}
+
+	block, _ := pem.Decode(secret.Data[corev1.TLSCertKey])
+	if block == nil {
+		t.Fatalf("secret %s/%s holds no certificate", namespace, name)
+	}
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !cert.Equal(want) {
+		t.Errorf("secret %s/%s holds certificate %s, wanted %s", namespace, name, cert.SerialNumber, want.SerialNumber)
+	}
+}
+
+func TestCertificateCovers(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		dnsNames []string
+		check    string
+		want     bool
+	}{
+		{
+			name:     "exact name",
+			dnsNames: []string{"api.cluster.example.com"},
+			check:    "api.cluster.example.com",
+			want:     true,
+		},
+		{
+			name:     "name covered by wildcard",
+			dnsNames: []string{"*.cluster.example.com"},
+			check:    "api.cluster.example.com",
+			want:     true,
+		},
+		{
+			name:     "wildcard name",
+			dnsNames: []string{"*.apps.cluster.example.com"},
+			check:    "*.apps.cluster.example.com",
+			want:     true,
+		},
+		{
+			name:     "wildcard name not covered by specific name",
+			dnsNames: []string{"console.apps.cluster.example.com"},
+			check:    "*.apps.cluster.example.com",
+		},
+		{
+			name:     "different name",
+			dnsNames: []string{"api.other.example.com"},
+			check:    "api.cluster.example.com",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			cert := &x509.Certificate{DNSNames: tt.dnsNames}
+
+			if got := certificateCovers(cert, tt.check); got != tt.want {
+				t.Errorf("got %v, wanted %v", got, tt.want)
+			}
+		})
+	}
+}
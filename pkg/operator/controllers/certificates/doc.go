@@ -0,0 +1,40 @@
+package certificates
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+/*
+
+The controller in this package installs and renews the API server and ingress
+certificates which customers with a custom domain provide through the RP
+certificateProfile.  The RP copies the profile into spec.certificates of the
+ARO Cluster resource.
+
+A certificate comes either from a secret in the customer's key vault, or from
+an ACME issuer which validates the cluster domain with DNS-01 challenges in
+the customer's Azure DNS zone.  Both are accessed with the cluster service
+principal, which the customer must grant access to the key vault secret or DNS
+zone.
+
+The certificate and key are stored in a TLS secret which is referenced as
+the default certificate of the default IngressController
+(openshift-ingress/aro-custom-ingress-certificate), or as the named
+certificate of api.<domain> of the cluster APIServer
+(openshift-config/aro-custom-apiserver-certificate).
+
+Key vault secrets are re-read on every reconcile, so that certificates renewed
+in the key vault (referenced by a versionless secret ID) are picked up.  ACME
+certificates are issued again once they are within renewBefore of expiry, or
+when the ACME issuer changes.  The reconcile runs at least hourly.
+
+When a certificate is removed from spec.certificates, its named certificate
+or default certificate reference is removed, so that the cluster serves its
+default certificate again, and its secret is deleted.
+
+Problems are reported as the Degraded condition of this controller; expired
+or expiring certificates are reported by the IngressCertificateChecker.
+
+aro.certificates.enabled:
+- When set to false, the controller will noop and not perform any further action
+
+*/
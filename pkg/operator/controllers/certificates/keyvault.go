@@ -0,0 +1,99 @@
+package certificates
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"golang.org/x/crypto/pkcs12"
+
+	"github.com/Azure/ARO-RP/pkg/util/azureclient/keyvault"
+	utilpem "github.com/Azure/ARO-RP/pkg/util/pem"
+)
+
+// keyVaultSource reads a certificate from a customer key vault secret.  Key
+// vault certificates are exposed as secrets holding either PEM or base64
+// encoded PKCS#12, depending on the content type of the certificate.
+type keyVaultSource struct {
+	kv       keyvault.BaseClient
+	secretID string
+}
+
+func (s *keyVaultSource) Certificate(ctx context.Context, dnsNames []string) (*rsa.PrivateKey, []*x509.Certificate, error) {
+	vaultBaseURL, name, version, err := parseSecretID(s.secretID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	bundle, err := s.kv.GetSecret(ctx, vaultBaseURL, name, version)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if bundle.Value == nil {
+		return nil, nil, fmt.Errorf("key vault secret %s is empty", s.secretID)
+	}
+
+	b := []byte(*bundle.Value)
+	if bundle.ContentType != nil && *bundle.ContentType == "application/x-pkcs12" {
+		b, err = pkcs12ToPEM(*bundle.Value)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return utilpem.Parse(b)
+}
+
+// parseSecretID splits a key vault secret ID of the form
+// https://<vault>.vault.azure.net/secrets/<name>[/<version>]
+func parseSecretID(secretID string) (vaultBaseURL, name, version string, err error) {
+	u, err := url.Parse(secretID)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if u.Scheme != "https" || u.Host == "" || len(parts) < 2 || len(parts) > 3 || parts[0] != "secrets" {
+		return "", "", "", fmt.Errorf("invalid key vault secret ID %q", secretID)
+	}
+
+	if len(parts) == 3 {
+		version = parts[2]
+	}
+
+	return u.Scheme + "://" + u.Host, parts[1], version, nil
+}
+
+func pkcs12ToPEM(value string) ([]byte, error) {
+	data, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+
+	// key vault exports PKCS#12 without a password
+	blocks, err := pkcs12.ToPEM(data, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if len(blocks) == 0 {
+		return nil, errors.New("PKCS#12 data is empty")
+	}
+
+	var b []byte
+	for _, block := range blocks {
+		b = append(b, pem.EncodeToMemory(&pem.Block{Type: block.Type, Bytes: block.Bytes})...)
+	}
+
+	return b, nil
+}
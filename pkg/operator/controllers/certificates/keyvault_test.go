@@ -0,0 +1,142 @@
+package certificates
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	azkeyvault "github.com/Azure/azure-sdk-for-go/services/keyvault/v7.0/keyvault"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/golang/mock/gomock"
+
+	mock_keyvault "github.com/Azure/ARO-RP/pkg/util/mocks/azureclient/keyvault"
+	utiltls "github.com/Azure/ARO-RP/pkg/util/tls"
+	utilerror "github.com/Azure/ARO-RP/test/util/error"
+)
+
+func TestKeyVaultSourceCertificate(t *testing.T) {
+	ctx := context.Background()
+
+	key, certs, err := utiltls.GenerateKeyAndCertificate("api.cluster.example.com", nil, nil, false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	value := string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: b})) +
+		string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certs[0].Raw}))
+
+	for _, tt := range []struct {
+		name     string
+		secretID string
+		mocks    func(*mock_keyvault.MockBaseClient)
+		wantErr  string
+	}{
+		{
+			name:     "PEM secret",
+			secretID: "https://vault.vault.azure.net/secrets/apiserver/0123456789abcdef",
+			mocks: func(kv *mock_keyvault.MockBaseClient) {
+				kv.EXPECT().GetSecret(gomock.Any(), "https://vault.vault.azure.net", "apiserver", "0123456789abcdef").Return(azkeyvault.SecretBundle{
+					Value:       to.StringPtr(value),
+					ContentType: to.StringPtr("application/x-pem-file"),
+				}, nil)
+			},
+		},
+		{
+			name:     "empty secret",
+			secretID: "https://vault.vault.azure.net/secrets/apiserver",
+			mocks: func(kv *mock_keyvault.MockBaseClient) {
+				kv.EXPECT().GetSecret(gomock.Any(), "https://vault.vault.azure.net", "apiserver", "").Return(azkeyvault.SecretBundle{}, nil)
+			},
+			wantErr: "key vault secret https://vault.vault.azure.net/secrets/apiserver is empty",
+		},
+		{
+			name:     "invalid PKCS#12 secret",
+			secretID: "https://vault.vault.azure.net/secrets/apiserver",
+			mocks: func(kv *mock_keyvault.MockBaseClient) {
+				kv.EXPECT().GetSecret(gomock.Any(), "https://vault.vault.azure.net", "apiserver", "").Return(azkeyvault.SecretBundle{
+					Value:       to.StringPtr("not base64"),
+					ContentType: to.StringPtr("application/x-pkcs12"),
+				}, nil)
+			},
+			wantErr: "illegal base64 data at input byte 3",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			kv := mock_keyvault.NewMockBaseClient(controller)
+			tt.mocks(kv)
+
+			s := &keyVaultSource{
+				kv:       kv,
+				secretID: tt.secretID,
+			}
+
+			gotKey, gotCerts, err := s.Certificate(ctx, nil)
+			utilerror.AssertErrorMessage(t, err, tt.wantErr)
+
+			if tt.wantErr == "" {
+				if !gotKey.Equal(key) {
+					t.Error("unexpected key")
+				}
+				if len(gotCerts) != 1 || !gotCerts[0].Equal(certs[0]) {
+					t.Error("unexpected certificates")
+				}
+			}
+		})
+	}
+}
+
+func TestParseSecretID(t *testing.T) {
+	for _, tt := range []struct {
+		name             string
+		secretID         string
+		wantVaultBaseURL string
+		wantName         string
+		wantVersion      string
+		wantErr          string
+	}{
+		{
+			name:             "versionless",
+			secretID:         "https://vault.vault.azure.net/secrets/ingress",
+			wantVaultBaseURL: "https://vault.vault.azure.net",
+			wantName:         "ingress",
+		},
+		{
+			name:             "versioned",
+			secretID:         "https://vault.vault.azure.net/secrets/ingress/0123456789abcdef",
+			wantVaultBaseURL: "https://vault.vault.azure.net",
+			wantName:         "ingress",
+			wantVersion:      "0123456789abcdef",
+		},
+		{
+			name:     "certificate instead of secret",
+			secretID: "https://vault.vault.azure.net/certificates/ingress",
+			wantErr:  `invalid key vault secret ID "https://vault.vault.azure.net/certificates/ingress"`,
+		},
+		{
+			name:     "not https",
+			secretID: "http://vault.vault.azure.net/secrets/ingress",
+			wantErr:  `invalid key vault secret ID "http://vault.vault.azure.net/secrets/ingress"`,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			vaultBaseURL, name, version, err := parseSecretID(tt.secretID)
+			utilerror.AssertErrorMessage(t, err, tt.wantErr)
+
+			if vaultBaseURL != tt.wantVaultBaseURL || name != tt.wantName || version != tt.wantVersion {
+				t.Errorf("got %q, %q, %q", vaultBaseURL, name, version)
+			}
+		})
+	}
+}
@@ -20,6 +20,7 @@ import (
 
 	"github.com/Azure/ARO-RP/pkg/operator"
 	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/diagnostics"
 	"github.com/Azure/ARO-RP/pkg/util/conditions"
 )
 
@@ -128,5 +129,5 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 			builder.WithPredicates(defaultClusterDNSPredicate),
 		)
 
-	return builder.Named(ControllerName).Complete(r)
+	return builder.Named(ControllerName).Complete(diagnostics.Wrap(ControllerName, operator.CheckerEnabled, r))
 }
@@ -4,6 +4,7 @@
 // Included checks are:
 //  - existence of custom ingress certificate
 //  - existence of default ingresscontroller
+//  - expiry of customer provided API server and ingress certificates
 
 package ingresscertificatechecker
 
@@ -13,6 +14,9 @@ package ingresscertificatechecker
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
+	"time"
 
 	configv1 "github.com/openshift/api/config/v1"
 	operatorv1 "github.com/openshift/api/operator/v1"
@@ -22,14 +26,23 @@ import (
 
 	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
 	"github.com/Azure/ARO-RP/pkg/util/dns"
+	utilpem "github.com/Azure/ARO-RP/pkg/util/pem"
 )
 
-const ingressNameSuffix = "-ingress"
+const (
+	ingressNameSuffix = "-ingress"
+
+	// expiryWarning is how long before expiry a customer provided certificate
+	// which hasn't been renewed is reported.  The Certificates controller
+	// renews ACME certificates 30 days before expiry.
+	expiryWarning = 21 * 24 * time.Hour
+)
 
 var (
 	errNoCertificateAndCustomDomain       = errors.New("missing ingress certificate for cluster with custom domain")
 	errNoCertificateAndManagedDomain      = errors.New("missing ingress certificate for cluster with managed domain")
 	errInvalidCertificateAndManagedDomain = errors.New("invalid ingress certificate name for cluster with managed domain")
+	errInvalidCustomCertificate           = errors.New("invalid custom certificate")
 )
 
 type ingressCertificateChecker interface {
@@ -57,11 +70,21 @@ func (r *checker) Check(ctx context.Context) error {
 		return err
 	}
 
-	clusterHasManagedDomain, err := r.clusterHasManagedDomain(ctx)
+	cluster, err := r.cluster(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = validateCertificate(cv.Spec.ClusterID, ingress.Spec.DefaultCertificate, dns.IsManagedDomain(cluster.Spec.Domain))
 	if err != nil {
 		return err
 	}
-	return validateCertificate(cv.Spec.ClusterID, ingress.Spec.DefaultCertificate, clusterHasManagedDomain)
+
+	if cluster.Spec.Certificates == nil {
+		return nil
+	}
+
+	return r.checkCustomCertificates(ctx, cluster.Spec.Certificates, "api."+cluster.Spec.Domain, ingress.Spec.DefaultCertificate, time.Now())
 }
 
 func (r *checker) ingress(ctx context.Context) (*operatorv1.IngressController, error) {
@@ -82,14 +105,84 @@ func (r *checker) clusterVersion(ctx context.Context) (*configv1.ClusterVersion,
 	return cv, nil
 }
 
-func (r *checker) clusterHasManagedDomain(ctx context.Context) (bool, error) {
+func (r *checker) cluster(ctx context.Context) (*arov1alpha1.Cluster, error) {
 	cluster := &arov1alpha1.Cluster{}
 	err := r.client.Get(ctx, types.NamespacedName{Name: arov1alpha1.SingletonClusterName}, cluster)
 	if err != nil {
-		return false, err
+		return nil, err
+	}
+	return cluster, nil
+}
+
+// checkCustomCertificates checks that the certificates requested in
+// spec.certificates are installed and aren't about to expire
+func (r *checker) checkCustomCertificates(ctx context.Context, spec *arov1alpha1.CertificatesSpec, apiServerName string, ingressCertificate *corev1.LocalObjectReference, now time.Time) error {
+	if spec.APIServer != nil {
+		secretName, err := r.apiServerCertificateName(ctx, apiServerName)
+		if err != nil {
+			return err
+		}
+		if secretName == "" {
+			return fmt.Errorf("%w: no API server certificate is installed for %s", errInvalidCustomCertificate, apiServerName)
+		}
+
+		err = r.checkCertificateExpiry(ctx, "API server", "openshift-config", secretName, now)
+		if err != nil {
+			return err
+		}
 	}
 
-	return dns.IsManagedDomain(cluster.Spec.Domain), nil
+	if spec.Ingress != nil {
+		err := r.checkCertificateExpiry(ctx, "ingress", "openshift-ingress", ingressCertificate.Name, now)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// apiServerCertificateName returns the name of the secret holding the named
+// certificate for name, if any
+func (r *checker) apiServerCertificateName(ctx context.Context, name string) (string, error) {
+	apiserver := &configv1.APIServer{}
+	err := r.client.Get(ctx, types.NamespacedName{Name: "cluster"}, apiserver)
+	if err != nil {
+		return "", err
+	}
+
+	for _, nc := range apiserver.Spec.ServingCerts.NamedCertificates {
+		for _, n := range nc.Names {
+			if strings.EqualFold(n, name) {
+				return nc.ServingCertificate.Name, nil
+			}
+		}
+	}
+
+	return "", nil
+}
+
+func (r *checker) checkCertificateExpiry(ctx context.Context, certificateName, namespace, secretName string, now time.Time) error {
+	secret := &corev1.Secret{}
+	err := r.client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: secretName}, secret)
+	if err != nil {
+		return err
+	}
+
+	cert, err := utilpem.ParseFirstCertificate(secret.Data[corev1.TLSCertKey])
+	if err != nil {
+		return fmt.Errorf("%w: the %s certificate in secret %s/%s cannot be parsed: %v", errInvalidCustomCertificate, certificateName, namespace, secretName, err)
+	}
+
+	if now.After(cert.NotAfter) {
+		return fmt.Errorf("%w: the %s certificate expired at %s", errInvalidCustomCertificate, certificateName, cert.NotAfter.UTC().Format(time.RFC3339))
+	}
+
+	if cert.NotAfter.Sub(now) < expiryWarning {
+		return fmt.Errorf("%w: the %s certificate expires at %s and has not been renewed", errInvalidCustomCertificate, certificateName, cert.NotAfter.UTC().Format(time.RFC3339))
+	}
+
+	return nil
 }
 
 func validateCertificate(clusterId configv1.ClusterID, ingressCertificate *corev1.LocalObjectReference, clusterHasManagedDomain bool) error {
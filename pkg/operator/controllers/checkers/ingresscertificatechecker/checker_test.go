@@ -5,7 +5,10 @@ package ingresscertificatechecker
 
 import (
 	"context"
+	"crypto/x509"
+	"encoding/pem"
 	"testing"
+	"time"
 
 	configv1 "github.com/openshift/api/config/v1"
 	operatorv1 "github.com/openshift/api/operator/v1"
@@ -15,6 +18,8 @@ import (
 	ctrlfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	utiltls "github.com/Azure/ARO-RP/pkg/util/tls"
+	utilerror "github.com/Azure/ARO-RP/test/util/error"
 )
 
 type testData struct {
@@ -191,3 +196,128 @@ func fakeCluster(domain string) *arov1alpha1.Cluster {
 		},
 	}
 }
+
+func TestCheckCustomCertificates(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	secret := func(namespace, name string, notAfter time.Time) *corev1.Secret {
+		_, certs, err := utiltls.GenerateTestKeyAndCertificate("custom.domain.io", nil, nil, false, false, func(template *x509.Certificate) {
+			template.NotAfter = notAfter
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+			},
+			Data: map[string][]byte{
+				corev1.TLSCertKey: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certs[0].Raw}),
+			},
+		}
+	}
+
+	apiserver := func(secretName string) *configv1.APIServer {
+		return &configv1.APIServer{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "cluster",
+			},
+			Spec: configv1.APIServerSpec{
+				ServingCerts: configv1.APIServerServingCerts{
+					NamedCertificates: []configv1.APIServerNamedServingCert{
+						{
+							Names:              []string{"api.custom.domain.io"},
+							ServingCertificate: configv1.SecretNameReference{Name: secretName},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	certificateSource := &arov1alpha1.CertificateSourceSpec{
+		KeyVaultSecretID: "https://vault.vault.azure.net/secrets/certificate",
+	}
+
+	for _, tt := range []struct {
+		name    string
+		spec    *arov1alpha1.CertificatesSpec
+		objects []client.Object
+		wantErr string
+	}{
+		{
+			name: "valid certificates",
+			spec: &arov1alpha1.CertificatesSpec{
+				APIServer: certificateSource,
+				Ingress:   certificateSource,
+			},
+			objects: []client.Object{
+				apiserver("apiserver-certificate"),
+				secret("openshift-config", "apiserver-certificate", now.AddDate(0, 6, 0)),
+				secret("openshift-ingress", "ingress-certificate", now.AddDate(0, 6, 0)),
+			},
+		},
+		{
+			name: "API server certificate not installed",
+			spec: &arov1alpha1.CertificatesSpec{
+				APIServer: certificateSource,
+			},
+			objects: []client.Object{
+				&configv1.APIServer{ObjectMeta: metav1.ObjectMeta{Name: "cluster"}},
+			},
+			wantErr: "invalid custom certificate: no API server certificate is installed for api.custom.domain.io",
+		},
+		{
+			name: "API server certificate expiring",
+			spec: &arov1alpha1.CertificatesSpec{
+				APIServer: certificateSource,
+			},
+			objects: []client.Object{
+				apiserver("apiserver-certificate"),
+				secret("openshift-config", "apiserver-certificate", now.Add(10*24*time.Hour)),
+			},
+			wantErr: "invalid custom certificate: the API server certificate expires at " + now.Add(10*24*time.Hour).UTC().Format(time.RFC3339) + " and has not been renewed",
+		},
+		{
+			name: "ingress certificate expired",
+			spec: &arov1alpha1.CertificatesSpec{
+				Ingress: certificateSource,
+			},
+			objects: []client.Object{
+				secret("openshift-ingress", "ingress-certificate", now.Add(-time.Hour)),
+			},
+			wantErr: "invalid custom certificate: the ingress certificate expired at " + now.Add(-time.Hour).UTC().Format(time.RFC3339),
+		},
+		{
+			name: "ingress certificate secret missing",
+			spec: &arov1alpha1.CertificatesSpec{
+				Ingress: certificateSource,
+			},
+			wantErr: `secrets "ingress-certificate" not found`,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster := fakeCluster("custom.domain.io")
+			cluster.Spec.Certificates = tt.spec
+
+			client := ctrlfake.NewClientBuilder().
+				WithObjects(
+					fakeClusterVersion(),
+					fakeIngressController(&corev1.LocalObjectReference{Name: "ingress-certificate"}),
+					cluster,
+				).
+				WithObjects(tt.objects...).
+				Build()
+
+			sp := &checker{
+				client: client,
+			}
+
+			err := sp.Check(ctx)
+			utilerror.AssertErrorMessage(t, err, tt.wantErr)
+		})
+	}
+}
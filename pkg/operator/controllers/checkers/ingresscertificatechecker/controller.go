@@ -22,6 +22,7 @@ import (
 
 	"github.com/Azure/ARO-RP/pkg/operator"
 	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/diagnostics"
 	"github.com/Azure/ARO-RP/pkg/util/conditions"
 )
 
@@ -75,10 +76,12 @@ func (r *Reconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.
 		return reconcile.Result{}, err
 	}
 
-	// In case of this error we want to set condition to False, but
+	// In case of these errors we want to set condition to False, but
 	// we don't want to continuously try to reconcile it as it might
-	// be expected config in some cases (e.g. custom domain cluster)
-	if errors.Is(checkErr, errNoCertificateAndCustomDomain) {
+	// be expected config in some cases (e.g. custom domain cluster), or
+	// only the customer can fix it (e.g. renew their certificate)
+	if errors.Is(checkErr, errNoCertificateAndCustomDomain) ||
+		errors.Is(checkErr, errInvalidCustomCertificate) {
 		return reconcile.Result{RequeueAfter: time.Hour}, nil
 	}
 
@@ -143,5 +146,5 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 			builder.WithPredicates(clusterVersionPredicate),
 		)
 
-	return builder.Named(ControllerName).Complete(r)
+	return builder.Named(ControllerName).Complete(diagnostics.Wrap(ControllerName, operator.CheckerEnabled, r))
 }
@@ -5,6 +5,7 @@ package ingresscertificatechecker
 
 import (
 	"context"
+	"fmt"
 	"reflect"
 	"testing"
 	"time"
@@ -58,6 +59,13 @@ func TestReconcile(t *testing.T) {
 			checkerReturnErr:     errNoCertificateAndCustomDomain,
 			wantResult:           reconcile.Result{RequeueAfter: time.Hour},
 		},
+		{
+			name:                 "customer certificate errors are not retried immediately",
+			wantConditionStatus:  operatorv1.ConditionFalse,
+			wantConditionMessage: "invalid custom certificate: the ingress certificate expired at 2023-01-01T00:00:00Z",
+			checkerReturnErr:     fmt.Errorf("%w: the ingress certificate expired at 2023-01-01T00:00:00Z", errInvalidCustomCertificate),
+			wantResult:           reconcile.Result{RequeueAfter: time.Hour},
+		},
 		{
 			name:                "controller disabled",
 			controllerDisabled:  true,
@@ -5,10 +5,16 @@ package internetchecker
 
 import (
 	"context"
-	"fmt"
+	"crypto/tls"
+	"errors"
 	"net/http"
+	"net/http/httptrace"
+	"net/url"
 	"strings"
+	"sync"
 	"time"
+
+	"golang.org/x/net/http/httpproxy"
 )
 
 type simpleHTTPClient interface {
@@ -16,7 +22,35 @@ type simpleHTTPClient interface {
 }
 
 type internetChecker interface {
-	Check(URLs []string) error
+	Check(URLs []string, proxy *httpproxy.Config) []*checkResult
+}
+
+// checkResult is the outcome of the last attempt to reach a URL
+type checkResult struct {
+	url        string
+	proxy      string
+	statusCode int
+
+	dns     time.Duration
+	connect time.Duration
+	tls     time.Duration
+	total   time.Duration
+
+	// phase is the phase of the request which failed, if known
+	phase string
+	err   error
+}
+
+func (res *checkResult) Error() string {
+	msg := res.url + ": "
+	if res.phase != "" {
+		msg += res.phase + ": "
+	}
+	msg += res.err.Error()
+	if res.proxy != "" {
+		msg += " (via proxy " + res.proxy + ")"
+	}
+	return msg
 }
 
 // checker evaluates our capability to create new
@@ -24,87 +58,209 @@ type internetChecker interface {
 type checker struct {
 	checkTimeout time.Duration
 	httpClient   simpleHTTPClient
+
+	mu        sync.Mutex
+	proxyFunc func(*url.URL) (*url.URL, error)
 }
 
 func newInternetChecker() *checker {
-	return &checker{
+	r := &checker{
 		checkTimeout: time.Minute,
-		httpClient: &http.Client{
-			Transport: &http.Transport{
-				// We set DisableKeepAlives for two reasons:
-				//
-				// 1. If we're talking HTTP/2 and the remote end blackholes traffic,
-				// Go has a bug whereby it doesn't reset the connection after a
-				// timeout (https://github.com/golang/go/issues/36026).  If this
-				// happens, we never have a chance to get healthy.  We have
-				// specifically seen this with gcs.prod.monitoring.core.windows.net
-				// in Korea Central, which currently has a bad server which when we
-				// hit it causes our cluster creations to fail.
-				//
-				// 2. We *want* to evaluate our capability to successfully create
-				// *new* connections to internet endpoints anyway.
-				DisableKeepAlives: true,
-			},
+	}
+
+	r.httpClient = &http.Client{
+		Transport: &http.Transport{
+			Proxy: r.proxy,
+			// We set DisableKeepAlives for two reasons:
+			//
+			// 1. If we're talking HTTP/2 and the remote end blackholes traffic,
+			// Go has a bug whereby it doesn't reset the connection after a
+			// timeout (https://github.com/golang/go/issues/36026).  If this
+			// happens, we never have a chance to get healthy.  We have
+			// specifically seen this with gcs.prod.monitoring.core.windows.net
+			// in Korea Central, which currently has a bad server which when we
+			// hit it causes our cluster creations to fail.
+			//
+			// 2. We *want* to evaluate our capability to successfully create
+			// *new* connections to internet endpoints anyway.
+			DisableKeepAlives: true,
 		},
 	}
+
+	return r
 }
-func (r *checker) Check(URLs []string) error {
-	ch := make(chan error)
-	checkCount := 0
-	for _, url := range URLs {
-		checkCount++
-		go func(urlToCheck string) {
-			ch <- r.checkWithRetry(urlToCheck)
-		}(url)
-	}
 
-	errsAll := []string{}
-	for i := 0; i < checkCount; i++ {
-		if err := <-ch; err != nil {
-			errsAll = append(errsAll, err.Error())
-		}
+// Check checks every URL concurrently and returns the results in the order of
+// URLs.  Requests are sent through the given proxy configuration, if any.
+func (r *checker) Check(URLs []string, proxy *httpproxy.Config) []*checkResult {
+	r.setProxy(proxy)
+
+	results := make([]*checkResult, len(URLs))
+
+	var wg sync.WaitGroup
+	for i, url := range URLs {
+		wg.Add(1)
+		go func(i int, urlToCheck string) {
+			defer wg.Done()
+			results[i] = r.checkWithRetry(urlToCheck)
+		}(i, url)
 	}
-	if len(errsAll) != 0 {
-		// TODO: Consider replacing with multi error wrapping with Go 1.20: https://github.com/golang/go/issues/53435#issuecomment-1320343377
-		return fmt.Errorf(strings.Join(errsAll, "\n"))
+	wg.Wait()
+
+	return results
+}
+
+func (r *checker) setProxy(proxy *httpproxy.Config) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.proxyFunc = nil
+	if proxy != nil {
+		r.proxyFunc = proxy.ProxyFunc()
 	}
+}
 
-	return nil
+// proxy returns the proxy to use for a request, as configured by the last call
+// to Check
+func (r *checker) proxy(req *http.Request) (*url.URL, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.proxyFunc == nil {
+		return nil, nil
+	}
+	return r.proxyFunc(req.URL)
 }
 
 // checkWithRetry checks the URL, retrying a failed query a few times
-func (r *checker) checkWithRetry(url string) error {
-	var err error
+func (r *checker) checkWithRetry(url string) *checkResult {
+	var res *checkResult
 
 	for i := 0; i < 6; i++ {
-		err = r.checkOnce(url, r.checkTimeout/6)
-		if err == nil {
-			return nil
+		res = r.checkOnce(url, r.checkTimeout/6)
+		if res.err == nil {
+			return res
 		}
 	}
 
-	return err
+	return res
 }
 
 // checkOnce checks a given url.  The check both times out after a given timeout
 // *and* will wait for the timeout if it fails, so that we don't hit endpoints
 // too much.
-func (r *checker) checkOnce(url string, timeout time.Duration) error {
+func (r *checker) checkOnce(url string, timeout time.Duration) *checkResult {
+	res := &checkResult{
+		url: url,
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodHead, url, nil)
 	if err != nil {
 		<-ctx.Done()
-		return err
+		res.err = err
+		return res
 	}
 
+	if proxy, err := r.proxy(req); err == nil && proxy != nil {
+		res.proxy = proxy.Redacted()
+	}
+
+	t := &tracer{}
+	start := time.Now()
+	req = req.WithContext(httptrace.WithClientTrace(ctx, t.clientTrace()))
+
 	resp, err := r.httpClient.Do(req)
+	res.total = time.Since(start)
 	if err != nil {
 		<-ctx.Done()
-		return fmt.Errorf("%s: %s", url, err)
+		t.record(res)
+		res.err = err
+		return res
 	}
 
+	t.record(res)
+	res.phase = ""
+	res.statusCode = resp.StatusCode
 	resp.Body.Close()
-	return nil
+	return res
+}
+
+// tracer records the duration of each phase of a request.  While a phase is in
+// progress, it is recorded as the phase which would have failed.  The hooks can
+// fire after the request has been abandoned, hence the lock.
+type tracer struct {
+	mu sync.Mutex
+
+	dnsStart, connectStart, tlsStart time.Time
+	dns, connect, tls                time.Duration
+	phase                            string
+}
+
+func (t *tracer) clientTrace() *httptrace.ClientTrace {
+	return &httptrace.ClientTrace{
+		DNSStart: func(httptrace.DNSStartInfo) {
+			t.start(&t.dnsStart, "dns")
+		},
+		DNSDone: func(info httptrace.DNSDoneInfo) {
+			t.done(&t.dnsStart, &t.dns, info.Err)
+		},
+		ConnectStart: func(string, string) {
+			t.start(&t.connectStart, "connect")
+		},
+		ConnectDone: func(_, _ string, err error) {
+			t.done(&t.connectStart, &t.connect, err)
+		},
+		TLSHandshakeStart: func() {
+			t.start(&t.tlsStart, "tls")
+		},
+		TLSHandshakeDone: func(_ tls.ConnectionState, err error) {
+			t.done(&t.tlsStart, &t.tls, err)
+		},
+	}
+}
+
+func (t *tracer) start(start *time.Time, phase string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	*start = time.Now()
+	t.phase = phase
+}
+
+func (t *tracer) done(start *time.Time, d *time.Duration, err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	*d = time.Since(*start)
+	if err == nil {
+		t.phase = ""
+	}
+}
+
+func (t *tracer) record(res *checkResult) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	res.dns, res.connect, res.tls = t.dns, t.connect, t.tls
+	res.phase = t.phase
+}
+
+// checkError summarises the failed results, or returns nil if every URL was
+// reached
+func checkError(results []*checkResult) error {
+	var errs []string
+	for _, res := range results {
+		if res.err != nil {
+			errs = append(errs, res.Error())
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+
+	// TODO: Consider replacing with multi error wrapping with Go 1.20: https://github.com/golang/go/issues/53435#issuecomment-1320343377
+	return errors.New(strings.Join(errs, "\n"))
 }
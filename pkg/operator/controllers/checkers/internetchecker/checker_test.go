@@ -5,16 +5,21 @@ package internetchecker
 
 import (
 	"context"
+	"errors"
 	"io"
 	"net"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"os"
+	"reflect"
+	"sort"
+	"sync"
 	"syscall"
 	"testing"
 	"time"
 
-	operatorv1 "github.com/openshift/api/operator/v1"
+	"golang.org/x/net/http/httpproxy"
 
 	utilerror "github.com/Azure/ARO-RP/test/util/error"
 )
@@ -66,36 +71,35 @@ var (
 
 func TestCheck(t *testing.T) {
 	var testCases = []struct {
-		name          string
-		responses     []*fakeResponse
-		wantErr       string
-		wantCondition operatorv1.ConditionStatus
+		name           string
+		responses      []*fakeResponse
+		wantErr        string
+		wantStatusCode int
 	}{
 		{
-			name:          "200 OK",
-			responses:     []*fakeResponse{okResp},
-			wantCondition: operatorv1.ConditionTrue,
+			name:           "200 OK",
+			responses:      []*fakeResponse{okResp},
+			wantStatusCode: http.StatusOK,
 		},
 		{
-			name:          "bad request",
-			responses:     []*fakeResponse{badReq},
-			wantCondition: operatorv1.ConditionTrue,
+			name:           "bad request",
+			responses:      []*fakeResponse{badReq},
+			wantStatusCode: http.StatusBadRequest,
 		},
 		{
-			name:          "eventual 200 OK",
-			responses:     []*fakeResponse{networkUnreach, timedoutReq, okResp},
-			wantCondition: operatorv1.ConditionTrue,
+			name:           "eventual 200 OK",
+			responses:      []*fakeResponse{networkUnreach, timedoutReq, okResp},
+			wantStatusCode: http.StatusOK,
 		},
 		{
-			name:          "eventual bad request",
-			responses:     []*fakeResponse{timedoutReq, networkUnreach, badReq},
-			wantCondition: operatorv1.ConditionTrue,
+			name:           "eventual bad request",
+			responses:      []*fakeResponse{timedoutReq, networkUnreach, badReq},
+			wantStatusCode: http.StatusBadRequest,
 		},
 		{
-			name:          "timedout request",
-			responses:     []*fakeResponse{networkUnreach, timedoutReq, timedoutReq, timedoutReq, timedoutReq, timedoutReq},
-			wantErr:       "https://not-used-in-test.io: context deadline exceeded",
-			wantCondition: operatorv1.ConditionFalse,
+			name:      "timedout request",
+			responses: []*fakeResponse{networkUnreach, timedoutReq, timedoutReq, timedoutReq, timedoutReq, timedoutReq},
+			wantErr:   "https://not-used-in-test.io: context deadline exceeded",
 		},
 	}
 
@@ -105,8 +109,67 @@ func TestCheck(t *testing.T) {
 				checkTimeout: 100 * time.Millisecond,
 				httpClient:   &testClient{responses: test.responses},
 			}
-			err := r.Check([]string{urltocheck})
-			utilerror.AssertErrorMessage(t, err, test.wantErr)
+			results := r.Check([]string{urltocheck}, nil)
+			if len(results) != 1 {
+				t.Fatalf("got %d results", len(results))
+			}
+			if results[0].statusCode != test.wantStatusCode {
+				t.Errorf("got status code %d", results[0].statusCode)
+			}
+			utilerror.AssertErrorMessage(t, checkError(results), test.wantErr)
 		})
 	}
 }
+
+func TestCheckProxy(t *testing.T) {
+	var mu sync.Mutex
+	var proxied []string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		proxied = append(proxied, req.URL.String())
+		if req.URL.Host == "blocked.example.com" {
+			w.WriteHeader(http.StatusForbidden)
+		}
+	}))
+	defer proxy.Close()
+
+	r := newInternetChecker()
+	r.checkTimeout = 6 * time.Second
+
+	results := r.Check([]string{"http://allowed.example.com/", "http://blocked.example.com/"}, &httpproxy.Config{
+		HTTPProxy: proxy.URL,
+	})
+
+	sort.Strings(proxied)
+	if !reflect.DeepEqual(proxied, []string{"http://allowed.example.com/", "http://blocked.example.com/"}) {
+		t.Error(proxied)
+	}
+
+	for i, want := range []int{http.StatusOK, http.StatusForbidden} {
+		if results[i].err != nil {
+			t.Error(results[i].err)
+		}
+		if results[i].proxy != proxy.URL {
+			t.Errorf("got proxy %q", results[i].proxy)
+		}
+		if results[i].statusCode != want {
+			t.Errorf("got status code %d", results[i].statusCode)
+		}
+	}
+}
+
+func TestCheckResultError(t *testing.T) {
+	res := &checkResult{
+		url:   urltocheck,
+		proxy: "http://proxy.example.com:3128",
+		phase: "connect",
+		err:   errors.New("connection refused"),
+	}
+
+	want := "https://not-used-in-test.io: connect: connection refused (via proxy http://proxy.example.com:3128)"
+	if res.Error() != want {
+		t.Error(res.Error())
+	}
+}
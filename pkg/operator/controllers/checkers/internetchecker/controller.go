@@ -7,8 +7,11 @@ import (
 	"context"
 	"time"
 
+	configv1 "github.com/openshift/api/config/v1"
 	operatorv1 "github.com/openshift/api/operator/v1"
 	"github.com/sirupsen/logrus"
+	"golang.org/x/net/http/httpproxy"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
@@ -18,6 +21,7 @@ import (
 
 	"github.com/Azure/ARO-RP/pkg/operator"
 	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/diagnostics"
 	"github.com/Azure/ARO-RP/pkg/util/conditions"
 )
 
@@ -26,6 +30,7 @@ import (
 // from the annotation below.
 // +kubebuilder:rbac:groups=aro.openshift.io,resources=clusters,verbs=get;list;watch
 // +kubebuilder:rbac:groups=aro.openshift.io,resources=clusters/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=config.openshift.io,resources=proxies,verbs=get;list;watch
 
 const (
 	ControllerName = "InternetChecker"
@@ -37,6 +42,7 @@ type Reconciler struct {
 	role string
 
 	checker internetChecker
+	history history
 
 	client client.Client
 }
@@ -47,6 +53,7 @@ func NewReconciler(log *logrus.Entry, client client.Client, role string) *Reconc
 		role: role,
 
 		checker: newInternetChecker(),
+		history: history{},
 
 		client: client,
 	}
@@ -66,7 +73,21 @@ func (r *Reconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.
 	}
 
 	r.log.Debug("running")
-	checkErr := r.checker.Check(instance.Spec.InternetChecker.URLs)
+	proxy, err := r.proxyConfig(ctx)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	results := r.checker.Check(instance.Spec.InternetChecker.URLs, proxy)
+	r.emitMetrics(results)
+
+	for _, res := range results {
+		if res.err != nil {
+			r.log.WithField("url", res.url).WithField("phase", res.phase).WithField("proxy", res.proxy).Warn(res.err)
+		}
+	}
+
+	checkErr := checkError(results)
 	condition := r.condition(checkErr)
 
 	err = conditions.SetCondition(ctx, r.client, condition, r.role)
@@ -81,6 +102,29 @@ func (r *Reconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.
 	return reconcile.Result{RequeueAfter: time.Hour}, checkErr
 }
 
+// proxyConfig returns the cluster-wide proxy configuration, or nil if the
+// cluster does not use a proxy
+func (r *Reconciler) proxyConfig(ctx context.Context) (*httpproxy.Config, error) {
+	proxy := &configv1.Proxy{}
+	err := r.client.Get(ctx, types.NamespacedName{Name: "cluster"}, proxy)
+	if kerrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if proxy.Status.HTTPProxy == "" && proxy.Status.HTTPSProxy == "" {
+		return nil, nil
+	}
+
+	return &httpproxy.Config{
+		HTTPProxy:  proxy.Status.HTTPProxy,
+		HTTPSProxy: proxy.Status.HTTPSProxy,
+		NoProxy:    proxy.Status.NoProxy,
+	}, nil
+}
+
 func (r *Reconciler) reconcileDisabled(ctx context.Context) (ctrl.Result, error) {
 	condition := &operatorv1.OperatorCondition{
 		Type:   r.conditionType(),
@@ -129,5 +173,5 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 	builder := ctrl.NewControllerManagedBy(mgr).
 		For(&arov1alpha1.Cluster{}, builder.WithPredicates(aroClusterPredicate))
 
-	return builder.Named(ControllerName).Complete(r)
+	return builder.Named(ControllerName).Complete(diagnostics.Wrap(ControllerName, operator.CheckerEnabled, r))
 }
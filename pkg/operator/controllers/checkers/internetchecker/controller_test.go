@@ -6,11 +6,14 @@ package internetchecker
 import (
 	"context"
 	"errors"
+	"net/http"
 	"reflect"
 	"testing"
 	"time"
 
+	configv1 "github.com/openshift/api/config/v1"
 	operatorv1 "github.com/openshift/api/operator/v1"
+	"golang.org/x/net/http/httpproxy"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -25,34 +28,62 @@ import (
 	utilerror "github.com/Azure/ARO-RP/test/util/error"
 )
 
-type fakeChecker func(URLs []string) error
+type fakeChecker func(URLs []string, proxy *httpproxy.Config) []*checkResult
 
-func (fc fakeChecker) Check(URLs []string) error {
-	return fc(URLs)
+func (fc fakeChecker) Check(URLs []string, proxy *httpproxy.Config) []*checkResult {
+	return fc(URLs, proxy)
 }
 
 func TestReconcile(t *testing.T) {
 	ctx := context.Background()
 	urlsToCheck := []string{"https://fake-url-for-test-only.xyz"}
 
+	clusterProxy := &configv1.Proxy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "cluster",
+		},
+		Status: configv1.ProxyStatus{
+			HTTPProxy:  "http://proxy.example.com:3128",
+			HTTPSProxy: "http://proxy.example.com:3128",
+			NoProxy:    ".cluster.local",
+		},
+	}
+
 	tests := []struct {
 		name               string
 		controllerDisabled bool
+		proxy              *configv1.Proxy
 		checkerReturnErr   error
+		wantProxy          *httpproxy.Config
 		wantCondition      operatorv1.ConditionStatus
+		wantMessage        string
 		wantErr            string
 		wantResult         reconcile.Result
 	}{
 		{
 			name:          "no errors",
 			wantCondition: operatorv1.ConditionTrue,
+			wantMessage:   "Outgoing connection successful",
+			wantResult:    reconcile.Result{RequeueAfter: time.Hour},
+		},
+		{
+			name:  "no errors through the cluster proxy",
+			proxy: clusterProxy,
+			wantProxy: &httpproxy.Config{
+				HTTPProxy:  "http://proxy.example.com:3128",
+				HTTPSProxy: "http://proxy.example.com:3128",
+				NoProxy:    ".cluster.local",
+			},
+			wantCondition: operatorv1.ConditionTrue,
+			wantMessage:   "Outgoing connection successful",
 			wantResult:    reconcile.Result{RequeueAfter: time.Hour},
 		},
 		{
 			name:             "error making a request",
 			checkerReturnErr: errors.New("fake error from checker"),
 			wantCondition:    operatorv1.ConditionFalse,
-			wantErr:          "fake error from checker",
+			wantMessage:      "https://fake-url-for-test-only.xyz: tls: fake error from checker",
+			wantErr:          "https://fake-url-for-test-only.xyz: tls: fake error from checker",
 			wantResult:       reconcile.Result{RequeueAfter: time.Hour},
 		},
 		{
@@ -89,19 +120,33 @@ func TestReconcile(t *testing.T) {
 						instance.Spec.OperatorFlags[operator.CheckerEnabled] = operator.FlagFalse
 					}
 
-					clientFake := fake.NewClientBuilder().WithObjects(instance).Build()
+					clientBuilder := fake.NewClientBuilder().WithObjects(instance)
+					if tt.proxy != nil {
+						clientBuilder = clientBuilder.WithObjects(tt.proxy)
+					}
+					clientFake := clientBuilder.Build()
 
 					r := &Reconciler{
 						log:  utillog.GetLogger(),
 						role: testRole,
-						checker: fakeChecker(func(URLs []string) error {
+						checker: fakeChecker(func(URLs []string, proxy *httpproxy.Config) []*checkResult {
 							if !reflect.DeepEqual(urlsToCheck, URLs) {
 								t.Error(cmp.Diff(urlsToCheck, URLs))
 							}
+							if !reflect.DeepEqual(tt.wantProxy, proxy) {
+								t.Error(cmp.Diff(tt.wantProxy, proxy))
+							}
 
-							return tt.checkerReturnErr
+							res := &checkResult{url: URLs[0], statusCode: http.StatusOK}
+							if tt.checkerReturnErr != nil {
+								res.statusCode = 0
+								res.phase = "tls"
+								res.err = tt.checkerReturnErr
+							}
+							return []*checkResult{res}
 						}),
-						client: clientFake,
+						history: history{},
+						client:  clientFake,
 					}
 
 					result, err := r.Reconcile(ctx, ctrl.Request{})
@@ -128,6 +173,9 @@ func TestReconcile(t *testing.T) {
 					if condition.Status != tt.wantCondition {
 						t.Error(condition.Status)
 					}
+					if condition.Message != tt.wantMessage {
+						t.Error(condition.Message)
+					}
 				})
 			}
 		})
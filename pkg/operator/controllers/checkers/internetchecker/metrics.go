@@ -0,0 +1,110 @@
+package internetchecker
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// historySize is the number of checks of each URL over which the success rate
+// is calculated
+const historySize = 24
+
+var (
+	metricReachable = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "aro_operator_internet_checker_reachable",
+		Help: "Whether the last check of the URL succeeded.",
+	}, []string{"role", "url"})
+
+	metricSuccessRatio = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "aro_operator_internet_checker_success_ratio",
+		Help: "Ratio of successful checks of the URL over the recent check history.",
+	}, []string{"role", "url"})
+
+	metricStatusCode = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "aro_operator_internet_checker_status_code",
+		Help: "HTTP status code returned by the last check of the URL, or 0 if there was no response.",
+	}, []string{"role", "url"})
+
+	metricDuration = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "aro_operator_internet_checker_duration_seconds",
+		Help: "Duration of each phase of the last check of the URL.",
+	}, []string{"role", "url", "phase"})
+)
+
+func init() {
+	metrics.Registry.MustRegister(metricReachable, metricSuccessRatio, metricStatusCode, metricDuration)
+}
+
+// history holds the recent check outcomes of each URL
+type history map[string][]bool
+
+// record adds the results to the history and forgets URLs which are no longer
+// checked.  It returns the forgotten URLs.
+func (h history) record(results []*checkResult) []string {
+	current := map[string]struct{}{}
+	for _, res := range results {
+		current[res.url] = struct{}{}
+
+		outcomes := append(h[res.url], res.err == nil)
+		if len(outcomes) > historySize {
+			outcomes = outcomes[len(outcomes)-historySize:]
+		}
+		h[res.url] = outcomes
+	}
+
+	var removed []string
+	for url := range h {
+		if _, ok := current[url]; !ok {
+			delete(h, url)
+			removed = append(removed, url)
+		}
+	}
+
+	return removed
+}
+
+// successRatio returns the proportion of recent checks of the URL which
+// succeeded
+func (h history) successRatio(url string) float64 {
+	outcomes := h[url]
+	if len(outcomes) == 0 {
+		return 0
+	}
+
+	var succeeded int
+	for _, ok := range outcomes {
+		if ok {
+			succeeded++
+		}
+	}
+
+	return float64(succeeded) / float64(len(outcomes))
+}
+
+func (r *Reconciler) emitMetrics(results []*checkResult) {
+	for _, url := range r.history.record(results) {
+		labels := prometheus.Labels{"role": r.role, "url": url}
+		metricReachable.Delete(labels)
+		metricSuccessRatio.Delete(labels)
+		metricStatusCode.Delete(labels)
+		metricDuration.DeletePartialMatch(labels)
+	}
+
+	for _, res := range results {
+		var reachable float64
+		if res.err == nil {
+			reachable = 1
+		}
+
+		metricReachable.WithLabelValues(r.role, res.url).Set(reachable)
+		metricSuccessRatio.WithLabelValues(r.role, res.url).Set(r.history.successRatio(res.url))
+		metricStatusCode.WithLabelValues(r.role, res.url).Set(float64(res.statusCode))
+		metricDuration.WithLabelValues(r.role, res.url, "dns").Set(res.dns.Seconds())
+		metricDuration.WithLabelValues(r.role, res.url, "connect").Set(res.connect.Seconds())
+		metricDuration.WithLabelValues(r.role, res.url, "tls").Set(res.tls.Seconds())
+		metricDuration.WithLabelValues(r.role, res.url, "total").Set(res.total.Seconds())
+	}
+}
@@ -0,0 +1,47 @@
+package internetchecker
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestHistory(t *testing.T) {
+	h := history{}
+	failed := errors.New("failed")
+
+	for i := 0; i < historySize; i++ {
+		h.record([]*checkResult{{url: "https://a"}, {url: "https://b", err: failed}})
+	}
+
+	if ratio := h.successRatio("https://a"); ratio != 1 {
+		t.Errorf("got a ratio %v", ratio)
+	}
+	if ratio := h.successRatio("https://b"); ratio != 0 {
+		t.Errorf("got b ratio %v", ratio)
+	}
+
+	// the oldest outcomes fall out of the history
+	for i := 0; i < historySize/4; i++ {
+		h.record([]*checkResult{{url: "https://a", err: failed}, {url: "https://b"}})
+	}
+
+	if ratio := h.successRatio("https://a"); ratio != 0.75 {
+		t.Errorf("got a ratio %v", ratio)
+	}
+	if ratio := h.successRatio("https://b"); ratio != 0.25 {
+		t.Errorf("got b ratio %v", ratio)
+	}
+
+	// URLs which are no longer checked are forgotten
+	removed := h.record([]*checkResult{{url: "https://a"}})
+	if !reflect.DeepEqual(removed, []string{"https://b"}) {
+		t.Error(removed)
+	}
+	if ratio := h.successRatio("https://b"); ratio != 0 {
+		t.Errorf("got b ratio %v", ratio)
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/Azure/ARO-RP/pkg/operator"
 	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
 	"github.com/Azure/ARO-RP/pkg/operator/controllers/base"
+	"github.com/Azure/ARO-RP/pkg/operator/diagnostics"
 )
 
 const (
@@ -180,7 +181,7 @@ func (r *CloudProviderConfigReconciler) SetupWithManager(mgr ctrl.Manager) error
 			builder.WithPredicates(cloudProviderConfigPredicate),
 		).
 		Named(ControllerName).
-		Complete(r)
+		Complete(diagnostics.Wrap(ControllerName, operator.CloudProviderConfigEnabled, r))
 }
 
 // GetDisableOutboundSNAT Returns the value of disableOutboundSNAT from the Config
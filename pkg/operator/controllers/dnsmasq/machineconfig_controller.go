@@ -17,6 +17,7 @@ import (
 
 	"github.com/Azure/ARO-RP/pkg/operator"
 	"github.com/Azure/ARO-RP/pkg/operator/controllers/base"
+	"github.com/Azure/ARO-RP/pkg/operator/diagnostics"
 	"github.com/Azure/ARO-RP/pkg/util/dynamichelper"
 )
 
@@ -98,5 +99,5 @@ func (r *MachineConfigReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&mcv1.MachineConfig{}).
 		Named(MachineConfigControllerName).
-		Complete(r)
+		Complete(diagnostics.Wrap(MachineConfigControllerName, operator.DnsmasqEnabled, r))
 }
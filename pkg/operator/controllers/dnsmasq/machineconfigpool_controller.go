@@ -16,6 +16,7 @@ import (
 
 	"github.com/Azure/ARO-RP/pkg/operator"
 	"github.com/Azure/ARO-RP/pkg/operator/controllers/base"
+	"github.com/Azure/ARO-RP/pkg/operator/diagnostics"
 	"github.com/Azure/ARO-RP/pkg/util/dynamichelper"
 )
 
@@ -87,5 +88,5 @@ func (r *MachineConfigPoolReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&mcv1.MachineConfigPool{}).
 		Named(MachineConfigPoolControllerName).
-		Complete(r)
+		Complete(diagnostics.Wrap(MachineConfigPoolControllerName, operator.DnsmasqEnabled, r))
 }
@@ -0,0 +1,174 @@
+package drift
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/equality"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/base"
+	"github.com/Azure/ARO-RP/pkg/operator/diagnostics"
+	"github.com/Azure/ARO-RP/pkg/util/conditions"
+)
+
+const (
+	ControllerName = "Drift"
+
+	// reportInterval is how often the drift report is refreshed
+	reportInterval = 5 * time.Minute
+)
+
+// Reconciler publishes the drift aggregated by the Recorder in the
+// DriftReport resource and the ManagedResourceDrift condition
+type Reconciler struct {
+	base.AROController
+
+	recorder *Recorder
+	now      func() time.Time
+}
+
+func NewReconciler(log *logrus.Entry, client client.Client, recorder *Recorder) *Reconciler {
+	return &Reconciler{
+		AROController: base.AROController{
+			Log:    log,
+			Client: client,
+			Name:   ControllerName,
+		},
+		recorder: recorder,
+		now:      time.Now,
+	}
+}
+
+func (r *Reconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
+	instance, err := r.GetCluster(ctx)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if !instance.Spec.OperatorFlags.GetSimpleBoolean(operator.DriftEnabled) {
+		r.Log.Debug("controller is disabled")
+		return reconcile.Result{}, nil
+	}
+
+	r.Log.Debug("running")
+	resources := r.recorder.snapshot(r.now())
+	fighting := fighting(resources)
+
+	emitMetrics(fighting)
+
+	err = r.updateReport(ctx, resources)
+	if err != nil {
+		r.Log.Error(err)
+		r.SetDegraded(ctx, err)
+		return reconcile.Result{}, err
+	}
+
+	err = conditions.SetCondition(ctx, r.Client, driftCondition(fighting), operator.RoleMaster)
+	if err != nil {
+		r.Log.Error(err)
+		r.SetDegraded(ctx, err)
+		return reconcile.Result{}, err
+	}
+
+	r.ClearConditions(ctx)
+	return reconcile.Result{RequeueAfter: reportInterval}, nil
+}
+
+// updateReport creates or updates the DriftReport singleton
+func (r *Reconciler) updateReport(ctx context.Context, resources []arov1alpha1.DriftedResource) error {
+	report := &arov1alpha1.DriftReport{}
+	err := r.Client.Get(ctx, types.NamespacedName{Name: arov1alpha1.SingletonDriftReportName}, report)
+	if kerrors.IsNotFound(err) {
+		report = &arov1alpha1.DriftReport{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: arov1alpha1.SingletonDriftReportName,
+			},
+		}
+		err = r.Client.Create(ctx, report)
+	}
+	if err != nil {
+		return err
+	}
+
+	status := arov1alpha1.DriftReportStatus{
+		Resources: resources,
+	}
+	if equality.Semantic.DeepEqual(report.Status, status) {
+		return nil
+	}
+
+	report.Status = status
+	return r.Client.Status().Update(ctx, report)
+}
+
+func driftCondition(fighting []arov1alpha1.DriftedResource) *operatorv1.OperatorCondition {
+	cond := &operatorv1.OperatorCondition{
+		Type:    arov1alpha1.ManagedResourceDrift,
+		Status:  operatorv1.ConditionFalse,
+		Reason:  "AsExpected",
+		Message: "No managed resources are being repeatedly corrected",
+	}
+
+	if len(fighting) == 0 {
+		return cond
+	}
+
+	summaries := make([]string, 0, len(fighting))
+	for _, res := range fighting {
+		name := res.Name
+		if res.Namespace != "" {
+			name = res.Namespace + "/" + name
+		}
+
+		summary := fmt.Sprintf("%s %s corrected %d times", res.GroupKind, name, res.RecentCorrections)
+		if res.LastManager != "" {
+			summary += " (last changed by " + res.LastManager + ")"
+		}
+		summaries = append(summaries, summary)
+	}
+
+	cond.Status = operatorv1.ConditionTrue
+	cond.Reason = "RepeatedCorrections"
+	cond.Message = fmt.Sprintf("%d managed resources corrected %d or more times in the last hour: %s",
+		len(fighting), fightThreshold, strings.Join(summaries, ", "))
+
+	return cond
+}
+
+func emitMetrics(fighting []arov1alpha1.DriftedResource) {
+	metricFighting.Reset()
+	for _, res := range fighting {
+		metricFighting.WithLabelValues(res.GroupKind).Inc()
+	}
+}
+
+// SetupWithManager setup our manager
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.Log.Info("starting drift controller")
+
+	aroClusterPredicate := predicate.NewPredicateFuncs(func(o client.Object) bool {
+		return o.GetName() == arov1alpha1.SingletonClusterName
+	})
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&arov1alpha1.Cluster{}, builder.WithPredicates(aroClusterPredicate, predicate.GenerationChangedPredicate{})).
+		Named(ControllerName).
+		Complete(diagnostics.Wrap(ControllerName, operator.DriftEnabled, r))
+}
@@ -0,0 +1,141 @@
+package drift
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/util/dynamichelper"
+	_ "github.com/Azure/ARO-RP/pkg/util/scheme"
+	utilconditions "github.com/Azure/ARO-RP/test/util/conditions"
+	utilerror "github.com/Azure/ARO-RP/test/util/error"
+)
+
+func TestReconciler(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	deploymentDrift := func(ago time.Duration) *dynamichelper.Drift {
+		return &dynamichelper.Drift{
+			GroupKind: "Deployment.apps",
+			Namespace: "openshift-azure-logging",
+			Name:      "mdsd",
+			Fields:    []string{"spec.template.spec.containers"},
+			Manager:   "kubectl-edit",
+			Time:      now.Add(-ago),
+		}
+	}
+
+	for _, tt := range []struct {
+		name          string
+		flag          string
+		drifts        []*dynamichelper.Drift
+		wantResources int
+		wantCondition *operatorv1.OperatorCondition
+		wantErr       string
+	}{
+		{
+			name:          "no drift",
+			flag:          operator.FlagTrue,
+			wantCondition: driftCondition(nil),
+		},
+		{
+			name: "occasional drift",
+			flag: operator.FlagTrue,
+			drifts: []*dynamichelper.Drift{
+				deploymentDrift(3 * time.Hour),
+				deploymentDrift(2 * time.Hour),
+				deploymentDrift(time.Minute),
+			},
+			wantResources: 1,
+			wantCondition: driftCondition(nil),
+		},
+		{
+			name: "repeated drift",
+			flag: operator.FlagTrue,
+			drifts: []*dynamichelper.Drift{
+				deploymentDrift(50 * time.Minute),
+				deploymentDrift(20 * time.Minute),
+				deploymentDrift(time.Minute),
+			},
+			wantResources: 1,
+			wantCondition: &operatorv1.OperatorCondition{
+				Type:    arov1alpha1.ManagedResourceDrift,
+				Status:  operatorv1.ConditionTrue,
+				Reason:  "RepeatedCorrections",
+				Message: "1 managed resources corrected 3 or more times in the last hour: Deployment.apps openshift-azure-logging/mdsd corrected 3 times (last changed by kubectl-edit)",
+			},
+		},
+		{
+			name: "controller disabled",
+			flag: operator.FlagFalse,
+			drifts: []*dynamichelper.Drift{
+				deploymentDrift(time.Minute),
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster := &arov1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: arov1alpha1.SingletonClusterName},
+				Spec: arov1alpha1.ClusterSpec{
+					OperatorFlags: arov1alpha1.OperatorFlags{
+						operator.DriftEnabled: tt.flag,
+					},
+				},
+			}
+			client := ctrlfake.NewClientBuilder().WithObjects(cluster).Build()
+
+			recorder := NewRecorder()
+			for _, d := range tt.drifts {
+				recorder.RecordDrift(d)
+			}
+
+			r := NewReconciler(logrus.NewEntry(logrus.StandardLogger()), client, recorder)
+			r.now = func() time.Time { return now }
+
+			_, err := r.Reconcile(ctx, ctrl.Request{})
+			utilerror.AssertErrorMessage(t, err, tt.wantErr)
+
+			report := &arov1alpha1.DriftReport{}
+			err = client.Get(ctx, types.NamespacedName{Name: arov1alpha1.SingletonDriftReportName}, report)
+			if tt.flag == operator.FlagFalse {
+				if err == nil {
+					t.Error("unexpected drift report")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(report.Status.Resources) != tt.wantResources {
+				t.Errorf("got %d resources, want %d", len(report.Status.Resources), tt.wantResources)
+			}
+			if tt.wantResources > 0 {
+				res := report.Status.Resources[0]
+				if res.Corrections != len(tt.drifts) {
+					t.Errorf("got %d corrections, want %d", res.Corrections, len(tt.drifts))
+				}
+				if !reflect.DeepEqual(res.LastFields, []string{"spec.template.spec.containers"}) {
+					t.Error(res.LastFields)
+				}
+			}
+
+			tt.wantCondition.LastTransitionTime = metav1.Now()
+			utilconditions.AssertControllerConditions(t, ctx, client, []operatorv1.OperatorCondition{*tt.wantCondition})
+		})
+	}
+}
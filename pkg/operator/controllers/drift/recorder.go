@@ -0,0 +1,167 @@
+package drift
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/util/dynamichelper"
+)
+
+const (
+	// recentWindow is the window within which corrections of a resource are
+	// counted as recent
+	recentWindow = time.Hour
+
+	// fightThreshold is the number of recent corrections of a resource at
+	// which we consider the operator to be fighting another actor over it
+	fightThreshold = 3
+
+	// retention is how long a resource which is no longer corrected is
+	// reported for
+	retention = 24 * time.Hour
+
+	// maxResources bounds the number of resources the Recorder keeps.  When
+	// it is reached, the least recently corrected resource is forgotten.
+	maxResources = 500
+)
+
+var (
+	metricCorrections = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "aro_operator_drift_corrections_total",
+		Help: "Number of managed resources corrected by the operator.",
+	}, []string{"group_kind"})
+
+	metricFighting = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "aro_operator_drift_fighting_resources",
+		Help: "Number of managed resources repeatedly corrected by the operator within the last hour.",
+	}, []string{"group_kind"})
+)
+
+func init() {
+	metrics.Registry.MustRegister(metricCorrections, metricFighting)
+}
+
+// Recorder aggregates the drift corrected by the dynamic helper.  It is shared
+// by every controller in the operator.
+type Recorder struct {
+	mu        sync.Mutex
+	resources map[string]*resource
+}
+
+var _ dynamichelper.DriftRecorder = &Recorder{}
+
+// resource is the drift history of a single managed resource
+type resource struct {
+	status arov1alpha1.DriftedResource
+	recent []time.Time
+}
+
+func NewRecorder() *Recorder {
+	return &Recorder{
+		resources: map[string]*resource{},
+	}
+}
+
+// RecordDrift records a correction made by the dynamic helper
+func (r *Recorder) RecordDrift(drift *dynamichelper.Drift) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := drift.GroupKind + "/" + drift.Namespace + "/" + drift.Name
+
+	res, ok := r.resources[key]
+	if !ok {
+		if len(r.resources) >= maxResources {
+			r.evictOldest()
+		}
+
+		res = &resource{
+			status: arov1alpha1.DriftedResource{
+				GroupKind:      drift.GroupKind,
+				Namespace:      drift.Namespace,
+				Name:           drift.Name,
+				FirstCorrected: metav1.NewTime(drift.Time),
+			},
+		}
+		r.resources[key] = res
+	}
+
+	res.status.Corrections++
+	res.status.LastFields = drift.Fields
+	res.status.LastManager = drift.Manager
+	res.status.LastCorrected = metav1.NewTime(drift.Time)
+	res.recent = append(res.recent, drift.Time)
+
+	metricCorrections.WithLabelValues(drift.GroupKind).Inc()
+}
+
+// evictOldest forgets the least recently corrected resource.  r.mu must be
+// held.
+func (r *Recorder) evictOldest() {
+	var oldest string
+	for key, res := range r.resources {
+		if oldest == "" || res.status.LastCorrected.Before(&r.resources[oldest].status.LastCorrected) {
+			oldest = key
+		}
+	}
+
+	delete(r.resources, oldest)
+}
+
+// snapshot returns the drift of every resource corrected within retention,
+// ordered by GroupKind, namespace and name.  Corrections older than
+// recentWindow and resources older than retention are forgotten as of now.
+func (r *Recorder) snapshot(now time.Time) []arov1alpha1.DriftedResource {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	resources := make([]arov1alpha1.DriftedResource, 0, len(r.resources))
+	for key, res := range r.resources {
+		if now.Sub(res.status.LastCorrected.Time) > retention {
+			delete(r.resources, key)
+			continue
+		}
+
+		i := 0
+		for i < len(res.recent) && now.Sub(res.recent[i]) > recentWindow {
+			i++
+		}
+		res.recent = res.recent[i:]
+		res.status.RecentCorrections = len(res.recent)
+
+		resources = append(resources, *res.status.DeepCopy())
+	}
+
+	sort.Slice(resources, func(i, j int) bool {
+		if resources[i].GroupKind != resources[j].GroupKind {
+			return resources[i].GroupKind < resources[j].GroupKind
+		}
+		if resources[i].Namespace != resources[j].Namespace {
+			return resources[i].Namespace < resources[j].Namespace
+		}
+		return resources[i].Name < resources[j].Name
+	})
+
+	return resources
+}
+
+// fighting returns the resources which have been corrected at least
+// fightThreshold times within recentWindow
+func fighting(resources []arov1alpha1.DriftedResource) []arov1alpha1.DriftedResource {
+	var fighting []arov1alpha1.DriftedResource
+	for _, res := range resources {
+		if res.RecentCorrections >= fightThreshold {
+			fighting = append(fighting, res)
+		}
+	}
+	return fighting
+}
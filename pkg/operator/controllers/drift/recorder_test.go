@@ -0,0 +1,124 @@
+package drift
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/util/dynamichelper"
+)
+
+func TestRecorder(t *testing.T) {
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	r := NewRecorder()
+	for _, d := range []*dynamichelper.Drift{
+		{
+			GroupKind: "ConfigMap",
+			Namespace: "openshift-azure-operator",
+			Name:      "config",
+			Fields:    []string{"data.key"},
+			Manager:   "kubectl-edit",
+			Time:      start,
+		},
+		{
+			GroupKind: "Deployment.apps",
+			Namespace: "openshift-azure-logging",
+			Name:      "mdsd",
+			Fields:    []string{"spec.replicas"},
+			Manager:   "kube-controller-manager",
+			Time:      start.Add(30 * time.Minute),
+		},
+		{
+			GroupKind: "ConfigMap",
+			Namespace: "openshift-azure-operator",
+			Name:      "config",
+			Fields:    []string{"data.key", "metadata.labels.foo"},
+			Manager:   "someoperator",
+			Time:      start.Add(70 * time.Minute),
+		},
+	} {
+		r.RecordDrift(d)
+	}
+
+	// the first correction of the ConfigMap is no longer recent
+	got := r.snapshot(start.Add(90 * time.Minute))
+
+	want := []arov1alpha1.DriftedResource{
+		{
+			GroupKind:         "ConfigMap",
+			Namespace:         "openshift-azure-operator",
+			Name:              "config",
+			Corrections:       2,
+			RecentCorrections: 1,
+			LastFields:        []string{"data.key", "metadata.labels.foo"},
+			LastManager:       "someoperator",
+			FirstCorrected:    metav1.NewTime(start),
+			LastCorrected:     metav1.NewTime(start.Add(70 * time.Minute)),
+		},
+		{
+			GroupKind:         "Deployment.apps",
+			Namespace:         "openshift-azure-logging",
+			Name:              "mdsd",
+			Corrections:       1,
+			RecentCorrections: 1,
+			LastFields:        []string{"spec.replicas"},
+			LastManager:       "kube-controller-manager",
+			FirstCorrected:    metav1.NewTime(start.Add(30 * time.Minute)),
+			LastCorrected:     metav1.NewTime(start.Add(30 * time.Minute)),
+		},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %#v, want %#v", got, want)
+	}
+}
+
+func TestRecorderForgetsResources(t *testing.T) {
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	r := NewRecorder()
+	for i := 0; i <= maxResources; i++ {
+		r.RecordDrift(&dynamichelper.Drift{
+			GroupKind: "ConfigMap",
+			Namespace: "openshift-azure-operator",
+			Name:      fmt.Sprintf("config-%04d", i),
+			Time:      start.Add(time.Duration(i) * time.Minute),
+		})
+	}
+
+	// the least recently corrected resource made room for the last one
+	got := r.snapshot(start.Add(time.Duration(maxResources) * time.Minute))
+	if len(got) != maxResources {
+		t.Fatal(len(got))
+	}
+	if got[0].Name != "config-0001" {
+		t.Error(got[0].Name)
+	}
+
+	// resources which are no longer corrected are forgotten after retention,
+	// which leaves the last 10
+	got = r.snapshot(start.Add(retention + time.Duration(maxResources-10)*time.Minute + time.Second))
+	if len(got) != 10 {
+		t.Error(len(got))
+	}
+}
+
+func TestFighting(t *testing.T) {
+	resources := []arov1alpha1.DriftedResource{
+		{Name: "calm", Corrections: 10, RecentCorrections: 2},
+		{Name: "fighting", Corrections: 3, RecentCorrections: 3},
+	}
+
+	got := fighting(resources)
+	if len(got) != 1 || got[0].Name != "fighting" {
+		t.Errorf("got %#v", got)
+	}
+}
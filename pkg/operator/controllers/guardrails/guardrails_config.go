@@ -55,6 +55,14 @@ const (
 
 	defaultReconciliationMinutes = "60"
 
+	// auditPollInterval is how often the audit violations are read between
+	// reconciliations, matching the default gatekeeper audit interval
+	auditPollInterval = time.Minute
+
+	enforcementDeny   = "deny"
+	enforcementDryRun = "dryrun"
+	enforcementWarn   = "warn"
+
 	defaultValidatingWebhookFailurePolicy = "Ignore"
 	defaultValidatingWebhookTimeout       = "3"
 	defaultMutatingWebhookFailurePolicy   = "Ignore"
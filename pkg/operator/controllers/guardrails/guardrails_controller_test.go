@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/golang/mock/gomock"
+	operatorv1 "github.com/openshift/api/operator/v1"
 	"github.com/sirupsen/logrus"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrlfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -19,6 +20,7 @@ import (
 	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
 	"github.com/Azure/ARO-RP/pkg/operator/controllers/guardrails/config"
 	mock_deployer "github.com/Azure/ARO-RP/pkg/util/mocks/deployer"
+	utilconditions "github.com/Azure/ARO-RP/test/util/conditions"
 )
 
 func TestGuardRailsReconciler(t *testing.T) {
@@ -28,7 +30,8 @@ func TestGuardRailsReconciler(t *testing.T) {
 		flags         arov1alpha1.OperatorFlags
 		cleanupNeeded bool
 		// errors
-		wantErr string
+		wantErr        string
+		wantConditions []operatorv1.OperatorCondition
 	}{
 		{
 			name: "disabled",
@@ -37,6 +40,13 @@ func TestGuardRailsReconciler(t *testing.T) {
 				operator.GuardrailsDeployManaged: operator.FlagFalse,
 				controllerPullSpec:               "wonderfulPullspec",
 			},
+			wantConditions: []operatorv1.OperatorCondition{
+				{
+					Type:               arov1alpha1.GuardRailsStatus,
+					Status:             operatorv1.ConditionUnknown,
+					LastTransitionTime: metav1.Now(),
+				},
+			},
 		},
 		{
 			name: "managed",
@@ -159,6 +169,13 @@ func TestGuardRailsReconciler(t *testing.T) {
 			mocks: func(md *mock_deployer.MockDeployer, cluster *arov1alpha1.Cluster) {
 				md.EXPECT().Remove(gomock.Any(), gomock.Any()).Return(nil)
 			},
+			wantConditions: []operatorv1.OperatorCondition{
+				{
+					Type:               arov1alpha1.GuardRailsStatus,
+					Status:             operatorv1.ConditionUnknown,
+					LastTransitionTime: metav1.Now(),
+				},
+			},
 		},
 		{
 			name: "managed=false (removal), Remove() fails",
@@ -199,9 +216,19 @@ func TestGuardRailsReconciler(t *testing.T) {
 					OperatorFlags: tt.flags,
 					ACRDomain:     "acrtest.example.com",
 				},
+				Status: arov1alpha1.ClusterStatus{
+					Conditions: []operatorv1.OperatorCondition{
+						{
+							Type:    arov1alpha1.GuardRailsStatus,
+							Status:  operatorv1.ConditionFalse,
+							Reason:  "AuditDone",
+							Message: "1 violations: aro-machines-deny (deny): 1 violations",
+						},
+					},
+				},
 			}
 			deployer := mock_deployer.NewMockDeployer(controller)
-			clientBuilder := ctrlfake.NewClientBuilder().WithObjects(cluster)
+			client := ctrlfake.NewClientBuilder().WithObjects(cluster).Build()
 
 			if tt.mocks != nil {
 				tt.mocks(deployer, cluster)
@@ -210,7 +237,7 @@ func TestGuardRailsReconciler(t *testing.T) {
 			r := &Reconciler{
 				log:               logrus.NewEntry(logrus.StandardLogger()),
 				deployer:          deployer,
-				client:            clientBuilder.Build(),
+				client:            client,
 				readinessTimeout:  0 * time.Second,
 				readinessPollTime: 1 * time.Second,
 				cleanupNeeded:     tt.cleanupNeeded,
@@ -223,6 +250,8 @@ func TestGuardRailsReconciler(t *testing.T) {
 			if err == nil && tt.wantErr != "" {
 				t.Errorf("did not get an error, but wanted error '%v'", tt.wantErr)
 			}
+
+			utilconditions.AssertControllerConditions(t, context.Background(), client, tt.wantConditions)
 		})
 	}
 }
@@ -16,6 +16,7 @@ import (
 	"time"
 
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	kruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 
@@ -35,59 +36,117 @@ func (r *Reconciler) getPolicyConfig(ctx context.Context, instance *arov1alpha1.
 	managed := instance.Spec.OperatorFlags.GetWithDefault(managedPath, "false")
 
 	enforcementPath := fmt.Sprintf(controllerPolicyEnforcementTemplate, name)
-	enforcement := instance.Spec.OperatorFlags.GetWithDefault(enforcementPath, "dryrun")
+	enforcement := strings.ToLower(instance.Spec.OperatorFlags.GetWithDefault(enforcementPath, enforcementDryRun))
+
+	// a GuardrailsPolicy of the same name takes precedence over the flag
+	policy := &arov1alpha1.GuardrailsPolicy{}
+	err := r.client.Get(ctx, types.NamespacedName{Name: name}, policy)
+	switch {
+	case err == nil:
+		enforcement = strings.ToLower(policy.Spec.Enforcement)
+	case !kerrors.IsNotFound(err):
+		return "", "", err
+	}
+
+	switch enforcement {
+	case enforcementDeny, enforcementDryRun, enforcementWarn:
+	default:
+		// an unrecognised enforcement would be rejected by gatekeeper, so
+		// fall back to auditing rather than failing to deploy the policy
+		r.log.Warnf("invalid enforcement %q for policy %s, using %s", enforcement, name, enforcementDryRun)
+		enforcement = enforcementDryRun
+	}
 
 	return managed, enforcement, nil
 }
 
-func (r *Reconciler) ensurePolicy(ctx context.Context, fs embed.FS, path string) error {
+// renderPolicies renders the policy Constraints with their configured
+// enforcement, and returns the managed and unmanaged ones separately, along
+// with the status of the managed ones
+func (r *Reconciler) renderPolicies(ctx context.Context, fs embed.FS, path string) (managed, unmanaged []*unstructured.Unstructured, policies []*policyStatus, err error) {
 	template, err := template.ParseFS(fs, filepath.Join(path, "*"))
 	if err != nil {
-		return err
+		return nil, nil, nil, err
 	}
 
 	instance := &arov1alpha1.Cluster{}
 	err = r.client.Get(ctx, types.NamespacedName{Name: arov1alpha1.SingletonClusterName}, instance)
 	if err != nil {
-		return err
+		return nil, nil, nil, err
 	}
 
-	creates := make([]kruntime.Object, 0)
 	buffer := new(bytes.Buffer)
 	for _, templ := range template.Templates() {
-		managed, enforcement, err := r.getPolicyConfig(ctx, instance, templ.Name())
+		managedPolicy, enforcement, err := r.getPolicyConfig(ctx, instance, templ.Name())
 		if err != nil {
-			return err
+			return nil, nil, nil, err
 		}
 		policyConfig := &config.GuardRailsPolicyConfig{
 			Enforcement: enforcement,
 		}
+		buffer.Reset()
 		err = templ.Execute(buffer, policyConfig)
 		if err != nil {
-			return err
+			return nil, nil, nil, err
 		}
 		data := buffer.Bytes()
 
 		uns, err := dynamichelper.DecodeUnstructured(data)
 		if err != nil {
-			return err
+			return nil, nil, nil, err
 		}
 
-		if managed != "true" {
-			err := r.dh.EnsureDeletedGVR(ctx, uns.GroupVersionKind().GroupKind().String(), uns.GetNamespace(), uns.GetName(), uns.GroupVersionKind().Version)
-			if err != nil && !kerrors.IsNotFound(err) && !strings.Contains(strings.ToLower(err.Error()), "notfound") {
-				return err
-			}
+		if managedPolicy != "true" {
+			unmanaged = append(unmanaged, uns)
 			continue
 		}
 
+		managed = append(managed, uns)
+		policies = append(policies, &policyStatus{
+			name:        uns.GetName(),
+			groupKind:   uns.GroupVersionKind().GroupKind().String(),
+			version:     uns.GroupVersionKind().Version,
+			enforcement: enforcement,
+		})
+	}
+
+	return managed, unmanaged, policies, nil
+}
+
+func (r *Reconciler) ensurePolicy(ctx context.Context, fs embed.FS, path string) error {
+	managed, unmanaged, policies, err := r.renderPolicies(ctx, fs, path)
+	if err != nil {
+		return err
+	}
+
+	for _, uns := range unmanaged {
+		err := r.dh.EnsureDeletedGVR(ctx, uns.GroupVersionKind().GroupKind().String(), uns.GetNamespace(), uns.GetName(), uns.GroupVersionKind().Version)
+		if err != nil && !kerrors.IsNotFound(err) && !strings.Contains(strings.ToLower(err.Error()), "notfound") {
+			return err
+		}
+	}
+
+	creates := make([]kruntime.Object, 0, len(managed))
+	for _, uns := range managed {
 		creates = append(creates, uns)
 	}
 	err = r.dh.Ensure(ctx, creates...)
 	if err != nil {
 		return err
 	}
-	return nil
+
+	return r.updatePolicyStatus(ctx, policies)
+}
+
+// auditPolicy refreshes the GuardRailsStatus condition from the latest
+// gatekeeper audit without re-applying the policies
+func (r *Reconciler) auditPolicy(ctx context.Context, fs embed.FS, path string) error {
+	_, _, policies, err := r.renderPolicies(ctx, fs, path)
+	if err != nil {
+		return err
+	}
+
+	return r.updatePolicyStatus(ctx, policies)
 }
 
 func (r *Reconciler) removePolicy(ctx context.Context, fs embed.FS, path string) error {
@@ -126,6 +185,11 @@ func (r *Reconciler) policyTicker(ctx context.Context, instance *arov1alpha1.Clu
 
 	ticker := time.NewTicker(time.Duration(r.reconciliationMinutes) * time.Minute)
 	defer ticker.Stop()
+
+	// gatekeeper audits far more often than the policies are reconciled, so
+	// poll the audit results in between to keep the condition current
+	auditTicker := time.NewTicker(auditPollInterval)
+	defer auditTicker.Stop()
 	for {
 		select {
 		case done := <-r.policyTickerDone:
@@ -141,6 +205,11 @@ func (r *Reconciler) policyTicker(ctx context.Context, instance *arov1alpha1.Clu
 			if err != nil {
 				r.log.Errorf("policyTicker ensurePolicy error %s", err.Error())
 			}
+		case <-auditTicker.C:
+			err = r.auditPolicy(ctx, gkPolicyConstraints, gkConstraintsPath)
+			if err != nil {
+				r.log.Errorf("policyTicker auditPolicy error %s", err.Error())
+			}
 		}
 	}
 }
@@ -164,8 +233,10 @@ func (r *Reconciler) startTicker(ctx context.Context, instance *arov1alpha1.Clus
 }
 
 func (r *Reconciler) stopTicker() {
-	if r.policyTickerDone != nil {
-		r.policyTickerDone <- true
-		close(r.policyTickerDone)
+	// the ticker clears policyTickerDone once stopped, so keep our own copy
+	// to close
+	if done := r.policyTickerDone; done != nil {
+		done <- true
+		close(done)
 	}
 }
@@ -0,0 +1,241 @@
+package guardrails
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	operatorv1 "github.com/openshift/api/operator/v1"
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	kruntime "k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/util/dynamichelper"
+	mock_dynamichelper "github.com/Azure/ARO-RP/pkg/util/mocks/dynamichelper"
+	utilconditions "github.com/Azure/ARO-RP/test/util/conditions"
+	utilerror "github.com/Azure/ARO-RP/test/util/error"
+)
+
+func TestEnsurePolicy(t *testing.T) {
+	for _, tt := range []struct {
+		name            string
+		flags           arov1alpha1.OperatorFlags
+		policies        []client.Object
+		mocks           func(*mock_dynamichelper.MockInterface)
+		wantEnforcement map[string]string
+		wantCondition   operatorv1.OperatorCondition
+		wantErr         string
+	}{
+		{
+			name: "no managed policies",
+			mocks: func(dh *mock_dynamichelper.MockInterface) {
+				dh.EXPECT().EnsureDeletedGVR(gomock.Any(), gomock.Any(), "", gomock.Any(), "v1beta1").Times(5).Return(nil)
+			},
+			wantEnforcement: map[string]string{},
+			wantCondition: operatorv1.OperatorCondition{
+				Type:    arov1alpha1.GuardRailsStatus,
+				Status:  operatorv1.ConditionTrue,
+				Reason:  "AuditDone",
+				Message: "No policies are managed",
+			},
+		},
+		{
+			name: "audit violations are summarised",
+			flags: arov1alpha1.OperatorFlags{
+				"aro.guardrails.policies.aro-machines-deny.managed":        "true",
+				"aro.guardrails.policies.aro-machines-deny.enforcement":    "Deny",
+				"aro.guardrails.policies.aro-pull-secret-deny.managed":     "true",
+				"aro.guardrails.policies.aro-pull-secret-deny.enforcement": "enforce",
+			},
+			mocks: func(dh *mock_dynamichelper.MockInterface) {
+				dh.EXPECT().EnsureDeletedGVR(gomock.Any(), gomock.Any(), "", gomock.Any(), "v1beta1").Times(3).Return(nil)
+				dh.EXPECT().GetConstraintViolations(gomock.Any(), "ARODenyLabels.constraints.gatekeeper.sh", "aro-machines-deny", "v1beta1").Return(int64(0), nil)
+				dh.EXPECT().GetConstraintViolations(gomock.Any(), "ARODenyDeletePullSecret.constraints.gatekeeper.sh", "aro-pull-secret-deny", "v1beta1").Return(int64(2), nil)
+			},
+			wantEnforcement: map[string]string{
+				"aro-machines-deny":    "deny",
+				"aro-pull-secret-deny": "dryrun",
+			},
+			wantCondition: operatorv1.OperatorCondition{
+				Type:    arov1alpha1.GuardRailsStatus,
+				Status:  operatorv1.ConditionFalse,
+				Reason:  "AuditDone",
+				Message: "2 violations: aro-machines-deny (deny): 0 violations, aro-pull-secret-deny (dryrun): 2 violations",
+			},
+		},
+		{
+			name: "GuardrailsPolicy takes precedence over the enforcement flag",
+			flags: arov1alpha1.OperatorFlags{
+				"aro.guardrails.policies.aro-machines-deny.managed":        "true",
+				"aro.guardrails.policies.aro-machines-deny.enforcement":    "deny",
+				"aro.guardrails.policies.aro-pull-secret-deny.managed":     "true",
+				"aro.guardrails.policies.aro-pull-secret-deny.enforcement": "warn",
+			},
+			policies: []client.Object{
+				&arov1alpha1.GuardrailsPolicy{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "aro-machines-deny",
+					},
+					Spec: arov1alpha1.GuardrailsPolicySpec{
+						Enforcement: "dryrun",
+					},
+				},
+			},
+			mocks: func(dh *mock_dynamichelper.MockInterface) {
+				dh.EXPECT().EnsureDeletedGVR(gomock.Any(), gomock.Any(), "", gomock.Any(), "v1beta1").Times(3).Return(nil)
+				dh.EXPECT().GetConstraintViolations(gomock.Any(), gomock.Any(), "aro-machines-deny", "v1beta1").Return(int64(1), nil)
+				dh.EXPECT().GetConstraintViolations(gomock.Any(), gomock.Any(), "aro-pull-secret-deny", "v1beta1").Return(int64(0), nil)
+			},
+			wantEnforcement: map[string]string{
+				"aro-machines-deny":    "dryrun",
+				"aro-pull-secret-deny": "warn",
+			},
+			wantCondition: operatorv1.OperatorCondition{
+				Type:    arov1alpha1.GuardRailsStatus,
+				Status:  operatorv1.ConditionFalse,
+				Reason:  "AuditDone",
+				Message: "1 violations: aro-machines-deny (dryrun): 1 violations, aro-pull-secret-deny (warn): 0 violations",
+			},
+		},
+		{
+			name: "violations which cannot be read are reported",
+			flags: arov1alpha1.OperatorFlags{
+				"aro.guardrails.policies.aro-machines-deny.managed":     "true",
+				"aro.guardrails.policies.aro-machines-deny.enforcement": "warn",
+			},
+			mocks: func(dh *mock_dynamichelper.MockInterface) {
+				dh.EXPECT().EnsureDeletedGVR(gomock.Any(), gomock.Any(), "", gomock.Any(), "v1beta1").Times(4).Return(nil)
+				dh.EXPECT().GetConstraintViolations(gomock.Any(), gomock.Any(), "aro-machines-deny", "v1beta1").Return(int64(0), errors.New("not found"))
+			},
+			wantEnforcement: map[string]string{
+				"aro-machines-deny": "warn",
+			},
+			wantCondition: operatorv1.OperatorCondition{
+				Type:    arov1alpha1.GuardRailsStatus,
+				Status:  operatorv1.ConditionTrue,
+				Reason:  "AuditIncomplete",
+				Message: "0 violations: aro-machines-deny (warn): unknown",
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			cluster := &arov1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: arov1alpha1.SingletonClusterName,
+				},
+				Spec: arov1alpha1.ClusterSpec{
+					OperatorFlags: tt.flags,
+				},
+			}
+
+			dh := mock_dynamichelper.NewMockInterface(controller)
+			tt.mocks(dh)
+
+			gotEnforcement := map[string]string{}
+			dh.EXPECT().Ensure(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, objs ...kruntime.Object) error {
+				for _, obj := range objs {
+					uns := obj.(*unstructured.Unstructured)
+					enforcement, err := dynamichelper.GetEnforcementAction(uns)
+					if err != nil {
+						return err
+					}
+					gotEnforcement[uns.GetName()] = enforcement
+				}
+				return nil
+			})
+
+			client := ctrlfake.NewClientBuilder().WithObjects(cluster).WithObjects(tt.policies...).Build()
+
+			r := &Reconciler{
+				log:    logrus.NewEntry(logrus.StandardLogger()),
+				client: client,
+				dh:     dh,
+			}
+
+			err := r.ensurePolicy(ctx, gkPolicyConstraints, gkConstraintsPath)
+			utilerror.AssertErrorMessage(t, err, tt.wantErr)
+
+			for name, want := range tt.wantEnforcement {
+				if gotEnforcement[name] != want {
+					t.Errorf("%s: got enforcement %q, wanted %q", name, gotEnforcement[name], want)
+				}
+			}
+			if len(gotEnforcement) != len(tt.wantEnforcement) {
+				t.Errorf("got %d managed policies", len(gotEnforcement))
+			}
+
+			tt.wantCondition.LastTransitionTime = metav1.Now()
+			utilconditions.AssertControllerConditions(t, ctx, client, []operatorv1.OperatorCondition{tt.wantCondition})
+		})
+	}
+}
+
+func TestAuditPolicy(t *testing.T) {
+	ctx := context.Background()
+
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	cluster := &arov1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: arov1alpha1.SingletonClusterName,
+		},
+		Spec: arov1alpha1.ClusterSpec{
+			OperatorFlags: arov1alpha1.OperatorFlags{
+				"aro.guardrails.policies.aro-machines-deny.managed":     "true",
+				"aro.guardrails.policies.aro-machines-deny.enforcement": "dryrun",
+			},
+		},
+		Status: arov1alpha1.ClusterStatus{
+			Conditions: []operatorv1.OperatorCondition{
+				{
+					Type:    arov1alpha1.GuardRailsStatus,
+					Status:  operatorv1.ConditionTrue,
+					Reason:  "AuditDone",
+					Message: "0 violations: aro-machines-deny (dryrun): 0 violations",
+				},
+			},
+		},
+	}
+
+	// the audit only reads the violations, and neither creates nor deletes
+	// any Constraint
+	dh := mock_dynamichelper.NewMockInterface(controller)
+	dh.EXPECT().GetConstraintViolations(gomock.Any(), gomock.Any(), "aro-machines-deny", "v1beta1").Return(int64(3), nil)
+
+	client := ctrlfake.NewClientBuilder().WithObjects(cluster).Build()
+
+	r := &Reconciler{
+		log:    logrus.NewEntry(logrus.StandardLogger()),
+		client: client,
+		dh:     dh,
+	}
+
+	err := r.auditPolicy(ctx, gkPolicyConstraints, gkConstraintsPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	utilconditions.AssertControllerConditions(t, ctx, client, []operatorv1.OperatorCondition{
+		{
+			Type:               arov1alpha1.GuardRailsStatus,
+			Status:             operatorv1.ConditionFalse,
+			Reason:             "AuditDone",
+			Message:            "3 violations: aro-machines-deny (dryrun): 3 violations",
+			LastTransitionTime: metav1.Now(),
+		},
+	})
+}
@@ -0,0 +1,94 @@
+package guardrails
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/util/conditions"
+)
+
+// policyStatus is the audit result of a managed gatekeeper Constraint
+type policyStatus struct {
+	name        string
+	groupKind   string
+	version     string
+	enforcement string
+	violations  int64
+	err         error
+}
+
+func (p *policyStatus) String() string {
+	if p.err != nil {
+		return fmt.Sprintf("%s (%s): unknown", p.name, p.enforcement)
+	}
+	return fmt.Sprintf("%s (%s): %d violations", p.name, p.enforcement, p.violations)
+}
+
+// updatePolicyStatus collects the gatekeeper audit violations of the managed
+// policies and summarises them in the GuardRailsStatus condition.  This lets a
+// new policy run in dryrun or warn mode and have its impact assessed before it
+// is switched to deny.
+func (r *Reconciler) updatePolicyStatus(ctx context.Context, policies []*policyStatus) error {
+	for _, p := range policies {
+		p.violations, p.err = r.dh.GetConstraintViolations(ctx, p.groupKind, p.name, p.version)
+		if p.err != nil {
+			r.log.Warnf("failed to get violations of policy %s: %s", p.name, p.err)
+		}
+	}
+
+	return conditions.SetCondition(ctx, r.client, policyCondition(policies), operator.RoleMaster)
+}
+
+// clearPolicyStatus marks the GuardRailsStatus condition unknown once the
+// policies are no longer audited, rather than leaving a stale summary behind
+func (r *Reconciler) clearPolicyStatus(ctx context.Context) error {
+	cond := &operatorv1.OperatorCondition{
+		Type:   arov1alpha1.GuardRailsStatus,
+		Status: operatorv1.ConditionUnknown,
+	}
+
+	return conditions.SetCondition(ctx, r.client, cond, operator.RoleMaster)
+}
+
+func policyCondition(policies []*policyStatus) *operatorv1.OperatorCondition {
+	cond := &operatorv1.OperatorCondition{
+		Type:               arov1alpha1.GuardRailsStatus,
+		Status:             operatorv1.ConditionTrue,
+		Reason:             "AuditDone",
+		Message:            "No policies are managed",
+		LastTransitionTime: metav1.Now(),
+	}
+
+	if len(policies) == 0 {
+		return cond
+	}
+
+	sort.Slice(policies, func(i, j int) bool { return policies[i].name < policies[j].name })
+
+	var total int64
+	summaries := make([]string, 0, len(policies))
+	for _, p := range policies {
+		if p.err != nil {
+			cond.Reason = "AuditIncomplete"
+		}
+		total += p.violations
+		summaries = append(summaries, p.String())
+	}
+
+	if total > 0 {
+		cond.Status = operatorv1.ConditionFalse
+	}
+	cond.Message = fmt.Sprintf("%d violations: %s", total, strings.Join(summaries, ", "))
+
+	return cond
+}
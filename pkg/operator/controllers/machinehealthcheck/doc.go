@@ -23,6 +23,12 @@ aro.machinehealthcheck.managed
   occurs 2 or more times within an hour.
 
 The aro-machinehealth check is configured in a way that if 2 worker nodes go not ready it will not take any action.
+
+Clusters whose machine pools need different remediation can instead set spec.machineHealthChecks on the ARO
+Cluster resource. The controller then renders one aro-machinehealthcheck-<name> MHC per policy in place of the
+default one, each with its own selector, unhealthy and node startup timeouts, maxUnhealthy and recurring pause
+windows during which remediation is paused. The RP does not overwrite spec.machineHealthChecks. The observed
+health and number of machines being remediated for each MHC are reported in status.machineHealthChecks.
 More information about how the MHC works can be found here:
 https://docs.openshift.com/container-platform/4.12/machine_management/deploying-machine-health-checks.html
 
@@ -6,6 +6,9 @@ package machinehealthcheck
 import (
 	"context"
 	_ "embed"
+	"fmt"
+	"reflect"
+	"sort"
 	"strings"
 	"time"
 
@@ -27,6 +30,7 @@ import (
 	"github.com/Azure/ARO-RP/pkg/operator"
 	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
 	"github.com/Azure/ARO-RP/pkg/operator/controllers/base"
+	"github.com/Azure/ARO-RP/pkg/operator/diagnostics"
 	"github.com/Azure/ARO-RP/pkg/util/dynamichelper"
 )
 
@@ -39,12 +43,16 @@ var mhcremediationalertYaml []byte
 const (
 	ControllerName      string = "MachineHealthCheck"
 	MHCPausedAnnotation string = "cluster.x-k8s.io/paused"
+
+	machineHealthCheckName      = "aro-machinehealthcheck"
+	machineHealthCheckNamespace = "openshift-machine-api"
 )
 
 type Reconciler struct {
 	base.AROController
-
 	dh dynamichelper.Interface
+
+	now func() time.Time
 }
 
 func NewReconciler(log *logrus.Entry, client client.Client, dh dynamichelper.Interface) *Reconciler {
@@ -55,6 +63,8 @@ func NewReconciler(log *logrus.Entry, client client.Client, dh dynamichelper.Int
 			Name:   ControllerName,
 		},
 		dh: dh,
+
+		now: time.Now,
 	}
 }
 
@@ -62,7 +72,6 @@ func NewReconciler(log *logrus.Entry, client client.Client, dh dynamichelper.Int
 // reconciles the associated ARO MachineHealthCheck object
 func (r *Reconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
 	instance, err := r.GetCluster(ctx)
-
 	if err != nil {
 		return reconcile.Result{}, err
 	}
@@ -74,7 +83,15 @@ func (r *Reconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.
 
 	r.Log.Debug("running")
 	if !instance.Spec.OperatorFlags.GetSimpleBoolean(operator.MachineHealthCheckManaged) {
-		err := r.dh.EnsureDeleted(ctx, "MachineHealthCheck", "openshift-machine-api", "aro-machinehealthcheck")
+		err := r.dh.EnsureDeleted(ctx, "MachineHealthCheck", machineHealthCheckNamespace, machineHealthCheckName)
+		if err != nil {
+			r.Log.Error(err)
+			r.SetDegraded(ctx, err)
+
+			return reconcile.Result{RequeueAfter: time.Hour}, err
+		}
+
+		err = r.dh.EnsureDeleted(ctx, "PrometheusRule", machineHealthCheckNamespace, "mhc-remediation-alert")
 		if err != nil {
 			r.Log.Error(err)
 			r.SetDegraded(ctx, err)
@@ -82,7 +99,10 @@ func (r *Reconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.
 			return reconcile.Result{RequeueAfter: time.Hour}, err
 		}
 
-		err = r.dh.EnsureDeleted(ctx, "PrometheusRule", "openshift-machine-api", "mhc-remediation-alert")
+		stale, err := r.reconcileStatus(ctx, instance, nil)
+		if err == nil {
+			err = r.removeMachineHealthChecks(ctx, stale)
+		}
 		if err != nil {
 			r.Log.Error(err)
 			r.SetDegraded(ctx, err)
@@ -94,35 +114,35 @@ func (r *Reconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.
 		return reconcile.Result{}, nil
 	}
 
-	var resources []kruntime.Object
+	isUpgrading, err := r.isClusterUpgrading(ctx)
+	if err != nil {
+		r.Log.Error(err)
+		r.SetDegraded(ctx, err)
 
-	for _, asset := range [][]byte{machinehealthcheckYaml, mhcremediationalertYaml} {
-		resource, _, err := scheme.Codecs.UniversalDeserializer().Decode(asset, nil, nil)
-		if err != nil {
-			r.Log.Error(err)
-			r.SetDegraded(ctx, err)
+		return reconcile.Result{}, err
+	}
 
-			return reconcile.Result{}, err
-		}
+	mhcs, requeueAfter, err := r.machineHealthChecks(instance, isUpgrading)
+	if err != nil {
+		r.Log.Error(err)
+		r.SetDegraded(ctx, err)
 
-		if mhc, ok := resource.(*machinev1beta1.MachineHealthCheck); ok {
-			isUpgrading, err := r.isClusterUpgrading(ctx)
-			if err != nil {
-				r.Log.Error(err)
-				r.SetDegraded(ctx, err)
+		return reconcile.Result{}, err
+	}
 
-				return reconcile.Result{}, err
-			}
+	alert, _, err := scheme.Codecs.UniversalDeserializer().Decode(mhcremediationalertYaml, nil, nil)
+	if err != nil {
+		r.Log.Error(err)
+		r.SetDegraded(ctx, err)
 
-			if isUpgrading {
-				mhc.ObjectMeta.Annotations = map[string]string{
-					MHCPausedAnnotation: "",
-				}
-			}
-		}
+		return reconcile.Result{}, err
+	}
 
-		resources = append(resources, resource)
+	var resources []kruntime.Object
+	for _, mhc := range mhcs {
+		resources = append(resources, mhc)
 	}
+	resources = append(resources, alert)
 
 	// helps with garbage collection of the resources we are dealing with
 	err = dynamichelper.SetControllerReferences(resources, instance)
@@ -145,8 +165,136 @@ func (r *Reconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.
 		return reconcile.Result{}, err
 	}
 
+	// remove the default MHC or the MHCs of policies which no longer exist
+	stale, err := r.reconcileStatus(ctx, instance, mhcs)
+	if err == nil {
+		err = r.removeMachineHealthChecks(ctx, stale)
+	}
+	if err != nil {
+		r.Log.Error(err)
+		r.SetDegraded(ctx, err)
+
+		return reconcile.Result{}, err
+	}
+
 	r.ClearConditions(ctx)
-	return reconcile.Result{}, nil
+	return reconcile.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// machineHealthChecks renders the desired MachineHealthChecks: one per policy,
+// or the default MachineHealthCheck if there are no policies.  MachineHealthChecks
+// are paused while the cluster is upgrading or during a policy's pause window.
+// It also returns the time until the next pause window starts or ends.
+func (r *Reconciler) machineHealthChecks(instance *arov1alpha1.Cluster, isUpgrading bool) ([]*machinev1beta1.MachineHealthCheck, time.Duration, error) {
+	now := r.now()
+
+	if len(instance.Spec.MachineHealthChecks) == 0 {
+		resource, _, err := scheme.Codecs.UniversalDeserializer().Decode(machinehealthcheckYaml, nil, nil)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		mhc := resource.(*machinev1beta1.MachineHealthCheck)
+		if isUpgrading {
+			mhc.ObjectMeta.Annotations = map[string]string{
+				MHCPausedAnnotation: "",
+			}
+		}
+
+		return []*machinev1beta1.MachineHealthCheck{mhc}, 0, nil
+	}
+
+	var mhcs []*machinev1beta1.MachineHealthCheck
+	var next time.Time
+	for i := range instance.Spec.MachineHealthChecks {
+		policy := &instance.Spec.MachineHealthChecks[i]
+
+		paused, n, err := pauseWindowState(policy.PauseWindows, now)
+		if err != nil {
+			return nil, 0, fmt.Errorf("machineHealthChecks %q: %w", policy.Name, err)
+		}
+		if !n.IsZero() && (next.IsZero() || n.Before(next)) {
+			next = n
+		}
+
+		mhc := policyMachineHealthCheck(policy)
+		if isUpgrading || paused {
+			mhc.ObjectMeta.Annotations = map[string]string{
+				MHCPausedAnnotation: "",
+			}
+		}
+
+		mhcs = append(mhcs, mhc)
+	}
+
+	var requeueAfter time.Duration
+	if !next.IsZero() {
+		requeueAfter = next.Sub(now)
+	}
+
+	return mhcs, requeueAfter, nil
+}
+
+// reconcileStatus records the status of the desired MachineHealthChecks on the
+// cluster and returns the names of ARO MachineHealthChecks which are not
+// desired
+func (r *Reconciler) reconcileStatus(ctx context.Context, instance *arov1alpha1.Cluster, desired []*machinev1beta1.MachineHealthCheck) ([]string, error) {
+	mhcList := &machinev1beta1.MachineHealthCheckList{}
+	err := r.Client.List(ctx, mhcList, client.InNamespace(machineHealthCheckNamespace))
+	if err != nil {
+		return nil, err
+	}
+
+	existing := map[string]*machinev1beta1.MachineHealthCheck{}
+	for i := range mhcList.Items {
+		existing[mhcList.Items[i].Name] = &mhcList.Items[i]
+	}
+
+	var statuses []arov1alpha1.MachineHealthCheckStatus
+	isDesired := map[string]bool{}
+	for _, mhc := range desired {
+		isDesired[mhc.Name] = true
+
+		status := arov1alpha1.MachineHealthCheckStatus{Name: mhc.Name}
+		if current, ok := existing[mhc.Name]; ok {
+			status = machineHealthCheckStatus(current)
+		}
+		_, status.Paused = mhc.Annotations[MHCPausedAnnotation]
+
+		statuses = append(statuses, status)
+	}
+
+	var stale []string
+	if !isDesired[machineHealthCheckName] && desired != nil {
+		stale = append(stale, machineHealthCheckName)
+	}
+	for name := range existing {
+		if strings.HasPrefix(name, machineHealthCheckName+"-") && !isDesired[name] {
+			stale = append(stale, name)
+		}
+	}
+	sort.Strings(stale)
+
+	if !reflect.DeepEqual(instance.Status.MachineHealthChecks, statuses) {
+		instance.Status.MachineHealthChecks = statuses
+		err = r.Client.Status().Update(ctx, instance)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return stale, nil
+}
+
+func (r *Reconciler) removeMachineHealthChecks(ctx context.Context, names []string) error {
+	for _, name := range names {
+		err := r.dh.EnsureDeleted(ctx, "MachineHealthCheck", machineHealthCheckNamespace, name)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 func (r *Reconciler) isClusterUpgrading(ctx context.Context) (bool, error) {
@@ -183,5 +331,5 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 			&handler.EnqueueRequestForObject{},
 			builder.WithPredicates(clusterVersionPredicate),
 		).
-		Complete(r)
+		Complete(diagnostics.Wrap(ControllerName, operator.MachineHealthCheckEnabled, r))
 }
@@ -6,9 +6,12 @@ package machinehealthcheck
 import (
 	"context"
 	"errors"
+	"fmt"
+	"reflect"
 	"testing"
 	"time"
 
+	"github.com/Azure/go-autorest/autorest/to"
 	"github.com/golang/mock/gomock"
 	configv1 "github.com/openshift/api/config/v1"
 	machinev1beta1 "github.com/openshift/api/machine/v1beta1"
@@ -17,6 +20,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kruntime "k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	ctrlfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/Azure/ARO-RP/pkg/operator"
@@ -57,12 +61,16 @@ func TestMachineHealthCheckReconciler(t *testing.T) {
 		},
 	}
 
+	now := time.Date(2023, 6, 5, 10, 0, 0, 0, time.UTC) // a Monday
+
 	type test struct {
 		name             string
 		instance         *arov1alpha1.Cluster
 		clusterversion   *configv1.ClusterVersion
+		mhcs             []client.Object
 		mocks            func(mdh *mock_dynamichelper.MockInterface)
 		wantConditions   []operatorv1.OperatorCondition
+		wantStatus       []arov1alpha1.MachineHealthCheckStatus
 		wantErr          string
 		wantRequeueAfter time.Duration
 	}
@@ -222,6 +230,124 @@ func TestMachineHealthCheckReconciler(t *testing.T) {
 			},
 			wantErr: "",
 		},
+		{
+			name: "Managed Feature Flag is true with policies: renders one MHC per policy and removes stale MHCs",
+			instance: &arov1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: arov1alpha1.SingletonClusterName,
+				},
+				Spec: arov1alpha1.ClusterSpec{
+					OperatorFlags: arov1alpha1.OperatorFlags{
+						operator.MachineHealthCheckEnabled: operator.FlagTrue,
+						operator.MachineHealthCheckManaged: operator.FlagTrue,
+					},
+					MachineHealthChecks: []arov1alpha1.MachineHealthCheckPolicy{
+						{
+							Name: "gpu",
+							PauseWindows: []arov1alpha1.MachineHealthCheckPauseWindow{
+								{
+									Days:     []arov1alpha1.Weekday{"Monday"},
+									Start:    "09:00",
+									Duration: metav1.Duration{Duration: 2 * time.Hour},
+								},
+							},
+						},
+						{
+							Name: "spot",
+						},
+					},
+				},
+			},
+			mhcs: []client.Object{
+				&machinev1beta1.MachineHealthCheck{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "aro-machinehealthcheck",
+						Namespace: "openshift-machine-api",
+					},
+				},
+				&machinev1beta1.MachineHealthCheck{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "aro-machinehealthcheck-gpu",
+						Namespace: "openshift-machine-api",
+					},
+					Status: machinev1beta1.MachineHealthCheckStatus{
+						ExpectedMachines:    to.IntPtr(3),
+						CurrentHealthy:      to.IntPtr(2),
+						RemediationsAllowed: 0,
+					},
+				},
+				&machinev1beta1.MachineHealthCheck{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "aro-machinehealthcheck-old",
+						Namespace: "openshift-machine-api",
+					},
+				},
+				&machinev1beta1.MachineHealthCheck{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "customer-machinehealthcheck",
+						Namespace: "openshift-machine-api",
+					},
+				},
+			},
+			mocks: func(mdh *mock_dynamichelper.MockInterface) {
+				mdh.EXPECT().Ensure(gomock.Any(), mhcNames("aro-machinehealthcheck-gpu", "aro-machinehealthcheck-spot")).Return(nil).Times(1)
+				mdh.EXPECT().EnsureDeleted(gomock.Any(), "MachineHealthCheck", "openshift-machine-api", "aro-machinehealthcheck").Times(1)
+				mdh.EXPECT().EnsureDeleted(gomock.Any(), "MachineHealthCheck", "openshift-machine-api", "aro-machinehealthcheck-old").Times(1)
+			},
+			wantConditions: defaultConditions,
+			wantStatus: []arov1alpha1.MachineHealthCheckStatus{
+				{
+					Name:             "aro-machinehealthcheck-gpu",
+					ExpectedMachines: 3,
+					CurrentHealthy:   2,
+					Remediating:      1,
+					Paused:           true,
+				},
+				{
+					Name: "aro-machinehealthcheck-spot",
+				},
+			},
+			wantRequeueAfter: time.Hour,
+		},
+		{
+			name: "Managed Feature Flag is true with an invalid pause window: an error is returned",
+			instance: &arov1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: arov1alpha1.SingletonClusterName,
+				},
+				Spec: arov1alpha1.ClusterSpec{
+					OperatorFlags: arov1alpha1.OperatorFlags{
+						operator.MachineHealthCheckEnabled: operator.FlagTrue,
+						operator.MachineHealthCheckManaged: operator.FlagTrue,
+					},
+					MachineHealthChecks: []arov1alpha1.MachineHealthCheckPolicy{
+						{
+							Name: "gpu",
+							PauseWindows: []arov1alpha1.MachineHealthCheckPauseWindow{
+								{
+									Start: "25:00",
+								},
+							},
+						},
+					},
+				},
+				Status: arov1alpha1.ClusterStatus{
+					Conditions: defaultConditions,
+				},
+			},
+			mocks:   func(mdh *mock_dynamichelper.MockInterface) {},
+			wantErr: `machineHealthChecks "gpu": invalid pause window 0 start "25:00"`,
+			wantConditions: []operatorv1.OperatorCondition{
+				defaultAvailable,
+				defaultProgressing,
+				{
+					Type:               ControllerName + "Controller" + operatorv1.OperatorStatusTypeDegraded,
+					Status:             operatorv1.ConditionTrue,
+					LastTransitionTime: transitionTime,
+					Message:            `machineHealthChecks "gpu": invalid pause window 0 start "25:00"`,
+				},
+			},
+		},
 		{
 			name: "When ensuring resources fails, an error is returned",
 			instance: &arov1alpha1.Cluster{
@@ -271,6 +397,7 @@ func TestMachineHealthCheckReconciler(t *testing.T) {
 			} else {
 				clientBuilder = clientBuilder.WithObjects(tt.clusterversion)
 			}
+			clientBuilder = clientBuilder.WithObjects(tt.mhcs...)
 
 			ctx := context.Background()
 
@@ -279,6 +406,7 @@ func TestMachineHealthCheckReconciler(t *testing.T) {
 				clientBuilder.Build(),
 				mdh,
 			)
+			r.now = func() time.Time { return now }
 
 			request := ctrl.Request{}
 			request.Name = "cluster"
@@ -291,6 +419,26 @@ func TestMachineHealthCheckReconciler(t *testing.T) {
 
 			if tt.instance != nil {
 				utilconditions.AssertControllerConditions(t, ctx, r.AROController.Client, tt.wantConditions)
+
+				if tt.wantErr == "" {
+					cluster, err := r.GetCluster(ctx)
+					if err != nil {
+						t.Fatal(err)
+					}
+					wantStatus := tt.wantStatus
+					if wantStatus == nil && tt.instance.Spec.OperatorFlags.GetSimpleBoolean(operator.MachineHealthCheckEnabled) &&
+						tt.instance.Spec.OperatorFlags.GetSimpleBoolean(operator.MachineHealthCheckManaged) {
+						wantStatus = []arov1alpha1.MachineHealthCheckStatus{
+							{
+								Name:   "aro-machinehealthcheck",
+								Paused: tt.clusterversion != nil,
+							},
+						}
+					}
+					if !reflect.DeepEqual(cluster.Status.MachineHealthChecks, wantStatus) {
+						t.Errorf("got status %#v", cluster.Status.MachineHealthChecks)
+					}
+				}
 			}
 
 			utilerror.AssertErrorMessage(t, err, tt.wantErr)
@@ -328,3 +476,29 @@ func (m mhcIsPausedMatcher) String() string {
 func mhcIsPaused(paused bool) gomock.Matcher {
 	return mhcIsPausedMatcher{paused: paused}
 }
+
+type mhcNamesMatcher []string
+
+func (m mhcNamesMatcher) Matches(x interface{}) bool {
+	objs, ok := x.([]kruntime.Object)
+	if !ok {
+		return false
+	}
+
+	var names []string
+	for _, obj := range objs {
+		if mhc, ok := obj.(*machinev1beta1.MachineHealthCheck); ok {
+			names = append(names, mhc.Name)
+		}
+	}
+
+	return reflect.DeepEqual(names, []string(m))
+}
+
+func (m mhcNamesMatcher) String() string {
+	return fmt.Sprintf("has mhcs %v", []string(m))
+}
+
+func mhcNames(names ...string) gomock.Matcher {
+	return mhcNamesMatcher(names)
+}
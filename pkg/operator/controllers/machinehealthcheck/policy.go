@@ -0,0 +1,153 @@
+package machinehealthcheck
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"fmt"
+	"time"
+
+	machinev1beta1 "github.com/openshift/api/machine/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+)
+
+const (
+	defaultUnhealthyTimeout   = 15 * time.Minute
+	defaultNodeStartupTimeout = 25 * time.Minute
+)
+
+// policyMachineHealthCheck renders the MachineHealthCheck for a policy
+func policyMachineHealthCheck(policy *arov1alpha1.MachineHealthCheckPolicy) *machinev1beta1.MachineHealthCheck {
+	unhealthyTimeout := metav1.Duration{Duration: defaultUnhealthyTimeout}
+	if policy.UnhealthyTimeout != nil {
+		unhealthyTimeout = *policy.UnhealthyTimeout
+	}
+
+	nodeStartupTimeout := &metav1.Duration{Duration: defaultNodeStartupTimeout}
+	if policy.NodeStartupTimeout != nil {
+		nodeStartupTimeout = policy.NodeStartupTimeout.DeepCopy()
+	}
+
+	maxUnhealthy := intstr.FromString("1")
+	if policy.MaxUnhealthy != nil {
+		maxUnhealthy = *policy.MaxUnhealthy
+	}
+
+	return &machinev1beta1.MachineHealthCheck{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: machinev1beta1.GroupVersion.String(),
+			Kind:       "MachineHealthCheck",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      machineHealthCheckName + "-" + policy.Name,
+			Namespace: machineHealthCheckNamespace,
+		},
+		Spec: machinev1beta1.MachineHealthCheckSpec{
+			Selector: *policy.Selector.DeepCopy(),
+			UnhealthyConditions: []machinev1beta1.UnhealthyCondition{
+				{
+					Type:    corev1.NodeReady,
+					Status:  corev1.ConditionFalse,
+					Timeout: unhealthyTimeout,
+				},
+				{
+					Type:    corev1.NodeReady,
+					Status:  corev1.ConditionUnknown,
+					Timeout: unhealthyTimeout,
+				},
+			},
+			MaxUnhealthy:       &maxUnhealthy,
+			NodeStartupTimeout: nodeStartupTimeout,
+		},
+	}
+}
+
+// pauseWindowState returns whether now falls in one of the pause windows, and
+// the time of the next window start or end after now.  The returned time is
+// zero if there are no windows.
+func pauseWindowState(windows []arov1alpha1.MachineHealthCheckPauseWindow, now time.Time) (bool, time.Time, error) {
+	now = now.UTC()
+
+	var paused bool
+	var next time.Time
+	updateNext := func(t time.Time) {
+		if t.After(now) && (next.IsZero() || t.Before(next)) {
+			next = t
+		}
+	}
+
+	for i, w := range windows {
+		start, err := time.Parse("15:04", w.Start)
+		if err != nil {
+			return false, time.Time{}, fmt.Errorf("invalid pause window %d start %q", i, w.Start)
+		}
+		if w.Duration.Duration <= 0 {
+			return false, time.Time{}, fmt.Errorf("invalid pause window %d duration %q", i, w.Duration.Duration)
+		}
+
+		days := map[time.Weekday]bool{}
+		for _, d := range w.Days {
+			wd, ok := weekdays[d]
+			if !ok {
+				return false, time.Time{}, fmt.Errorf("invalid pause window %d day %q", i, d)
+			}
+			days[wd] = true
+		}
+
+		// consider every start from the earliest whose window could still be
+		// open up to a week ahead, which always includes the next start
+		lookback := int(w.Duration.Duration/(24*time.Hour)) + 1
+		for d := -lookback; d <= 7; d++ {
+			day := now.AddDate(0, 0, d)
+			s := time.Date(day.Year(), day.Month(), day.Day(), start.Hour(), start.Minute(), 0, 0, time.UTC)
+			if len(days) > 0 && !days[s.Weekday()] {
+				continue
+			}
+			e := s.Add(w.Duration.Duration)
+
+			if !now.Before(s) && now.Before(e) {
+				paused = true
+			}
+			updateNext(s)
+			updateNext(e)
+		}
+	}
+
+	return paused, next, nil
+}
+
+var weekdays = map[arov1alpha1.Weekday]time.Weekday{
+	"Sunday":    time.Sunday,
+	"Monday":    time.Monday,
+	"Tuesday":   time.Tuesday,
+	"Wednesday": time.Wednesday,
+	"Thursday":  time.Thursday,
+	"Friday":    time.Friday,
+	"Saturday":  time.Saturday,
+}
+
+// machineHealthCheckStatus summarises the status of a MachineHealthCheck
+func machineHealthCheckStatus(mhc *machinev1beta1.MachineHealthCheck) arov1alpha1.MachineHealthCheckStatus {
+	status := arov1alpha1.MachineHealthCheckStatus{
+		Name:                mhc.Name,
+		RemediationsAllowed: mhc.Status.RemediationsAllowed,
+	}
+
+	_, status.Paused = mhc.Annotations[MHCPausedAnnotation]
+
+	if mhc.Status.ExpectedMachines != nil {
+		status.ExpectedMachines = *mhc.Status.ExpectedMachines
+	}
+	if mhc.Status.CurrentHealthy != nil {
+		status.CurrentHealthy = *mhc.Status.CurrentHealthy
+	}
+	if status.ExpectedMachines > status.CurrentHealthy {
+		status.Remediating = status.ExpectedMachines - status.CurrentHealthy
+	}
+
+	return status
+}
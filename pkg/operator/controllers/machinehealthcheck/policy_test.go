@@ -0,0 +1,134 @@
+package machinehealthcheck
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-test/deep"
+	machinev1beta1 "github.com/openshift/api/machine/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	utilerror "github.com/Azure/ARO-RP/test/util/error"
+)
+
+func TestPauseWindowState(t *testing.T) {
+	now := time.Date(2023, 6, 5, 1, 0, 0, 0, time.UTC) // a Monday
+
+	for _, tt := range []struct {
+		name       string
+		windows    []arov1alpha1.MachineHealthCheckPauseWindow
+		wantPaused bool
+		wantNext   time.Time
+		wantErr    string
+	}{
+		{
+			name: "no windows",
+		},
+		{
+			name: "daily window later today",
+			windows: []arov1alpha1.MachineHealthCheckPauseWindow{
+				{Start: "22:00", Duration: metav1.Duration{Duration: time.Hour}},
+			},
+			wantNext: time.Date(2023, 6, 5, 22, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "window spanning midnight is open",
+			windows: []arov1alpha1.MachineHealthCheckPauseWindow{
+				{Days: []arov1alpha1.Weekday{"Sunday"}, Start: "23:00", Duration: metav1.Duration{Duration: 3 * time.Hour}},
+			},
+			wantPaused: true,
+			wantNext:   time.Date(2023, 6, 5, 2, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "weekly window next week",
+			windows: []arov1alpha1.MachineHealthCheckPauseWindow{
+				{Days: []arov1alpha1.Weekday{"Monday"}, Start: "00:00", Duration: metav1.Duration{Duration: time.Hour}},
+			},
+			wantNext: time.Date(2023, 6, 12, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "invalid day",
+			windows: []arov1alpha1.MachineHealthCheckPauseWindow{
+				{Days: []arov1alpha1.Weekday{"Funday"}, Start: "00:00", Duration: metav1.Duration{Duration: time.Hour}},
+			},
+			wantErr: `invalid pause window 0 day "Funday"`,
+		},
+		{
+			name: "invalid duration",
+			windows: []arov1alpha1.MachineHealthCheckPauseWindow{
+				{Start: "00:00"},
+			},
+			wantErr: `invalid pause window 0 duration "0s"`,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			paused, next, err := pauseWindowState(tt.windows, now)
+			utilerror.AssertErrorMessage(t, err, tt.wantErr)
+
+			if paused != tt.wantPaused {
+				t.Errorf("got paused %v", paused)
+			}
+			if !next.Equal(tt.wantNext) {
+				t.Errorf("got next %v", next)
+			}
+		})
+	}
+}
+
+func TestPolicyMachineHealthCheck(t *testing.T) {
+	maxUnhealthy := intstr.FromString("40%")
+
+	policy := &arov1alpha1.MachineHealthCheckPolicy{
+		Name: "gpu",
+		Selector: metav1.LabelSelector{
+			MatchLabels: map[string]string{
+				"machine.openshift.io/cluster-api-machineset": "cluster-gpu-eastus1",
+			},
+		},
+		UnhealthyTimeout: &metav1.Duration{Duration: time.Hour},
+		MaxUnhealthy:     &maxUnhealthy,
+	}
+
+	want := &machinev1beta1.MachineHealthCheck{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "machine.openshift.io/v1beta1",
+			Kind:       "MachineHealthCheck",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "aro-machinehealthcheck-gpu",
+			Namespace: "openshift-machine-api",
+		},
+		Spec: machinev1beta1.MachineHealthCheckSpec{
+			Selector: metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"machine.openshift.io/cluster-api-machineset": "cluster-gpu-eastus1",
+				},
+			},
+			UnhealthyConditions: []machinev1beta1.UnhealthyCondition{
+				{
+					Type:    corev1.NodeReady,
+					Status:  corev1.ConditionFalse,
+					Timeout: metav1.Duration{Duration: time.Hour},
+				},
+				{
+					Type:    corev1.NodeReady,
+					Status:  corev1.ConditionUnknown,
+					Timeout: metav1.Duration{Duration: time.Hour},
+				},
+			},
+			MaxUnhealthy:       &maxUnhealthy,
+			NodeStartupTimeout: &metav1.Duration{Duration: 25 * time.Minute},
+		},
+	}
+
+	got := policyMachineHealthCheck(policy)
+	for _, diff := range deep.Equal(got, want) {
+		t.Error(diff)
+	}
+}
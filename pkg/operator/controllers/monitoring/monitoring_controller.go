@@ -27,6 +27,7 @@ import (
 	"github.com/Azure/ARO-RP/pkg/operator"
 	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
 	"github.com/Azure/ARO-RP/pkg/operator/controllers/base"
+	"github.com/Azure/ARO-RP/pkg/operator/diagnostics"
 )
 
 const (
@@ -223,5 +224,5 @@ func (r *MonitoringReconciler) SetupWithManager(mgr ctrl.Manager) error {
 			builder.WithPredicates(monitoringConfigMapPredicate),
 		).
 		Named(ControllerName).
-		Complete(r)
+		Complete(diagnostics.Wrap(ControllerName, operator.MonitoringEnabled, r))
 }
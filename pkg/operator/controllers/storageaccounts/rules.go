@@ -0,0 +1,254 @@
+package storageaccounts
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"net"
+	"regexp"
+	"strings"
+
+	mgmtstorage "github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-06-01/storage"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/Azure/go-autorest/autorest/to"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+)
+
+// ruleChange is a change made to the network rules of a storage account
+type ruleChange struct {
+	action   arov1alpha1.StorageAccountRuleChangeAction
+	ruleType arov1alpha1.StorageAccountRuleType
+	rule     string
+	reason   string
+}
+
+// validateSpec returns spec without the subnets and IP rules which are not
+// valid, and the invalid entries
+func validateSpec(spec arov1alpha1.StorageAccountsSpec) (arov1alpha1.StorageAccountsSpec, []string) {
+	var invalid []string
+
+	valid := arov1alpha1.StorageAccountsSpec{
+		PrivateEndpointOnly: spec.PrivateEndpointOnly,
+	}
+
+	for _, subnet := range spec.ExtraAllowedSubnets {
+		r, err := azure.ParseResourceID(subnet)
+		if err != nil || !strings.EqualFold(r.Provider, "Microsoft.Network") || !strings.EqualFold(r.ResourceType, "virtualNetworks") || !strings.Contains(strings.ToLower(subnet), "/subnets/") {
+			invalid = append(invalid, subnet)
+			continue
+		}
+		valid.ExtraAllowedSubnets = append(valid.ExtraAllowedSubnets, subnet)
+	}
+
+	for _, rule := range spec.IPRules {
+		// storage accounts accept IPv4 addresses and CIDR ranges only
+		ip := net.ParseIP(rule)
+		if ip == nil {
+			ip, _, _ = net.ParseCIDR(rule)
+		}
+		if ip == nil || ip.To4() == nil {
+			invalid = append(invalid, rule)
+			continue
+		}
+		valid.IPRules = append(valid.IPRules, rule)
+	}
+
+	return valid, invalid
+}
+
+// rpManagedSubnet matches the subnets of the RP, its private endpoint, the
+// gateway and the Hive shards, which the RP allows on the cluster storage
+// accounts (see pkg/cluster/deploybaseresources_additional.go)
+var rpManagedSubnet = regexp.MustCompile(`(?i)/providers/Microsoft\.Network/virtualNetworks/(rp-pe-vnet-[0-9]+/subnets/rp-pe-subnet|rp-vnet/subnets/rp-subnet|gateway-vnet/subnets/gateway-subnet|aks-net/subnets/PodSubnet-[0-9]+)$`)
+
+func isRPManagedSubnet(subnet string) bool {
+	return rpManagedSubnet.MatchString(subnet)
+}
+
+// hasApprovedPrivateEndpoint returns true if the account has an approved
+// private endpoint connection
+func hasApprovedPrivateEndpoint(account *mgmtstorage.Account) bool {
+	if account.AccountProperties == nil || account.AccountProperties.PrivateEndpointConnections == nil {
+		return false
+	}
+
+	for _, pec := range *account.AccountProperties.PrivateEndpointConnections {
+		if pec.PrivateEndpointConnectionProperties != nil &&
+			pec.PrivateEndpointConnectionProperties.PrivateLinkServiceConnectionState != nil &&
+			pec.PrivateEndpointConnectionProperties.PrivateLinkServiceConnectionState.Status == mgmtstorage.Approved {
+			return true
+		}
+	}
+
+	return false
+}
+
+// reconcileNetworkRuleSet updates rules to allow the cluster subnets and the
+// exceptions declared in spec, or, in private endpoint only mode, to deny all
+// access other than through private endpoints and the subnets managed by the
+// RP.  Rules which were previously
+// added for spec but are no longer declared are removed; other rules present
+// on the account are left alone.  It returns the changes made and the rules
+// now present because of spec.
+func reconcileNetworkRuleSet(rules *mgmtstorage.NetworkRuleSet, clusterSubnets []string, spec arov1alpha1.StorageAccountsSpec, previousCustomerRules []string) ([]ruleChange, []string) {
+	var changes []ruleChange
+
+	if spec.PrivateEndpointOnly {
+		const reason = "spec.storageAccounts.privateEndpointOnly is set"
+
+		if rules.VirtualNetworkRules != nil && len(*rules.VirtualNetworkRules) > 0 {
+			kept := []mgmtstorage.VirtualNetworkRule{}
+			for _, rule := range *rules.VirtualNetworkRules {
+				if isRPManagedSubnet(to.String(rule.VirtualNetworkResourceID)) {
+					kept = append(kept, rule)
+					continue
+				}
+				changes = append(changes, ruleChange{arov1alpha1.StorageAccountRuleRemoved, arov1alpha1.StorageAccountRuleTypeVirtualNetwork, to.String(rule.VirtualNetworkResourceID), reason})
+			}
+			rules.VirtualNetworkRules = &kept
+		}
+
+		if rules.IPRules != nil && len(*rules.IPRules) > 0 {
+			for _, rule := range *rules.IPRules {
+				changes = append(changes, ruleChange{arov1alpha1.StorageAccountRuleRemoved, arov1alpha1.StorageAccountRuleTypeIP, to.String(rule.IPAddressOrRange), reason})
+			}
+			rules.IPRules = &[]mgmtstorage.IPRule{}
+		}
+
+		if rules.DefaultAction != mgmtstorage.DefaultActionDeny {
+			rules.DefaultAction = mgmtstorage.DefaultActionDeny
+			changes = append(changes, ruleChange{arov1alpha1.StorageAccountRuleSet, arov1alpha1.StorageAccountRuleTypeDefaultAction, string(mgmtstorage.DefaultActionDeny), reason})
+		}
+
+		return changes, nil
+	}
+
+	isDeclared := map[string]bool{}
+	for _, subnet := range spec.ExtraAllowedSubnets {
+		isDeclared[strings.ToLower(subnet)] = true
+	}
+	for _, subnet := range clusterSubnets {
+		isDeclared[strings.ToLower(subnet)] = true
+	}
+	for _, rule := range spec.IPRules {
+		isDeclared[rule] = true
+	}
+
+	for _, rule := range previousCustomerRules {
+		if isDeclared[strings.ToLower(rule)] || isDeclared[rule] {
+			continue
+		}
+
+		if strings.HasPrefix(rule, "/") {
+			if removeVirtualNetworkRule(rules, rule) {
+				changes = append(changes, ruleChange{arov1alpha1.StorageAccountRuleRemoved, arov1alpha1.StorageAccountRuleTypeVirtualNetwork, rule, "no longer declared in spec.storageAccounts.extraAllowedSubnets"})
+			}
+		} else if removeIPRule(rules, rule) {
+			changes = append(changes, ruleChange{arov1alpha1.StorageAccountRuleRemoved, arov1alpha1.StorageAccountRuleTypeIP, rule, "no longer declared in spec.storageAccounts.ipRules"})
+		}
+	}
+
+	for _, subnet := range clusterSubnets {
+		if addVirtualNetworkRule(rules, subnet) {
+			changes = append(changes, ruleChange{arov1alpha1.StorageAccountRuleAdded, arov1alpha1.StorageAccountRuleTypeVirtualNetwork, subnet, "cluster subnet"})
+		}
+	}
+
+	for _, subnet := range spec.ExtraAllowedSubnets {
+		if addVirtualNetworkRule(rules, subnet) {
+			changes = append(changes, ruleChange{arov1alpha1.StorageAccountRuleAdded, arov1alpha1.StorageAccountRuleTypeVirtualNetwork, subnet, "declared in spec.storageAccounts.extraAllowedSubnets"})
+		}
+	}
+
+	for _, rule := range spec.IPRules {
+		if addIPRule(rules, rule) {
+			changes = append(changes, ruleChange{arov1alpha1.StorageAccountRuleAdded, arov1alpha1.StorageAccountRuleTypeIP, rule, "declared in spec.storageAccounts.ipRules"})
+		}
+	}
+
+	var customerRules []string
+	customerRules = append(customerRules, spec.ExtraAllowedSubnets...)
+	customerRules = append(customerRules, spec.IPRules...)
+
+	return changes, customerRules
+}
+
+func addVirtualNetworkRule(rules *mgmtstorage.NetworkRuleSet, subnet string) bool {
+	if rules.VirtualNetworkRules == nil {
+		rules.VirtualNetworkRules = &[]mgmtstorage.VirtualNetworkRule{}
+	}
+
+	for _, rule := range *rules.VirtualNetworkRules {
+		if strings.EqualFold(to.String(rule.VirtualNetworkResourceID), subnet) {
+			return false
+		}
+	}
+
+	*rules.VirtualNetworkRules = append(*rules.VirtualNetworkRules, mgmtstorage.VirtualNetworkRule{
+		VirtualNetworkResourceID: to.StringPtr(subnet),
+		Action:                   mgmtstorage.Allow,
+	})
+	return true
+}
+
+func removeVirtualNetworkRule(rules *mgmtstorage.NetworkRuleSet, subnet string) bool {
+	if rules.VirtualNetworkRules == nil {
+		return false
+	}
+
+	for i, rule := range *rules.VirtualNetworkRules {
+		if strings.EqualFold(to.String(rule.VirtualNetworkResourceID), subnet) {
+			*rules.VirtualNetworkRules = append((*rules.VirtualNetworkRules)[:i], (*rules.VirtualNetworkRules)[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+func addIPRule(rules *mgmtstorage.NetworkRuleSet, ipAddressOrRange string) bool {
+	if rules.IPRules == nil {
+		rules.IPRules = &[]mgmtstorage.IPRule{}
+	}
+
+	for _, rule := range *rules.IPRules {
+		if to.String(rule.IPAddressOrRange) == ipAddressOrRange {
+			return false
+		}
+	}
+
+	*rules.IPRules = append(*rules.IPRules, mgmtstorage.IPRule{
+		IPAddressOrRange: to.StringPtr(ipAddressOrRange),
+		Action:           mgmtstorage.Allow,
+	})
+	return true
+}
+
+func removeIPRule(rules *mgmtstorage.NetworkRuleSet, ipAddressOrRange string) bool {
+	if rules.IPRules == nil {
+		return false
+	}
+
+	for i, rule := range *rules.IPRules {
+		if to.String(rule.IPAddressOrRange) == ipAddressOrRange {
+			*rules.IPRules = append((*rules.IPRules)[:i], (*rules.IPRules)[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// ruleSetRules returns the virtual network and IP rules of rules
+func ruleSetRules(rules *mgmtstorage.NetworkRuleSet) (virtualNetworkRules, ipRules []string) {
+	if rules.VirtualNetworkRules != nil {
+		for _, rule := range *rules.VirtualNetworkRules {
+			virtualNetworkRules = append(virtualNetworkRules, to.String(rule.VirtualNetworkResourceID))
+		}
+	}
+	if rules.IPRules != nil {
+		for _, rule := range *rules.IPRules {
+			ipRules = append(ipRules, to.String(rule.IPAddressOrRange))
+		}
+	}
+	return
+}
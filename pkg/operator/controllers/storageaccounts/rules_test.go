@@ -0,0 +1,159 @@
+package storageaccounts
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"reflect"
+	"testing"
+
+	mgmtstorage "github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-06-01/storage"
+	"github.com/Azure/go-autorest/autorest/to"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+)
+
+func TestValidateSpec(t *testing.T) {
+	spec := arov1alpha1.StorageAccountsSpec{
+		ExtraAllowedSubnets: []string{
+			resourceIdWorker,
+			"/subscriptions/" + subscriptionId + "/resourceGroups/" + vnetResourceGroup + "/providers/Microsoft.Network/virtualNetworks/" + vnetName,
+			"not-a-subnet",
+		},
+		IPRules: []string{"203.0.113.7", "203.0.113.0/24", "2001:db8::/32", "not-an-ip"},
+	}
+
+	valid, invalid := validateSpec(spec)
+
+	wantValid := arov1alpha1.StorageAccountsSpec{
+		ExtraAllowedSubnets: []string{resourceIdWorker},
+		IPRules:             []string{"203.0.113.7", "203.0.113.0/24"},
+	}
+	if !reflect.DeepEqual(valid, wantValid) {
+		t.Errorf("got valid %#v", valid)
+	}
+
+	wantInvalid := []string{spec.ExtraAllowedSubnets[1], "not-a-subnet", "2001:db8::/32", "not-an-ip"}
+	if !reflect.DeepEqual(invalid, wantInvalid) {
+		t.Errorf("got invalid %#v", invalid)
+	}
+}
+
+func TestReconcileNetworkRuleSet(t *testing.T) {
+	const (
+		extraSubnet  = "/subscriptions/" + "0000000-0000-0000-0000-000000000000" + "/resourceGroups/other/providers/Microsoft.Network/virtualNetworks/other/subnets/build"
+		manualSubnet = "/subscriptions/" + "0000000-0000-0000-0000-000000000000" + "/resourceGroups/other/providers/Microsoft.Network/virtualNetworks/other/subnets/manual"
+		rpSubnet     = "/subscriptions/" + "1111111-1111-1111-1111-111111111111" + "/resourceGroups/rp-eastus/providers/Microsoft.Network/virtualNetworks/rp-vnet/subnets/rp-subnet"
+		hiveSubnet   = "/subscriptions/" + "1111111-1111-1111-1111-111111111111" + "/resourceGroups/rp-eastus/providers/Microsoft.Network/virtualNetworks/aks-net/subnets/PodSubnet-002"
+	)
+
+	ruleSet := func(subnets []string, ips []string) *mgmtstorage.NetworkRuleSet {
+		rules := &mgmtstorage.NetworkRuleSet{
+			DefaultAction:       mgmtstorage.DefaultActionDeny,
+			VirtualNetworkRules: &[]mgmtstorage.VirtualNetworkRule{},
+		}
+		for _, subnet := range subnets {
+			*rules.VirtualNetworkRules = append(*rules.VirtualNetworkRules, mgmtstorage.VirtualNetworkRule{VirtualNetworkResourceID: to.StringPtr(subnet), Action: mgmtstorage.Allow})
+		}
+		if ips != nil {
+			rules.IPRules = &[]mgmtstorage.IPRule{}
+			for _, ip := range ips {
+				*rules.IPRules = append(*rules.IPRules, mgmtstorage.IPRule{IPAddressOrRange: to.StringPtr(ip), Action: mgmtstorage.Allow})
+			}
+		}
+		return rules
+	}
+
+	for _, tt := range []struct {
+		name              string
+		rules             *mgmtstorage.NetworkRuleSet
+		spec              arov1alpha1.StorageAccountsSpec
+		previous          []string
+		wantRules         *mgmtstorage.NetworkRuleSet
+		wantChanges       []ruleChange
+		wantCustomerRules []string
+	}{
+		{
+			name:      "nothing to do",
+			rules:     ruleSet([]string{resourceIdMaster, manualSubnet}, nil),
+			wantRules: ruleSet([]string{resourceIdMaster, manualSubnet}, nil),
+		},
+		{
+			name:  "declared exceptions are added",
+			rules: ruleSet([]string{resourceIdMaster}, nil),
+			spec: arov1alpha1.StorageAccountsSpec{
+				ExtraAllowedSubnets: []string{extraSubnet},
+				IPRules:             []string{"203.0.113.0/24"},
+			},
+			wantRules: ruleSet([]string{resourceIdMaster, extraSubnet}, []string{"203.0.113.0/24"}),
+			wantChanges: []ruleChange{
+				{arov1alpha1.StorageAccountRuleAdded, arov1alpha1.StorageAccountRuleTypeVirtualNetwork, extraSubnet, "declared in spec.storageAccounts.extraAllowedSubnets"},
+				{arov1alpha1.StorageAccountRuleAdded, arov1alpha1.StorageAccountRuleTypeIP, "203.0.113.0/24", "declared in spec.storageAccounts.ipRules"},
+			},
+			wantCustomerRules: []string{extraSubnet, "203.0.113.0/24"},
+		},
+		{
+			name:     "exceptions no longer declared are removed, manual rules are kept",
+			rules:    ruleSet([]string{resourceIdMaster, extraSubnet, manualSubnet}, []string{"203.0.113.0/24", "198.51.100.1"}),
+			previous: []string{extraSubnet, "203.0.113.0/24"},
+			spec: arov1alpha1.StorageAccountsSpec{
+				IPRules: []string{"203.0.113.0/24"},
+			},
+			wantRules: ruleSet([]string{resourceIdMaster, manualSubnet}, []string{"203.0.113.0/24", "198.51.100.1"}),
+			wantChanges: []ruleChange{
+				{arov1alpha1.StorageAccountRuleRemoved, arov1alpha1.StorageAccountRuleTypeVirtualNetwork, extraSubnet, "no longer declared in spec.storageAccounts.extraAllowedSubnets"},
+			},
+			wantCustomerRules: []string{"203.0.113.0/24"},
+		},
+		{
+			name:      "exceptions which became cluster subnets are kept",
+			rules:     ruleSet([]string{resourceIdMaster}, nil),
+			previous:  []string{resourceIdMaster},
+			wantRules: ruleSet([]string{resourceIdMaster}, nil),
+		},
+		{
+			name:  "private endpoint only removes all rules but the RP's",
+			rules: ruleSet([]string{rpSubnet, resourceIdMaster, hiveSubnet, manualSubnet}, []string{"198.51.100.1"}),
+			spec: arov1alpha1.StorageAccountsSpec{
+				ExtraAllowedSubnets: []string{extraSubnet},
+				PrivateEndpointOnly: true,
+			},
+			wantRules: ruleSet([]string{rpSubnet, hiveSubnet}, []string{}),
+			wantChanges: []ruleChange{
+				{arov1alpha1.StorageAccountRuleRemoved, arov1alpha1.StorageAccountRuleTypeVirtualNetwork, resourceIdMaster, "spec.storageAccounts.privateEndpointOnly is set"},
+				{arov1alpha1.StorageAccountRuleRemoved, arov1alpha1.StorageAccountRuleTypeVirtualNetwork, manualSubnet, "spec.storageAccounts.privateEndpointOnly is set"},
+				{arov1alpha1.StorageAccountRuleRemoved, arov1alpha1.StorageAccountRuleTypeIP, "198.51.100.1", "spec.storageAccounts.privateEndpointOnly is set"},
+			},
+		},
+		{
+			name: "private endpoint only denies by default",
+			rules: &mgmtstorage.NetworkRuleSet{
+				DefaultAction: mgmtstorage.DefaultActionAllow,
+			},
+			spec: arov1alpha1.StorageAccountsSpec{
+				PrivateEndpointOnly: true,
+			},
+			wantRules: &mgmtstorage.NetworkRuleSet{
+				DefaultAction: mgmtstorage.DefaultActionDeny,
+			},
+			wantChanges: []ruleChange{
+				{arov1alpha1.StorageAccountRuleSet, arov1alpha1.StorageAccountRuleTypeDefaultAction, "Deny", "spec.storageAccounts.privateEndpointOnly is set"},
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			changes, customerRules := reconcileNetworkRuleSet(tt.rules, []string{resourceIdMaster}, tt.spec, tt.previous)
+
+			if !reflect.DeepEqual(tt.rules, tt.wantRules) {
+				gotSubnets, gotIPs := ruleSetRules(tt.rules)
+				t.Errorf("got rules %v %v", gotSubnets, gotIPs)
+			}
+			if !reflect.DeepEqual(changes, tt.wantChanges) {
+				t.Errorf("got changes %#v", changes)
+			}
+			if !reflect.DeepEqual(customerRules, tt.wantCustomerRules) {
+				t.Errorf("got customer rules %#v", customerRules)
+			}
+		})
+	}
+}
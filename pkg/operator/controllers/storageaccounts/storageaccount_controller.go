@@ -10,6 +10,7 @@ import (
 	machinev1beta1 "github.com/openshift/api/machine/v1beta1"
 	"github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -20,6 +21,7 @@ import (
 
 	"github.com/Azure/ARO-RP/pkg/operator"
 	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/diagnostics"
 	"github.com/Azure/ARO-RP/pkg/util/azureclient"
 	"github.com/Azure/ARO-RP/pkg/util/azureclient/mgmt/storage"
 	"github.com/Azure/ARO-RP/pkg/util/clusterauthorizer"
@@ -34,7 +36,8 @@ const (
 type Reconciler struct {
 	log *logrus.Entry
 
-	client client.Client
+	client   client.Client
+	recorder record.EventRecorder
 }
 
 // reconcileManager is instance of manager instantiated per request
@@ -45,16 +48,18 @@ type reconcileManager struct {
 	subscriptionID string
 
 	client      client.Client
+	recorder    record.EventRecorder
 	kubeSubnets subnet.KubeManager
 	subnets     subnet.Manager
 	storage     storage.AccountsClient
 }
 
 // NewReconciler creates a new Reconciler
-func NewReconciler(log *logrus.Entry, client client.Client) *Reconciler {
+func NewReconciler(log *logrus.Entry, client client.Client, recorder record.EventRecorder) *Reconciler {
 	return &Reconciler{
-		log:    log,
-		client: client,
+		log:      log,
+		client:   client,
+		recorder: recorder,
 	}
 }
 
@@ -101,6 +106,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.
 		subscriptionID: resource.SubscriptionID,
 
 		client:      r.client,
+		recorder:    r.recorder,
 		kubeSubnets: subnet.NewKubeManager(r.client, resource.SubscriptionID),
 		subnets:     subnet.NewManager(&azEnv, resource.SubscriptionID, authorizer),
 		storage:     storage.NewAccountsClient(&azEnv, resource.SubscriptionID, authorizer),
@@ -124,5 +130,5 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Watches(&source.Kind{Type: &machinev1beta1.Machine{}}, &handler.EnqueueRequestForObject{}, builder.WithPredicates(masterMachinePredicate)). // to reconcile on master machine replacement
 		Watches(&source.Kind{Type: &machinev1beta1.MachineSet{}}, &handler.EnqueueRequestForObject{}).                                              // to reconcile on worker machinesets
 		Named(ControllerName).
-		Complete(r)
+		Complete(diagnostics.Wrap(ControllerName, operator.StorageAccountsEnabled, r))
 }
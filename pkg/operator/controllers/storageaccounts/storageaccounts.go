@@ -6,17 +6,25 @@ package storageaccounts
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"strings"
 
 	mgmtstorage "github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-06-01/storage"
-	"github.com/Azure/go-autorest/autorest/to"
 	imageregistryv1 "github.com/openshift/api/imageregistry/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
 
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
 	"github.com/Azure/ARO-RP/pkg/util/azureerrors"
 	"github.com/Azure/ARO-RP/pkg/util/stringutils"
 )
 
+// maxRecentChanges is the number of rule changes kept in the status of each
+// storage account
+const maxRecentChanges = 20
+
 func (r *reconcileManager) reconcileAccounts(ctx context.Context) error {
 	location := r.instance.Spec.Location
 	resourceGroup := stringutils.LastTokenByte(r.instance.Spec.ClusterResourceGroupID, '/')
@@ -77,38 +85,41 @@ func (r *reconcileManager) reconcileAccounts(ctx context.Context) error {
 		rc.Spec.Storage.Azure.AccountName,
 	}
 
-	for _, accountName := range storageAccounts {
-		var changed bool
+	spec, invalid := validateSpec(r.instance.Spec.StorageAccounts)
+	for _, rule := range invalid {
+		r.log.Warnf("ignoring invalid storage account rule %s", rule)
+		r.recorder.Eventf(r.instance, corev1.EventTypeWarning, "InvalidStorageAccountRule", "Ignoring invalid rule %s in spec.storageAccounts", rule)
+	}
 
+	previous := map[string]arov1alpha1.StorageAccountStatus{}
+	for _, status := range r.instance.Status.StorageAccounts {
+		previous[status.Name] = status
+	}
+
+	statuses := make([]arov1alpha1.StorageAccountStatus, 0, len(storageAccounts))
+	for _, accountName := range storageAccounts {
 		account, err := r.storage.GetProperties(ctx, resourceGroup, accountName, "")
 		if err != nil {
 			return err
 		}
 
-		for _, subnet := range serviceSubnets {
-			// if subnet ResourceID was found and we need to append
-			found := false
+		if account.AccountProperties.NetworkRuleSet == nil {
+			account.AccountProperties.NetworkRuleSet = &mgmtstorage.NetworkRuleSet{}
+		}
 
-			if account.AccountProperties.NetworkRuleSet != nil && account.AccountProperties.NetworkRuleSet.VirtualNetworkRules != nil {
-				for _, rule := range *account.AccountProperties.NetworkRuleSet.VirtualNetworkRules {
-					if strings.EqualFold(to.String(rule.VirtualNetworkResourceID), subnet) {
-						found = true
-						break
-					}
-				}
-			}
+		status := previous[accountName]
+		status.Name = accountName
 
-			// if rule was not found - we add it
-			if !found {
-				*account.AccountProperties.NetworkRuleSet.VirtualNetworkRules = append(*account.AccountProperties.NetworkRuleSet.VirtualNetworkRules, mgmtstorage.VirtualNetworkRule{
-					VirtualNetworkResourceID: to.StringPtr(subnet),
-					Action:                   mgmtstorage.Allow,
-				})
-				changed = true
-			}
+		accountSpec := spec
+		if accountSpec.PrivateEndpointOnly && !hasApprovedPrivateEndpoint(&account) {
+			r.log.Warnf("not restricting storage account %s to private endpoints: it has no approved private endpoint connection", accountName)
+			r.recorder.Eventf(r.instance, corev1.EventTypeWarning, "PrivateEndpointOnlyRefused", "Not restricting storage account %s to private endpoints: it has no approved private endpoint connection", accountName)
+			accountSpec.PrivateEndpointOnly = false
 		}
 
-		if changed {
+		changes, customerRules := reconcileNetworkRuleSet(account.AccountProperties.NetworkRuleSet, serviceSubnets, accountSpec, status.CustomerRules)
+
+		if len(changes) > 0 {
 			sa := mgmtstorage.AccountUpdateParameters{
 				AccountPropertiesUpdateParameters: &mgmtstorage.AccountPropertiesUpdateParameters{
 					NetworkRuleSet: account.AccountProperties.NetworkRuleSet,
@@ -119,8 +130,52 @@ func (r *reconcileManager) reconcileAccounts(ctx context.Context) error {
 			if err != nil {
 				return err
 			}
+
+			r.recordChanges(accountName, &status, changes)
 		}
+
+		status.PrivateEndpointOnly = accountSpec.PrivateEndpointOnly
+		status.CustomerRules = customerRules
+		status.VirtualNetworkRules, status.IPRules = ruleSetRules(account.AccountProperties.NetworkRuleSet)
+
+		statuses = append(statuses, status)
+	}
+
+	if reflect.DeepEqual(r.instance.Status.StorageAccounts, statuses) {
+		return nil
 	}
 
-	return nil
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		instance := &arov1alpha1.Cluster{}
+		err := r.client.Get(ctx, types.NamespacedName{Name: arov1alpha1.SingletonClusterName}, instance)
+		if err != nil {
+			return err
+		}
+
+		instance.Status.StorageAccounts = statuses
+		return r.client.Status().Update(ctx, instance)
+	})
+}
+
+// recordChanges records the rule changes made to a storage account as events
+// and in its status, keeping the latest maxRecentChanges changes
+func (r *reconcileManager) recordChanges(accountName string, status *arov1alpha1.StorageAccountStatus, changes []ruleChange) {
+	now := metav1.Now()
+
+	for _, c := range changes {
+		r.log.Infof("%s %s rule %s on storage account %s: %s", strings.ToLower(string(c.action)), c.ruleType, c.rule, accountName, c.reason)
+		r.recorder.Eventf(r.instance, corev1.EventTypeNormal, "StorageAccountRule"+string(c.action), "%s %s rule %s on storage account %s: %s", c.action, c.ruleType, c.rule, accountName, c.reason)
+
+		status.RecentChanges = append(status.RecentChanges, arov1alpha1.StorageAccountRuleChange{
+			Time:   now,
+			Action: c.action,
+			Type:   c.ruleType,
+			Rule:   c.rule,
+			Reason: c.reason,
+		})
+	}
+
+	if len(status.RecentChanges) > maxRecentChanges {
+		status.RecentChanges = status.RecentChanges[len(status.RecentChanges)-maxRecentChanges:]
+	}
 }
@@ -17,6 +17,7 @@ import (
 	imageregistryv1 "github.com/openshift/api/imageregistry/v1"
 	"github.com/sirupsen/logrus"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/Azure/ARO-RP/pkg/api"
@@ -47,10 +48,14 @@ var (
 
 	resourceIdMaster = "/subscriptions/" + subscriptionId + "/resourceGroups/" + vnetResourceGroup + "/providers/Microsoft.Network/virtualNetworks/" + vnetName + "/subnets/" + subnetNameMaster
 	resourceIdWorker = "/subscriptions/" + subscriptionId + "/resourceGroups/" + vnetResourceGroup + "/providers/Microsoft.Network/virtualNetworks/" + vnetName + "/subnets/" + subnetNameWorker
+	resourceIdRP     = "/subscriptions/" + subscriptionId + "/resourceGroups/rp-" + location + "/providers/Microsoft.Network/virtualNetworks/rp-vnet/subnets/rp-subnet"
 )
 
 func getValidClusterInstance(operatorFlag bool) *arov1alpha1.Cluster {
 	return &arov1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: arov1alpha1.SingletonClusterName,
+		},
 		Spec: arov1alpha1.ClusterSpec{
 			ClusterResourceGroupID: clusterResourceGroupId,
 			Location:               location,
@@ -80,6 +85,16 @@ func getValidAccount(virtualNetworkResourceIDs []string) *mgmtstorage.Account {
 	return account
 }
 
+func getPrivateEndpointConnection(status mgmtstorage.PrivateEndpointServiceConnectionStatus) mgmtstorage.PrivateEndpointConnection {
+	return mgmtstorage.PrivateEndpointConnection{
+		PrivateEndpointConnectionProperties: &mgmtstorage.PrivateEndpointConnectionProperties{
+			PrivateLinkServiceConnectionState: &mgmtstorage.PrivateLinkServiceConnectionState{
+				Status: status,
+			},
+		},
+	}
+}
+
 func getValidSubnet(resourceId string) *mgmtnetwork.Subnet {
 	s := &mgmtnetwork.Subnet{
 		ID: to.StringPtr(resourceId),
@@ -109,6 +124,7 @@ func TestReconcileManager(t *testing.T) {
 		instance     func(*arov1alpha1.Cluster)
 		operatorFlag bool
 		wantErr      error
+		wantEvents   []string
 	}{
 		{
 			name:         "Operator Flag enabled - nothing to do",
@@ -215,6 +231,90 @@ func TestReconcileManager(t *testing.T) {
 				storage.EXPECT().Update(gomock.Any(), clusterResourceGroupName, registryStorageAccountName, updated)
 			},
 		},
+		{
+			name:         "Operator Flag enabled - private endpoint only is refused without an approved private endpoint",
+			operatorFlag: true,
+			instance: func(instance *arov1alpha1.Cluster) {
+				instance.Spec.StorageAccounts.PrivateEndpointOnly = true
+			},
+			mocks: func(storage *mock_storage.MockAccountsClient, kubeSubnet *mock_subnet.MockKubeManager, mgmtSubnet *mock_subnet.MockManager) {
+				// Azure subnets
+				masterSubnet := getValidSubnet(resourceIdMaster)
+				workerSubnet := getValidSubnet(resourceIdWorker)
+
+				mgmtSubnet.EXPECT().Get(gomock.Any(), resourceIdMaster).Return(masterSubnet, nil)
+				mgmtSubnet.EXPECT().Get(gomock.Any(), resourceIdWorker).Return(workerSubnet, nil)
+
+				// cluster subnets
+				kubeSubnet.EXPECT().List(gomock.Any()).Return([]subnet.Subnet{
+					{
+						ResourceID: resourceIdMaster,
+						IsMaster:   true,
+					},
+					{
+						ResourceID: resourceIdWorker,
+						IsMaster:   false,
+					},
+				}, nil)
+
+				// storage objects in azure, with a pending private endpoint
+				// connection only
+				result := getValidAccount([]string{resourceIdMaster, resourceIdWorker})
+				result.PrivateEndpointConnections = &[]mgmtstorage.PrivateEndpointConnection{
+					getPrivateEndpointConnection(mgmtstorage.Pending),
+				}
+				storage.EXPECT().GetProperties(gomock.Any(), clusterResourceGroupName, clusterStorageAccountName, gomock.Any()).Return(*result, nil)
+				storage.EXPECT().GetProperties(gomock.Any(), clusterResourceGroupName, registryStorageAccountName, gomock.Any()).Return(*result, nil)
+			},
+			wantEvents: []string{
+				"Warning PrivateEndpointOnlyRefused Not restricting storage account " + clusterStorageAccountName + " to private endpoints: it has no approved private endpoint connection",
+				"Warning PrivateEndpointOnlyRefused Not restricting storage account " + registryStorageAccountName + " to private endpoints: it has no approved private endpoint connection",
+			},
+		},
+		{
+			name:         "Operator Flag enabled - private endpoint only with an approved private endpoint keeps the RP rules",
+			operatorFlag: true,
+			instance: func(instance *arov1alpha1.Cluster) {
+				instance.Spec.StorageAccounts.PrivateEndpointOnly = true
+			},
+			mocks: func(storage *mock_storage.MockAccountsClient, kubeSubnet *mock_subnet.MockKubeManager, mgmtSubnet *mock_subnet.MockManager) {
+				// Azure subnets
+				masterSubnet := getValidSubnet(resourceIdMaster)
+				workerSubnet := getValidSubnet(resourceIdWorker)
+
+				mgmtSubnet.EXPECT().Get(gomock.Any(), resourceIdMaster).Return(masterSubnet, nil)
+				mgmtSubnet.EXPECT().Get(gomock.Any(), resourceIdWorker).Return(workerSubnet, nil)
+
+				// cluster subnets
+				kubeSubnet.EXPECT().List(gomock.Any()).Return([]subnet.Subnet{
+					{
+						ResourceID: resourceIdMaster,
+						IsMaster:   true,
+					},
+					{
+						ResourceID: resourceIdWorker,
+						IsMaster:   false,
+					},
+				}, nil)
+
+				for _, accountName := range []string{clusterStorageAccountName, registryStorageAccountName} {
+					// storage objects in azure
+					result := getValidAccount([]string{resourceIdRP, resourceIdMaster, resourceIdWorker})
+					result.PrivateEndpointConnections = &[]mgmtstorage.PrivateEndpointConnection{
+						getPrivateEndpointConnection(mgmtstorage.Approved),
+					}
+					updated := mgmtstorage.AccountUpdateParameters{
+						AccountPropertiesUpdateParameters: &mgmtstorage.AccountPropertiesUpdateParameters{
+							NetworkRuleSet: getValidAccount([]string{resourceIdRP}).NetworkRuleSet,
+						},
+					}
+					updated.NetworkRuleSet.DefaultAction = mgmtstorage.DefaultActionDeny
+
+					storage.EXPECT().GetProperties(gomock.Any(), clusterResourceGroupName, accountName, gomock.Any()).Return(*result, nil)
+					storage.EXPECT().Update(gomock.Any(), clusterResourceGroupName, accountName, updated)
+				}
+			},
+		},
 		{
 			name:         "Operator Flag enabled - not found error on getting worker subnet skips subnet",
 			operatorFlag: true,
@@ -423,7 +523,9 @@ func TestReconcileManager(t *testing.T) {
 					},
 				},
 			}
-			clientFake := fake.NewClientBuilder().WithObjects(rc).Build()
+			clientFake := fake.NewClientBuilder().WithObjects(rc, instance).Build()
+
+			recorder := record.NewFakeRecorder(10)
 
 			r := reconcileManager{
 				log:            log,
@@ -433,6 +535,7 @@ func TestReconcileManager(t *testing.T) {
 				subnets:        subnet,
 				kubeSubnets:    kubeSubnet,
 				client:         clientFake,
+				recorder:       recorder,
 			}
 
 			err := r.reconcileAccounts(context.Background())
@@ -444,6 +547,17 @@ func TestReconcileManager(t *testing.T) {
 					t.Errorf("Expected Error %s, got %s when processing %s testcase", tt.wantErr.Error(), err.Error(), tt.name)
 				}
 			}
+
+			for _, want := range tt.wantEvents {
+				select {
+				case event := <-recorder.Events:
+					if event != want {
+						t.Errorf("got event %q, wanted %q", event, want)
+					}
+				default:
+					t.Errorf("missing event %q", want)
+				}
+			}
 		})
 	}
 }
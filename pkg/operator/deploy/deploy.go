@@ -56,6 +56,8 @@ type Operator interface {
 	Restart(context.Context, []string) error
 	IsRunningDesiredVersion(context.Context) (bool, error)
 	RenewMDSDCertificate(context.Context) error
+	UpdateAutoscaler(context.Context) error
+	UpdateCertificates(context.Context) error
 }
 
 type operator struct {
@@ -254,6 +256,8 @@ func (o *operator) resources(ctx context.Context) ([]kruntime.Object, error) {
 				MonitoringGCSNamespace:   o.env.ClusterGenevaLoggingNamespace(),
 			},
 			ServiceSubnets: serviceSubnets,
+			Autoscaler:     autoscalerSpec(o.oc.Properties.AutoscalerProfile),
+			Certificates:   certificatesSpec(o.oc.Properties.CertificateProfile),
 			InternetChecker: arov1alpha1.InternetCheckerSpec{
 				URLs: []string{
 					fmt.Sprintf("https://%s/", o.env.ACRDomain()),
@@ -432,6 +436,38 @@ func (o *operator) RenewMDSDCertificate(ctx context.Context) error {
 	return nil
 }
 
+// UpdateAutoscaler updates spec.autoscaler of the ARO Cluster resource from
+// the cluster's autoscalerProfile without redeploying the operator
+func (o *operator) UpdateAutoscaler(ctx context.Context) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cluster, err := o.arocli.AroV1alpha1().Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		cluster.Spec.Autoscaler = autoscalerSpec(o.oc.Properties.AutoscalerProfile)
+
+		_, err = o.arocli.AroV1alpha1().Clusters().Update(ctx, cluster, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+// UpdateCertificates updates spec.certificates of the ARO Cluster resource
+// from the cluster's certificateProfile without redeploying the operator
+func (o *operator) UpdateCertificates(ctx context.Context) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cluster, err := o.arocli.AroV1alpha1().Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		cluster.Spec.Certificates = certificatesSpec(o.oc.Properties.CertificateProfile)
+
+		_, err = o.arocli.AroV1alpha1().Clusters().Update(ctx, cluster, metav1.UpdateOptions{})
+		return err
+	})
+}
+
 func (o *operator) IsReady(ctx context.Context) (bool, error) {
 	ok, err := ready.CheckDeploymentIsReady(ctx, o.kubernetescli.AppsV1().Deployments(pkgoperator.Namespace), "aro-operator-master")()
 	o.log.Infof("deployment %q ok status is: %v, err is: %v", "aro-operator-master", ok, err)
@@ -527,6 +563,70 @@ func (o *operator) IsRunningDesiredVersion(ctx context.Context) (bool, error) {
 	return true, nil
 }
 
+func autoscalerSpec(ap *api.AutoscalerProfile) *arov1alpha1.AutoscalerSpec {
+	if ap == nil {
+		return nil
+	}
+
+	spec := &arov1alpha1.AutoscalerSpec{}
+
+	if ap.ScaleDown != nil {
+		spec.ScaleDown = &arov1alpha1.AutoscalerScaleDownSpec{
+			Enabled:              ap.ScaleDown.State != api.ScaleDownStateDisabled,
+			DelayAfterAdd:        ap.ScaleDown.DelayAfterAdd,
+			UnneededTime:         ap.ScaleDown.UnneededTime,
+			UtilizationThreshold: ap.ScaleDown.UtilizationThreshold,
+		}
+	}
+
+	for _, wp := range ap.WorkerProfiles {
+		spec.MachineSets = append(spec.MachineSets, arov1alpha1.MachineAutoscalerSpec{
+			Name:        wp.Name,
+			MinReplicas: int32(wp.MinCount),
+			MaxReplicas: int32(wp.MaxCount),
+		})
+	}
+
+	return spec
+}
+
+func certificatesSpec(cp *api.CertificateProfile) *arov1alpha1.CertificatesSpec {
+	if cp == nil {
+		return nil
+	}
+
+	return &arov1alpha1.CertificatesSpec{
+		APIServer: certificateSourceSpec(cp.APIServer),
+		Ingress:   certificateSourceSpec(cp.Ingress),
+	}
+}
+
+func certificateSourceSpec(cs *api.CertificateSource) *arov1alpha1.CertificateSourceSpec {
+	if cs == nil {
+		return nil
+	}
+
+	switch cs.Type {
+	case api.CertificateSourceTypeKeyVault:
+		return &arov1alpha1.CertificateSourceSpec{
+			KeyVaultSecretID: cs.KeyVaultSecretID,
+		}
+	case api.CertificateSourceTypeACME:
+		if cs.ACME == nil {
+			return nil
+		}
+		return &arov1alpha1.CertificateSourceSpec{
+			ACME: &arov1alpha1.ACMEIssuerSpec{
+				DirectoryURL: cs.ACME.DirectoryURL,
+				Email:        cs.ACME.Email,
+				DNSZoneID:    cs.ACME.DNSZoneID,
+			},
+		}
+	}
+
+	return nil
+}
+
 func checkIngressIP(ingressProfiles []api.IngressProfile) (string, error) {
 	if ingressProfiles == nil || len(ingressProfiles) < 1 {
 		return "", errors.New("no Ingress Profiles found")
@@ -17,6 +17,7 @@ import (
 	ctrlfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/Azure/ARO-RP/pkg/api"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
 	"github.com/Azure/ARO-RP/pkg/util/cmp"
 	mock_env "github.com/Azure/ARO-RP/pkg/util/mocks/env"
 	utilerror "github.com/Azure/ARO-RP/test/util/error"
@@ -129,6 +130,139 @@ func TestCheckIngressIP(t *testing.T) {
 	}
 }
 
+func TestAutoscalerSpec(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		ap   *api.AutoscalerProfile
+		want *arov1alpha1.AutoscalerSpec
+	}{
+		{
+			name: "no autoscalerProfile",
+		},
+		{
+			name: "empty autoscalerProfile",
+			ap:   &api.AutoscalerProfile{},
+			want: &arov1alpha1.AutoscalerSpec{},
+		},
+		{
+			name: "scale down state defaults to enabled",
+			ap: &api.AutoscalerProfile{
+				ScaleDown: &api.AutoscalerScaleDown{
+					DelayAfterAdd: "10m",
+				},
+			},
+			want: &arov1alpha1.AutoscalerSpec{
+				ScaleDown: &arov1alpha1.AutoscalerScaleDownSpec{
+					Enabled:       true,
+					DelayAfterAdd: "10m",
+				},
+			},
+		},
+		{
+			name: "scale down disabled, worker profiles autoscaled",
+			ap: &api.AutoscalerProfile{
+				ScaleDown: &api.AutoscalerScaleDown{
+					State:                api.ScaleDownStateDisabled,
+					UnneededTime:         "5m",
+					UtilizationThreshold: "0.4",
+				},
+				WorkerProfiles: []api.AutoscalerWorkerProfile{
+					{
+						Name:     "cluster-worker-eastus1",
+						MinCount: 1,
+						MaxCount: 5,
+					},
+					{
+						Name:     "cluster-worker-eastus2",
+						MaxCount: 3,
+					},
+				},
+			},
+			want: &arov1alpha1.AutoscalerSpec{
+				ScaleDown: &arov1alpha1.AutoscalerScaleDownSpec{
+					UnneededTime:         "5m",
+					UtilizationThreshold: "0.4",
+				},
+				MachineSets: []arov1alpha1.MachineAutoscalerSpec{
+					{
+						Name:        "cluster-worker-eastus1",
+						MinReplicas: 1,
+						MaxReplicas: 5,
+					},
+					{
+						Name:        "cluster-worker-eastus2",
+						MaxReplicas: 3,
+					},
+				},
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got := autoscalerSpec(tt.ap)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Error(cmp.Diff(got, tt.want))
+			}
+		})
+	}
+}
+
+func TestCertificatesSpec(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		cp   *api.CertificateProfile
+		want *arov1alpha1.CertificatesSpec
+	}{
+		{
+			name: "no certificateProfile",
+		},
+		{
+			name: "key vault and ACME certificates",
+			cp: &api.CertificateProfile{
+				APIServer: &api.CertificateSource{
+					Type:             api.CertificateSourceTypeKeyVault,
+					KeyVaultSecretID: "https://myvault.vault.azure.net/secrets/apiserver",
+				},
+				Ingress: &api.CertificateSource{
+					Type: api.CertificateSourceTypeACME,
+					ACME: &api.ACMEIssuer{
+						DirectoryURL: "https://acme.example.com/directory",
+						Email:        "admin@example.com",
+						DNSZoneID:    "/subscriptions/subscriptionId/resourceGroups/dns/providers/Microsoft.Network/dnsZones/example.com",
+					},
+				},
+			},
+			want: &arov1alpha1.CertificatesSpec{
+				APIServer: &arov1alpha1.CertificateSourceSpec{
+					KeyVaultSecretID: "https://myvault.vault.azure.net/secrets/apiserver",
+				},
+				Ingress: &arov1alpha1.CertificateSourceSpec{
+					ACME: &arov1alpha1.ACMEIssuerSpec{
+						DirectoryURL: "https://acme.example.com/directory",
+						Email:        "admin@example.com",
+						DNSZoneID:    "/subscriptions/subscriptionId/resourceGroups/dns/providers/Microsoft.Network/dnsZones/example.com",
+					},
+				},
+			},
+		},
+		{
+			name: "unknown source type",
+			cp: &api.CertificateProfile{
+				Ingress: &api.CertificateSource{
+					Type: "invalid",
+				},
+			},
+			want: &arov1alpha1.CertificatesSpec{},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got := certificatesSpec(tt.cp)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Error(cmp.Diff(got, tt.want))
+			}
+		})
+	}
+}
+
 func TestCreateDeploymentData(t *testing.T) {
 	operatorImageTag := "v20071110"
 	operatorImageUntagged := "arosvc.azurecr.io/aro"
@@ -0,0 +1,115 @@
+package diagnostics
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+)
+
+const (
+	// Port is the port of the operator metrics server, which also serves the
+	// diagnostics endpoint
+	Port = 8081
+
+	// Path is the path of the diagnostics endpoint
+	Path = "/diagnostics"
+)
+
+const (
+	ResultSuccess = "Success"
+	ResultError   = "Error"
+)
+
+// Diagnostics is the response of the diagnostics endpoint
+type Diagnostics struct {
+	Role        string              `json:"role"`
+	Controllers []*ControllerStatus `json:"controllers"`
+}
+
+// ControllerStatus is the reconcile telemetry of a controller
+type ControllerStatus struct {
+	Name string `json:"name"`
+
+	// Flag is the operator flag which enables the controller, if any, and
+	// Enabled its state.  Enabled is not set if the cluster resource could not
+	// be read.
+	Flag    string `json:"flag,omitempty"`
+	Enabled *bool  `json:"enabled,omitempty"`
+
+	LastReconcileTime *time.Time `json:"lastReconcileTime,omitempty"`
+	LastResult        string     `json:"lastResult,omitempty"`
+	LastError         string     `json:"lastError,omitempty"`
+	LastSuccessTime   *time.Time `json:"lastSuccessTime,omitempty"`
+	ErrorStreak       int        `json:"errorStreak"`
+	Reconciles        int64      `json:"reconciles"`
+
+	DurationHistogram []*DurationBucket `json:"durationHistogram"`
+}
+
+// DurationBucket counts the reconciles which took longer than the previous
+// bucket's upper bound and at most UpperBound seconds.  The last bucket has no
+// upper bound.
+type DurationBucket struct {
+	UpperBound float64 `json:"upperBound,omitempty"`
+	Count      int64   `json:"count"`
+}
+
+type handler struct {
+	log      *logrus.Entry
+	client   client.Client
+	role     string
+	registry *registry
+}
+
+// NewHandler returns the diagnostics endpoint handler, which lists the
+// telemetry of every controller wrapped by Wrap
+func NewHandler(log *logrus.Entry, client client.Client, role string) http.Handler {
+	return &handler{
+		log:      log,
+		client:   client,
+		role:     role,
+		registry: defaultRegistry,
+	}
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	d := &Diagnostics{
+		Role:        h.role,
+		Controllers: h.registry.snapshot(),
+	}
+
+	instance := &arov1alpha1.Cluster{}
+	err := h.client.Get(r.Context(), types.NamespacedName{Name: arov1alpha1.SingletonClusterName}, instance)
+	if err != nil {
+		h.log.Warnf("failed to get cluster: %s", err)
+	} else {
+		for _, c := range d.Controllers {
+			enabled := c.Flag == "" || instance.Spec.OperatorFlags.GetSimpleBoolean(c.Flag)
+			c.Enabled = &enabled
+		}
+	}
+
+	b, err := json.MarshalIndent(d, "", "    ")
+	if err != nil {
+		h.log.Error(err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(b)
+}
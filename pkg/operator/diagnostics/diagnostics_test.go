@@ -0,0 +1,140 @@
+package diagnostics
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-test/deep"
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	_ "github.com/Azure/ARO-RP/pkg/util/scheme"
+)
+
+type fakeReconciler []error
+
+func (r *fakeReconciler) Reconcile(context.Context, reconcile.Request) (reconcile.Result, error) {
+	err := (*r)[0]
+	*r = (*r)[1:]
+	return reconcile.Result{}, err
+}
+
+func TestDiagnostics(t *testing.T) {
+	ctx := context.Background()
+	start := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	failed := errors.New("failed")
+
+	now := start
+	reg := newRegistry()
+	reg.now = func() time.Time {
+		// every reconcile takes a second
+		now = now.Add(time.Second)
+		return now
+	}
+
+	machine := reg.wrap("Machine", operator.MachineEnabled, &fakeReconciler{nil, failed, failed})
+	banner := reg.wrap("Banner", operator.BannerEnabled, &fakeReconciler{failed, nil})
+	reg.wrap("PreviewFeature", "", &fakeReconciler{})
+
+	for _, r := range []reconcile.Reconciler{machine, machine, banner, machine, banner} {
+		_, _ = r.Reconcile(ctx, reconcile.Request{})
+	}
+
+	cluster := &arov1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: arov1alpha1.SingletonClusterName,
+		},
+		Spec: arov1alpha1.ClusterSpec{
+			OperatorFlags: arov1alpha1.OperatorFlags{
+				operator.MachineEnabled: operator.FlagTrue,
+				operator.BannerEnabled:  operator.FlagFalse,
+			},
+		},
+	}
+
+	h := &handler{
+		log:      logrus.NewEntry(logrus.StandardLogger()),
+		client:   ctrlfake.NewClientBuilder().WithObjects(cluster).Build(),
+		role:     operator.RoleMaster,
+		registry: reg,
+	}
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, Path, nil))
+
+	if w.Code != http.StatusOK {
+		t.Fatal(w.Code)
+	}
+
+	var got *Diagnostics
+	err := json.Unmarshal(w.Body.Bytes(), &got)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	histogram := func(oneSecond int64) []*DurationBucket {
+		buckets := make([]*DurationBucket, 0, len(durationBuckets)+1)
+		for _, b := range durationBuckets {
+			bucket := &DurationBucket{UpperBound: b}
+			if b == 1 {
+				bucket.Count = oneSecond
+			}
+			buckets = append(buckets, bucket)
+		}
+		return append(buckets, &DurationBucket{})
+	}
+	timePtr := func(seconds int) *time.Time {
+		t := start.Add(time.Duration(seconds) * time.Second)
+		return &t
+	}
+	truePtr, falsePtr := true, false
+
+	want := &Diagnostics{
+		Role: operator.RoleMaster,
+		Controllers: []*ControllerStatus{
+			{
+				Name:              "Banner",
+				Flag:              operator.BannerEnabled,
+				Enabled:           &falsePtr,
+				LastReconcileTime: timePtr(10),
+				LastResult:        ResultSuccess,
+				LastSuccessTime:   timePtr(10),
+				Reconciles:        2,
+				DurationHistogram: histogram(2),
+			},
+			{
+				Name:              "Machine",
+				Flag:              operator.MachineEnabled,
+				Enabled:           &truePtr,
+				LastReconcileTime: timePtr(8),
+				LastResult:        ResultError,
+				LastError:         "failed",
+				LastSuccessTime:   timePtr(2),
+				ErrorStreak:       2,
+				Reconciles:        3,
+				DurationHistogram: histogram(3),
+			},
+			{
+				Name:              "PreviewFeature",
+				Enabled:           &truePtr,
+				DurationHistogram: histogram(0),
+			},
+		},
+	}
+
+	for _, diff := range deep.Equal(got, want) {
+		t.Error(diff)
+	}
+}
@@ -0,0 +1,189 @@
+package diagnostics
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// durationBuckets are the upper bounds, in seconds, of the time-to-reconcile
+// histogram
+var durationBuckets = []float64{0.01, 0.05, 0.1, 0.5, 1, 5, 10, 30, 60, 300}
+
+var (
+	metricLastReconcile = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "aro_operator_controller_last_reconcile_timestamp_seconds",
+		Help: "Time of the last reconcile of the controller.",
+	}, []string{"controller"})
+
+	metricLastSuccess = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "aro_operator_controller_last_success_timestamp_seconds",
+		Help: "Time of the last successful reconcile of the controller.",
+	}, []string{"controller"})
+
+	metricErrorStreak = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "aro_operator_controller_error_streak",
+		Help: "Number of consecutive failed reconciles of the controller.",
+	}, []string{"controller"})
+
+	metricDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "aro_operator_controller_reconcile_duration_seconds",
+		Help:    "Time to reconcile of the controller.",
+		Buckets: durationBuckets,
+	}, []string{"controller"})
+)
+
+func init() {
+	metrics.Registry.MustRegister(metricLastReconcile, metricLastSuccess, metricErrorStreak, metricDuration)
+}
+
+// defaultRegistry holds the telemetry of every controller in the operator
+var defaultRegistry = newRegistry()
+
+// Wrap returns a reconciler which records the telemetry of every reconcile of
+// r.  flag is the operator flag which enables the controller, or empty if the
+// controller cannot be disabled.
+func Wrap(name, flag string, r reconcile.Reconciler) reconcile.Reconciler {
+	return defaultRegistry.wrap(name, flag, r)
+}
+
+type registry struct {
+	mu          sync.Mutex
+	now         func() time.Time
+	controllers map[string]*controller
+}
+
+func newRegistry() *registry {
+	return &registry{
+		now:         time.Now,
+		controllers: map[string]*controller{},
+	}
+}
+
+// controller is the telemetry of a single controller
+type controller struct {
+	name string
+	flag string
+
+	lastReconcileTime time.Time
+	lastSuccessTime   time.Time
+	lastError         error
+	errorStreak       int
+	reconciles        int64
+	durations         []int64 // one per bucket, plus overflow
+}
+
+func (reg *registry) wrap(name, flag string, r reconcile.Reconciler) reconcile.Reconciler {
+	return &instrumentedReconciler{
+		registry:   reg,
+		controller: reg.register(name, flag),
+		reconciler: r,
+	}
+}
+
+func (reg *registry) register(name, flag string) *controller {
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+
+	c := &controller{
+		name:      name,
+		flag:      flag,
+		durations: make([]int64, len(durationBuckets)+1),
+	}
+	reg.controllers[name] = c
+
+	return c
+}
+
+func (reg *registry) observe(c *controller, start time.Time, err error) {
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+
+	end := reg.now()
+	duration := end.Sub(start).Seconds()
+
+	c.reconciles++
+	c.lastReconcileTime = end
+	c.lastError = err
+	if err == nil {
+		c.errorStreak = 0
+		c.lastSuccessTime = end
+	} else {
+		c.errorStreak++
+	}
+	c.durations[sort.SearchFloat64s(durationBuckets, duration)]++
+
+	metricLastReconcile.WithLabelValues(c.name).Set(float64(end.Unix()))
+	if err == nil {
+		metricLastSuccess.WithLabelValues(c.name).Set(float64(end.Unix()))
+	}
+	metricErrorStreak.WithLabelValues(c.name).Set(float64(c.errorStreak))
+	metricDuration.WithLabelValues(c.name).Observe(duration)
+}
+
+// snapshot returns the status of every controller, ordered by name
+func (reg *registry) snapshot() []*ControllerStatus {
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+
+	statuses := make([]*ControllerStatus, 0, len(reg.controllers))
+	for _, c := range reg.controllers {
+		s := &ControllerStatus{
+			Name:        c.name,
+			Flag:        c.flag,
+			ErrorStreak: c.errorStreak,
+			Reconciles:  c.reconciles,
+		}
+
+		if !c.lastReconcileTime.IsZero() {
+			t := c.lastReconcileTime.UTC()
+			s.LastReconcileTime = &t
+
+			s.LastResult = ResultSuccess
+			if c.lastError != nil {
+				s.LastResult = ResultError
+				s.LastError = c.lastError.Error()
+			}
+		}
+		if !c.lastSuccessTime.IsZero() {
+			t := c.lastSuccessTime.UTC()
+			s.LastSuccessTime = &t
+		}
+
+		for i, count := range c.durations {
+			bucket := &DurationBucket{Count: count}
+			if i < len(durationBuckets) {
+				bucket.UpperBound = durationBuckets[i]
+			}
+			s.DurationHistogram = append(s.DurationHistogram, bucket)
+		}
+
+		statuses = append(statuses, s)
+	}
+
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Name < statuses[j].Name })
+
+	return statuses
+}
+
+type instrumentedReconciler struct {
+	registry   *registry
+	controller *controller
+	reconciler reconcile.Reconciler
+}
+
+func (r *instrumentedReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	start := r.registry.now()
+	result, err := r.reconciler.Reconcile(ctx, request)
+	r.registry.observe(r.controller, start, err)
+
+	return result, err
+}
@@ -33,6 +33,9 @@ const (
 	GuardrailsEnabled                  = "aro.guardrails.enabled"
 	GuardrailsDeployManaged            = "aro.guardrails.deploy.managed"
 	CloudProviderConfigEnabled         = "aro.cloudproviderconfig.enabled"
+	DriftEnabled                       = "aro.drift.enabled"
+	AutoscalerEnabled                  = "aro.autoscaler.enabled"
+	CertificatesEnabled                = "aro.certificates.enabled"
 	FlagTrue                           = "true"
 	FlagFalse                          = "false"
 )
@@ -70,5 +73,8 @@ func DefaultOperatorFlags() map[string]string {
 		GuardrailsEnabled:                  FlagFalse,
 		GuardrailsDeployManaged:            FlagFalse,
 		CloudProviderConfigEnabled:         FlagTrue,
+		DriftEnabled:                       FlagTrue,
+		AutoscalerEnabled:                  FlagTrue,
+		CertificatesEnabled:                FlagTrue,
 	}
 }
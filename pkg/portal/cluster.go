@@ -5,18 +5,31 @@ package portal
 
 import (
 	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/gorilla/mux"
+	"k8s.io/apimachinery/pkg/util/validation"
 
 	"github.com/Azure/ARO-RP/pkg/portal/cluster"
 	"github.com/Azure/ARO-RP/pkg/portal/prometheus"
 )
 
+const (
+	defaultLogTailLines = 500
+	maxLogTailLines     = 10000
+
+	// maxStatisticsDuration is the longest time range a dashboard panel can
+	// be queried over
+	maxStatisticsDuration = 8 * 7 * 24 * time.Hour
+)
+
 type AdminOpenShiftCluster struct {
 	Key                     string `json:"key"`
 	Name                    string `json:"name"`
@@ -195,6 +208,17 @@ func (p *portal) machineSets(w http.ResponseWriter, r *http.Request) {
 	_, _ = w.Write(b)
 }
 
+func (p *portal) dashboardList(w http.ResponseWriter, r *http.Request) {
+	b, err := json.MarshalIndent(p.dashboards.List(), "", "    ")
+	if err != nil {
+		p.internalServerError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(b)
+}
+
 func (p *portal) statistics(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	duration := r.URL.Query().Get("duration")
@@ -203,6 +227,10 @@ func (p *portal) statistics(w http.ResponseWriter, r *http.Request) {
 		p.badRequest(w, err)
 		return
 	}
+	if parsedDuration <= 0 || parsedDuration > maxStatisticsDuration {
+		p.badRequest(w, fmt.Errorf("duration must be between 0 and %s", maxStatisticsDuration))
+		return
+	}
 	endTimeString := r.URL.Query().Get("endtime")
 	endTime, err := time.Parse(time.RFC3339, endTimeString)
 	if err != nil {
@@ -210,14 +238,13 @@ func (p *portal) statistics(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	apiVars := mux.Vars(r)
-	statisticsType := apiVars["statisticsType"]
 	subscriptionID := apiVars["subscription"]
 	resourceGroup := apiVars["resourceGroup"]
 	clusterName := apiVars["clusterName"]
 	resourceID := p.getResourceID(subscriptionID, resourceGroup, clusterName)
-	promQuery, err := cluster.GetPromQuery(statisticsType)
-	if err != nil {
-		p.badRequest(w, err)
+	panel := p.dashboards.Panel(apiVars["dashboard"], apiVars["panel"])
+	if panel == nil {
+		http.Error(w, fmt.Sprintf("dashboard panel %s/%s not found", apiVars["dashboard"], apiVars["panel"]), http.StatusNotFound)
 		return
 	}
 	prom := prometheus.New(p.log, p.dbOpenShiftClusters, p.dialer)
@@ -234,7 +261,7 @@ func (p *portal) statistics(w http.ResponseWriter, r *http.Request) {
 	}
 	promHost, promScheme := prom.GetPrometheusHostAndScheme()
 	prometheusURL := promScheme + "://" + promHost
-	APIStatistics, err := fetcher.Statistics(ctx, httpClient, promQuery, parsedDuration, endTime, prometheusURL)
+	APIStatistics, err := fetcher.Statistics(ctx, httpClient, panel, parsedDuration, endTime, prometheusURL)
 	if err != nil {
 		p.internalServerError(w, err)
 		return
@@ -251,3 +278,189 @@ func (p *portal) statistics(w http.ResponseWriter, r *http.Request) {
 		p.log.Error(err)
 	}
 }
+
+func (p *portal) namespaces(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	fetcher, err := p.makeFetcher(ctx, r)
+	if err != nil {
+		p.internalServerError(w, err)
+		return
+	}
+
+	namespaces, err := fetcher.Namespaces(ctx)
+	if err != nil {
+		p.internalServerError(w, err)
+		return
+	}
+
+	b, err := json.MarshalIndent(namespaces, "", "    ")
+	if err != nil {
+		p.internalServerError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(b)
+}
+
+func (p *portal) pods(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	namespace := r.URL.Query().Get("namespace")
+	if namespace != "" && len(validation.IsDNS1123Label(namespace)) > 0 {
+		p.badRequest(w, fmt.Errorf("invalid namespace %q", namespace))
+		return
+	}
+
+	fetcher, err := p.makeFetcher(ctx, r)
+	if err != nil {
+		p.internalServerError(w, err)
+		return
+	}
+
+	pods, err := fetcher.Pods(ctx, namespace)
+	if err != nil {
+		p.internalServerError(w, err)
+		return
+	}
+
+	b, err := json.MarshalIndent(pods, "", "    ")
+	if err != nil {
+		p.internalServerError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(b)
+}
+
+// events streams the recent and new events of the cluster, optionally
+// filtered by namespace, as server-sent events.  The stream ends when the
+// client disconnects or the watch can't be resumed; clients are expected to
+// reconnect.
+func (p *portal) events(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	namespace := r.URL.Query().Get("namespace")
+	if namespace != "" && len(validation.IsDNS1123Label(namespace)) > 0 {
+		p.badRequest(w, fmt.Errorf("invalid namespace %q", namespace))
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		p.internalServerError(w, fmt.Errorf("streaming is not supported"))
+		return
+	}
+
+	fetcher, err := p.makeFetcher(ctx, r)
+	if err != nil {
+		p.internalServerError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+
+	err = fetcher.WatchEvents(ctx, namespace, func(event *cluster.EventInformation) error {
+		b, err := json.Marshal(event)
+		if err != nil {
+			return err
+		}
+
+		_, err = fmt.Fprintf(w, "data: %s\n\n", b)
+		if err != nil {
+			return err
+		}
+
+		flusher.Flush()
+		return nil
+	})
+	if err != nil {
+		// headers may have been sent already, so just log the error
+		p.log.Warn(err)
+	}
+}
+
+// containerLogs returns the last lines of the logs of a container.  If
+// follow=true is set, the logs are streamed until the client disconnects.
+func (p *portal) containerLogs(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	apiVars := mux.Vars(r)
+	namespace := apiVars["namespace"]
+	pod := apiVars["pod"]
+	container := r.URL.Query().Get("container")
+
+	if len(validation.IsDNS1123Label(namespace)) > 0 ||
+		len(validation.IsDNS1123Subdomain(pod)) > 0 ||
+		(container != "" && len(validation.IsDNS1123Label(container)) > 0) {
+		p.badRequest(w, fmt.Errorf("invalid container %s/%s/%s", namespace, pod, container))
+		return
+	}
+
+	tailLines := int64(defaultLogTailLines)
+	if s := r.URL.Query().Get("tailLines"); s != "" {
+		i, err := strconv.ParseInt(s, 10, 64)
+		if err != nil || i < 1 || i > maxLogTailLines {
+			p.badRequest(w, fmt.Errorf("invalid tailLines %q", s))
+			return
+		}
+		tailLines = i
+	}
+
+	follow := r.URL.Query().Get("follow") == "true"
+
+	var flusher http.Flusher
+	if follow {
+		var ok bool
+		flusher, ok = w.(http.Flusher)
+		if !ok {
+			p.internalServerError(w, fmt.Errorf("streaming is not supported"))
+			return
+		}
+	}
+
+	fetcher, err := p.makeFetcher(ctx, r)
+	if err != nil {
+		p.internalServerError(w, err)
+		return
+	}
+
+	logs, err := fetcher.ContainerLogs(ctx, namespace, pod, container, tailLines, follow)
+	if err != nil {
+		p.internalServerError(w, err)
+		return
+	}
+	defer logs.Close()
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+
+	if !follow {
+		_, err = io.Copy(w, logs)
+		if err != nil {
+			p.log.Warn(err)
+		}
+		return
+	}
+
+	b := make([]byte, 32*1024)
+	for {
+		n, err := logs.Read(b)
+		if n > 0 {
+			_, werr := w.Write(b[:n])
+			if werr != nil {
+				return
+			}
+			flusher.Flush()
+		}
+		if err == io.EOF || ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			p.log.Warn(err)
+			return
+		}
+	}
+}
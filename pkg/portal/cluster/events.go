@@ -0,0 +1,169 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+// recentEvents is the number of most recent events sent before new events are
+// watched
+const recentEvents = 100
+
+type EventInformation struct {
+	Namespace     string `json:"namespace"`
+	Name          string `json:"name"`
+	Type          string `json:"type"`
+	Reason        string `json:"reason"`
+	Message       string `json:"message"`
+	ObjectKind    string `json:"objectKind"`
+	ObjectName    string `json:"objectName"`
+	Count         int32  `json:"count"`
+	LastTimestamp string `json:"lastTimestamp"`
+}
+
+func EventFromEvent(event *corev1.Event) *EventInformation {
+	return &EventInformation{
+		Namespace:     event.Namespace,
+		Name:          event.Name,
+		Type:          event.Type,
+		Reason:        event.Reason,
+		Message:       event.Message,
+		ObjectKind:    event.InvolvedObject.Kind,
+		ObjectName:    event.InvolvedObject.Name,
+		Count:         event.Count,
+		LastTimestamp: eventTime(event).UTC().Format(time.RFC3339),
+	}
+}
+
+// WatchEvents calls onEvent with the most recent events of namespace, or of
+// all namespaces if namespace is empty, and then with every new or updated
+// event until ctx is done or onEvent returns an error.  The watch is resumed
+// from the last seen resource version whenever the API server closes it.
+func (f *realFetcher) WatchEvents(ctx context.Context, namespace string, onEvent func(*EventInformation) error) error {
+	items, resourceVersion, err := f.recentEvents(ctx, namespace)
+	if err != nil {
+		return err
+	}
+
+	for i := range items {
+		err = onEvent(EventFromEvent(&items[i]))
+		if err != nil {
+			return err
+		}
+	}
+
+	for ctx.Err() == nil {
+		w, err := f.kubernetesCli.CoreV1().Events(namespace).Watch(ctx, metav1.ListOptions{
+			ResourceVersion:     resourceVersion,
+			AllowWatchBookmarks: true,
+		})
+		if err != nil {
+			return err
+		}
+
+		resourceVersion, err = watchEvents(ctx, w, resourceVersion, onEvent)
+		w.Stop()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// recentEvents returns the recentEvents most recent events of namespace,
+// oldest first, and the resource version they were listed at.  Events are
+// listed in pages so that no more than two pages are held at a time.
+func (f *realFetcher) recentEvents(ctx context.Context, namespace string) ([]corev1.Event, string, error) {
+	var items []corev1.Event
+
+	opts := metav1.ListOptions{
+		Limit: recentEvents,
+	}
+	for {
+		events, err := f.kubernetesCli.CoreV1().Events(namespace).List(ctx, opts)
+		if err != nil {
+			return nil, "", err
+		}
+
+		items = append(items, events.Items...)
+		sort.SliceStable(items, func(i, j int) bool {
+			return eventTime(&items[i]).Before(eventTime(&items[j]))
+		})
+
+		if len(items) > recentEvents {
+			items = append([]corev1.Event(nil), items[len(items)-recentEvents:]...)
+		}
+
+		if events.Continue == "" {
+			return items, events.ResourceVersion, nil
+		}
+		opts.Continue = events.Continue
+	}
+}
+
+// watchEvents calls onEvent with the events received from w until it is
+// closed, and returns the resource version to resume watching from
+func watchEvents(ctx context.Context, w watch.Interface, resourceVersion string, onEvent func(*EventInformation) error) (string, error) {
+	for {
+		select {
+		case <-ctx.Done():
+			return resourceVersion, nil
+		case e, ok := <-w.ResultChan():
+			if !ok {
+				return resourceVersion, nil
+			}
+
+			switch e.Type {
+			case watch.Error:
+				// the resource version has expired; clients reconnect and
+				// list the recent events again
+				return "", kerrors.FromObject(e.Object)
+			case watch.Added, watch.Modified, watch.Bookmark:
+			default:
+				continue
+			}
+
+			event, ok := e.Object.(*corev1.Event)
+			if !ok {
+				continue
+			}
+
+			resourceVersion = event.ResourceVersion
+
+			if e.Type == watch.Bookmark {
+				continue
+			}
+
+			err := onEvent(EventFromEvent(event))
+			if err != nil {
+				return "", err
+			}
+		}
+	}
+}
+
+func (c *client) WatchEvents(ctx context.Context, namespace string, onEvent func(*EventInformation) error) error {
+	return c.fetcher.WatchEvents(ctx, namespace, onEvent)
+}
+
+// Helping Functions
+func eventTime(event *corev1.Event) time.Time {
+	switch {
+	case !event.LastTimestamp.IsZero():
+		return event.LastTimestamp.Time
+	case !event.EventTime.IsZero():
+		return event.EventTime.Time
+	default:
+		return event.CreationTimestamp.Time
+	}
+}
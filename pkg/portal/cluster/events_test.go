@@ -0,0 +1,161 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-test/deep"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes/fake"
+	ktesting "k8s.io/client-go/testing"
+
+	testlog "github.com/Azure/ARO-RP/test/util/log"
+)
+
+func TestWatchEvents(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	lastTimestamp := metav1.NewTime(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	event := func(name string, minutes int) *corev1.Event {
+		return &corev1.Event{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "openshift-apiserver",
+			},
+			InvolvedObject: corev1.ObjectReference{
+				Kind: "Pod",
+				Name: "apiserver",
+			},
+			Type:          corev1.EventTypeWarning,
+			Reason:        "BackOff",
+			Message:       "Back-off restarting failed container",
+			Count:         1,
+			LastTimestamp: metav1.NewTime(lastTimestamp.Add(time.Duration(minutes) * time.Minute)),
+		}
+	}
+
+	kubernetes := fake.NewSimpleClientset(event("second", 2), event("first", 1))
+
+	fakeWatch := watch.NewFake()
+	kubernetes.PrependWatchReactor("events", ktesting.DefaultWatchReactor(fakeWatch, nil))
+
+	_, log := testlog.New()
+
+	c := &client{
+		fetcher: &realFetcher{
+			kubernetesCli: kubernetes,
+			log:           log,
+		},
+		log: log,
+	}
+
+	go func() {
+		fakeWatch.Delete(event("deleted", 3))
+		fakeWatch.Add(event("third", 3))
+	}()
+
+	var names []string
+	err := c.WatchEvents(ctx, "openshift-apiserver", func(e *EventInformation) error {
+		names = append(names, e.Name)
+
+		if e.Name == "third" {
+			if e.LastTimestamp != "2021-01-01T00:03:00Z" || e.ObjectKind != "Pod" || e.ObjectName != "apiserver" {
+				t.Errorf("got %#v", e)
+			}
+			return fmt.Errorf("done")
+		}
+		return nil
+	})
+	if err == nil || err.Error() != "done" {
+		t.Fatal(err)
+	}
+
+	for _, l := range deep.Equal([]string{"first", "second", "third"}, names) {
+		t.Error(l)
+	}
+}
+
+func TestWatchEventsResumes(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	kubernetes := fake.NewSimpleClientset()
+
+	first, second := watch.NewFake(), watch.NewFake()
+	var resourceVersions []string
+	kubernetes.PrependWatchReactor("events", func(action ktesting.Action) (bool, watch.Interface, error) {
+		resourceVersions = append(resourceVersions, action.(ktesting.WatchAction).GetWatchRestrictions().ResourceVersion)
+		if len(resourceVersions) == 1 {
+			return true, first, nil
+		}
+		return true, second, nil
+	})
+
+	_, log := testlog.New()
+
+	c := &client{
+		fetcher: &realFetcher{
+			kubernetesCli: kubernetes,
+			log:           log,
+		},
+		log: log,
+	}
+
+	go func() {
+		first.Add(&corev1.Event{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "first",
+				Namespace:       "openshift-apiserver",
+				ResourceVersion: "5",
+			},
+		})
+		first.Action(watch.Bookmark, &corev1.Event{
+			ObjectMeta: metav1.ObjectMeta{
+				ResourceVersion: "7",
+			},
+		})
+		// the API server closes the watch
+		first.Stop()
+	}()
+
+	go func() {
+		second.Add(&corev1.Event{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "second",
+				Namespace:       "openshift-apiserver",
+				ResourceVersion: "8",
+			},
+		})
+	}()
+
+	var names []string
+	err := c.WatchEvents(ctx, "openshift-apiserver", func(e *EventInformation) error {
+		names = append(names, e.Name)
+
+		if e.Name == "second" {
+			return fmt.Errorf("done")
+		}
+		return nil
+	})
+	if err == nil || err.Error() != "done" {
+		t.Fatal(err)
+	}
+
+	for _, l := range deep.Equal([]string{"first", "second"}, names) {
+		t.Error(l)
+	}
+
+	// the second watch resumes from the bookmark
+	for _, l := range deep.Equal([]string{"", "7"}, resourceVersions) {
+		t.Error(l)
+	}
+}
@@ -5,6 +5,7 @@ package cluster
 
 import (
 	"context"
+	"io"
 	"net/http"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/portal/dashboard"
 	"github.com/Azure/ARO-RP/pkg/proxy"
 	"github.com/Azure/ARO-RP/pkg/util/restconfig"
 )
@@ -25,7 +27,11 @@ type FetchClient interface {
 	ClusterOperators(context.Context) (*ClusterOperatorsInformation, error)
 	Machines(context.Context) (*MachineListInformation, error)
 	MachineSets(context.Context) (*MachineSetListInformation, error)
-	Statistics(context.Context, *http.Client, string, time.Duration, time.Time, string) ([]Metrics, error)
+	Statistics(context.Context, *http.Client, *dashboard.Panel, time.Duration, time.Time, string) ([]Metrics, error)
+	Namespaces(context.Context) (*NamespaceListInformation, error)
+	Pods(context.Context, string) (*PodListInformation, error)
+	WatchEvents(context.Context, string, func(*EventInformation) error) error
+	ContainerLogs(context.Context, string, string, string, int64, bool) (io.ReadCloser, error)
 }
 
 // client is an implementation of FetchClient. It currently contains a "fetcher"
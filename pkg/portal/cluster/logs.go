@@ -0,0 +1,26 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"io"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// ContainerLogs returns the last tailLines lines of the logs of a container
+// of a pod.  If follow is set, the logs are streamed until ctx is done.
+func (f *realFetcher) ContainerLogs(ctx context.Context, namespace, pod, container string, tailLines int64, follow bool) (io.ReadCloser, error) {
+	return f.kubernetesCli.CoreV1().Pods(namespace).GetLogs(pod, &corev1.PodLogOptions{
+		Container:  container,
+		TailLines:  &tailLines,
+		Follow:     follow,
+		Timestamps: true,
+	}).Stream(ctx)
+}
+
+func (c *client) ContainerLogs(ctx context.Context, namespace, pod, container string, tailLines int64, follow bool) (io.ReadCloser, error) {
+	return c.fetcher.ContainerLogs(ctx, namespace, pod, container, tailLines, follow)
+}
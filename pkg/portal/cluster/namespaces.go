@@ -0,0 +1,50 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type NamespaceInformation struct {
+	Name        string `json:"name"`
+	Phase       string `json:"phase"`
+	CreatedTime string `json:"createdTime"`
+}
+
+type NamespaceListInformation struct {
+	Namespaces []NamespaceInformation `json:"namespaces"`
+}
+
+func NamespacesFromNamespaceList(namespaces *corev1.NamespaceList) *NamespaceListInformation {
+	final := &NamespaceListInformation{
+		Namespaces: make([]NamespaceInformation, len(namespaces.Items)),
+	}
+
+	for i, namespace := range namespaces.Items {
+		final.Namespaces[i] = NamespaceInformation{
+			Name:        namespace.Name,
+			Phase:       string(namespace.Status.Phase),
+			CreatedTime: namespace.CreationTimestamp.String(),
+		}
+	}
+
+	return final
+}
+
+func (f *realFetcher) Namespaces(ctx context.Context) (*NamespaceListInformation, error) {
+	r, err := f.kubernetesCli.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return NamespacesFromNamespaceList(r), nil
+}
+
+func (c *client) Namespaces(ctx context.Context) (*NamespaceListInformation, error) {
+	return c.fetcher.Namespaces(ctx)
+}
@@ -0,0 +1,116 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type ContainerInformation struct {
+	Name         string `json:"name"`
+	Image        string `json:"image"`
+	Ready        bool   `json:"ready"`
+	RestartCount int32  `json:"restartCount"`
+	State        string `json:"state"`
+	Reason       string `json:"reason,omitempty"`
+}
+
+type PodInformation struct {
+	Namespace    string                 `json:"namespace"`
+	Name         string                 `json:"name"`
+	NodeName     string                 `json:"nodeName"`
+	Phase        string                 `json:"phase"`
+	Ready        string                 `json:"ready"`
+	RestartCount int32                  `json:"restartCount"`
+	CreatedTime  string                 `json:"createdTime"`
+	Containers   []ContainerInformation `json:"containers"`
+}
+
+type PodListInformation struct {
+	Pods []PodInformation `json:"pods"`
+}
+
+func PodsFromPodList(pods *corev1.PodList) *PodListInformation {
+	final := &PodListInformation{
+		Pods: make([]PodInformation, len(pods.Items)),
+	}
+
+	for i, pod := range pods.Items {
+		podInformation := PodInformation{
+			Namespace:   pod.Namespace,
+			Name:        pod.Name,
+			NodeName:    pod.Spec.NodeName,
+			Phase:       string(pod.Status.Phase),
+			CreatedTime: pod.CreationTimestamp.String(),
+			Containers:  getContainers(pod),
+		}
+
+		var ready int
+		for _, container := range podInformation.Containers {
+			if container.Ready {
+				ready++
+			}
+			podInformation.RestartCount += container.RestartCount
+		}
+		podInformation.Ready = fmt.Sprintf("%d/%d", ready, len(pod.Spec.Containers))
+
+		final.Pods[i] = podInformation
+	}
+
+	return final
+}
+
+// Pods returns the pods of namespace, or of all namespaces if namespace is
+// empty
+func (f *realFetcher) Pods(ctx context.Context, namespace string) (*PodListInformation, error) {
+	r, err := f.kubernetesCli.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return PodsFromPodList(r), nil
+}
+
+func (c *client) Pods(ctx context.Context, namespace string) (*PodListInformation, error) {
+	return c.fetcher.Pods(ctx, namespace)
+}
+
+// Helping Functions
+func getContainers(pod corev1.Pod) []ContainerInformation {
+	statuses := map[string]corev1.ContainerStatus{}
+	for _, status := range pod.Status.ContainerStatuses {
+		statuses[status.Name] = status
+	}
+
+	containers := []ContainerInformation{}
+	for _, container := range pod.Spec.Containers {
+		containerInformation := ContainerInformation{
+			Name:  container.Name,
+			Image: container.Image,
+			State: "Waiting",
+		}
+
+		if status, ok := statuses[container.Name]; ok {
+			containerInformation.Ready = status.Ready
+			containerInformation.RestartCount = status.RestartCount
+
+			switch {
+			case status.State.Running != nil:
+				containerInformation.State = "Running"
+			case status.State.Terminated != nil:
+				containerInformation.State = "Terminated"
+				containerInformation.Reason = status.State.Terminated.Reason
+			case status.State.Waiting != nil:
+				containerInformation.Reason = status.State.Waiting.Reason
+			}
+		}
+
+		containers = append(containers, containerInformation)
+	}
+	return containers
+}
@@ -0,0 +1,110 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-test/deep"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	testlog "github.com/Azure/ARO-RP/test/util/log"
+)
+
+func TestPods(t *testing.T) {
+	ctx := context.Background()
+
+	kubernetes := fake.NewSimpleClientset(
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "apiserver",
+				Namespace: "openshift-apiserver",
+			},
+			Spec: corev1.PodSpec{
+				NodeName: "master-0",
+				Containers: []corev1.Container{
+					{Name: "apiserver", Image: "apiserver:latest"},
+					{Name: "check-endpoints", Image: "check-endpoints:latest"},
+				},
+			},
+			Status: corev1.PodStatus{
+				Phase: corev1.PodRunning,
+				ContainerStatuses: []corev1.ContainerStatus{
+					{
+						Name:         "apiserver",
+						Ready:        true,
+						RestartCount: 1,
+						State:        corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+					},
+					{
+						Name:         "check-endpoints",
+						RestartCount: 5,
+						State:        corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+					},
+				},
+			},
+		},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "console",
+				Namespace: "openshift-console",
+			},
+		},
+	)
+
+	_, log := testlog.New()
+
+	c := &client{
+		fetcher: &realFetcher{
+			kubernetesCli: kubernetes,
+			log:           log,
+		},
+		log: log,
+	}
+
+	info, err := c.Pods(ctx, "openshift-apiserver")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := range info.Pods {
+		info.Pods[i].CreatedTime = ""
+	}
+
+	expected := &PodListInformation{
+		Pods: []PodInformation{
+			{
+				Namespace:    "openshift-apiserver",
+				Name:         "apiserver",
+				NodeName:     "master-0",
+				Phase:        "Running",
+				Ready:        "1/2",
+				RestartCount: 6,
+				Containers: []ContainerInformation{
+					{
+						Name:         "apiserver",
+						Image:        "apiserver:latest",
+						Ready:        true,
+						RestartCount: 1,
+						State:        "Running",
+					},
+					{
+						Name:         "check-endpoints",
+						Image:        "check-endpoints:latest",
+						RestartCount: 5,
+						State:        "Waiting",
+						Reason:       "CrashLoopBackOff",
+					},
+				},
+			},
+		},
+	}
+
+	for _, l := range deep.Equal(expected, info) {
+		t.Error(l)
+	}
+}
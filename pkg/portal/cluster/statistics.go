@@ -5,13 +5,14 @@ package cluster
 
 import (
 	"context"
-	"errors"
 	"net/http"
 	"time"
 
 	prometheusAPI "github.com/prometheus/client_golang/api"
 	v1 "github.com/prometheus/client_golang/api/prometheus/v1"
 	"github.com/prometheus/common/model"
+
+	"github.com/Azure/ARO-RP/pkg/portal/dashboard"
 )
 
 // MetricValue contains the actual data of the metrics at certain timestamp, and a slice of this is used in the `Metrics` struct to combine all the metrics in one object.
@@ -26,11 +27,13 @@ type Metrics struct {
 	Value []MetricValue `json:"metricvalue"`
 }
 
-func (c *client) Statistics(ctx context.Context, httpClient *http.Client, promQuery string, duration time.Duration, endTime time.Time, prometheusURL string) ([]Metrics, error) {
-	return c.fetcher.statistics(ctx, httpClient, promQuery, duration, endTime, prometheusURL)
+func (c *client) Statistics(ctx context.Context, httpClient *http.Client, panel *dashboard.Panel, duration time.Duration, endTime time.Time, prometheusURL string) ([]Metrics, error) {
+	return c.fetcher.statistics(ctx, httpClient, panel, duration, endTime, prometheusURL)
 }
 
-func (f *realFetcher) statistics(ctx context.Context, httpClient *http.Client, promQuery string, duration time.Duration, endTime time.Time, prometheusURL string) ([]Metrics, error) {
+// statistics runs the queries of a dashboard panel over the given time range,
+// with a step picked from its duration
+func (f *realFetcher) statistics(ctx context.Context, httpClient *http.Client, panel *dashboard.Panel, duration time.Duration, endTime time.Time, prometheusURL string) ([]Metrics, error) {
 	promConfig := prometheusAPI.Config{
 		Address:      prometheusURL,
 		RoundTripper: httpClient.Transport,
@@ -42,28 +45,33 @@ func (f *realFetcher) statistics(ctx context.Context, httpClient *http.Client, p
 	}
 
 	v1api := v1.NewAPI(client)
-	startTime := endTime.Add(-1 * duration)
-	value, warning, err := v1api.QueryRange(ctx, promQuery, v1.Range{
-		Start: startTime,
+	r := v1.Range{
+		Start: endTime.Add(-1 * duration),
 		End:   endTime,
-		Step:  time.Minute * 2,
-	})
-	if len(warning) > 0 {
-		f.log.Warn(warning)
+		Step:  dashboard.Step(duration),
 	}
-	if err != nil {
-		return nil, err
+
+	metrics := make([]Metrics, 0)
+	for _, query := range panel.Queries {
+		value, warning, err := v1api.QueryRange(ctx, query.Expr, r)
+		if len(warning) > 0 {
+			f.log.Warn(warning)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		metrics = append(metrics, convertToTypeMetrics(&query, value.(model.Matrix))...)
 	}
 
-	valueMatrix := value.(model.Matrix)
-	return convertToTypeMetrics(valueMatrix), nil
+	return metrics, nil
 }
 
-func convertToTypeMetrics(v model.Matrix) []Metrics {
+func convertToTypeMetrics(query *dashboard.Query, v model.Matrix) []Metrics {
 	metrics := make([]Metrics, 0)
 	for _, i := range v {
 		metric := Metrics{}
-		metric.Name = i.Metric.String()
+		metric.Name = query.SeriesName(i.Metric)
 		metricValues := make([]MetricValue, 0)
 		for _, j := range i.Values {
 			metricValues = append(metricValues, MetricValue{
@@ -77,28 +85,3 @@ func convertToTypeMetrics(v model.Matrix) []Metrics {
 
 	return metrics
 }
-
-func GetPromQuery(statisticsType string) (string, error) {
-	promQueries := map[string]string{
-		"kubeapicodes":  "sum(rate(apiserver_request_total{job=\"apiserver\",code=~\"[45]..\"}[10m])) by (code, verb)",
-		"kubeapicpu":    "rate(process_cpu_seconds_total{job=\"apiserver\"}[5m])",
-		"kubeapimemory": "process_resident_memory_bytes{job=\"apiserver\"}",
-		// kube-controller-manager
-		"kubecontrollermanagercodes":  "sum(rate(rest_client_requests_total{job=\"kube-controller-manager\"}[5m])) by (code)",
-		"kubecontrollermanagercpu":    "rate(process_cpu_seconds_total{job=\"kube-controller-manager\"}[5m])",
-		"kubecontrollermanagermemory": "process_resident_memory_bytes{job=\"kube-controller-manager\"}",
-		// DNS
-		"dnsresponsecodes":    "sum(rate(coredns_dns_responses_total[5m])) by (rcode)",
-		"dnserrorrate":        "sum(rate(coredns_dns_responses_total{rcode=~\"SERVFAIL|NXDOMAIN\"}[5m])) by (pod) / sum(rate(coredns_dns_responses_total{rcode=~\"NOERROR\"}[5m])) by (pod)",
-		"dnshealthcheck":      "histogram_quantile(0.99, sum(rate(coredns_health_request_duration_seconds_bucket[5m])) by (le))",
-		"dnsforwardedtraffic": "histogram_quantile(0.95, sum(rate(coredns_forward_request_duration_seconds_bucket[5m])) by (le))",
-		"dnsalltraffic":       "histogram_quantile(0.95, sum(rate(coredns_dns_request_duration_seconds_bucket[5m])) by (le))",
-		// Ingress
-		"ingresscontrollercondition": "sum(ingress_controller_conditions) by (condition)",
-	}
-	promQuery, ok := promQueries[statisticsType]
-	if !ok {
-		return "", errors.New("invalid statistic type '" + statisticsType + "'")
-	}
-	return promQuery, nil
-}
@@ -4,6 +4,7 @@ package portal
 // Licensed under the Apache License 2.0.
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -12,8 +13,10 @@ import (
 
 	"github.com/go-test/deep"
 	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
 
 	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/portal/middleware"
 	testdatabase "github.com/Azure/ARO-RP/test/database"
 )
 
@@ -235,3 +238,94 @@ func TestClusterDetail(t *testing.T) {
 		t.Error(l)
 	}
 }
+
+func TestContainerLogsElevatedAccess(t *testing.T) {
+	resourceID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/resourcegroupname/providers/microsoft.redhatopenshift/openshiftclusters/cluster"
+	elevatedGroupIDs := []string{"10000000-0000-0000-0000-000000000000"}
+	username := "username"
+	expiresAt := time.Date(2099, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	grant := func(id string, state api.ElevatedAccessState) *api.PortalDocument {
+		return &api.PortalDocument{
+			ID: id,
+			Portal: &api.Portal{
+				Username: username,
+				ID:       resourceID,
+				ElevatedAccess: &api.ElevatedAccess{
+					State:     state,
+					ExpiresAt: &expiresAt,
+				},
+			},
+		}
+	}
+
+	for _, tt := range []struct {
+		name           string
+		groups         []string
+		grants         []*api.PortalDocument
+		wantStatusCode int
+		wantBody       string
+	}{
+		{
+			name:           "not elevated",
+			groups:         []string{"20000000-0000-0000-0000-000000000000"},
+			grants:         []*api.PortalDocument{grant("00000000-0000-0000-0000-00000000000a", api.ElevatedAccessStateApproved)},
+			wantStatusCode: http.StatusForbidden,
+			wantBody:       "Elevated access is required.\n",
+		},
+		{
+			name:           "elevated without grant",
+			groups:         elevatedGroupIDs,
+			grants:         []*api.PortalDocument{grant("00000000-0000-0000-0000-00000000000a", api.ElevatedAccessStateRequested)},
+			wantStatusCode: http.StatusForbidden,
+			wantBody:       "An approved elevated access grant to this cluster is required.\n",
+		},
+		{
+			// the invalid tailLines is rejected by the handler, so the request
+			// got past the elevated access check
+			name:           "elevated with grant",
+			groups:         elevatedGroupIDs,
+			grants:         []*api.PortalDocument{grant("00000000-0000-0000-0000-00000000000a", api.ElevatedAccessStateApproved)},
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       "Bad Request\n",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			dbPortal, _ := testdatabase.NewFakePortal()
+
+			fixture := testdatabase.NewFixture().
+				WithPortal(dbPortal)
+			fixture.AddPortalDocuments(tt.grants...)
+
+			err := fixture.Create()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			p := &portal{
+				log:              logrus.NewEntry(logrus.StandardLogger()),
+				elevatedGroupIDs: elevatedGroupIDs,
+				dbPortal:         dbPortal,
+			}
+
+			ctx := context.WithValue(context.Background(), middleware.ContextKeyUsername, username)
+			ctx = context.WithValue(ctx, middleware.ContextKeyGroups, tt.groups)
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/api/00000000-0000-0000-0000-000000000000/resourcegroupname/cluster/namespaces/openshift-etcd/pods/etcd-0/logs?tailLines=0", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			aadAuthenticatedRouter := mux.NewRouter()
+			p.aadAuthenticatedRoutes(aadAuthenticatedRouter, nil, nil, nil)
+			w := httptest.NewRecorder()
+			aadAuthenticatedRouter.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("got status %d, wanted %d", w.Code, tt.wantStatusCode)
+			}
+			if w.Body.String() != tt.wantBody {
+				t.Errorf("got body %q, wanted %q", w.Body.String(), tt.wantBody)
+			}
+		})
+	}
+}
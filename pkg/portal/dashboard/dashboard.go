@@ -0,0 +1,261 @@
+package dashboard
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/prometheus/common/model"
+)
+
+//go:embed dashboards
+var embeddedFiles embed.FS
+
+const (
+	// maxPoints is the number of points per series that Step aims for
+	maxPoints = 250
+
+	// minStep is the smallest step returned by Step; it matches the
+	// in-cluster Prometheus scrape interval
+	minStep = 30 * time.Second
+
+	// schemaVersion is the version of the dashboard schema this portal
+	// understands.  It is bumped on incompatible changes, so that a
+	// dashboards directory written for another portal fails to load rather
+	// than being misread.
+	schemaVersion = 1
+)
+
+// steps are the steps Step picks from, so that graphs line up across panels
+var steps = []time.Duration{
+	30 * time.Second,
+	time.Minute,
+	2 * time.Minute,
+	5 * time.Minute,
+	10 * time.Minute,
+	15 * time.Minute,
+	30 * time.Minute,
+	time.Hour,
+	2 * time.Hour,
+	3 * time.Hour,
+	6 * time.Hour,
+	12 * time.Hour,
+	24 * time.Hour,
+}
+
+var (
+	rxName   = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+	rxLegend = regexp.MustCompile(`\{\{\s*([a-zA-Z_][a-zA-Z0-9_]*)\s*\}\}`)
+)
+
+// Dashboard is a named set of panels rendered together by the portal
+type Dashboard struct {
+	Version int      `json:"version"`
+	Name    string   `json:"name"`
+	Title   string   `json:"title"`
+	Panels  []*Panel `json:"panels"`
+}
+
+// Panel is a graph made up of one or more Prometheus range queries
+type Panel struct {
+	Name       string      `json:"name"`
+	Title      string      `json:"title"`
+	Unit       string      `json:"unit,omitempty"`
+	Thresholds []Threshold `json:"thresholds,omitempty"`
+	Queries    []Query     `json:"queries"`
+}
+
+// Threshold is a value drawn on a panel above which the metric needs
+// attention
+type Threshold struct {
+	Value    float64 `json:"value"`
+	Severity string  `json:"severity"`
+}
+
+// Query is a PromQL expression.  Legend optionally names each resulting
+// series using {{label}} placeholders; the default is the series' labels.
+type Query struct {
+	Expr   string `json:"expr"`
+	Legend string `json:"legend,omitempty"`
+}
+
+// Dashboards holds the dashboards served by the portal, by name
+type Dashboards map[string]*Dashboard
+
+// Load returns the embedded dashboards.  If dir is not empty, the *.yaml files
+// in dir are loaded too and replace any embedded dashboard of the same name.
+func Load(dir string) (Dashboards, error) {
+	dashboards := Dashboards{}
+
+	err := dashboards.load(embeddedFiles, "dashboards")
+	if err != nil {
+		return nil, err
+	}
+
+	if dir != "" {
+		err = dashboards.load(os.DirFS(dir), ".")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return dashboards, nil
+}
+
+func (d Dashboards) load(fsys fs.FS, dir string) error {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() || path.Ext(entry.Name()) != ".yaml" {
+			continue
+		}
+
+		b, err := fs.ReadFile(fsys, path.Join(dir, entry.Name()))
+		if err != nil {
+			return err
+		}
+
+		var dashboard *Dashboard
+		err = yaml.Unmarshal(b, &dashboard)
+		if err != nil {
+			return fmt.Errorf("%s: %w", entry.Name(), err)
+		}
+
+		if dashboard == nil {
+			return fmt.Errorf("%s: empty dashboard", entry.Name())
+		}
+
+		dashboard.Name = strings.TrimSuffix(entry.Name(), ".yaml")
+
+		err = dashboard.validate()
+		if err != nil {
+			return fmt.Errorf("%s: %w", entry.Name(), err)
+		}
+
+		d[dashboard.Name] = dashboard
+	}
+
+	return nil
+}
+
+func (d *Dashboard) validate() error {
+	if d.Version != schemaVersion {
+		return fmt.Errorf("unsupported version %d", d.Version)
+	}
+
+	if !rxName.MatchString(d.Name) {
+		return fmt.Errorf("invalid dashboard name %q", d.Name)
+	}
+
+	if d.Title == "" {
+		return fmt.Errorf("title is required")
+	}
+
+	if len(d.Panels) == 0 {
+		return fmt.Errorf("at least one panel is required")
+	}
+
+	names := map[string]struct{}{}
+	for i, p := range d.Panels {
+		if p == nil {
+			return fmt.Errorf("panels[%d]: empty panel", i)
+		}
+
+		if !rxName.MatchString(p.Name) {
+			return fmt.Errorf("panels[%d]: invalid panel name %q", i, p.Name)
+		}
+
+		if _, found := names[p.Name]; found {
+			return fmt.Errorf("panels[%d]: duplicate panel name %q", i, p.Name)
+		}
+		names[p.Name] = struct{}{}
+
+		if p.Title == "" {
+			return fmt.Errorf("panels[%d]: title is required", i)
+		}
+
+		if len(p.Queries) == 0 {
+			return fmt.Errorf("panels[%d]: at least one query is required", i)
+		}
+
+		for j, q := range p.Queries {
+			if strings.TrimSpace(q.Expr) == "" {
+				return fmt.Errorf("panels[%d].queries[%d]: expr is required", i, j)
+			}
+		}
+
+		for j, t := range p.Thresholds {
+			switch t.Severity {
+			case "warning", "critical":
+			default:
+				return fmt.Errorf("panels[%d].thresholds[%d]: invalid severity %q", i, j, t.Severity)
+			}
+		}
+	}
+
+	return nil
+}
+
+// List returns the dashboards sorted by title
+func (d Dashboards) List() []*Dashboard {
+	dashboards := make([]*Dashboard, 0, len(d))
+	for _, dashboard := range d {
+		dashboards = append(dashboards, dashboard)
+	}
+
+	sort.Slice(dashboards, func(i, j int) bool { return dashboards[i].Title < dashboards[j].Title })
+
+	return dashboards
+}
+
+// Panel returns the named panel of the named dashboard, or nil if either does
+// not exist
+func (d Dashboards) Panel(dashboard, panel string) *Panel {
+	if d[dashboard] == nil {
+		return nil
+	}
+
+	for _, p := range d[dashboard].Panels {
+		if p.Name == panel {
+			return p
+		}
+	}
+
+	return nil
+}
+
+// Step returns the query step for a time range of the given duration: the
+// smallest of steps which keeps each series below maxPoints points
+func Step(duration time.Duration) time.Duration {
+	for _, step := range steps {
+		if step >= minStep && duration/step <= maxPoints {
+			return step
+		}
+	}
+
+	return steps[len(steps)-1]
+}
+
+// SeriesName returns the name of a series returned by query q
+func (q *Query) SeriesName(metric model.Metric) string {
+	if q.Legend == "" {
+		return metric.String()
+	}
+
+	return rxLegend.ReplaceAllStringFunc(q.Legend, func(s string) string {
+		return string(metric[model.LabelName(rxLegend.FindStringSubmatch(s)[1])])
+	})
+}
@@ -0,0 +1,246 @@
+package dashboard
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/prometheus/common/model"
+
+	utilerror "github.com/Azure/ARO-RP/test/util/error"
+)
+
+func TestLoad(t *testing.T) {
+	for _, tt := range []struct {
+		name         string
+		files        map[string]string
+		wantPanels   map[string][]string
+		wantTitle    string
+		wantErr      string
+		wantNotFound string
+	}{
+		{
+			name: "embedded",
+			wantPanels: map[string][]string{
+				"api":           {"codes", "cpu", "memory"},
+				"clusterhealth": {"nodesnotready", "clusteroperators", "etcdleaderchanges", "apiavailability", "podrestarts"},
+				"dns":           {"responsecodes", "alltraffic", "errorrate", "healthcheck", "forwardedtraffic"},
+				"ingress":       {"controllercondition"},
+				"kcm":           {"codes", "cpu", "memory"},
+			},
+		},
+		{
+			name: "override and add",
+			files: map[string]string{
+				"api.yaml": `version: 1
+title: Overridden
+panels:
+- name: requests
+  title: Requests
+  queries:
+  - expr: sum(rate(apiserver_request_total[5m]))
+`,
+				"etcd.yaml": `version: 1
+title: etcd
+panels:
+- name: dbsize
+  title: Database size
+  unit: bytes
+  thresholds:
+  - value: 6e9
+    severity: warning
+  queries:
+  - expr: etcd_mvcc_db_total_size_in_bytes
+    legend: "{{pod}}"
+`,
+				"README.md": "ignored",
+			},
+			wantPanels: map[string][]string{
+				"api":           {"requests"},
+				"clusterhealth": {"nodesnotready", "clusteroperators", "etcdleaderchanges", "apiavailability", "podrestarts"},
+				"dns":           {"responsecodes", "alltraffic", "errorrate", "healthcheck", "forwardedtraffic"},
+				"etcd":          {"dbsize"},
+				"ingress":       {"controllercondition"},
+				"kcm":           {"codes", "cpu", "memory"},
+			},
+		},
+		{
+			name: "override with another version",
+			files: map[string]string{
+				"api.yaml": `version: 2
+title: Overridden
+panels:
+- name: requests
+  title: Requests
+  queries:
+  - expr: sum(rate(apiserver_request_total[5m]))
+`,
+			},
+			wantErr: "api.yaml: unsupported version 2",
+		},
+		{
+			name: "missing version",
+			files: map[string]string{
+				"bad.yaml": "title: bad\npanels:\n- name: a\n  title: a\n  queries:\n  - expr: up\n",
+			},
+			wantErr: "bad.yaml: unsupported version 0",
+		},
+		{
+			name: "invalid name",
+			files: map[string]string{
+				"Bad_Name.yaml": "version: 1\ntitle: bad\npanels:\n- name: a\n  title: a\n  queries:\n  - expr: up\n",
+			},
+			wantErr: `Bad_Name.yaml: invalid dashboard name "Bad_Name"`,
+		},
+		{
+			name: "missing query",
+			files: map[string]string{
+				"bad.yaml": "version: 1\ntitle: bad\npanels:\n- name: a\n  title: a\n",
+			},
+			wantErr: "bad.yaml: panels[0]: at least one query is required",
+		},
+		{
+			name: "duplicate panel",
+			files: map[string]string{
+				"bad.yaml": "version: 1\ntitle: bad\npanels:\n- name: a\n  title: a\n  queries:\n  - expr: up\n- name: a\n  title: b\n  queries:\n  - expr: up\n",
+			},
+			wantErr: `bad.yaml: panels[1]: duplicate panel name "a"`,
+		},
+		{
+			name: "invalid severity",
+			files: map[string]string{
+				"bad.yaml": "version: 1\ntitle: bad\npanels:\n- name: a\n  title: a\n  thresholds:\n  - value: 1\n    severity: high\n  queries:\n  - expr: up\n",
+			},
+			wantErr: `bad.yaml: panels[0].thresholds[0]: invalid severity "high"`,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var dir string
+			if tt.files != nil {
+				dir = t.TempDir()
+				for name, content := range tt.files {
+					err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o666)
+					if err != nil {
+						t.Fatal(err)
+					}
+				}
+			}
+
+			dashboards, err := Load(dir)
+			utilerror.AssertErrorMessage(t, err, tt.wantErr)
+			if err != nil {
+				return
+			}
+
+			if len(dashboards) != len(tt.wantPanels) {
+				t.Errorf("got %d dashboards, wanted %d", len(dashboards), len(tt.wantPanels))
+			}
+
+			for name, wantPanels := range tt.wantPanels {
+				d := dashboards[name]
+				if d == nil {
+					t.Errorf("dashboard %s not found", name)
+					continue
+				}
+
+				if d.Name != name {
+					t.Error(d.Name)
+				}
+
+				if len(d.Panels) != len(wantPanels) {
+					t.Errorf("%s: got %d panels, wanted %d", name, len(d.Panels), len(wantPanels))
+					continue
+				}
+
+				for i, p := range d.Panels {
+					if p.Name != wantPanels[i] {
+						t.Errorf("%s: got panel %s, wanted %s", name, p.Name, wantPanels[i])
+					}
+					if dashboards.Panel(name, p.Name) != p {
+						t.Errorf("%s: panel %s not found", name, p.Name)
+					}
+				}
+			}
+
+			if dashboards.Panel("api", "missing") != nil || dashboards.Panel("missing", "cpu") != nil {
+				t.Error("unexpected panel")
+			}
+		})
+	}
+}
+
+func TestList(t *testing.T) {
+	dashboards := Dashboards{
+		"b": {Name: "b", Title: "Beta"},
+		"a": {Name: "a", Title: "Gamma"},
+		"c": {Name: "c", Title: "Alpha"},
+	}
+
+	var names []string
+	for _, d := range dashboards.List() {
+		names = append(names, d.Name)
+	}
+
+	if len(names) != 3 || names[0] != "c" || names[1] != "b" || names[2] != "a" {
+		t.Error(names)
+	}
+}
+
+func TestStep(t *testing.T) {
+	for _, tt := range []struct {
+		duration time.Duration
+		want     time.Duration
+	}{
+		{duration: time.Minute, want: 30 * time.Second},
+		{duration: time.Hour, want: 30 * time.Second},
+		{duration: 6 * time.Hour, want: 2 * time.Minute},
+		{duration: 24 * time.Hour, want: 10 * time.Minute},
+		{duration: 7 * 24 * time.Hour, want: time.Hour},
+		{duration: 8 * 7 * 24 * time.Hour, want: 6 * time.Hour},
+		{duration: 1000 * 24 * time.Hour, want: 24 * time.Hour},
+	} {
+		t.Run(tt.duration.String(), func(t *testing.T) {
+			got := Step(tt.duration)
+			if got != tt.want {
+				t.Errorf("got %s, wanted %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSeriesName(t *testing.T) {
+	metric := model.Metric{
+		"code": "500",
+		"verb": "GET",
+	}
+
+	for _, tt := range []struct {
+		legend string
+		want   string
+	}{
+		{
+			want: `{code="500", verb="GET"}`,
+		},
+		{
+			legend: "{{code}} {{ verb }}",
+			want:   "500 GET",
+		},
+		{
+			legend: "{{missing}} requests",
+			want:   " requests",
+		},
+	} {
+		t.Run(tt.legend, func(t *testing.T) {
+			q := &Query{Legend: tt.legend}
+
+			got := q.SeriesName(metric)
+			if got != tt.want {
+				t.Errorf("got %q, wanted %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,397 @@
+package elevatedaccess
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/api/validate"
+	"github.com/Azure/ARO-RP/pkg/database"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+	"github.com/Azure/ARO-RP/pkg/env"
+	"github.com/Azure/ARO-RP/pkg/portal/middleware"
+	"github.com/Azure/ARO-RP/pkg/util/log/audit"
+)
+
+const (
+	// requestTimeout is how long a request can wait for approval
+	requestTimeout = time.Hour
+
+	// retention is how long grants are kept in the database after their
+	// last change; the audit log keeps them for longer
+	retention = 30 * 24 * time.Hour
+
+	defaultDuration = 4 * 60
+	maxDuration     = 8 * 60
+
+	maxJustificationLength = 1000
+	maxIncidentIDLength    = 100
+)
+
+var (
+	errConflict      = errors.New("conflict")
+	errNotFound      = errors.New("not found")
+	errUnknownAction = errors.New("unknown action")
+)
+
+// ElevatedAccess implements just-in-time elevated access to clusters.  A
+// member of the elevated groups requests elevated access to a cluster with a
+// justification and an incident ID; another member of the elevated groups
+// approves or denies the request.  Approved grants expire after the requested
+// duration and can be revoked before then.
+type ElevatedAccess struct {
+	log   *logrus.Entry
+	audit *logrus.Entry
+	env   env.Core
+
+	elevatedGroupIDs []string
+
+	dbPortal database.Portal
+
+	now func() time.Time
+}
+
+type request struct {
+	Justification string `json:"justification,omitempty"`
+	IncidentID    string `json:"incidentId,omitempty"`
+	Duration      int    `json:"duration,omitempty"`
+}
+
+type grant struct {
+	ID         string `json:"id"`
+	Username   string `json:"username"`
+	ResourceID string `json:"resourceId"`
+	Active     bool   `json:"active"`
+
+	*api.ElevatedAccess
+}
+
+func New(log *logrus.Entry,
+	audit *logrus.Entry,
+	env env.Core,
+	elevatedGroupIDs []string,
+	dbPortal database.Portal,
+) *ElevatedAccess {
+	return &ElevatedAccess{
+		log:   log,
+		audit: audit,
+		env:   env,
+
+		elevatedGroupIDs: elevatedGroupIDs,
+
+		dbPortal: dbPortal,
+
+		now: time.Now,
+	}
+}
+
+// Active returns the active elevated access grant of username to the cluster
+// resourceID which expires last, or nil if there is none
+func Active(ctx context.Context, dbPortal database.Portal, username, resourceID string, now time.Time) (*api.PortalDocument, error) {
+	docs, err := dbPortal.ListElevatedAccess(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var active *api.PortalDocument
+	for _, doc := range docs.PortalDocuments {
+		if doc.Portal.Username != username ||
+			!strings.EqualFold(doc.Portal.ID, resourceID) ||
+			!doc.Portal.ElevatedAccess.IsActive(now) {
+			continue
+		}
+
+		if active == nil || doc.Portal.ElevatedAccess.ExpiresAt.After(*active.Portal.ElevatedAccess.ExpiresAt) {
+			active = doc
+		}
+	}
+
+	return active, nil
+}
+
+// New requests elevated access to a cluster
+func (e *ElevatedAccess) New(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	resourceID := strings.Join(strings.Split(r.URL.Path, "/")[:9], "/")
+	if !validate.RxClusterID.MatchString(resourceID) {
+		http.Error(w, fmt.Sprintf("invalid resourceId %q", resourceID), http.StatusBadRequest)
+		return
+	}
+
+	if !e.isElevated(ctx) {
+		http.Error(w, "Membership of an elevated group is required.", http.StatusForbidden)
+		return
+	}
+
+	mediatype, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mediatype != "application/json" {
+		http.Error(w, http.StatusText(http.StatusUnsupportedMediaType), http.StatusUnsupportedMediaType)
+		return
+	}
+
+	var req *request
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil || req == nil {
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	req.Justification = strings.TrimSpace(req.Justification)
+	req.IncidentID = strings.TrimSpace(req.IncidentID)
+	if req.Duration == 0 {
+		req.Duration = defaultDuration
+	}
+
+	switch {
+	case req.Justification == "" || len(req.Justification) > maxJustificationLength:
+		http.Error(w, fmt.Sprintf("The justification must be between 1 and %d characters long.", maxJustificationLength), http.StatusBadRequest)
+		return
+	case req.IncidentID == "" || len(req.IncidentID) > maxIncidentIDLength:
+		http.Error(w, fmt.Sprintf("The incident ID must be between 1 and %d characters long.", maxIncidentIDLength), http.StatusBadRequest)
+		return
+	case req.Duration < 1 || req.Duration > maxDuration:
+		http.Error(w, fmt.Sprintf("The duration must be between 1 and %d minutes.", maxDuration), http.StatusBadRequest)
+		return
+	}
+
+	username := ctx.Value(middleware.ContextKeyUsername).(string)
+
+	docs, err := e.dbPortal.ListElevatedAccess(ctx)
+	if err != nil {
+		e.internalServerError(w, err)
+		return
+	}
+
+	now := e.now()
+	for _, doc := range docs.PortalDocuments {
+		if doc.Portal.Username == username && strings.EqualFold(doc.Portal.ID, resourceID) &&
+			(e.isPending(doc.Portal.ElevatedAccess, now) || doc.Portal.ElevatedAccess.IsActive(now)) {
+			http.Error(w, fmt.Sprintf("Elevated access %s to this cluster is already %s.", doc.ID, strings.ToLower(string(doc.Portal.ElevatedAccess.State))), http.StatusConflict)
+			return
+		}
+	}
+
+	doc := &api.PortalDocument{
+		ID:  e.dbPortal.NewUUID(),
+		TTL: int(retention / time.Second),
+		Portal: &api.Portal{
+			Username: username,
+			ID:       resourceID,
+			ElevatedAccess: &api.ElevatedAccess{
+				Justification: req.Justification,
+				IncidentID:    req.IncidentID,
+				Duration:      req.Duration,
+				State:         api.ElevatedAccessStateRequested,
+				RequestedAt:   now.UTC(),
+			},
+		},
+	}
+
+	doc, err = e.dbPortal.Create(ctx, doc)
+	if err != nil {
+		e.internalServerError(w, err)
+		return
+	}
+
+	e.auditGrant(r, "request", doc)
+	e.reply(w, http.StatusCreated, toGrant(doc, now))
+}
+
+// List returns the elevated access grants which have been changed within the
+// retention period, most recently requested first
+func (e *ElevatedAccess) List(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	docs, err := e.dbPortal.ListElevatedAccess(ctx)
+	if err != nil {
+		e.internalServerError(w, err)
+		return
+	}
+
+	now := e.now()
+	grants := make([]*grant, 0, len(docs.PortalDocuments))
+	for _, doc := range docs.PortalDocuments {
+		grants = append(grants, toGrant(doc, now))
+	}
+
+	sort.SliceStable(grants, func(i, j int) bool { return grants[i].RequestedAt.After(grants[j].RequestedAt) })
+
+	e.reply(w, http.StatusOK, grants)
+}
+
+// Action approves, denies or revokes an elevated access grant.  Requests can
+// only be approved or denied by somebody other than the requester.
+func (e *ElevatedAccess) Action(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := mux.Vars(r)["id"]
+	action := mux.Vars(r)["action"]
+
+	if !e.isElevated(ctx) {
+		http.Error(w, "Membership of an elevated group is required.", http.StatusForbidden)
+		return
+	}
+
+	username := ctx.Value(middleware.ContextKeyUsername).(string)
+
+	var conflict string
+	doc, err := e.dbPortal.Patch(ctx, id, func(doc *api.PortalDocument) error {
+		conflict = ""
+
+		if doc.Portal == nil || doc.Portal.ElevatedAccess == nil {
+			return errNotFound
+		}
+
+		ea := doc.Portal.ElevatedAccess
+		now := e.now().UTC()
+
+		switch action {
+		case "approve", "deny":
+			switch {
+			case doc.Portal.Username == username:
+				conflict = "Elevated access cannot be approved or denied by its requester."
+			case !e.isPending(ea, now):
+				conflict = fmt.Sprintf("Elevated access %s is not pending approval.", doc.ID)
+			}
+			if conflict != "" {
+				return errConflict
+			}
+
+			ea.DecidedBy = username
+			ea.DecidedAt = &now
+			if action == "approve" {
+				expiresAt := now.Add(time.Duration(ea.Duration) * time.Minute)
+				ea.State = api.ElevatedAccessStateApproved
+				ea.ExpiresAt = &expiresAt
+			} else {
+				ea.State = api.ElevatedAccessStateDenied
+			}
+
+		case "revoke":
+			if !ea.IsActive(now) {
+				conflict = fmt.Sprintf("Elevated access %s is not active.", doc.ID)
+				return errConflict
+			}
+
+			ea.State = api.ElevatedAccessStateRevoked
+			ea.RevokedBy = username
+			ea.RevokedAt = &now
+
+		default:
+			return errUnknownAction
+		}
+
+		return nil
+	})
+	switch {
+	case err == errUnknownAction:
+		http.Error(w, fmt.Sprintf("unknown action %q", action), http.StatusBadRequest)
+		return
+	case err == errConflict:
+		http.Error(w, conflict, http.StatusConflict)
+		return
+	case err == errNotFound, cosmosdb.IsErrorStatusCode(err, http.StatusNotFound):
+		http.Error(w, fmt.Sprintf("elevated access %q not found", id), http.StatusNotFound)
+		return
+	case err != nil:
+		e.internalServerError(w, err)
+		return
+	}
+
+	e.auditGrant(r, action, doc)
+	e.reply(w, http.StatusOK, toGrant(doc, e.now()))
+}
+
+func (e *ElevatedAccess) isElevated(ctx context.Context) bool {
+	groups, _ := ctx.Value(middleware.ContextKeyGroups).([]string)
+	return len(middleware.GroupsIntersect(e.elevatedGroupIDs, groups)) > 0
+}
+
+func (e *ElevatedAccess) isPending(ea *api.ElevatedAccess, now time.Time) bool {
+	return ea.State == api.ElevatedAccessStateRequested && now.Sub(ea.RequestedAt) < requestTimeout
+}
+
+// auditGrant records a change to an elevated access grant in the audit log,
+// including the justification and incident ID of the grant
+func (e *ElevatedAccess) auditGrant(r *http.Request, action string, doc *api.PortalDocument) {
+	username, _ := r.Context().Value(middleware.ContextKeyUsername).(string)
+	ea := doc.Portal.ElevatedAccess
+
+	description := fmt.Sprintf("Elevated access %s of %s: state %s, incident %q, justification %q, duration %d minutes",
+		doc.ID, doc.Portal.Username, ea.State, ea.IncidentID, ea.Justification, ea.Duration)
+	if ea.ExpiresAt != nil {
+		description += fmt.Sprintf(", expires at %s", ea.ExpiresAt.Format(time.RFC3339))
+	}
+
+	e.audit.WithFields(logrus.Fields{
+		audit.MetadataAdminOperation:  true,
+		audit.MetadataCreatedTime:     e.now().UTC().Format(time.RFC3339),
+		audit.MetadataLogKind:         audit.IFXAuditLogKind,
+		audit.MetadataSource:          audit.SourceAdminPortal,
+		audit.EnvKeyAppID:             audit.SourceAdminPortal,
+		audit.EnvKeyCloudRole:         audit.CloudRoleRP,
+		audit.EnvKeyEnvironment:       e.env.Environment().Name,
+		audit.EnvKeyHostname:          e.env.Hostname(),
+		audit.EnvKeyLocation:          e.env.Location(),
+		audit.PayloadKeyCategory:      audit.CategoryAuthorization,
+		audit.PayloadKeyOperationName: "ElevatedAccess " + action,
+		audit.PayloadKeyCallerIdentities: []audit.CallerIdentity{
+			{
+				CallerIdentityType:  audit.CallerIdentityTypeUsername,
+				CallerIdentityValue: username,
+				CallerIPAddress:     r.RemoteAddr,
+			},
+		},
+		audit.PayloadKeyTargetResources: []audit.TargetResource{
+			{
+				TargetResourceName: doc.Portal.ID,
+				TargetResourceType: "elevatedaccess",
+			},
+		},
+		audit.PayloadKeyResult: audit.Result{
+			ResultType:        audit.ResultTypeSuccess,
+			ResultDescription: description,
+		},
+	}).Info(audit.DefaultLogMessage)
+}
+
+func (e *ElevatedAccess) reply(w http.ResponseWriter, statusCode int, v interface{}) {
+	b, err := json.MarshalIndent(v, "", "    ")
+	if err != nil {
+		e.internalServerError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_, _ = w.Write(b)
+}
+
+func (e *ElevatedAccess) internalServerError(w http.ResponseWriter, err error) {
+	e.log.Warn(err)
+	http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+}
+
+func toGrant(doc *api.PortalDocument, now time.Time) *grant {
+	return &grant{
+		ID:             doc.ID,
+		Username:       doc.Portal.Username,
+		ResourceID:     doc.Portal.ID,
+		Active:         doc.Portal.ElevatedAccess.IsActive(now),
+		ElevatedAccess: doc.Portal.ElevatedAccess,
+	}
+}
@@ -0,0 +1,507 @@
+package elevatedaccess
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/database"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+	"github.com/Azure/ARO-RP/pkg/portal/middleware"
+	"github.com/Azure/ARO-RP/pkg/portal/util/responsewriter"
+	"github.com/Azure/ARO-RP/pkg/util/azureclient"
+	mock_env "github.com/Azure/ARO-RP/pkg/util/mocks/env"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+	testlog "github.com/Azure/ARO-RP/test/util/log"
+)
+
+const (
+	resourceID = "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/rg/providers/microsoft.redhatopenshift/openshiftclusters/cluster"
+	grantID    = "00000000-0000-0000-0000-00000000000a"
+	requester  = "requester"
+	approver   = "approver"
+)
+
+var (
+	elevatedGroupIDs = []string{"10000000-0000-0000-0000-000000000000"}
+	now              = time.Date(2022, 1, 1, 12, 0, 0, 0, time.UTC)
+)
+
+func grantDocument(state api.ElevatedAccessState, requestedAt time.Time, expiresAt *time.Time) *api.PortalDocument {
+	return &api.PortalDocument{
+		ID:  grantID,
+		TTL: 2592000,
+		Portal: &api.Portal{
+			Username: requester,
+			ID:       resourceID,
+			ElevatedAccess: &api.ElevatedAccess{
+				Justification: "investigating",
+				IncidentID:    "12345",
+				Duration:      60,
+				State:         state,
+				RequestedAt:   requestedAt,
+				ExpiresAt:     expiresAt,
+			},
+		},
+	}
+}
+
+func newTestElevatedAccess(t *testing.T, dbPortal database.Portal) *ElevatedAccess {
+	controller := gomock.NewController(t)
+	t.Cleanup(controller.Finish)
+
+	_env := mock_env.NewMockCore(controller)
+	_env.EXPECT().Environment().AnyTimes().Return(&azureclient.PublicCloud)
+	_env.EXPECT().Hostname().AnyTimes().Return("testhost")
+	_env.EXPECT().Location().AnyTimes().Return("eastus")
+
+	_, log := testlog.New()
+	_, audit := testlog.NewAudit()
+
+	e := New(log, audit, _env, elevatedGroupIDs, dbPortal)
+	e.now = func() time.Time { return now }
+
+	return e
+}
+
+func TestNew(t *testing.T) {
+	expiresAt := now.Add(time.Hour)
+
+	for _, tt := range []struct {
+		name           string
+		body           string
+		groups         []string
+		fixtureChecker func(*testdatabase.Fixture, *testdatabase.Checker, *cosmosdb.FakePortalDocumentClient)
+		wantStatusCode int
+		wantBody       string
+	}{
+		{
+			name:   "success",
+			body:   `{"justification":" investigating ","incidentId":"12345"}`,
+			groups: elevatedGroupIDs,
+			fixtureChecker: func(fixture *testdatabase.Fixture, checker *testdatabase.Checker, portalClient *cosmosdb.FakePortalDocumentClient) {
+				checker.AddPortalDocuments(&api.PortalDocument{
+					ID:  "03030303-0303-0303-0303-030303030001",
+					TTL: 2592000,
+					Portal: &api.Portal{
+						Username: requester,
+						ID:       resourceID,
+						ElevatedAccess: &api.ElevatedAccess{
+							Justification: "investigating",
+							IncidentID:    "12345",
+							Duration:      defaultDuration,
+							State:         api.ElevatedAccessStateRequested,
+							RequestedAt:   now,
+						},
+					},
+				})
+			},
+			wantStatusCode: http.StatusCreated,
+			wantBody: `{
+    "id": "03030303-0303-0303-0303-030303030001",
+    "username": "requester",
+    "resourceId": "` + resourceID + `",
+    "active": false,
+    "justification": "investigating",
+    "incidentId": "12345",
+    "duration": 240,
+    "state": "Requested",
+    "requestedAt": "2022-01-01T12:00:00Z"
+}`,
+		},
+		{
+			name:           "not elevated",
+			body:           `{"justification":"investigating","incidentId":"12345"}`,
+			wantStatusCode: http.StatusForbidden,
+			wantBody:       "Membership of an elevated group is required.\n",
+		},
+		{
+			name:           "missing justification",
+			body:           `{"incidentId":"12345"}`,
+			groups:         elevatedGroupIDs,
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       "The justification must be between 1 and 1000 characters long.\n",
+		},
+		{
+			name:           "missing incident ID",
+			body:           `{"justification":"investigating"}`,
+			groups:         elevatedGroupIDs,
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       "The incident ID must be between 1 and 100 characters long.\n",
+		},
+		{
+			name:           "duration too long",
+			body:           `{"justification":"investigating","incidentId":"12345","duration":481}`,
+			groups:         elevatedGroupIDs,
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       "The duration must be between 1 and 480 minutes.\n",
+		},
+		{
+			name:           "junk request",
+			body:           `{{`,
+			groups:         elevatedGroupIDs,
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       "Bad Request\n",
+		},
+		{
+			name:   "already pending",
+			body:   `{"justification":"investigating","incidentId":"12345"}`,
+			groups: elevatedGroupIDs,
+			fixtureChecker: func(fixture *testdatabase.Fixture, checker *testdatabase.Checker, portalClient *cosmosdb.FakePortalDocumentClient) {
+				doc := grantDocument(api.ElevatedAccessStateRequested, now.Add(-time.Minute), nil)
+				fixture.AddPortalDocuments(doc)
+				checker.AddPortalDocuments(doc)
+			},
+			wantStatusCode: http.StatusConflict,
+			wantBody:       "Elevated access " + grantID + " to this cluster is already requested.\n",
+		},
+		{
+			name:   "already active",
+			body:   `{"justification":"investigating","incidentId":"12345"}`,
+			groups: elevatedGroupIDs,
+			fixtureChecker: func(fixture *testdatabase.Fixture, checker *testdatabase.Checker, portalClient *cosmosdb.FakePortalDocumentClient) {
+				doc := grantDocument(api.ElevatedAccessStateApproved, now.Add(-time.Minute), &expiresAt)
+				fixture.AddPortalDocuments(doc)
+				checker.AddPortalDocuments(doc)
+			},
+			wantStatusCode: http.StatusConflict,
+			wantBody:       "Elevated access " + grantID + " to this cluster is already approved.\n",
+		},
+		{
+			name:   "expired request does not conflict",
+			body:   `{"justification":"investigating","incidentId":"12345","duration":30}`,
+			groups: elevatedGroupIDs,
+			fixtureChecker: func(fixture *testdatabase.Fixture, checker *testdatabase.Checker, portalClient *cosmosdb.FakePortalDocumentClient) {
+				doc := grantDocument(api.ElevatedAccessStateRequested, now.Add(-2*time.Hour), nil)
+				fixture.AddPortalDocuments(doc)
+				checker.AddPortalDocuments(doc, &api.PortalDocument{
+					ID:  "03030303-0303-0303-0303-030303030001",
+					TTL: 2592000,
+					Portal: &api.Portal{
+						Username: requester,
+						ID:       resourceID,
+						ElevatedAccess: &api.ElevatedAccess{
+							Justification: "investigating",
+							IncidentID:    "12345",
+							Duration:      30,
+							State:         api.ElevatedAccessStateRequested,
+							RequestedAt:   now,
+						},
+					},
+				})
+			},
+			wantStatusCode: http.StatusCreated,
+			wantBody: `{
+    "id": "03030303-0303-0303-0303-030303030001",
+    "username": "requester",
+    "resourceId": "` + resourceID + `",
+    "active": false,
+    "justification": "investigating",
+    "incidentId": "12345",
+    "duration": 30,
+    "state": "Requested",
+    "requestedAt": "2022-01-01T12:00:00Z"
+}`,
+		},
+		{
+			name:   "sad database",
+			body:   `{"justification":"investigating","incidentId":"12345"}`,
+			groups: elevatedGroupIDs,
+			fixtureChecker: func(fixture *testdatabase.Fixture, checker *testdatabase.Checker, portalClient *cosmosdb.FakePortalDocumentClient) {
+				portalClient.SetError(fmt.Errorf("sad"))
+			},
+			wantStatusCode: http.StatusInternalServerError,
+			wantBody:       "Internal Server Error\n",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			dbPortal, portalClient := testdatabase.NewFakePortal()
+
+			fixture := testdatabase.NewFixture().
+				WithPortal(dbPortal)
+
+			checker := testdatabase.NewChecker()
+
+			if tt.fixtureChecker != nil {
+				tt.fixtureChecker(fixture, checker, portalClient)
+			}
+
+			err := fixture.Create()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			ctx := context.WithValue(context.Background(), middleware.ContextKeyUsername, requester)
+			ctx = context.WithValue(ctx, middleware.ContextKeyGroups, tt.groups)
+			r, err := http.NewRequestWithContext(ctx, http.MethodPost,
+				"https://localhost:8444"+resourceID+"/elevatedaccess/new", strings.NewReader(tt.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			r.Header.Set("Content-Type", "application/json")
+
+			e := newTestElevatedAccess(t, dbPortal)
+
+			router := mux.NewRouter()
+			router.Methods(http.MethodPost).Path("/subscriptions/{subscriptionId}/resourcegroups/{resourceGroupName}/providers/microsoft.redhatopenshift/openshiftclusters/{resourceName}/elevatedaccess/new").HandlerFunc(e.New)
+
+			w := responsewriter.New(r)
+
+			router.ServeHTTP(w, r)
+
+			portalClient.SetError(nil)
+
+			for _, err = range checker.CheckPortals(portalClient) {
+				t.Error(err)
+			}
+
+			resp := w.Response()
+
+			if resp.StatusCode != tt.wantStatusCode {
+				t.Error(resp.StatusCode)
+			}
+
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if string(b) != tt.wantBody {
+				t.Errorf("wanted %s but got %s", tt.wantBody, string(b))
+			}
+		})
+	}
+}
+
+func TestAction(t *testing.T) {
+	expiresAt := now.Add(time.Hour)
+	expired := now.Add(-time.Minute)
+
+	for _, tt := range []struct {
+		name           string
+		action         string
+		username       string
+		groups         []string
+		fixture        *api.PortalDocument
+		wantDocument   *api.PortalDocument
+		wantStatusCode int
+		wantBody       string
+	}{
+		{
+			name:     "approve",
+			action:   "approve",
+			username: approver,
+			groups:   elevatedGroupIDs,
+			fixture:  grantDocument(api.ElevatedAccessStateRequested, now.Add(-time.Minute), nil),
+			wantDocument: func() *api.PortalDocument {
+				doc := grantDocument(api.ElevatedAccessStateApproved, now.Add(-time.Minute), &expiresAt)
+				doc.Portal.ElevatedAccess.DecidedBy = approver
+				doc.Portal.ElevatedAccess.DecidedAt = &now
+				return doc
+			}(),
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:     "deny",
+			action:   "deny",
+			username: approver,
+			groups:   elevatedGroupIDs,
+			fixture:  grantDocument(api.ElevatedAccessStateRequested, now.Add(-time.Minute), nil),
+			wantDocument: func() *api.PortalDocument {
+				doc := grantDocument(api.ElevatedAccessStateDenied, now.Add(-time.Minute), nil)
+				doc.Portal.ElevatedAccess.DecidedBy = approver
+				doc.Portal.ElevatedAccess.DecidedAt = &now
+				return doc
+			}(),
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "approve own request",
+			action:         "approve",
+			username:       requester,
+			groups:         elevatedGroupIDs,
+			fixture:        grantDocument(api.ElevatedAccessStateRequested, now.Add(-time.Minute), nil),
+			wantDocument:   grantDocument(api.ElevatedAccessStateRequested, now.Add(-time.Minute), nil),
+			wantStatusCode: http.StatusConflict,
+			wantBody:       "Elevated access cannot be approved or denied by its requester.\n",
+		},
+		{
+			name:           "approve timed out request",
+			action:         "approve",
+			username:       approver,
+			groups:         elevatedGroupIDs,
+			fixture:        grantDocument(api.ElevatedAccessStateRequested, now.Add(-2*time.Hour), nil),
+			wantDocument:   grantDocument(api.ElevatedAccessStateRequested, now.Add(-2*time.Hour), nil),
+			wantStatusCode: http.StatusConflict,
+			wantBody:       "Elevated access " + grantID + " is not pending approval.\n",
+		},
+		{
+			name:     "revoke",
+			action:   "revoke",
+			username: approver,
+			groups:   elevatedGroupIDs,
+			fixture:  grantDocument(api.ElevatedAccessStateApproved, now.Add(-time.Minute), &expiresAt),
+			wantDocument: func() *api.PortalDocument {
+				doc := grantDocument(api.ElevatedAccessStateRevoked, now.Add(-time.Minute), &expiresAt)
+				doc.Portal.ElevatedAccess.RevokedBy = approver
+				doc.Portal.ElevatedAccess.RevokedAt = &now
+				return doc
+			}(),
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "revoke expired grant",
+			action:         "revoke",
+			username:       approver,
+			groups:         elevatedGroupIDs,
+			fixture:        grantDocument(api.ElevatedAccessStateApproved, now.Add(-2*time.Hour), &expired),
+			wantDocument:   grantDocument(api.ElevatedAccessStateApproved, now.Add(-2*time.Hour), &expired),
+			wantStatusCode: http.StatusConflict,
+			wantBody:       "Elevated access " + grantID + " is not active.\n",
+		},
+		{
+			name:           "unknown action",
+			action:         "extend",
+			username:       approver,
+			groups:         elevatedGroupIDs,
+			fixture:        grantDocument(api.ElevatedAccessStateRequested, now.Add(-time.Minute), nil),
+			wantDocument:   grantDocument(api.ElevatedAccessStateRequested, now.Add(-time.Minute), nil),
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       "unknown action \"extend\"\n",
+		},
+		{
+			name:           "not found",
+			action:         "approve",
+			username:       approver,
+			groups:         elevatedGroupIDs,
+			wantStatusCode: http.StatusNotFound,
+			wantBody:       "elevated access \"" + grantID + "\" not found\n",
+		},
+		{
+			name:           "not elevated",
+			action:         "approve",
+			username:       approver,
+			fixture:        grantDocument(api.ElevatedAccessStateRequested, now.Add(-time.Minute), nil),
+			wantDocument:   grantDocument(api.ElevatedAccessStateRequested, now.Add(-time.Minute), nil),
+			wantStatusCode: http.StatusForbidden,
+			wantBody:       "Membership of an elevated group is required.\n",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			dbPortal, portalClient := testdatabase.NewFakePortal()
+
+			fixture := testdatabase.NewFixture().
+				WithPortal(dbPortal)
+
+			checker := testdatabase.NewChecker()
+
+			if tt.fixture != nil {
+				fixture.AddPortalDocuments(tt.fixture)
+			}
+			if tt.wantDocument != nil {
+				checker.AddPortalDocuments(tt.wantDocument)
+			}
+
+			err := fixture.Create()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			ctx := context.WithValue(context.Background(), middleware.ContextKeyUsername, tt.username)
+			ctx = context.WithValue(ctx, middleware.ContextKeyGroups, tt.groups)
+			r, err := http.NewRequestWithContext(ctx, http.MethodPost,
+				"https://localhost:8444/api/elevatedaccess/"+grantID+"/"+tt.action, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			e := newTestElevatedAccess(t, dbPortal)
+
+			router := mux.NewRouter()
+			router.Methods(http.MethodPost).Path("/api/elevatedaccess/{id}/{action}").HandlerFunc(e.Action)
+
+			w := responsewriter.New(r)
+
+			router.ServeHTTP(w, r)
+
+			for _, err = range checker.CheckPortals(portalClient) {
+				t.Error(err)
+			}
+
+			resp := w.Response()
+
+			if resp.StatusCode != tt.wantStatusCode {
+				t.Error(resp.StatusCode)
+			}
+
+			if tt.wantBody == "" {
+				return
+			}
+
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if string(b) != tt.wantBody {
+				t.Errorf("wanted %s but got %s", tt.wantBody, string(b))
+			}
+		})
+	}
+}
+
+func TestActive(t *testing.T) {
+	ctx := context.Background()
+
+	soon := now.Add(time.Hour)
+	later := now.Add(2 * time.Hour)
+	expired := now.Add(-time.Minute)
+
+	dbPortal, _ := testdatabase.NewFakePortal()
+
+	fixture := testdatabase.NewFixture().
+		WithPortal(dbPortal)
+
+	for i, doc := range []*api.PortalDocument{
+		grantDocument(api.ElevatedAccessStateApproved, now, &soon),
+		grantDocument(api.ElevatedAccessStateApproved, now, &later),
+		grantDocument(api.ElevatedAccessStateApproved, now, &expired),
+		grantDocument(api.ElevatedAccessStateRevoked, now, &later),
+		grantDocument(api.ElevatedAccessStateRequested, now, nil),
+	} {
+		doc.ID = fmt.Sprintf("00000000-0000-0000-0000-00000000000%d", i)
+		fixture.AddPortalDocuments(doc)
+	}
+
+	err := fixture.Create()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	doc, err := Active(ctx, dbPortal, requester, strings.ToUpper(resourceID), now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if doc == nil || doc.ID != "00000000-0000-0000-0000-000000000001" {
+		t.Errorf("unexpected grant %v", doc)
+	}
+
+	doc, err = Active(ctx, dbPortal, approver, resourceID, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if doc != nil {
+		t.Errorf("unexpected grant %v", doc)
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/Azure/ARO-RP/pkg/api/validate"
 	"github.com/Azure/ARO-RP/pkg/database"
 	"github.com/Azure/ARO-RP/pkg/env"
+	"github.com/Azure/ARO-RP/pkg/portal/elevatedaccess"
 	"github.com/Azure/ARO-RP/pkg/portal/middleware"
 	"github.com/Azure/ARO-RP/pkg/portal/util/clientcache"
 	"github.com/Azure/ARO-RP/pkg/proxy"
@@ -85,7 +86,8 @@ func New(baseLog *logrus.Entry,
 }
 
 // New creates a New PortalDocument allowing kubeconfig access to a cluster for
-// 6 hours and returns a kubeconfig with the temporary credentials
+// 6 hours, or until the expiry of the elevated access grant for elevated
+// kubeconfigs, and returns a kubeconfig with the temporary credentials
 func (k *Kubeconfig) New(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
@@ -95,17 +97,41 @@ func (k *Kubeconfig) New(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	username := ctx.Value(middleware.ContextKeyUsername).(string)
 	elevated := len(middleware.GroupsIntersect(k.elevatedGroupIDs, ctx.Value(middleware.ContextKeyGroups).([]string))) > 0
+	timeout := kubeconfigNewTimeout
+
+	// members of the elevated groups get an elevated kubeconfig only while
+	// they hold an approved elevated access grant to the cluster, and the
+	// kubeconfig does not outlive the grant
+	var elevatedAccessID string
+	if elevated {
+		grant, err := elevatedaccess.Active(ctx, k.DbPortal, username, resourceID, time.Now())
+		if err != nil {
+			k.internalServerError(w, err)
+			return
+		}
+
+		if grant != nil {
+			elevatedAccessID = grant.ID
+			if remaining := time.Until(*grant.Portal.ElevatedAccess.ExpiresAt); remaining < timeout {
+				timeout = remaining
+			}
+		} else {
+			elevated = false
+		}
+	}
 
 	token := k.DbPortal.NewUUID()
 	portalDoc := &api.PortalDocument{
 		ID:  token,
-		TTL: int(kubeconfigNewTimeout / time.Second),
+		TTL: int(timeout / time.Second),
 		Portal: &api.Portal{
-			Username: ctx.Value(middleware.ContextKeyUsername).(string),
+			Username: username,
 			ID:       resourceID,
 			Kubeconfig: &api.Kubeconfig{
-				Elevated: elevated,
+				Elevated:         elevated,
+				ElevatedAccessID: elevatedAccessID,
 			},
 		},
 	}
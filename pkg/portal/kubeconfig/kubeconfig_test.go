@@ -11,6 +11,7 @@ import (
 	"net/http"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/gorilla/mux"
@@ -33,6 +34,19 @@ func TestNew(t *testing.T) {
 
 	servingCert := &x509.Certificate{}
 
+	expiresAt := time.Date(2099, 1, 1, 0, 0, 0, 0, time.UTC)
+	grant := &api.PortalDocument{
+		ID: "00000000-0000-0000-0000-00000000000a",
+		Portal: &api.Portal{
+			Username: username,
+			ID:       resourceID,
+			ElevatedAccess: &api.ElevatedAccess{
+				State:     api.ElevatedAccessStateApproved,
+				ExpiresAt: &expiresAt,
+			},
+		},
+	}
+
 	for _, tt := range []struct {
 		name           string
 		r              func(*http.Request)
@@ -66,6 +80,9 @@ func TestNew(t *testing.T) {
 			name:     "success - elevated",
 			elevated: true,
 			fixtureChecker: func(fixture *testdatabase.Fixture, checker *testdatabase.Checker, portalClient *cosmosdb.FakePortalDocumentClient) {
+				fixture.AddPortalDocuments(grant)
+				checker.AddPortalDocuments(grant)
+
 				portalDocument := &api.PortalDocument{
 					ID:  password,
 					TTL: 21600,
@@ -73,7 +90,8 @@ func TestNew(t *testing.T) {
 						Username: username,
 						ID:       resourceID,
 						Kubeconfig: &api.Kubeconfig{
-							Elevated: true,
+							Elevated:         true,
+							ElevatedAccessID: grant.ID,
 						},
 					},
 				}
@@ -85,6 +103,27 @@ func TestNew(t *testing.T) {
 			},
 			wantBody: "{\n    \"kind\": \"Config\",\n    \"apiVersion\": \"v1\",\n    \"preferences\": {},\n    \"clusters\": [\n        {\n            \"name\": \"cluster\",\n            \"cluster\": {\n                \"server\": \"https://localhost:8444/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/rg/providers/microsoft.redhatopenshift/openshiftclusters/cluster/kubeconfig/proxy\",\n                \"certificate-authority-data\": \"LS0tLS1CRUdJTiBDRVJUSUZJQ0FURS0tLS0tCi0tLS0tRU5EIENFUlRJRklDQVRFLS0tLS0K\"\n            }\n        }\n    ],\n    \"users\": [\n        {\n            \"name\": \"user\",\n            \"user\": {\n                \"token\": \"03030303-0303-0303-0303-030303030001\"\n            }\n        }\n    ],\n    \"contexts\": [\n        {\n            \"name\": \"context\",\n            \"context\": {\n                \"cluster\": \"cluster\",\n                \"user\": \"user\",\n                \"namespace\": \"default\"\n            }\n        }\n    ],\n    \"current-context\": \"context\"\n}",
 		},
+		{
+			name:     "success - elevated group without grant",
+			elevated: true,
+			fixtureChecker: func(fixture *testdatabase.Fixture, checker *testdatabase.Checker, portalClient *cosmosdb.FakePortalDocumentClient) {
+				portalDocument := &api.PortalDocument{
+					ID:  password,
+					TTL: 21600,
+					Portal: &api.Portal{
+						Username:   username,
+						ID:         resourceID,
+						Kubeconfig: &api.Kubeconfig{},
+					},
+				}
+				checker.AddPortalDocuments(portalDocument)
+			},
+			wantStatusCode: http.StatusOK,
+			wantHeaders: http.Header{
+				"Content-Disposition": []string{`attachment; filename="cluster.kubeconfig"`},
+			},
+			wantBody: "{\n    \"kind\": \"Config\",\n    \"apiVersion\": \"v1\",\n    \"preferences\": {},\n    \"clusters\": [\n        {\n            \"name\": \"cluster\",\n            \"cluster\": {\n                \"server\": \"https://localhost:8444/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/rg/providers/microsoft.redhatopenshift/openshiftclusters/cluster/kubeconfig/proxy\",\n                \"certificate-authority-data\": \"LS0tLS1CRUdJTiBDRVJUSUZJQ0FURS0tLS0tCi0tLS0tRU5EIENFUlRJRklDQVRFLS0tLS0K\"\n            }\n        }\n    ],\n    \"users\": [\n        {\n            \"name\": \"user\",\n            \"user\": {\n                \"token\": \"03030303-0303-0303-0303-030303030001\"\n            }\n        }\n    ],\n    \"contexts\": [\n        {\n            \"name\": \"context\",\n            \"context\": {\n                \"cluster\": \"cluster\",\n                \"user\": \"user\",\n                \"namespace\": \"default\"\n            }\n        }\n    ],\n    \"current-context\": \"context\"\n}",
+		},
 		{
 			name: "bad path",
 			r: func(r *http.Request) {
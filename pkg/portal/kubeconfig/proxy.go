@@ -18,6 +18,7 @@ import (
 
 	"github.com/Azure/ARO-RP/pkg/api"
 	"github.com/Azure/ARO-RP/pkg/api/validate"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
 	"github.com/Azure/ARO-RP/pkg/portal/middleware"
 	"github.com/Azure/ARO-RP/pkg/portal/util/responsewriter"
 	utilpem "github.com/Azure/ARO-RP/pkg/util/pem"
@@ -58,6 +59,20 @@ func (k *Kubeconfig) director(r *http.Request) {
 		return
 	}
 
+	// elevated kubeconfigs stop working as soon as their elevated access
+	// grant is revoked
+	if portalDoc.Portal.Kubeconfig.Elevated {
+		ok, err := k.isElevatedAccessActive(ctx, portalDoc.Portal.Kubeconfig.ElevatedAccessID)
+		if err != nil {
+			k.error(r, http.StatusInternalServerError, err)
+			return
+		}
+		if !ok {
+			k.error(r, http.StatusForbidden, nil)
+			return
+		}
+	}
+
 	key := struct {
 		resourceID string
 		elevated   bool
@@ -91,6 +106,21 @@ func (k *Kubeconfig) director(r *http.Request) {
 	*r = *r.WithContext(context.WithValue(ctx, contextKeyClient, cli))
 }
 
+func (k *Kubeconfig) isElevatedAccessActive(ctx context.Context, id string) (bool, error) {
+	if id == "" {
+		return false, nil
+	}
+
+	doc, err := k.DbPortal.Get(ctx, id)
+	if cosmosdb.IsErrorStatusCode(err, http.StatusNotFound) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return doc.Portal.ElevatedAccess != nil && doc.Portal.ElevatedAccess.IsActive(time.Now()), nil
+}
+
 // cli returns an appropriately configured HTTP client for forwarding the
 // incoming request to a cluster
 func (k *Kubeconfig) cli(ctx context.Context, resourceID string, elevated bool) (*http.Client, error) {
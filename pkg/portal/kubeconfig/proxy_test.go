@@ -14,6 +14,7 @@ import (
 	"net/http"
 	"net/http/httputil"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/gorilla/mux"
@@ -98,6 +99,22 @@ func TestProxy(t *testing.T) {
 	username := "username"
 	token := "00000000-0000-0000-0000-000000000000"
 	apiServerPrivateEndpointIP := "1.2.3.4"
+	grantID := "00000000-0000-0000-0000-00000000000a"
+	expiresAt := time.Date(2099, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	grant := func(state api.ElevatedAccessState) *api.PortalDocument {
+		return &api.PortalDocument{
+			ID: grantID,
+			Portal: &api.Portal{
+				Username: username,
+				ID:       resourceID,
+				ElevatedAccess: &api.ElevatedAccess{
+					State:     state,
+					ExpiresAt: &expiresAt,
+				},
+			},
+		}
+	}
 
 	cakey, cacerts, err := utiltls.GenerateKeyAndCertificate("ca", nil, nil, true, false)
 	if err != nil {
@@ -150,12 +167,13 @@ func TestProxy(t *testing.T) {
 						Username: username,
 						ID:       resourceID,
 						Kubeconfig: &api.Kubeconfig{
-							Elevated: true,
+							Elevated:         true,
+							ElevatedAccessID: grantID,
 						},
 					},
 				}
-				fixture.AddPortalDocuments(portalDocument)
-				checker.AddPortalDocuments(portalDocument)
+				fixture.AddPortalDocuments(portalDocument, grant(api.ElevatedAccessStateApproved))
+				checker.AddPortalDocuments(portalDocument, grant(api.ElevatedAccessStateApproved))
 				openShiftClusterDocument := &api.OpenShiftClusterDocument{
 					ID:  resourceID,
 					Key: resourceID,
@@ -178,6 +196,27 @@ func TestProxy(t *testing.T) {
 			wantStatusCode: http.StatusOK,
 			wantBody:       "GET /test HTTP/1.1\r\nHost: kubernetes:6443\r\nAccept-Encoding: gzip\r\nUser-Agent: testua\r\nX-Authenticated-Name: system:aro-service\r\n\r\n",
 		},
+		{
+			name: "elevated, grant revoked",
+			fixtureChecker: func(fixture *testdatabase.Fixture, checker *testdatabase.Checker, openShiftClustersClient *cosmosdb.FakeOpenShiftClusterDocumentClient, portalClient *cosmosdb.FakePortalDocumentClient) {
+				portalDocument := &api.PortalDocument{
+					ID:  token,
+					TTL: 21600,
+					Portal: &api.Portal{
+						Username: username,
+						ID:       resourceID,
+						Kubeconfig: &api.Kubeconfig{
+							Elevated:         true,
+							ElevatedAccessID: grantID,
+						},
+					},
+				}
+				fixture.AddPortalDocuments(portalDocument, grant(api.ElevatedAccessStateRevoked))
+				checker.AddPortalDocuments(portalDocument, grant(api.ElevatedAccessStateRevoked))
+			},
+			wantStatusCode: http.StatusForbidden,
+			wantBody:       "Forbidden\n",
+		},
 		{
 			name: "success - not elevated",
 			fixtureChecker: func(fixture *testdatabase.Fixture, checker *testdatabase.Checker, openShiftClustersClient *cosmosdb.FakeOpenShiftClusterDocumentClient, portalClient *cosmosdb.FakePortalDocumentClient) {
@@ -30,6 +30,8 @@ import (
 	"github.com/Azure/ARO-RP/pkg/metrics"
 	"github.com/Azure/ARO-RP/pkg/portal/assets"
 	"github.com/Azure/ARO-RP/pkg/portal/cluster"
+	"github.com/Azure/ARO-RP/pkg/portal/dashboard"
+	"github.com/Azure/ARO-RP/pkg/portal/elevatedaccess"
 	"github.com/Azure/ARO-RP/pkg/portal/kubeconfig"
 	"github.com/Azure/ARO-RP/pkg/portal/middleware"
 	"github.com/Azure/ARO-RP/pkg/portal/prometheus"
@@ -69,6 +71,8 @@ type portal struct {
 
 	dialer proxy.Dialer
 
+	dashboards dashboard.Dashboards
+
 	templateV1         *template.Template
 	templateV2         *template.Template
 	templatePrometheus *template.Template
@@ -98,6 +102,7 @@ func NewPortal(env env.Core,
 	dbOpenShiftClusters database.OpenShiftClusters,
 	dbPortal database.Portal,
 	dialer proxy.Dialer,
+	dashboards dashboard.Dashboards,
 	m metrics.Emitter,
 ) Runnable {
 	return &portal{
@@ -126,6 +131,8 @@ func NewPortal(env env.Core,
 
 		dialer: dialer,
 
+		dashboards: dashboards,
+
 		m: m,
 	}
 }
@@ -289,6 +296,7 @@ func (p *portal) aadAuthenticatedRoutes(r *mux.Router, prom *prometheus.Promethe
 	r.Methods(http.MethodGet).Path("/api/clusters").HandlerFunc(p.clusters)
 	r.Methods(http.MethodGet).Path("/api/info").HandlerFunc(p.info)
 	r.Methods(http.MethodGet).Path("/api/regions").HandlerFunc(p.regions)
+	r.Methods(http.MethodGet).Path("/api/dashboards").HandlerFunc(p.dashboardList)
 
 	// Cluster-specific routes
 	r.Path("/api/{subscription}/{resourceGroup}/{clusterName}/clusteroperators").HandlerFunc(p.clusterOperators)
@@ -296,9 +304,18 @@ func (p *portal) aadAuthenticatedRoutes(r *mux.Router, prom *prometheus.Promethe
 	r.Path("/api/{subscription}/{resourceGroup}/{clusterName}/nodes").HandlerFunc(p.nodes)
 	r.Path("/api/{subscription}/{resourceGroup}/{clusterName}/machines").HandlerFunc(p.machines)
 	r.Path("/api/{subscription}/{resourceGroup}/{clusterName}/machine-sets").HandlerFunc(p.machineSets)
-	r.Path("/api/{subscription}/{resourceGroup}/{clusterName}/statistics/{statisticsType}").HandlerFunc(p.statistics)
+	r.Methods(http.MethodGet).Path("/api/{subscription}/{resourceGroup}/{clusterName}/dashboards/{dashboard}/panels/{panel}").HandlerFunc(p.statistics)
+	r.Methods(http.MethodGet).Path("/api/{subscription}/{resourceGroup}/{clusterName}/namespaces").HandlerFunc(p.namespaces)
+	r.Methods(http.MethodGet).Path("/api/{subscription}/{resourceGroup}/{clusterName}/pods").HandlerFunc(p.pods)
+	r.Methods(http.MethodGet).Path("/api/{subscription}/{resourceGroup}/{clusterName}/events").HandlerFunc(p.events)
 	r.Path("/api/{subscription}/{resourceGroup}/{clusterName}").HandlerFunc(p.clusterInfo)
 
+	// Cluster-specific routes which expose workload data, like SSH and
+	// kubeconfig, require an elevated access grant to the cluster
+	elevatedRouter := r.NewRoute().Subrouter()
+	elevatedRouter.Use(p.checkElevatedAccess)
+	elevatedRouter.Methods(http.MethodGet).Path("/api/{subscription}/{resourceGroup}/{clusterName}/namespaces/{namespace}/pods/{pod}/logs").HandlerFunc(p.containerLogs)
+
 	// prometheus
 	if prom != nil {
 		r.Path("/subscriptions/{subscriptionId}/resourcegroups/{resourceGroupName}/providers/microsoft.redhatopenshift/openshiftclusters/{resourceName}/prometheus/-/ready").Handler(prom.ReverseProxy)
@@ -324,6 +341,12 @@ func (p *portal) aadAuthenticatedRoutes(r *mux.Router, prom *prometheus.Promethe
 	// ssh
 	r.Methods(http.MethodPost).Path("/subscriptions/{subscriptionId}/resourcegroups/{resourceGroupName}/providers/microsoft.redhatopenshift/openshiftclusters/{resourceName}/ssh/new").HandlerFunc(sshStruct.New)
 
+	// elevated access
+	ea := elevatedaccess.New(p.log, p.audit, p.env, p.elevatedGroupIDs, p.dbPortal)
+	r.Methods(http.MethodGet).Path("/api/elevatedaccess").HandlerFunc(ea.List)
+	r.Methods(http.MethodPost).Path("/api/elevatedaccess/{id}/{action}").HandlerFunc(ea.Action)
+	r.Methods(http.MethodPost).Path("/subscriptions/{subscriptionId}/resourcegroups/{resourceGroupName}/providers/microsoft.redhatopenshift/openshiftclusters/{resourceName}/elevatedaccess/new").HandlerFunc(ea.New)
+
 	for _, name := range names {
 		regexp, _ := regexp.Compile(`v[1,2]/build/.*\..*`)
 		name := regexp.FindString(name)
@@ -389,6 +412,38 @@ func (p *portal) indexPrometheus(w http.ResponseWriter, r *http.Request) {
 	http.ServeContent(w, r, "index.html", time.Time{}, bytes.NewReader(buf.Bytes()))
 }
 
+// checkElevatedAccess admits only members of the elevated groups who hold an
+// approved elevated access grant to the cluster of the request
+func (p *portal) checkElevatedAccess(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		if len(middleware.GroupsIntersect(p.elevatedGroupIDs, ctx.Value(middleware.ContextKeyGroups).([]string))) == 0 {
+			http.Error(w, "Elevated access is required.", http.StatusForbidden)
+			return
+		}
+
+		apiVars := mux.Vars(r)
+		resourceID := p.getResourceID(apiVars["subscription"], apiVars["resourceGroup"], apiVars["clusterName"])
+		if !validate.RxClusterID.MatchString(resourceID) {
+			p.badRequest(w, fmt.Errorf("invalid resourceId %q", resourceID))
+			return
+		}
+
+		grant, err := elevatedaccess.Active(ctx, p.dbPortal, ctx.Value(middleware.ContextKeyUsername).(string), resourceID, time.Now())
+		if err != nil {
+			p.internalServerError(w, err)
+			return
+		}
+		if grant == nil {
+			http.Error(w, "An approved elevated access grant to this cluster is required.", http.StatusForbidden)
+			return
+		}
+
+		h.ServeHTTP(w, r)
+	})
+}
+
 // makeFetcher creates a cluster.FetchClient suitable for use by the Portal REST API
 func (p *portal) makeFetcher(ctx context.Context, r *http.Request) (cluster.FetchClient, error) {
 	apiVars := mux.Vars(r)
@@ -10,6 +10,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"strings"
 	"testing"
@@ -90,7 +91,7 @@ func TestSecurity(t *testing.T) {
 		},
 	}
 
-	p := NewPortal(_env, portalAuditLog, portalLog, portalAccessLog, l, sshl, nil, "", serverkey, servercerts, "", nil, nil, make([]byte, 32), sshkey, nil, elevatedGroupIDs, dbOpenShiftClusters, dbPortal, nil, &noop.Noop{})
+	p := NewPortal(_env, portalAuditLog, portalLog, portalAccessLog, l, sshl, nil, "", serverkey, servercerts, "", nil, nil, make([]byte, 32), sshkey, nil, elevatedGroupIDs, dbOpenShiftClusters, dbPortal, nil, nil, &noop.Noop{})
 	go func() {
 		err := p.Run(ctx)
 		if err != nil {
@@ -252,6 +253,38 @@ func TestSecurity(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "/api/logs",
+			request: func() (*http.Request, error) {
+				return http.NewRequest(http.MethodGet, "https://server/api/00000000-0000-0000-0000-000000000000/resourceGroupName/resourceName/namespaces/namespace/pods/pod/logs", nil)
+			},
+			checkResponse: func(t *testing.T, authenticated, elevated bool, resp *http.Response) {
+				if !authenticated {
+					return
+				}
+
+				want := "Elevated access is required.\n"
+				if elevated {
+					want = "An approved elevated access grant to this cluster is required.\n"
+				}
+
+				b, err := io.ReadAll(resp.Body)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if string(b) != want {
+					t.Error(string(b))
+				}
+			},
+			authenticatedWantStatusCode: http.StatusForbidden,
+			wantAuditOperation:          "GET /api/00000000-0000-0000-0000-000000000000/resourcegroupname/resourcename/namespaces/namespace/pods/pod/logs",
+			wantAuditTargetResources: []audit.TargetResource{
+				{
+					TargetResourceType: "",
+					TargetResourceName: "/api/00000000-0000-0000-0000-000000000000/resourcegroupname/resourcename/namespaces/namespace/pods/pod/logs",
+				},
+			},
+		},
 		{
 			name: "/doesnotexist",
 			request: func() (*http.Request, error) {
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"io"
 	"net"
+	"net/http"
 	"strings"
 	"time"
 
@@ -18,6 +19,7 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
 	utillog "github.com/Azure/ARO-RP/pkg/util/log"
 	"github.com/Azure/ARO-RP/pkg/util/recover"
 	"github.com/Azure/ARO-RP/pkg/util/uuid"
@@ -58,6 +60,10 @@ const (
 	sshTimeout = time.Hour // never allow a connection to live longer than an hour.
 )
 
+// grantCheckInterval is how often an open connection checks that its elevated
+// access grant has not been revoked
+var grantCheckInterval = time.Minute
+
 func (s *SSH) Run() error {
 	go func() {
 		defer recover.Panic(s.log)
@@ -139,6 +145,16 @@ func (s *SSH) newConn(ctx context.Context, clientConn net.Conn) error {
 
 	accessLog.Print("authentication succeeded")
 
+	// the grant may have been revoked since the password was issued
+	ea, err := s.activeElevatedAccess(ctx, portalDoc.Portal.SSH.ElevatedAccessID)
+	if err != nil {
+		return err
+	}
+	if ea == nil {
+		accessLog.Warn("elevated access is not active")
+		return nil
+	}
+
 	openShiftDoc, err := s.dbOpenShiftClusters.Get(ctx, strings.ToLower(portalDoc.Portal.ID))
 	if err != nil {
 		return err
@@ -190,15 +206,46 @@ func (s *SSH) newConn(ctx context.Context, clientConn net.Conn) error {
 	}
 
 	// Proxy channels and requests between the two connections.
-	return s.proxyConn(ctx, accessLog, keyring, upstreamConn, downstreamConn, upstreamNewChannels, downstreamNewChannels, upstreamRequests, downstreamRequests)
+	return s.proxyConn(ctx, accessLog, keyring, portalDoc.Portal.SSH.ElevatedAccessID, *ea.ExpiresAt, upstreamConn, downstreamConn, upstreamNewChannels, downstreamNewChannels, upstreamRequests, downstreamRequests)
+}
+
+// activeElevatedAccess returns the elevated access grant id, or nil if it is
+// no longer active
+func (s *SSH) activeElevatedAccess(ctx context.Context, id string) (*api.ElevatedAccess, error) {
+	if id == "" {
+		return nil, nil
+	}
+
+	doc, err := s.dbPortal.Get(ctx, id)
+	if cosmosdb.IsErrorStatusCode(err, http.StatusNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if doc.Portal == nil || doc.Portal.ElevatedAccess == nil || !doc.Portal.ElevatedAccess.IsActive(time.Now()) {
+		return nil, nil
+	}
+
+	return doc.Portal.ElevatedAccess, nil
 }
 
 // proxyConn handles incoming new channel and administrative requests.  It calls
-// newChannel to handle new channels, each on a new goroutine.
-func (s *SSH) proxyConn(ctx context.Context, accessLog *logrus.Entry, keyring agent.Agent, upstreamConn, downstreamConn cryptossh.Conn, upstreamNewChannels, downstreamNewChannels <-chan cryptossh.NewChannel, upstreamRequests, downstreamRequests <-chan *cryptossh.Request) error {
-	timer := time.NewTimer(sshTimeout)
+// newChannel to handle new channels, each on a new goroutine.  The connection
+// is closed when the elevated access grant expires or is revoked.
+func (s *SSH) proxyConn(ctx context.Context, accessLog *logrus.Entry, keyring agent.Agent, elevatedAccessID string, expiresAt time.Time, upstreamConn, downstreamConn cryptossh.Conn, upstreamNewChannels, downstreamNewChannels <-chan cryptossh.NewChannel, upstreamRequests, downstreamRequests <-chan *cryptossh.Request) error {
+	timeout := sshTimeout
+	if d := time.Until(expiresAt); d < timeout {
+		timeout = d
+	}
+
+	timer := time.NewTimer(timeout)
 	defer timer.Stop()
 
+	grantTicker := time.NewTicker(grantCheckInterval)
+	defer grantTicker.Stop()
+
 	var sessionOpened bool
 
 	for {
@@ -206,6 +253,18 @@ func (s *SSH) proxyConn(ctx context.Context, accessLog *logrus.Entry, keyring ag
 		case <-timer.C:
 			return nil
 
+		case <-grantTicker.C:
+			ea, err := s.activeElevatedAccess(ctx, elevatedAccessID)
+			if err != nil {
+				// don't drop the connection on a transient database error
+				accessLog.Warn(err)
+				continue
+			}
+			if ea == nil {
+				accessLog.Print("elevated access revoked")
+				return nil
+			}
+
 		case nc := <-upstreamNewChannels:
 			if nc == nil {
 				return nil
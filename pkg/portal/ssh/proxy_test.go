@@ -13,6 +13,7 @@ import (
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/gorilla/mux"
@@ -138,6 +139,7 @@ func TestProxy(t *testing.T) {
 	ctx := context.Background()
 	username := "test"
 	password := "00000000-0000-0000-0000-000000000000"
+	elevatedAccessID := "20000000-0000-0000-0000-000000000000"
 	subscriptionID := "10000000-0000-0000-0000-000000000000"
 	resourceGroup := "rg"
 	resourceName := "cluster"
@@ -182,7 +184,23 @@ func TestProxy(t *testing.T) {
 				ID:       resourceID,
 				Username: username,
 				SSH: &api.SSH{
-					Master: 1,
+					Master:           1,
+					ElevatedAccessID: elevatedAccessID,
+				},
+			},
+		}
+	}
+
+	goodElevatedAccessDocument := func() *api.PortalDocument {
+		expiresAt := time.Now().Add(time.Hour)
+		return &api.PortalDocument{
+			ID: elevatedAccessID,
+			Portal: &api.Portal{
+				ID:       resourceID,
+				Username: username,
+				ElevatedAccess: &api.ElevatedAccess{
+					State:     api.ElevatedAccessStateApproved,
+					ExpiresAt: &expiresAt,
 				},
 			},
 		}
@@ -204,6 +222,8 @@ func TestProxy(t *testing.T) {
 			username: username,
 			password: password,
 			fixtureChecker: func(tt *test, fixture *testdatabase.Fixture, checker *testdatabase.Checker, openShiftClustersClient *cosmosdb.FakeOpenShiftClusterDocumentClient, portalClient *cosmosdb.FakePortalDocumentClient) {
+				elevatedAccessDocument := goodElevatedAccessDocument()
+				fixture.AddPortalDocuments(elevatedAccessDocument)
 				portalDocument := goodPortalDocument(tt.password)
 				fixture.AddPortalDocuments(portalDocument)
 				openShiftClusterDocument := goodOpenShiftClusterDocument()
@@ -211,6 +231,7 @@ func TestProxy(t *testing.T) {
 				portalDocument = goodPortalDocument(tt.password)
 				portalDocument.Portal.SSH.Authenticated = true
 				checker.AddPortalDocuments(portalDocument)
+				checker.AddPortalDocuments(elevatedAccessDocument)
 				checker.AddOpenShiftClusterDocuments(openShiftClusterDocument)
 			},
 			mocks: func(dialer *mock_proxy.MockDialer) {
@@ -318,11 +339,14 @@ func TestProxy(t *testing.T) {
 			username: username,
 			password: password,
 			fixtureChecker: func(tt *test, fixture *testdatabase.Fixture, checker *testdatabase.Checker, openShiftClustersClient *cosmosdb.FakeOpenShiftClusterDocumentClient, portalClient *cosmosdb.FakePortalDocumentClient) {
+				elevatedAccessDocument := goodElevatedAccessDocument()
+				fixture.AddPortalDocuments(elevatedAccessDocument)
 				portalDocument := goodPortalDocument(tt.password)
 				fixture.AddPortalDocuments(portalDocument)
 				portalDocument = goodPortalDocument(tt.password)
 				portalDocument.Portal.SSH.Authenticated = true
 				checker.AddPortalDocuments(portalDocument)
+				checker.AddPortalDocuments(elevatedAccessDocument)
 
 				openShiftClustersClient.SetError(fmt.Errorf("sad"))
 			},
@@ -336,6 +360,36 @@ func TestProxy(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:     "revoked elevated access",
+			username: username,
+			password: password,
+			fixtureChecker: func(tt *test, fixture *testdatabase.Fixture, checker *testdatabase.Checker, openShiftClustersClient *cosmosdb.FakeOpenShiftClusterDocumentClient, portalClient *cosmosdb.FakePortalDocumentClient) {
+				elevatedAccessDocument := goodElevatedAccessDocument()
+				elevatedAccessDocument.Portal.ElevatedAccess.State = api.ElevatedAccessStateRevoked
+				fixture.AddPortalDocuments(elevatedAccessDocument)
+				portalDocument := goodPortalDocument(tt.password)
+				fixture.AddPortalDocuments(portalDocument)
+				portalDocument = goodPortalDocument(tt.password)
+				portalDocument.Portal.SSH.Authenticated = true
+				checker.AddPortalDocuments(portalDocument)
+				checker.AddPortalDocuments(elevatedAccessDocument)
+			},
+			wantErrPrefix: "EOF",
+			wantLogs: []map[string]types.GomegaMatcher{
+				{
+					"level":       gomega.Equal(logrus.InfoLevel),
+					"msg":         gomega.Equal("authentication succeeded"),
+					"remote_addr": gomega.Not(gomega.BeEmpty()),
+					"username":    gomega.Equal(username),
+				},
+				{
+					"level":    gomega.Equal(logrus.WarnLevel),
+					"msg":      gomega.Equal("elevated access is not active"),
+					"username": gomega.Equal(username),
+				},
+			},
+		},
 		{
 			name:     "sad portal database",
 			username: username,
@@ -358,6 +412,8 @@ func TestProxy(t *testing.T) {
 			username: username,
 			password: password,
 			fixtureChecker: func(tt *test, fixture *testdatabase.Fixture, checker *testdatabase.Checker, openShiftClustersClient *cosmosdb.FakeOpenShiftClusterDocumentClient, portalClient *cosmosdb.FakePortalDocumentClient) {
+				elevatedAccessDocument := goodElevatedAccessDocument()
+				fixture.AddPortalDocuments(elevatedAccessDocument)
 				portalDocument := goodPortalDocument(tt.password)
 				fixture.AddPortalDocuments(portalDocument)
 				openShiftClusterDocument := goodOpenShiftClusterDocument()
@@ -365,6 +421,7 @@ func TestProxy(t *testing.T) {
 				portalDocument = goodPortalDocument(tt.password)
 				portalDocument.Portal.SSH.Authenticated = true
 				checker.AddPortalDocuments(portalDocument)
+				checker.AddPortalDocuments(elevatedAccessDocument)
 				checker.AddOpenShiftClusterDocuments(openShiftClusterDocument)
 			},
 			mocks: func(dialer *mock_proxy.MockDialer) {
@@ -454,3 +511,136 @@ func TestProxy(t *testing.T) {
 		})
 	}
 }
+
+func TestProxyClosesRevokedConnection(t *testing.T) {
+	ctx := context.Background()
+	resourceID := "/subscriptions/10000000-0000-0000-0000-000000000000/resourcegroups/rg/providers/microsoft.redhatopenshift/openshiftclusters/cluster"
+	password := "00000000-0000-0000-0000-000000000000"
+	elevatedAccessID := "20000000-0000-0000-0000-000000000000"
+
+	defer func(interval time.Duration) { grantCheckInterval = interval }(grantCheckInterval)
+	grantCheckInterval = 10 * time.Millisecond
+
+	hostKey, _, err := utiltls.GenerateKeyAndCertificate("proxy", nil, nil, false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	clusterKey, _, err := utiltls.GenerateKeyAndCertificate("cluster", nil, nil, false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	l, err := fakeServer(&clusterKey.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	dbPortal, _ := testdatabase.NewFakePortal()
+	dbOpenShiftClusters, _ := testdatabase.NewFakeOpenShiftClusters()
+
+	expiresAt := time.Now().Add(time.Hour)
+	fixture := testdatabase.NewFixture().
+		WithOpenShiftClusters(dbOpenShiftClusters).
+		WithPortal(dbPortal)
+	fixture.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+		ID:  resourceID,
+		Key: resourceID,
+		OpenShiftCluster: &api.OpenShiftCluster{
+			Properties: api.OpenShiftClusterProperties{
+				NetworkProfile: api.NetworkProfile{
+					APIServerPrivateEndpointIP: "1.2.3.4",
+				},
+				SSHKey: api.SecureBytes(x509.MarshalPKCS1PrivateKey(clusterKey)),
+			},
+		},
+	})
+	fixture.AddPortalDocuments(&api.PortalDocument{
+		ID: password,
+		Portal: &api.Portal{
+			ID:       resourceID,
+			Username: "test",
+			SSH: &api.SSH{
+				ElevatedAccessID: elevatedAccessID,
+			},
+		},
+	}, &api.PortalDocument{
+		ID: elevatedAccessID,
+		Portal: &api.Portal{
+			ID:       resourceID,
+			Username: "test",
+			ElevatedAccess: &api.ElevatedAccess{
+				State:     api.ElevatedAccessStateApproved,
+				ExpiresAt: &expiresAt,
+			},
+		},
+	})
+	err = fixture.Create()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dialer := mock_proxy.NewMockDialer(ctrl)
+	dialer.EXPECT().DialContext(gomock.Any(), "tcp", "1.2.3.4:2200").Return(l.DialContext(ctx, "", ""))
+
+	hook, log := testlog.New()
+
+	s, err := New(nil, nil, log, nil, hostKey, nil, dbOpenShiftClusters, dbPortal, dialer)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client, client1 := bufferedpipe.New()
+
+	done := make(chan struct{})
+	go func() {
+		_ = s.newConn(ctx, client1)
+		close(done)
+	}()
+
+	publicKey, err := cryptossh.NewPublicKey(&hostKey.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	conn, _, _, err := cryptossh.NewClientConn(client, "", &cryptossh.ClientConfig{
+		HostKeyCallback: cryptossh.FixedHostKey(publicKey),
+		User:            "test",
+		Auth: []cryptossh.AuthMethod{
+			cryptossh.Password(password),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = dbPortal.Patch(ctx, elevatedAccessID, func(doc *api.PortalDocument) error {
+		doc.Portal.ElevatedAccess.State = api.ElevatedAccessStateRevoked
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("connection was not closed")
+	}
+
+	_ = conn.Wait()
+
+	found := false
+	for _, e := range hook.AllEntries() {
+		if e.Message == "elevated access revoked" {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("revocation was not logged")
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/Azure/ARO-RP/pkg/api/validate"
 	"github.com/Azure/ARO-RP/pkg/database"
 	"github.com/Azure/ARO-RP/pkg/env"
+	"github.com/Azure/ARO-RP/pkg/portal/elevatedaccess"
 	"github.com/Azure/ARO-RP/pkg/portal/middleware"
 	"github.com/Azure/ARO-RP/pkg/proxy"
 )
@@ -138,6 +139,16 @@ func (s *SSH) New(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	grant, err := elevatedaccess.Active(ctx, s.dbPortal, ctx.Value(middleware.ContextKeyUsername).(string), resourceID, time.Now())
+	if err != nil {
+		s.internalServerError(w, err)
+		return
+	}
+	if grant == nil {
+		s.sendResponse(w, "", "", "", "An approved elevated access grant to this cluster is required.", s.env.IsLocalDevelopmentMode())
+		return
+	}
+
 	username := r.Context().Value(middleware.ContextKeyUsername).(string)
 	username = strings.SplitN(username, "@", 2)[0]
 
@@ -149,7 +160,8 @@ func (s *SSH) New(w http.ResponseWriter, r *http.Request) {
 			Username: ctx.Value(middleware.ContextKeyUsername).(string),
 			ID:       resourceID,
 			SSH: &api.SSH{
-				Master: req.Master,
+				Master:           req.Master,
+				ElevatedAccessID: grant.ID,
 			},
 		},
 	}
@@ -11,6 +11,7 @@ import (
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/gorilla/mux"
@@ -33,6 +34,19 @@ func TestNew(t *testing.T) {
 	username := "username"
 	password := "03030303-0303-0303-0303-030303030001"
 	master := 0
+	expiresAt := time.Date(2099, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	grant := &api.PortalDocument{
+		ID: "00000000-0000-0000-0000-00000000000a",
+		Portal: &api.Portal{
+			Username: username,
+			ID:       resourceID,
+			ElevatedAccess: &api.ElevatedAccess{
+				State:     api.ElevatedAccessStateApproved,
+				ExpiresAt: &expiresAt,
+			},
+		},
+	}
 
 	hostKey, _, err := utiltls.GenerateKeyAndCertificate("proxy", nil, nil, false, false)
 	if err != nil {
@@ -47,21 +61,23 @@ func TestNew(t *testing.T) {
 	for _, tt := range []struct {
 		name           string
 		r              func(*http.Request)
-		checker        func(*testdatabase.Checker, *cosmosdb.FakePortalDocumentClient)
+		fixtureChecker func(*testdatabase.Fixture, *testdatabase.Checker, *cosmosdb.FakePortalDocumentClient)
 		wantStatusCode int
 		wantBody       string
 	}{
 		{
 			name: "success",
-			checker: func(checker *testdatabase.Checker, portalClient *cosmosdb.FakePortalDocumentClient) {
-				checker.AddPortalDocuments(&api.PortalDocument{
+			fixtureChecker: func(fixture *testdatabase.Fixture, checker *testdatabase.Checker, portalClient *cosmosdb.FakePortalDocumentClient) {
+				fixture.AddPortalDocuments(grant)
+				checker.AddPortalDocuments(grant, &api.PortalDocument{
 					ID:  password,
 					TTL: 60,
 					Portal: &api.Portal{
 						Username: username,
 						ID:       resourceID,
 						SSH: &api.SSH{
-							Master: master,
+							Master:           master,
+							ElevatedAccessID: grant.ID,
 						},
 					},
 				})
@@ -113,9 +129,14 @@ func TestNew(t *testing.T) {
 			wantStatusCode: http.StatusOK,
 			wantBody:       "{\n    \"error\": \"Elevated access is required.\"\n}\n",
 		},
+		{
+			name:           "no elevated access grant",
+			wantStatusCode: http.StatusOK,
+			wantBody:       "{\n    \"error\": \"An approved elevated access grant to this cluster is required.\"\n}\n",
+		},
 		{
 			name: "sad database",
-			checker: func(checker *testdatabase.Checker, portalClient *cosmosdb.FakePortalDocumentClient) {
+			fixtureChecker: func(fixture *testdatabase.Fixture, checker *testdatabase.Checker, portalClient *cosmosdb.FakePortalDocumentClient) {
 				portalClient.SetError(fmt.Errorf("sad"))
 			},
 			wantStatusCode: http.StatusInternalServerError,
@@ -127,10 +148,18 @@ func TestNew(t *testing.T) {
 
 			dbPortal, portalClient := testdatabase.NewFakePortal()
 
+			fixture := testdatabase.NewFixture().
+				WithPortal(dbPortal)
+
 			checker := testdatabase.NewChecker()
 
-			if tt.checker != nil {
-				tt.checker(checker, portalClient)
+			if tt.fixtureChecker != nil {
+				tt.fixtureChecker(fixture, checker, portalClient)
+			}
+
+			err := fixture.Create()
+			if err != nil {
+				t.Fatal(err)
 			}
 
 			ctx = context.WithValue(ctx, middleware.ContextKeyUsername, username)
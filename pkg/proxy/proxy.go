@@ -122,12 +122,13 @@ func (s Server) validateProxyRequest(w http.ResponseWriter, r *http.Request) err
 // Proxy takes an HTTP/1.x CONNECT Request and ResponseWriter from the Golang
 // HTTP stack and uses Hijack() to get the underlying Connection (c1).  It dials
 // a second Connection (c2) to the requested end Host and then copies data in
-// both directions (c1->c2 and c2->c1).
-func Proxy(log *logrus.Entry, w http.ResponseWriter, r *http.Request, sz int) {
+// both directions (c1->c2 and c2->c1).  It returns the number of bytes copied
+// in each direction.
+func Proxy(log *logrus.Entry, w http.ResponseWriter, r *http.Request, sz int) (bytesIn, bytesOut int64) {
 	c2, err := utilnet.Dial("tcp", r.Host, sz)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
-		return
+		return 0, 0
 	}
 
 	defer c2.Close()
@@ -135,7 +136,7 @@ func Proxy(log *logrus.Entry, w http.ResponseWriter, r *http.Request, sz int) {
 	hijacker, ok := w.(http.Hijacker)
 	if !ok {
 		http.Error(w, "hijacking not supported", http.StatusInternalServerError)
-		return
+		return 0, 0
 	}
 
 	// Do as much setup as possible before calling Hijack(), because after
@@ -147,7 +148,7 @@ func Proxy(log *logrus.Entry, w http.ResponseWriter, r *http.Request, sz int) {
 	c1, buf, err := hijacker.Hijack()
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
+		return 0, 0
 	}
 
 	defer c1.Close()
@@ -168,7 +169,7 @@ func Proxy(log *logrus.Entry, w http.ResponseWriter, r *http.Request, sz int) {
 				conn2.CloseWrite()
 			}
 		}()
-		_, _ = io.Copy(c2, buf)
+		bytesIn, _ = io.Copy(c2, buf)
 	}()
 
 	// copy from c2->c1.  Call c1.CloseWrite() when done.
@@ -178,5 +179,9 @@ func Proxy(log *logrus.Entry, w http.ResponseWriter, r *http.Request, sz int) {
 			closeWriter.CloseWrite()
 		}
 	}()
-	_, _ = io.Copy(c1, c2)
+	bytesOut, _ = io.Copy(c1, c2)
+
+	// bytesIn is set by the c1->c2 goroutine, which the deferred wg.Wait()
+	// waits for before Proxy returns; don't read it here
+	return
 }
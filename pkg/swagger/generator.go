@@ -15,6 +15,7 @@ import (
 	v20230701preview "github.com/Azure/ARO-RP/pkg/api/v20230701preview"
 	v20230904 "github.com/Azure/ARO-RP/pkg/api/v20230904"
 	v20231122 "github.com/Azure/ARO-RP/pkg/api/v20231122"
+	v20240101preview "github.com/Azure/ARO-RP/pkg/api/v20240101preview"
 )
 
 const apiv20200430Path = "github.com/Azure/ARO-RP/pkg/api/v20200430"
@@ -25,6 +26,7 @@ const apiv20230401Path = "github.com/Azure/ARO-RP/pkg/api/v20230401"
 const apiv20230701previewPath = "github.com/Azure/ARO-RP/pkg/api/v20230701preview"
 const apiv20230904Path = "github.com/Azure/ARO-RP/pkg/api/v20230904"
 const apiv20231122Path = "github.com/Azure/ARO-RP/pkg/api/v20231122"
+const apiv20240101previewPath = "github.com/Azure/ARO-RP/pkg/api/v20240101preview"
 
 type generator struct {
 	exampleSyncSetPutParameter                     func() interface{}
@@ -287,6 +289,43 @@ var apis = map[string]*generator{
 		kubeConfig:           true,
 		workerProfilesStatus: true,
 	},
+	apiv20240101previewPath: {
+		exampleSyncSetPutParameter:                     v20240101preview.ExampleSyncSetPutParameter,
+		exampleSyncSetPatchParameter:                   v20240101preview.ExampleSyncSetPatchParameter,
+		exampleSyncSetResponse:                         v20240101preview.ExampleSyncSetResponse,
+		exampleSyncSetListResponse:                     v20240101preview.ExampleSyncSetListResponse,
+		exampleMachinePoolPutParameter:                 v20240101preview.ExampleMachinePoolPutParameter,
+		exampleMachinePoolPatchParameter:               v20240101preview.ExampleMachinePoolPatchParameter,
+		exampleMachinePoolResponse:                     v20240101preview.ExampleMachinePoolResponse,
+		exampleMachinePoolListResponse:                 v20240101preview.ExampleMachinePoolListResponse,
+		exampleSyncIdentityProviderPutParameter:        v20240101preview.ExampleSyncIdentityProviderPutParameter,
+		exampleSyncIdentityProviderPatchParameter:      v20240101preview.ExampleSyncIdentityProviderPatchParameter,
+		exampleSyncIdentityProviderResponse:            v20240101preview.ExampleSyncIdentityProviderResponse,
+		exampleSyncIdentityProviderListResponse:        v20240101preview.ExampleSyncIdentityProviderListResponse,
+		exampleSecretPutParameter:                      v20240101preview.ExampleSecretPutParameter,
+		exampleSecretPatchParameter:                    v20240101preview.ExampleSecretPatchParameter,
+		exampleSecretResponse:                          v20240101preview.ExampleSecretResponse,
+		exampleSecretListResponse:                      v20240101preview.ExampleSecretListResponse,
+		exampleOpenShiftClusterPutParameter:            v20240101preview.ExampleOpenShiftClusterPutParameter,
+		exampleOpenShiftClusterPatchParameter:          v20240101preview.ExampleOpenShiftClusterPatchParameter,
+		exampleOpenShiftClusterGetResponse:             v20240101preview.ExampleOpenShiftClusterGetResponse,
+		exampleOpenShiftClusterPutOrPatchResponse:      v20240101preview.ExampleOpenShiftClusterPutOrPatchResponse,
+		exampleOpenShiftClusterCredentialsResponse:     v20240101preview.ExampleOpenShiftClusterCredentialsResponse,
+		exampleOpenShiftClusterListResponse:            v20240101preview.ExampleOpenShiftClusterListResponse,
+		exampleOpenShiftClusterAdminKubeconfigResponse: v20240101preview.ExampleOpenShiftClusterAdminKubeconfigResponse,
+		exampleOpenShiftVersionListResponse:            v20240101preview.ExampleOpenShiftVersionListResponse,
+		exampleOperationListResponse:                   api.ExampleOperationListResponse,
+
+		xmsEnum:              []string{"EncryptionAtHost", "FipsValidatedModules", "SoftwareDefinedNetwork", "Visibility", "OutboundType", "ScaleDownState", "AutoscalerState", "CertificateSourceType"},
+		xmsSecretList:        []string{"kubeconfig", "kubeadminPassword", "secretResources"},
+		xmsIdentifiers:       []string{},
+		commonTypesVersion:   "v3",
+		systemData:           true,
+		clusterManager:       true,
+		installVersionList:   true,
+		kubeConfig:           true,
+		workerProfilesStatus: true,
+	},
 }
 
 func New(api string) (*generator, error) {
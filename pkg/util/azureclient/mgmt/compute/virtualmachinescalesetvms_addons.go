@@ -5,6 +5,7 @@ package compute
 
 import (
 	"context"
+	"strings"
 
 	mgmtcompute "github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-06-01/compute"
 )
@@ -12,6 +13,7 @@ import (
 type VirtualMachineScaleSetVMsClientAddons interface {
 	List(ctx context.Context, resourceGroupName string, virtualMachineScaleSetName string, filter string, selectParameter string, expand string) ([]mgmtcompute.VirtualMachineScaleSetVM, error)
 	RunCommandAndWait(ctx context.Context, resourceGroupName string, VMScaleSetName string, instanceID string, parameters mgmtcompute.RunCommandInput) error
+	RunCommandAndGetOutput(ctx context.Context, resourceGroupName string, VMScaleSetName string, instanceID string, parameters mgmtcompute.RunCommandInput) (string, error)
 }
 
 func (c *virtualMachineScaleSetVMsClient) RunCommandAndWait(ctx context.Context, resourceGroupName string, VMScaleSetName string, instanceID string, parameters mgmtcompute.RunCommandInput) error {
@@ -23,6 +25,36 @@ func (c *virtualMachineScaleSetVMsClient) RunCommandAndWait(ctx context.Context,
 	return future.WaitForCompletionRef(ctx, c.VirtualMachineScaleSetVMsClient.Client)
 }
 
+// RunCommandAndGetOutput runs a command on a scaleset instance and returns the
+// messages (stdout and stderr) it reported
+func (c *virtualMachineScaleSetVMsClient) RunCommandAndGetOutput(ctx context.Context, resourceGroupName string, VMScaleSetName string, instanceID string, parameters mgmtcompute.RunCommandInput) (string, error) {
+	future, err := c.VirtualMachineScaleSetVMsClient.RunCommand(ctx, resourceGroupName, VMScaleSetName, instanceID, parameters)
+	if err != nil {
+		return "", err
+	}
+
+	err = future.WaitForCompletionRef(ctx, c.VirtualMachineScaleSetVMsClient.Client)
+	if err != nil {
+		return "", err
+	}
+
+	result, err := future.Result(c.VirtualMachineScaleSetVMsClient)
+	if err != nil {
+		return "", err
+	}
+
+	var messages []string
+	if result.Value != nil {
+		for _, status := range *result.Value {
+			if status.Message != nil {
+				messages = append(messages, *status.Message)
+			}
+		}
+	}
+
+	return strings.Join(messages, "\n"), nil
+}
+
 func (c *virtualMachineScaleSetVMsClient) List(ctx context.Context, resourceGroupName string, virtualMachineScaleSetName string, filter string, selectParameter string, expand string) ([]mgmtcompute.VirtualMachineScaleSetVM, error) {
 	var scaleSetsVMs []mgmtcompute.VirtualMachineScaleSetVM
 	result, err := c.VirtualMachineScaleSetVMsClient.List(ctx, resourceGroupName, virtualMachineScaleSetName, filter, selectParameter, expand)
@@ -86,7 +86,7 @@ func storageClient(env env.Interface, billing database.Billing, sub database.Sub
 
 func (m *manager) Ensure(ctx context.Context, doc *api.OpenShiftClusterDocument, sub *api.SubscriptionDocument) error {
 	billingDoc, err := m.billingDB.Create(ctx, &api.BillingDocument{
-		ID:                        doc.ID,
+		ID:                        doc.BillingDocumentID(),
 		Key:                       doc.Key,
 		ClusterResourceGroupIDKey: doc.ClusterResourceGroupIDKey,
 		InfraID:                   doc.OpenShiftCluster.Properties.InfraID,
@@ -113,7 +113,7 @@ func (m *manager) Ensure(ctx context.Context, doc *api.OpenShiftClusterDocument,
 
 func (m *manager) Delete(ctx context.Context, doc *api.OpenShiftClusterDocument) error {
 	m.log.Printf("updating billing record with deletion time")
-	billingDoc, err := m.billingDB.MarkForDeletion(ctx, doc.ID)
+	billingDoc, err := m.billingDB.MarkForDeletion(ctx, doc.BillingDocumentID())
 	if cosmosdb.IsErrorStatusCode(err, http.StatusNotFound) {
 		return nil
 	}
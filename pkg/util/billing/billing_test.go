@@ -118,14 +118,16 @@ func TestDelete(t *testing.T) {
 	ctx := context.Background()
 
 	const (
-		docID    = "00000000-0000-0000-0000-000000000000"
-		subID    = "11111111-1111-1111-1111-111111111111"
-		tenantID = "22222222-2222-2222-2222-222222222222"
-		location = "eastus"
+		docID     = "00000000-0000-0000-0000-000000000000"
+		subID     = "11111111-1111-1111-1111-111111111111"
+		tenantID  = "22222222-2222-2222-2222-222222222222"
+		location  = "eastus"
+		billingID = "33333333-3333-3333-3333-333333333333"
 	)
 
 	type test struct {
 		name          string
+		billingID     string
 		fixture       func(*testdatabase.Fixture)
 		wantDocuments func(*testdatabase.Checker)
 		dbError       error
@@ -162,6 +164,51 @@ func TestDelete(t *testing.T) {
 				})
 			},
 		},
+		{
+			name:      "mark for deletion on the billing entity of a resumed cluster",
+			billingID: billingID,
+			fixture: func(f *testdatabase.Fixture) {
+				f.AddBillingDocuments(&api.BillingDocument{
+					Key:                       strings.ToLower(testdatabase.GetResourcePath(subID, "resourceName")),
+					ClusterResourceGroupIDKey: fmt.Sprintf("/subscriptions/%s/resourcegroups/resourceGroup", subID),
+					ID:                        docID,
+					Billing: &api.Billing{
+						TenantID:     tenantID,
+						Location:     location,
+						DeletionTime: 1,
+					},
+				}, &api.BillingDocument{
+					Key:                       strings.ToLower(testdatabase.GetResourcePath(subID, "resourceName")),
+					ClusterResourceGroupIDKey: fmt.Sprintf("/subscriptions/%s/resourcegroups/resourceGroup", subID),
+					ID:                        billingID,
+					Billing: &api.Billing{
+						TenantID: tenantID,
+						Location: location,
+					},
+				})
+			},
+			wantDocuments: func(c *testdatabase.Checker) {
+				c.AddBillingDocuments(&api.BillingDocument{
+					Key:                       strings.ToLower(testdatabase.GetResourcePath(subID, "resourceName")),
+					ClusterResourceGroupIDKey: fmt.Sprintf("/subscriptions/%s/resourcegroups/resourceGroup", subID),
+					ID:                        docID,
+					Billing: &api.Billing{
+						TenantID:     tenantID,
+						Location:     location,
+						DeletionTime: 1,
+					},
+				}, &api.BillingDocument{
+					Key:                       strings.ToLower(testdatabase.GetResourcePath(subID, "resourceName")),
+					ClusterResourceGroupIDKey: fmt.Sprintf("/subscriptions/%s/resourcegroups/resourceGroup", subID),
+					ID:                        billingID,
+					Billing: &api.Billing{
+						TenantID:     tenantID,
+						Location:     location,
+						DeletionTime: 1,
+					},
+				})
+			},
+		},
 		{
 			name: "no error on mark for deletion on billing entry that is not found",
 			fixture: func(f *testdatabase.Fixture) {
@@ -223,7 +270,7 @@ func TestDelete(t *testing.T) {
 				subDB:     subscriptionsDatabase,
 			}
 
-			err = m.Delete(ctx, &api.OpenShiftClusterDocument{ID: docID})
+			err = m.Delete(ctx, &api.OpenShiftClusterDocument{ID: docID, BillingID: tt.billingID})
 			utilerror.AssertErrorMessage(t, err, tt.wantErr)
 
 			if tt.wantDocuments != nil {
@@ -247,6 +294,7 @@ func TestEnsure(t *testing.T) {
 		tenantID    = "22222222-2222-2222-2222-222222222222"
 		mockInfraID = "infra"
 		location    = "eastus"
+		billingID   = "33333333-3333-3333-3333-333333333333"
 	)
 
 	type test struct {
@@ -303,6 +351,64 @@ func TestEnsure(t *testing.T) {
 				})
 			},
 		},
+		{
+			name: "create a new billing entry for a resumed cluster, keeping the closed one",
+			fixture: func(f *testdatabase.Fixture) {
+				f.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+					Key:                       strings.ToLower(testdatabase.GetResourcePath(subID, "resourceName")),
+					ClusterResourceGroupIDKey: fmt.Sprintf("/subscriptions/%s/resourcegroups/resourceGroup", subID),
+					ID:                        docID,
+					BillingID:                 billingID,
+					OpenShiftCluster: &api.OpenShiftCluster{
+						Properties: api.OpenShiftClusterProperties{
+							InfraID: mockInfraID,
+						},
+						Location: location,
+					},
+				})
+				f.AddSubscriptionDocuments(&api.SubscriptionDocument{
+					ID: subID,
+					Subscription: &api.Subscription{
+						Properties: &api.SubscriptionProperties{
+							TenantID: tenantID,
+						},
+					},
+				})
+				f.AddBillingDocuments(&api.BillingDocument{
+					Key:                       strings.ToLower(testdatabase.GetResourcePath(subID, "resourceName")),
+					ClusterResourceGroupIDKey: fmt.Sprintf("/subscriptions/%s/resourcegroups/resourceGroup", subID),
+					ID:                        docID,
+					Billing: &api.Billing{
+						TenantID:     tenantID,
+						Location:     location,
+						DeletionTime: 1,
+					},
+					InfraID: mockInfraID,
+				})
+			},
+			wantDocuments: func(c *testdatabase.Checker) {
+				c.AddBillingDocuments(&api.BillingDocument{
+					Key:                       strings.ToLower(testdatabase.GetResourcePath(subID, "resourceName")),
+					ClusterResourceGroupIDKey: fmt.Sprintf("/subscriptions/%s/resourcegroups/resourceGroup", subID),
+					ID:                        docID,
+					Billing: &api.Billing{
+						TenantID:     tenantID,
+						Location:     location,
+						DeletionTime: 1,
+					},
+					InfraID: mockInfraID,
+				}, &api.BillingDocument{
+					Key:                       strings.ToLower(testdatabase.GetResourcePath(subID, "resourceName")),
+					ClusterResourceGroupIDKey: fmt.Sprintf("/subscriptions/%s/resourcegroups/resourceGroup", subID),
+					ID:                        billingID,
+					Billing: &api.Billing{
+						TenantID: tenantID,
+						Location: location,
+					},
+					InfraID: mockInfraID,
+				})
+			},
+		},
 		{
 			name: "error on create a new billing entry",
 			fixture: func(f *testdatabase.Fixture) {
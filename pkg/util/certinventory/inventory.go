@@ -0,0 +1,216 @@
+package certinventory
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/operator"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/certificates"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/genevalogging"
+	utilpem "github.com/Azure/ARO-RP/pkg/util/pem"
+	"github.com/Azure/ARO-RP/pkg/util/version"
+)
+
+// leadTimes is how long before expiry certificates with the RP and Manual
+// renewal policies are due for renewal
+var leadTimes = map[api.CertificateKind]time.Duration{
+	// the RP copies these from its key vaults, which renew them well
+	// ahead of expiry
+	api.CertificateKindAPIServer: 30 * 24 * time.Hour,
+	api.CertificateKindIngress:   30 * 24 * time.Hour,
+
+	// the RP only reads the MDSD certificate from its key vault at startup,
+	// so an SRE renews it once the RPs were restarted with the new one
+	api.CertificateKindMDSD: 30 * 24 * time.Hour,
+
+	// the RP generates the MCS certificate with a ten year validity
+	api.CertificateKindMachineConfigServer: 180 * 24 * time.Hour,
+
+	// etcd certificates of clusters before 4.9 are renewed by an SRE, so
+	// leave time to schedule the renewal
+	api.CertificateKindEtcd: 90 * 24 * time.Hour,
+}
+
+// RenewalDue returns true if a certificate of the given kind is due for
+// renewal
+func RenewalDue(kind api.CertificateKind, cert *x509.Certificate, now time.Time) bool {
+	return !now.Before(cert.NotAfter.Add(-leadTimes[kind]))
+}
+
+// List returns the inventory of the certificates of a cluster.  Certificates
+// whose secret doesn't exist are left out, e.g. the API server and ingress
+// certificates of a cluster with a custom domain which the customer installed
+// themselves.
+//
+// The gateway has no per-cluster certificate: gateway-enabled clusters reach
+// it through a private endpoint and TLS is passed through to the
+// destination.
+func List(ctx context.Context, kubernetescli kubernetes.Interface, doc *api.OpenShiftClusterDocument) (*api.CertificateInventory, error) {
+	l := &lister{
+		kubernetescli: kubernetescli,
+		inv:           &api.CertificateInventory{},
+	}
+
+	err := l.add(ctx, api.CertificateKindMDSD, operator.Namespace, operator.SecretName, genevalogging.GenevaCertName, api.CertificateRenewalPolicyManual)
+	if err != nil {
+		return nil, err
+	}
+
+	if cp := doc.OpenShiftCluster.Properties.CertificateProfile; cp != nil {
+		if cp.APIServer != nil {
+			err = l.add(ctx, api.CertificateKindAPIServer, "openshift-config", certificates.APIServerSecretName, corev1.TLSCertKey, customRenewalPolicy(cp.APIServer))
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if cp.Ingress != nil {
+			err = l.add(ctx, api.CertificateKindIngress, "openshift-ingress", certificates.IngressSecretName, corev1.TLSCertKey, customRenewalPolicy(cp.Ingress))
+			if err != nil {
+				return nil, err
+			}
+		}
+	} else {
+		// the secrets of clusters with a managed domain, see
+		// configureAPIServerCertificate and configureIngressCertificate
+		err = l.add(ctx, api.CertificateKindAPIServer, "openshift-config", doc.ID+"-apiserver", corev1.TLSCertKey, api.CertificateRenewalPolicyRP)
+		if err != nil {
+			return nil, err
+		}
+
+		err = l.add(ctx, api.CertificateKindIngress, "openshift-ingress", doc.ID+"-ingress", corev1.TLSCertKey, api.CertificateRenewalPolicyRP)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = l.add(ctx, api.CertificateKindMachineConfigServer, "openshift-machine-config-operator", "machine-config-server-tls", corev1.TLSCertKey, api.CertificateRenewalPolicyRP)
+	if err != nil {
+		return nil, err
+	}
+
+	err = l.add(ctx, api.CertificateKindKubeletServing, "openshift-kube-controller-manager-operator", "csr-signer", corev1.TLSCertKey, api.CertificateRenewalPolicyCluster)
+	if err != nil {
+		return nil, err
+	}
+
+	err = l.addEtcd(ctx, doc.OpenShiftCluster.Properties.ClusterProfile.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	return l.inv, nil
+}
+
+// DueTime returns the earliest RenewAfter of the certificates with the RP
+// renewal policy, or nil if there are none
+func DueTime(inv *api.CertificateInventory) *time.Time {
+	var dueTime *time.Time
+	for _, c := range inv.Certificates {
+		if c.RenewalPolicy != api.CertificateRenewalPolicyRP || c.RenewAfter == nil {
+			continue
+		}
+		if dueTime == nil || c.RenewAfter.Before(*dueTime) {
+			t := c.RenewAfter.UTC()
+			dueTime = &t
+		}
+	}
+	return dueTime
+}
+
+type lister struct {
+	kubernetescli kubernetes.Interface
+	inv           *api.CertificateInventory
+}
+
+func (l *lister) add(ctx context.Context, kind api.CertificateKind, namespace, name, key string, policy api.CertificateRenewalPolicy) error {
+	secret, err := l.kubernetescli.CoreV1().Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if kerrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return l.addSecret(kind, secret, key, policy)
+}
+
+func (l *lister) addSecret(kind api.CertificateKind, secret *corev1.Secret, key string, policy api.CertificateRenewalPolicy) error {
+	cert, err := utilpem.ParseFirstCertificate(secret.Data[key])
+	if err != nil {
+		return fmt.Errorf("secret %s/%s: %w", secret.Namespace, secret.Name, err)
+	}
+
+	c := api.Certificate{
+		Kind:          kind,
+		Namespace:     secret.Namespace,
+		SecretName:    secret.Name,
+		Subject:       cert.Subject.CommonName,
+		NotAfter:      cert.NotAfter,
+		RenewalPolicy: policy,
+	}
+
+	if policy == api.CertificateRenewalPolicyRP || policy == api.CertificateRenewalPolicyManual {
+		renewAfter := cert.NotAfter.Add(-leadTimes[kind])
+		c.RenewAfter = &renewAfter
+	}
+
+	l.inv.Certificates = append(l.inv.Certificates, c)
+	return nil
+}
+
+// addEtcd adds the etcd peer and serving certificates.  These are rotated by
+// the etcd operator from 4.9; before, they are renewed through the
+// etcdcertificaterenew admin action.
+func (l *lister) addEtcd(ctx context.Context, clusterVersion string) error {
+	v, err := version.ParseVersion(clusterVersion)
+	if err != nil {
+		return err
+	}
+
+	policy := api.CertificateRenewalPolicyCluster
+	if v.Lt(version.NewVersion(4, 9)) {
+		policy = api.CertificateRenewalPolicyManual
+	}
+
+	secrets, err := l.kubernetescli.CoreV1().Secrets("openshift-etcd").List(ctx, metav1.ListOptions{FieldSelector: fmt.Sprintf("type=%s", corev1.SecretTypeTLS)})
+	if err != nil {
+		return err
+	}
+
+	for i := range secrets.Items {
+		secret := &secrets.Items[i]
+		if secret.Type != corev1.SecretTypeTLS ||
+			!(strings.Contains(secret.Name, "etcd-peer") || strings.Contains(secret.Name, "etcd-serving")) {
+			continue
+		}
+
+		err = l.addSecret(api.CertificateKindEtcd, secret, corev1.TLSCertKey, policy)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// customRenewalPolicy returns the renewal policy of a certificate provided
+// through the certificateProfile: the ARO operator renews ACME certificates
+func customRenewalPolicy(cs *api.CertificateSource) api.CertificateRenewalPolicy {
+	if cs.Type == api.CertificateSourceTypeACME {
+		return api.CertificateRenewalPolicyCluster
+	}
+	return api.CertificateRenewalPolicyCustomer
+}
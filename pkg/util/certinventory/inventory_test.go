@@ -0,0 +1,203 @@
+package certinventory
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"reflect"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/util/cmp"
+	utiltls "github.com/Azure/ARO-RP/pkg/util/tls"
+	utilerror "github.com/Azure/ARO-RP/test/util/error"
+)
+
+func TestList(t *testing.T) {
+	ctx := context.Background()
+	notAfter := time.Now().Add(365 * 24 * time.Hour).UTC().Truncate(time.Second)
+
+	secret := func(namespace, name, key string) *corev1.Secret {
+		_, certs, err := utiltls.GenerateTestKeyAndCertificate(name, nil, nil, false, false, func(template *x509.Certificate) {
+			template.NotAfter = notAfter
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+			},
+			Type: corev1.SecretTypeTLS,
+			Data: map[string][]byte{
+				key: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certs[0].Raw}),
+			},
+		}
+	}
+
+	certificate := func(kind api.CertificateKind, namespace, name string, policy api.CertificateRenewalPolicy) api.Certificate {
+		c := api.Certificate{
+			Kind:          kind,
+			Namespace:     namespace,
+			SecretName:    name,
+			Subject:       name,
+			NotAfter:      notAfter,
+			RenewalPolicy: policy,
+		}
+		if policy == api.CertificateRenewalPolicyRP || policy == api.CertificateRenewalPolicyManual {
+			renewAfter := notAfter.Add(-leadTimes[kind])
+			c.RenewAfter = &renewAfter
+		}
+		return c
+	}
+
+	clusterSecrets := []kruntime.Object{
+		secret("openshift-azure-operator", "cluster", "gcscert.pem"),
+		secret("openshift-machine-config-operator", "machine-config-server-tls", corev1.TLSCertKey),
+		secret("openshift-kube-controller-manager-operator", "csr-signer", corev1.TLSCertKey),
+		secret("openshift-etcd", "etcd-peer-master-0", corev1.TLSCertKey),
+		secret("openshift-etcd", "etcd-serving-master-0", corev1.TLSCertKey),
+		secret("openshift-etcd", "etcd-client", corev1.TLSCertKey),
+	}
+
+	for _, tt := range []struct {
+		name               string
+		version            string
+		certificateProfile *api.CertificateProfile
+		objects            []kruntime.Object
+		want               []api.Certificate
+		wantErr            string
+	}{
+		{
+			name:    "managed domain, etcd rotated by the cluster",
+			version: "4.10.20",
+			objects: append([]kruntime.Object{
+				secret("openshift-config", "00000000-0000-0000-0000-000000000000-apiserver", corev1.TLSCertKey),
+				secret("openshift-ingress", "00000000-0000-0000-0000-000000000000-ingress", corev1.TLSCertKey),
+			}, clusterSecrets...),
+			want: []api.Certificate{
+				certificate(api.CertificateKindMDSD, "openshift-azure-operator", "cluster", api.CertificateRenewalPolicyManual),
+				certificate(api.CertificateKindAPIServer, "openshift-config", "00000000-0000-0000-0000-000000000000-apiserver", api.CertificateRenewalPolicyRP),
+				certificate(api.CertificateKindIngress, "openshift-ingress", "00000000-0000-0000-0000-000000000000-ingress", api.CertificateRenewalPolicyRP),
+				certificate(api.CertificateKindMachineConfigServer, "openshift-machine-config-operator", "machine-config-server-tls", api.CertificateRenewalPolicyRP),
+				certificate(api.CertificateKindKubeletServing, "openshift-kube-controller-manager-operator", "csr-signer", api.CertificateRenewalPolicyCluster),
+				certificate(api.CertificateKindEtcd, "openshift-etcd", "etcd-peer-master-0", api.CertificateRenewalPolicyCluster),
+				certificate(api.CertificateKindEtcd, "openshift-etcd", "etcd-serving-master-0", api.CertificateRenewalPolicyCluster),
+			},
+		},
+		{
+			name:    "custom domain with customer provided certificates, etcd renewed manually",
+			version: "4.8.11",
+			certificateProfile: &api.CertificateProfile{
+				APIServer: &api.CertificateSource{Type: api.CertificateSourceTypeKeyVault},
+				Ingress:   &api.CertificateSource{Type: api.CertificateSourceTypeACME},
+			},
+			objects: append([]kruntime.Object{
+				secret("openshift-config", "aro-custom-apiserver-certificate", corev1.TLSCertKey),
+				secret("openshift-ingress", "aro-custom-ingress-certificate", corev1.TLSCertKey),
+			}, clusterSecrets...),
+			want: []api.Certificate{
+				certificate(api.CertificateKindMDSD, "openshift-azure-operator", "cluster", api.CertificateRenewalPolicyManual),
+				certificate(api.CertificateKindAPIServer, "openshift-config", "aro-custom-apiserver-certificate", api.CertificateRenewalPolicyCustomer),
+				certificate(api.CertificateKindIngress, "openshift-ingress", "aro-custom-ingress-certificate", api.CertificateRenewalPolicyCluster),
+				certificate(api.CertificateKindMachineConfigServer, "openshift-machine-config-operator", "machine-config-server-tls", api.CertificateRenewalPolicyRP),
+				certificate(api.CertificateKindKubeletServing, "openshift-kube-controller-manager-operator", "csr-signer", api.CertificateRenewalPolicyCluster),
+				certificate(api.CertificateKindEtcd, "openshift-etcd", "etcd-peer-master-0", api.CertificateRenewalPolicyManual),
+				certificate(api.CertificateKindEtcd, "openshift-etcd", "etcd-serving-master-0", api.CertificateRenewalPolicyManual),
+			},
+		},
+		{
+			name:    "custom domain with certificates installed by the customer",
+			version: "4.10.20",
+			objects: clusterSecrets,
+			want: []api.Certificate{
+				certificate(api.CertificateKindMDSD, "openshift-azure-operator", "cluster", api.CertificateRenewalPolicyManual),
+				certificate(api.CertificateKindMachineConfigServer, "openshift-machine-config-operator", "machine-config-server-tls", api.CertificateRenewalPolicyRP),
+				certificate(api.CertificateKindKubeletServing, "openshift-kube-controller-manager-operator", "csr-signer", api.CertificateRenewalPolicyCluster),
+				certificate(api.CertificateKindEtcd, "openshift-etcd", "etcd-peer-master-0", api.CertificateRenewalPolicyCluster),
+				certificate(api.CertificateKindEtcd, "openshift-etcd", "etcd-serving-master-0", api.CertificateRenewalPolicyCluster),
+			},
+		},
+		{
+			name:    "invalid certificate",
+			version: "4.10.20",
+			objects: []kruntime.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "machine-config-server-tls",
+						Namespace: "openshift-machine-config-operator",
+					},
+				},
+			},
+			wantErr: "secret openshift-machine-config-operator/machine-config-server-tls: unable to find certificate",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			doc := &api.OpenShiftClusterDocument{
+				ID: "00000000-0000-0000-0000-000000000000",
+				OpenShiftCluster: &api.OpenShiftCluster{
+					Properties: api.OpenShiftClusterProperties{
+						ClusterProfile: api.ClusterProfile{
+							Version: tt.version,
+						},
+						CertificateProfile: tt.certificateProfile,
+					},
+				},
+			}
+
+			inv, err := List(ctx, fake.NewSimpleClientset(tt.objects...), doc)
+			utilerror.AssertErrorMessage(t, err, tt.wantErr)
+
+			if inv != nil && !reflect.DeepEqual(inv.Certificates, tt.want) {
+				t.Error(cmp.Diff(inv.Certificates, tt.want))
+			}
+		})
+	}
+}
+
+func TestDueTime(t *testing.T) {
+	t1 := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	t2 := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	t0 := time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC)
+
+	for _, tt := range []struct {
+		name         string
+		certificates []api.Certificate
+		want         *time.Time
+	}{
+		{
+			name: "no RP certificates",
+			certificates: []api.Certificate{
+				{RenewalPolicy: api.CertificateRenewalPolicyCluster},
+				{RenewalPolicy: api.CertificateRenewalPolicyManual, RenewAfter: &t0},
+			},
+		},
+		{
+			name: "earliest RP certificate",
+			certificates: []api.Certificate{
+				{RenewalPolicy: api.CertificateRenewalPolicyRP, RenewAfter: &t2},
+				{RenewalPolicy: api.CertificateRenewalPolicyManual, RenewAfter: &t0},
+				{RenewalPolicy: api.CertificateRenewalPolicyRP, RenewAfter: &t1},
+			},
+			want: &t1,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got := DueTime(&api.CertificateInventory{Certificates: tt.certificates})
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, wanted %v", got, tt.want)
+			}
+		})
+	}
+}
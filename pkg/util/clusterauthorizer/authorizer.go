@@ -51,12 +51,22 @@ func NewAzRefreshableAuthorizer(log *logrus.Entry, azEnv *azureclient.AROEnviron
 }
 
 func (a *azRefreshableAuthorizer) NewRefreshableAuthorizerToken(ctx context.Context) (autorest.Authorizer, error) {
+	return a.newRefreshableAuthorizerToken(a.azureEnvironment.ResourceManagerScope)
+}
+
+// NewKeyVaultRefreshableAuthorizerToken returns a refreshable authorizer for
+// the key vault data plane rather than Azure Resource Manager
+func (a *azRefreshableAuthorizer) NewKeyVaultRefreshableAuthorizerToken(ctx context.Context) (autorest.Authorizer, error) {
+	return a.newRefreshableAuthorizerToken(a.azureEnvironment.KeyVaultScope)
+}
+
+func (a *azRefreshableAuthorizer) newRefreshableAuthorizerToken(scope string) (autorest.Authorizer, error) {
 	tokenCredential, err := a.getTokenCredential(a.azureEnvironment)
 	if err != nil {
 		return nil, api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidServicePrincipalCredentials, "properties.servicePrincipalProfile", "the provided service principal is invalid")
 	}
 
-	scopes := []string{a.azureEnvironment.ResourceManagerScope}
+	scopes := []string{scope}
 
 	return azidext.NewTokenCredentialAdapter(tokenCredential, scopes), nil
 }
@@ -0,0 +1,57 @@
+package clusterdata
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+
+	configclient "github.com/openshift/client-go/config/clientset/versioned"
+	machineclient "github.com/openshift/client-go/machine/clientset/versioned"
+	operatorclient "github.com/openshift/client-go/operator/clientset/versioned"
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned"
+)
+
+// autoscalerEnricher reports the autoscaler status which the ARO operator
+// records on the ARO Cluster resource
+type autoscalerEnricher struct {
+}
+
+func (ae autoscalerEnricher) Enrich(
+	ctx context.Context,
+	log *logrus.Entry,
+	oc *api.OpenShiftCluster,
+	k8scli kubernetes.Interface,
+	configcli configclient.Interface,
+	machinecli machineclient.Interface,
+	operatorcli operatorclient.Interface,
+	arocli aroclient.Interface,
+) error {
+	cluster, err := arocli.AroV1alpha1().Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	if cluster.Status.Autoscaler == nil {
+		return nil
+	}
+
+	oc.Lock.Lock()
+	defer oc.Lock.Unlock()
+
+	oc.Properties.AutoscalerProfileStatus = &api.AutoscalerProfileStatus{
+		State:   api.AutoscalerState(cluster.Status.Autoscaler.State),
+		Message: cluster.Status.Autoscaler.Message,
+	}
+	return nil
+}
+
+func (ae autoscalerEnricher) SetDefaults(oc *api.OpenShiftCluster) {
+	oc.Properties.AutoscalerProfileStatus = nil
+}
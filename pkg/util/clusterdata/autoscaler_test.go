@@ -0,0 +1,79 @@
+package clusterdata
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned"
+	arofake "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/fake"
+	"github.com/Azure/ARO-RP/pkg/util/cmp"
+	utilerror "github.com/Azure/ARO-RP/test/util/error"
+)
+
+func TestAutoscalerEnricherTask(t *testing.T) {
+	log := logrus.NewEntry(logrus.StandardLogger())
+
+	for _, tt := range []struct {
+		name    string
+		client  aroclient.Interface
+		wantOc  *api.OpenShiftCluster
+		wantErr string
+	}{
+		{
+			name: "autoscaler status is reported",
+			client: arofake.NewSimpleClientset(&arov1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: arov1alpha1.SingletonClusterName},
+				Status: arov1alpha1.ClusterStatus{
+					Autoscaler: &arov1alpha1.AutoscalerStatus{
+						State:   arov1alpha1.AutoscalerStateFailed,
+						Message: `worker machineset "foo" not found`,
+					},
+				},
+			}),
+			wantOc: &api.OpenShiftCluster{
+				Properties: api.OpenShiftClusterProperties{
+					AutoscalerProfileStatus: &api.AutoscalerProfileStatus{
+						State:   api.AutoscalerStateFailed,
+						Message: `worker machineset "foo" not found`,
+					},
+				},
+			},
+		},
+		{
+			name: "autoscaler status is not set",
+			client: arofake.NewSimpleClientset(&arov1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: arov1alpha1.SingletonClusterName},
+			}),
+			wantOc: &api.OpenShiftCluster{},
+		},
+		{
+			name:    "cluster object does not exist",
+			client:  arofake.NewSimpleClientset(),
+			wantOc:  &api.OpenShiftCluster{},
+			wantErr: `clusters.aro.openshift.io "cluster" not found`,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			oc := &api.OpenShiftCluster{}
+			clients := clients{aro: tt.client}
+			e := autoscalerEnricher{}
+			e.SetDefaults(oc)
+
+			err := e.Enrich(context.Background(), log, oc, clients.k8s, clients.config, clients.machine, clients.operator, clients.aro)
+
+			utilerror.AssertErrorMessage(t, err, tt.wantErr)
+			if !reflect.DeepEqual(oc, tt.wantOc) {
+				t.Error(cmp.Diff(oc, tt.wantOc))
+			}
+		})
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/Azure/ARO-RP/pkg/api"
+	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned"
 )
 
 type clusterVersionEnricher struct {
@@ -27,6 +28,7 @@ func (ce clusterVersionEnricher) Enrich(
 	configcli configclient.Interface,
 	machinecli machineclient.Interface,
 	operatorcli operatorclient.Interface,
+	arocli aroclient.Interface,
 ) error {
 	cv, err := configcli.ConfigV1().ClusterVersions().Get(ctx, "version", metav1.GetOptions{})
 	if err != nil {
@@ -63,7 +63,7 @@ func TestClusterVersionEnricherTask(t *testing.T) {
 			e := clusterVersionEnricher{}
 			e.SetDefaults(oc)
 
-			err := e.Enrich(context.Background(), log, oc, clients.k8s, clients.config, clients.machine, clients.operator)
+			err := e.Enrich(context.Background(), log, oc, clients.k8s, clients.config, clients.machine, clients.operator, clients.aro)
 
 			if (err == nil && tt.wantErr != "") || (err != nil && err.Error() != tt.wantErr) {
 				t.Errorf("wanted err to be %s but got %s", err, tt.wantErr)
@@ -17,6 +17,7 @@ import (
 
 	"github.com/Azure/ARO-RP/pkg/api"
 	"github.com/Azure/ARO-RP/pkg/metrics"
+	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned"
 	"github.com/Azure/ARO-RP/pkg/proxy"
 	"github.com/Azure/ARO-RP/pkg/util/recover"
 	"github.com/Azure/ARO-RP/pkg/util/restconfig"
@@ -37,7 +38,7 @@ type ParallelEnricher struct {
 }
 
 type ClusterEnricher interface {
-	Enrich(context.Context, *logrus.Entry, *api.OpenShiftCluster, kubernetes.Interface, configclient.Interface, machineclient.Interface, operatorclient.Interface) error
+	Enrich(context.Context, *logrus.Entry, *api.OpenShiftCluster, kubernetes.Interface, configclient.Interface, machineclient.Interface, operatorclient.Interface, aroclient.Interface) error
 	SetDefaults(*api.OpenShiftCluster)
 }
 
@@ -46,6 +47,7 @@ type clients struct {
 	config   configclient.Interface
 	machine  machineclient.Interface
 	operator operatorclient.Interface
+	aro      aroclient.Interface
 }
 
 const (
@@ -53,6 +55,7 @@ const (
 	ingressProfile   = "ingressProfile"
 	clusterVersion   = "clusterVersion"
 	machineClient    = "machineClient"
+	autoscaler       = "autoscaler"
 )
 
 func NewParallelEnricher(metricsEmitter metrics.Emitter, dialer proxy.Dialer) ParallelEnricher {
@@ -63,6 +66,7 @@ func NewParallelEnricher(metricsEmitter metrics.Emitter, dialer proxy.Dialer) Pa
 			ingressProfile:   ingressProfileEnricher{},
 			clusterVersion:   clusterVersionEnricher{},
 			machineClient:    machineClientEnricher{},
+			autoscaler:       autoscalerEnricher{},
 		},
 		dialer: dialer,
 	}
@@ -78,12 +82,13 @@ func (p ParallelEnricher) Enrich(ctx context.Context, log *logrus.Entry, ocs ...
 			defer recover.Panic(log)
 			defer wg.Done()
 
-			k8sclient, machineclient, operatorclient, configclient, unsuccessfulEnrichers := p.initializeClients(ctx, log, oc)
+			k8sclient, machineclient, operatorclient, configclient, aroclient, unsuccessfulEnrichers := p.initializeClients(ctx, log, oc)
 			clients := clients{
 				k8s:      k8sclient,
 				machine:  machineclient,
 				operator: operatorclient,
 				config:   configclient,
+				aro:      aroclient,
 			}
 
 			p.enrichOne(ctx, log, oc, clients, unsuccessfulEnrichers)
@@ -113,7 +118,7 @@ func (p ParallelEnricher) enrichOne(ctx context.Context, log *logrus.Entry, oc *
 			t := time.Now()
 
 			e.SetDefaults(oc)
-			errors <- e.Enrich(ctx, log, oc, clients.k8s, clients.config, clients.machine, clients.operator)
+			errors <- e.Enrich(ctx, log, oc, clients.k8s, clients.config, clients.machine, clients.operator, clients.aro)
 
 			//only used in testing
 			if p.metricsWG != nil {
@@ -154,7 +159,7 @@ func (p ParallelEnricher) waitForResults(log *logrus.Entry, errChannel chan erro
 // if some clients fail to be initialized, it also returns the list of enrichers
 // that we should skip because the clients they are using failed to instantiate
 func (p ParallelEnricher) initializeClients(ctx context.Context, log *logrus.Entry, oc *api.OpenShiftCluster) (
-	k8s kubernetes.Interface, machineclient machineclient.Interface, operatorclient operatorclient.Interface, configclient configclient.Interface, unsuccessfulEnrichers map[string]bool) {
+	k8s kubernetes.Interface, machineclient machineclient.Interface, operatorclient operatorclient.Interface, configclient configclient.Interface, aroclient aroclient.Interface, unsuccessfulEnrichers map[string]bool) {
 	unsuccessfulEnrichers = make(map[string]bool)
 	k8s, err := p.setupK8sClient(ctx, oc)
 	if err != nil {
@@ -177,7 +182,12 @@ func (p ParallelEnricher) initializeClients(ctx context.Context, log *logrus.Ent
 		unsuccessfulEnrichers[clusterVersion] = true
 		p.taskError(log, err, 1)
 	}
-	return k8s, machineclient, operatorclient, configclient, unsuccessfulEnrichers
+	aroclient, err = p.setupAROClient(ctx, oc)
+	if err != nil {
+		unsuccessfulEnrichers[autoscaler] = true
+		p.taskError(log, err, 1)
+	}
+	return k8s, machineclient, operatorclient, configclient, aroclient, unsuccessfulEnrichers
 }
 
 func (p ParallelEnricher) taskError(log *logrus.Entry, err error, count int) {
@@ -220,6 +230,15 @@ func (p ParallelEnricher) setupMachineClient(ctx context.Context, oc *api.OpenSh
 	return machineclient.NewForConfig(restConfig)
 }
 
+func (p ParallelEnricher) setupAROClient(ctx context.Context, oc *api.OpenShiftCluster) (aroclient.Interface, error) {
+	restConfig, err := restconfig.RestConfig(p.dialer, oc)
+	if err != nil {
+		return nil, err
+	}
+
+	return aroclient.NewForConfig(restConfig)
+}
+
 func validateProvisioningState(log *logrus.Entry, oc *api.OpenShiftCluster) error {
 	switch oc.Properties.ProvisioningState {
 	case api.ProvisioningStateCreating, api.ProvisioningStateDeleting:
@@ -82,7 +82,7 @@ func TestEnrichOne(t *testing.T) {
 			metricsMock.EXPECT().EmitGauge("enricher.tasks.errors", int64(1), nil).Times(tt.errorCount)
 
 			enricherMock := mock_clusterdata.NewMockClusterEnricher(controller)
-			enricherMock.EXPECT().Enrich(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			enricherMock.EXPECT().Enrich(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 				Return(tt.enricherReturnValue).Times(tt.enricherCallCount)
 			enricherMock.EXPECT().SetDefaults(gomock.Any()).Times(tt.enricherCallCount)
 
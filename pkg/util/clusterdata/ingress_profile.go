@@ -16,6 +16,7 @@ import (
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/Azure/ARO-RP/pkg/api"
+	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned"
 )
 
 type ingressProfileEnricher struct {
@@ -29,6 +30,7 @@ func (ip ingressProfileEnricher) Enrich(
 	configcli configclient.Interface,
 	machinecli machineclient.Interface,
 	operatorcli operatorclient.Interface,
+	arocli aroclient.Interface,
 ) error {
 	// List IngressControllers from  openshift-ingress-operator namespace
 	// Each IngressController will be the basis for an IngressProfile with the below mapping:
@@ -311,7 +311,7 @@ func TestIngressProfilesEnricherTask(t *testing.T) {
 				k8s:      tt.kubecli,
 				operator: tt.operatorcli,
 			}
-			err := e.Enrich(context.Background(), log, oc, clients.k8s, clients.config, clients.machine, clients.operator)
+			err := e.Enrich(context.Background(), log, oc, clients.k8s, clients.config, clients.machine, clients.operator, clients.aro)
 			if (err == nil && tt.wantErr != "") || (err != nil && err.Error() != tt.wantErr) {
 				t.Errorf("wanted err to be %s but got %s", err, tt.wantErr)
 			}
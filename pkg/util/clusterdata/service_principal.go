@@ -14,6 +14,7 @@ import (
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/Azure/ARO-RP/pkg/api"
+	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned"
 )
 
 type clusterServicePrincipalEnricher struct {
@@ -27,6 +28,7 @@ func (ce clusterServicePrincipalEnricher) Enrich(
 	configcli configclient.Interface,
 	machinecli machineclient.Interface,
 	operatorcli operatorclient.Interface,
+	arocli aroclient.Interface,
 ) error {
 	secret, err := k8scli.CoreV1().Secrets("kube-system").Get(ctx, "azure-credentials", metav1.GetOptions{})
 	if err != nil {
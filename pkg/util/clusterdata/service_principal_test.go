@@ -78,7 +78,7 @@ func TestClusterServidePrincipalEnricherTask(t *testing.T) {
 			e.SetDefaults(oc)
 
 			clients := clients{k8s: tt.client}
-			err := e.Enrich(context.Background(), log, oc, clients.k8s, clients.config, clients.machine, clients.operator)
+			err := e.Enrich(context.Background(), log, oc, clients.k8s, clients.config, clients.machine, clients.operator, clients.aro)
 			if (err == nil && tt.wantErr != "") || (err != nil && err.Error() != tt.wantErr) {
 				t.Errorf("wanted err to be %s but got %s", err, tt.wantErr)
 			}
@@ -19,6 +19,7 @@ import (
 	"k8s.io/client-go/kubernetes/scheme"
 
 	"github.com/Azure/ARO-RP/pkg/api"
+	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned"
 	_ "github.com/Azure/ARO-RP/pkg/util/scheme"
 )
 
@@ -37,6 +38,7 @@ func (ce machineClientEnricher) Enrich(
 	configcli configclient.Interface,
 	machinecli machineclient.Interface,
 	operatorcli operatorclient.Interface,
+	arocli aroclient.Interface,
 ) error {
 	r, err := azure.ParseResourceID(oc.ID)
 	if err != nil {
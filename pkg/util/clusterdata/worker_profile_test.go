@@ -115,7 +115,7 @@ func TestWorkerProfilesEnricherTask(t *testing.T) {
 
 			//When
 			e.SetDefaults(tc.givenOc)
-			err := e.Enrich(context.Background(), log, tc.givenOc, clients.k8s, clients.config, clients.machine, clients.operator)
+			err := e.Enrich(context.Background(), log, tc.givenOc, clients.k8s, clients.config, clients.machine, clients.operator, clients.aro)
 
 			//Then
 			errorHandling.AssertErrorMessage(t, err, tc.wantErr)
@@ -0,0 +1,50 @@
+package conditions
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"strings"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+)
+
+// expectedStatus is the status of each ARO operator condition on a healthy
+// cluster
+var expectedStatus = map[string]operatorv1.ConditionStatus{
+	arov1alpha1.InternetReachableFromMaster: operatorv1.ConditionTrue,
+	arov1alpha1.InternetReachableFromWorker: operatorv1.ConditionTrue,
+	arov1alpha1.ServicePrincipalValid:       operatorv1.ConditionTrue,
+	arov1alpha1.DefaultIngressCertificate:   operatorv1.ConditionTrue,
+	arov1alpha1.MachineValid:                operatorv1.ConditionTrue,
+	arov1alpha1.ManagedResourceDrift:        operatorv1.ConditionFalse,
+}
+
+// IsExpected returns true if the ARO operator condition is in the state
+// expected on a healthy cluster.  Conditions whose healthy state is not known
+// are never expected.
+func IsExpected(cond operatorv1.OperatorCondition) bool {
+	if status, ok := expectedStatus[cond.Type]; ok {
+		return cond.Status == status
+	}
+
+	// controllers are expected to be available, not progressing and not degraded
+	return (strings.HasSuffix(cond.Type, "Controller"+operatorv1.OperatorStatusTypeAvailable) && cond.Status == operatorv1.ConditionTrue) ||
+		(strings.HasSuffix(cond.Type, "Controller"+operatorv1.OperatorStatusTypeProgressing) && cond.Status == operatorv1.ConditionFalse) ||
+		(strings.HasSuffix(cond.Type, "Controller"+operatorv1.OperatorStatusTypeDegraded) && cond.Status == operatorv1.ConditionFalse)
+}
+
+// Unhealthy returns the ARO operator conditions whose healthy state is known
+// and which are not in it
+func Unhealthy(conds []operatorv1.OperatorCondition) []operatorv1.OperatorCondition {
+	var unhealthy []operatorv1.OperatorCondition
+	for _, cond := range conds {
+		_, known := expectedStatus[cond.Type]
+		if (known || conditionIsControllerStatus(cond.Type)) && !IsExpected(cond) {
+			unhealthy = append(unhealthy, cond)
+		}
+	}
+	return unhealthy
+}
@@ -0,0 +1,128 @@
+package dynamichelper
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	kruntime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// Drift describes a correction made by Ensure to an object which no longer
+// matched its desired state
+type Drift struct {
+	GroupKind string
+	Namespace string
+	Name      string
+
+	// Fields are the paths of the fields which were changed.  Lists are
+	// compared as a whole.
+	Fields []string
+
+	// Manager is the field manager which last updated the object before it
+	// was corrected
+	Manager string
+
+	Time time.Time
+}
+
+// DriftRecorder is notified of every drift corrected by Ensure
+type DriftRecorder interface {
+	RecordDrift(drift *Drift)
+}
+
+// ignoredDriftFields are maintained by the API server or by controllers rather
+// than by whoever changed the object
+var ignoredDriftFields = map[string]struct{}{
+	"metadata.creationTimestamp": {},
+	"metadata.generation":        {},
+	"metadata.managedFields":     {},
+	"metadata.resourceVersion":   {},
+	"metadata.selfLink":          {},
+	"metadata.uid":               {},
+	"status":                     {},
+}
+
+// newDrift returns the drift between the existing object and the object it is
+// corrected to
+func newDrift(groupKind string, old, new kruntime.Object, now time.Time) (*Drift, error) {
+	acc, err := meta.Accessor(old)
+	if err != nil {
+		return nil, err
+	}
+
+	oldMap, err := kruntime.DefaultUnstructuredConverter.ToUnstructured(old)
+	if err != nil {
+		return nil, err
+	}
+
+	newMap, err := kruntime.DefaultUnstructuredConverter.ToUnstructured(new)
+	if err != nil {
+		return nil, err
+	}
+
+	drift := &Drift{
+		GroupKind: groupKind,
+		Namespace: acc.GetNamespace(),
+		Name:      acc.GetName(),
+		Time:      now,
+	}
+
+	drift.Fields = changedFields(nil, oldMap, newMap)
+	sort.Strings(drift.Fields)
+
+	// the most recent writer is the one which caused the drift
+	var last time.Time
+	for _, mf := range acc.GetManagedFields() {
+		if mf.Time != nil && !mf.Time.Time.Before(last) {
+			last = mf.Time.Time
+			drift.Manager = mf.Manager
+		}
+	}
+
+	return drift, nil
+}
+
+// changedFields returns the paths of the fields which differ between old and
+// new
+func changedFields(path []string, old, new map[string]interface{}) []string {
+	var fields []string
+
+	keys := map[string]struct{}{}
+	for k := range old {
+		keys[k] = struct{}{}
+	}
+	for k := range new {
+		keys[k] = struct{}{}
+	}
+
+	for k := range keys {
+		p := append(append([]string{}, path...), k)
+		field := strings.Join(p, ".")
+
+		if _, ok := ignoredDriftFields[field]; ok {
+			continue
+		}
+
+		o, n := old[k], new[k]
+		if reflect.DeepEqual(o, n) {
+			continue
+		}
+
+		om, oIsMap := o.(map[string]interface{})
+		nm, nIsMap := n.(map[string]interface{})
+		if oIsMap && nIsMap {
+			fields = append(fields, changedFields(p, om, nm)...)
+			continue
+		}
+
+		fields = append(fields, field)
+	}
+
+	return fields
+}
@@ -0,0 +1,151 @@
+package dynamichelper
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-test/deep"
+	"github.com/sirupsen/logrus"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+)
+
+func TestNewDrift(t *testing.T) {
+	now := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	old := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "aro-operator-master",
+			Namespace:       "openshift-azure-operator",
+			ResourceVersion: "2",
+			Labels: map[string]string{
+				"app": "aro-operator-master",
+			},
+			ManagedFields: []metav1.ManagedFieldsEntry{
+				{
+					Manager: "aro",
+					Time:    &metav1.Time{Time: now.Add(-2 * time.Hour)},
+				},
+				{
+					Manager: "kubectl-edit",
+					Time:    &metav1.Time{Time: now.Add(-time.Hour)},
+				},
+				{
+					Manager: "kube-controller-manager",
+					// status updates by controllers have no time
+				},
+			},
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: pointer.Int32(3),
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name:  "aro-operator",
+							Image: "customer/aro:latest",
+						},
+					},
+				},
+			},
+		},
+		Status: appsv1.DeploymentStatus{
+			Replicas: 3,
+		},
+	}
+
+	new := old.DeepCopy()
+	new.ResourceVersion = "3"
+	new.Labels["extra"] = "label"
+	new.Spec.Replicas = pointer.Int32(1)
+	new.Spec.Template.Spec.Containers[0].Image = "arosvc.azurecr.io/aro:v1"
+	new.Status.Replicas = 1
+
+	drift, err := newDrift("Deployment.apps", old, new, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &Drift{
+		GroupKind: "Deployment.apps",
+		Namespace: "openshift-azure-operator",
+		Name:      "aro-operator-master",
+		Fields: []string{
+			"metadata.labels.extra",
+			"spec.replicas",
+			"spec.template.spec.containers",
+		},
+		Manager: "kubectl-edit",
+		Time:    now,
+	}
+
+	for _, diff := range deep.Equal(drift, want) {
+		t.Error(diff)
+	}
+}
+
+type fakeDriftRecorder []*Drift
+
+func (r *fakeDriftRecorder) RecordDrift(drift *Drift) {
+	*r = append(*r, drift)
+}
+
+func TestRecordDrift(t *testing.T) {
+	now := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	for _, tt := range []struct {
+		name        string
+		lastManager string
+		wantRecord  bool
+	}{
+		{
+			name:        "changed by someone else",
+			lastManager: "kubectl-edit",
+			wantRecord:  true,
+		},
+		{
+			name:        "last written by us",
+			lastManager: "aro",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			old := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "config",
+					Namespace: "openshift-azure-operator",
+					ManagedFields: []metav1.ManagedFieldsEntry{
+						{
+							Manager: tt.lastManager,
+							Time:    &metav1.Time{Time: now.Add(-time.Hour)},
+						},
+					},
+				},
+				Data: map[string]string{
+					"key": "old",
+				},
+			}
+
+			new := old.DeepCopy()
+			new.Data["key"] = "new"
+
+			recorder := &fakeDriftRecorder{}
+			dh := &dynamicHelper{
+				log:           logrus.NewEntry(logrus.StandardLogger()),
+				driftRecorder: recorder,
+				fieldManager:  "aro",
+				now:           func() time.Time { return now },
+			}
+
+			dh.recordDrift("ConfigMap", old, new)
+
+			if recorded := len(*recorder) == 1; recorded != tt.wantRecord {
+				t.Errorf("got recorded %t, want %t", recorded, tt.wantRecord)
+			}
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	machinev1beta1 "github.com/openshift/api/machine/v1beta1"
 	hivev1 "github.com/openshift/hive/apis/hive/v1"
@@ -39,6 +40,7 @@ type Interface interface {
 	EnsureDeletedGVR(ctx context.Context, groupKind, namespace, name, optionalVersion string) error
 	Ensure(ctx context.Context, objs ...kruntime.Object) error
 	IsConstraintTemplateReady(ctx context.Context, name string) (bool, error)
+	GetConstraintViolations(ctx context.Context, groupKind, name, optionalVersion string) (int64, error)
 }
 
 type dynamicHelper struct {
@@ -47,11 +49,24 @@ type dynamicHelper struct {
 	log           *logrus.Entry
 	restcli       rest.Interface
 	dynamicClient dynamic.Interface
+
+	driftRecorder DriftRecorder
+	fieldManager  string
+	now           func() time.Time
 }
 
 func New(log *logrus.Entry, restconfig *rest.Config) (Interface, error) {
+	return NewWithDriftRecorder(log, restconfig, nil)
+}
+
+// NewWithDriftRecorder returns a dynamic helper which notifies driftRecorder of
+// every object it corrects
+func NewWithDriftRecorder(log *logrus.Entry, restconfig *rest.Config, driftRecorder DriftRecorder) (Interface, error) {
 	dh := &dynamicHelper{
 		log: log,
+
+		driftRecorder: driftRecorder,
+		now:           time.Now,
 	}
 
 	var err error
@@ -65,6 +80,13 @@ func New(log *logrus.Entry, restconfig *rest.Config) (Interface, error) {
 		return nil, err
 	}
 
+	// the API server records our writes under the user agent prefix
+	userAgent := restconfig.UserAgent
+	if userAgent == "" {
+		userAgent = rest.DefaultKubernetesUserAgent()
+	}
+	dh.fieldManager = strings.SplitN(userAgent, "/", 2)[0]
+
 	restconfig = rest.CopyConfig(restconfig)
 	restconfig.NegotiatedSerializer = scheme.Codecs.WithoutConversion()
 	restconfig.GroupVersion = &schema.GroupVersion{}
@@ -151,10 +173,36 @@ func (dh *dynamicHelper) ensureOne(ctx context.Context, new kruntime.Object) err
 			return err
 		}
 		dh.log.Infof("Update %s: %s", keyFunc(gvk.GroupKind(), acc.GetNamespace(), acc.GetName()), diff)
-		return dh.restcli.Put().AbsPath(makeURLSegments(gvr, acc.GetNamespace(), acc.GetName())...).Body(candidate).Do(ctx).Error()
+		err = dh.restcli.Put().AbsPath(makeURLSegments(gvr, acc.GetNamespace(), acc.GetName())...).Body(candidate).Do(ctx).Error()
+		if err != nil {
+			return err
+		}
+
+		dh.recordDrift(gvk.GroupKind().String(), old, candidate)
+		return nil
 	})
 }
 
+func (dh *dynamicHelper) recordDrift(groupKind string, old, new kruntime.Object) {
+	if dh.driftRecorder == nil {
+		return
+	}
+
+	drift, err := newDrift(groupKind, old, new, dh.now())
+	if err != nil {
+		dh.log.Warnf("failed to record drift: %s", err)
+		return
+	}
+
+	// if we wrote the object last, it differs because its desired state
+	// changed (e.g. on upgrade), not because someone else changed it
+	if drift.Manager == dh.fieldManager {
+		return
+	}
+
+	dh.driftRecorder.RecordDrift(drift)
+}
+
 func (dh *dynamicHelper) mergeWithLogic(name, groupKind string, old, new kruntime.Object) (kruntime.Object, bool, string, error) {
 	if strings.HasPrefix(name, "gatekeeper") {
 		dh.log.Debugf("Skip updating %s: %s", name, groupKind)
@@ -259,6 +307,10 @@ func merge(old, new kruntime.Object) (kruntime.Object, bool, string, error) {
 
 	case *arov1alpha1.Cluster:
 		old, new := old.(*arov1alpha1.Cluster), new.(*arov1alpha1.Cluster)
+		// MachineHealthChecks and StorageAccounts are configured on the
+		// cluster, not by the RP
+		new.Spec.MachineHealthChecks = old.Spec.MachineHealthChecks
+		new.Spec.StorageAccounts = old.Spec.StorageAccounts
 		new.Status = old.Status
 
 	case *hivev1.ClusterDeployment:
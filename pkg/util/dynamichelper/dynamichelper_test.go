@@ -531,6 +531,48 @@ func TestMerge(t *testing.T) {
 			},
 			wantEmptyDiff: true,
 		},
+		{
+			name: "Cluster MachineHealthChecks are preserved",
+			old: &arov1alpha1.Cluster{
+				Spec: arov1alpha1.ClusterSpec{
+					MachineHealthChecks: []arov1alpha1.MachineHealthCheckPolicy{
+						{
+							Name: "gpu",
+						},
+					},
+				},
+			},
+			new: &arov1alpha1.Cluster{},
+			want: &arov1alpha1.Cluster{
+				Spec: arov1alpha1.ClusterSpec{
+					MachineHealthChecks: []arov1alpha1.MachineHealthCheckPolicy{
+						{
+							Name: "gpu",
+						},
+					},
+				},
+			},
+			wantEmptyDiff: true,
+		},
+		{
+			name: "Cluster StorageAccounts are preserved",
+			old: &arov1alpha1.Cluster{
+				Spec: arov1alpha1.ClusterSpec{
+					StorageAccounts: arov1alpha1.StorageAccountsSpec{
+						IPRules: []string{"203.0.113.0/24"},
+					},
+				},
+			},
+			new: &arov1alpha1.Cluster{},
+			want: &arov1alpha1.Cluster{
+				Spec: arov1alpha1.ClusterSpec{
+					StorageAccounts: arov1alpha1.StorageAccountsSpec{
+						IPRules: []string{"203.0.113.0/24"},
+					},
+				},
+			},
+			wantEmptyDiff: true,
+		},
 		{
 			name: "CustomResourceDefinition Betav1 no changes",
 			old: &extensionsv1beta1.CustomResourceDefinition{
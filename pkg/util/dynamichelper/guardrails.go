@@ -237,3 +237,22 @@ func (dh *dynamicHelper) IsConstraintTemplateReady(ctx context.Context, name str
 	}
 	return created, nil
 }
+
+// GetConstraintViolations returns the number of violations found by the last
+// gatekeeper audit of a Constraint.  It returns 0 if the Constraint has not
+// been audited yet.
+func (dh *dynamicHelper) GetConstraintViolations(ctx context.Context, groupKind, name, optionalVersion string) (int64, error) {
+	gvr, err := dh.Resolve(groupKind, optionalVersion)
+	if err != nil {
+		return 0, err
+	}
+	c, err := dh.dynamicClient.Resource(*gvr).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return 0, err
+	}
+	violations, _, err := unstructured.NestedInt64(c.Object, "status", "totalViolations")
+	if err != nil {
+		return 0, err
+	}
+	return violations, nil
+}
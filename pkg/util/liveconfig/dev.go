@@ -29,7 +29,6 @@ func (d *dev) HiveRestConfig(ctx context.Context, shard int) (*rest.Config, erro
 		return restConfig, nil
 	}
 
-	// Hive shards are planned but not deployed or implemented yet
 	d.hiveCredentialsMutex.RLock()
 	cached, exists := d.cachedCredentials[shard]
 	d.hiveCredentialsMutex.RUnlock()
@@ -52,6 +51,10 @@ func (d *dev) HiveRestConfig(ctx context.Context, shard int) (*rest.Config, erro
 	return rest.CopyConfig(kubeConfig), nil
 }
 
+func (d *dev) HiveShardCount(ctx context.Context) (int, error) {
+	return hiveShardCount()
+}
+
 func (d *dev) InstallViaHive(ctx context.Context) (bool, error) {
 	installViaHive := os.Getenv(hiveInstallerEnableEnvVar)
 	if installViaHive != "" {
@@ -72,7 +72,6 @@ func parseKubeconfig(credentials []mgmtcontainerservice.CredentialResult) (*rest
 }
 
 func (p *prod) HiveRestConfig(ctx context.Context, shard int) (*rest.Config, error) {
-	// Hive shards are planned but not implemented yet
 	p.hiveCredentialsMutex.RLock()
 	cached, exists := p.cachedCredentials[shard]
 	p.hiveCredentialsMutex.RUnlock()
@@ -95,6 +94,10 @@ func (p *prod) HiveRestConfig(ctx context.Context, shard int) (*rest.Config, err
 	return rest.CopyConfig(kubeConfig), nil
 }
 
+func (p *prod) HiveShardCount(ctx context.Context) (int, error) {
+	return hiveShardCount()
+}
+
 func (p *prod) InstallViaHive(ctx context.Context) (bool, error) {
 	// TODO: Replace with RP Live Service Config (KeyVault)
 	installViaHive := os.Getenv(hiveInstallerEnableEnvVar)
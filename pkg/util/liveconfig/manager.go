@@ -5,6 +5,9 @@ package liveconfig
 
 import (
 	"context"
+	"fmt"
+	"os"
+	"strconv"
 	"sync"
 
 	"k8s.io/client-go/rest"
@@ -17,11 +20,15 @@ const (
 	hiveInstallerEnableEnvVar = "ARO_INSTALL_VIA_HIVE"
 	hiveDefaultPullSpecEnvVar = "ARO_HIVE_DEFAULT_INSTALLER_PULLSPEC"
 	hiveAdoptEnableEnvVar     = "ARO_ADOPT_BY_HIVE"
+	hiveShardCountEnvVar      = "ARO_HIVE_SHARD_COUNT"
 	useCheckAccess            = "USE_CHECKACCESS"
 )
 
 type Manager interface {
 	HiveRestConfig(context.Context, int) (*rest.Config, error)
+	// HiveShardCount returns the number of Hive shards available for cluster
+	// placement. Shards are numbered from 1.
+	HiveShardCount(context.Context) (int, error)
 	InstallViaHive(context.Context) (bool, error)
 	AdoptByHive(context.Context) (bool, error)
 	UseCheckAccess(context.Context) (bool, error)
@@ -62,3 +69,18 @@ func NewProd(location string, managedClustersClient containerservice.ManagedClus
 		hiveCredentialsMutex:  sync.RWMutex{},
 	}
 }
+
+func hiveShardCount() (int, error) {
+	// TODO: Replace with RP Live Service Config (KeyVault)
+	count := os.Getenv(hiveShardCountEnvVar)
+	if count == "" {
+		return 1, nil
+	}
+
+	i, err := strconv.Atoi(count)
+	if err != nil || i < 1 {
+		return 0, fmt.Errorf("invalid %s %q", hiveShardCountEnvVar, count)
+	}
+
+	return i, nil
+}
@@ -18,6 +18,8 @@ import (
 	unstructured "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	watch "k8s.io/apimachinery/pkg/watch"
+
+	api "github.com/Azure/ARO-RP/pkg/api"
 )
 
 // MockKubeActions is a mock of KubeActions interface.
@@ -71,6 +73,21 @@ func (mr *MockKubeActionsMockRecorder) ApproveCsr(arg0, arg1 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveCsr", reflect.TypeOf((*MockKubeActions)(nil).ApproveCsr), arg0, arg1)
 }
 
+// CertificateInventory mocks base method.
+func (m *MockKubeActions) CertificateInventory(arg0 context.Context, arg1 *api.OpenShiftClusterDocument) (*api.CertificateInventory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CertificateInventory", arg0, arg1)
+	ret0, _ := ret[0].(*api.CertificateInventory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CertificateInventory indicates an expected call of CertificateInventory.
+func (mr *MockKubeActionsMockRecorder) CertificateInventory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CertificateInventory", reflect.TypeOf((*MockKubeActions)(nil).CertificateInventory), arg0, arg1)
+}
+
 // CordonNode mocks base method.
 func (m *MockKubeActions) CordonNode(arg0 context.Context, arg1 string, arg2 bool) error {
 	m.ctrl.T.Helper()
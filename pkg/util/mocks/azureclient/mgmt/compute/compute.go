@@ -316,6 +316,21 @@ func (mr *MockVirtualMachineScaleSetVMsClientMockRecorder) List(arg0, arg1, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockVirtualMachineScaleSetVMsClient)(nil).List), arg0, arg1, arg2, arg3, arg4, arg5)
 }
 
+// RunCommandAndGetOutput mocks base method.
+func (m *MockVirtualMachineScaleSetVMsClient) RunCommandAndGetOutput(arg0 context.Context, arg1, arg2, arg3 string, arg4 compute.RunCommandInput) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunCommandAndGetOutput", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunCommandAndGetOutput indicates an expected call of RunCommandAndGetOutput.
+func (mr *MockVirtualMachineScaleSetVMsClientMockRecorder) RunCommandAndGetOutput(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunCommandAndGetOutput", reflect.TypeOf((*MockVirtualMachineScaleSetVMsClient)(nil).RunCommandAndGetOutput), arg0, arg1, arg2, arg3, arg4)
+}
+
 // RunCommandAndWait mocks base method.
 func (m *MockVirtualMachineScaleSetVMsClient) RunCommandAndWait(arg0 context.Context, arg1, arg2, arg3 string, arg4 compute.RunCommandInput) error {
 	m.ctrl.T.Helper()
@@ -16,6 +16,7 @@ import (
 	kubernetes "k8s.io/client-go/kubernetes"
 
 	api "github.com/Azure/ARO-RP/pkg/api"
+	versioned2 "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned"
 )
 
 // MockClusterEnricher is a mock of ClusterEnricher interface.
@@ -42,17 +43,17 @@ func (m *MockClusterEnricher) EXPECT() *MockClusterEnricherMockRecorder {
 }
 
 // Enrich mocks base method.
-func (m *MockClusterEnricher) Enrich(arg0 context.Context, arg1 *logrus.Entry, arg2 *api.OpenShiftCluster, arg3 kubernetes.Interface, arg4 versioned.Interface, arg5 versioned0.Interface, arg6 versioned1.Interface) error {
+func (m *MockClusterEnricher) Enrich(arg0 context.Context, arg1 *logrus.Entry, arg2 *api.OpenShiftCluster, arg3 kubernetes.Interface, arg4 versioned.Interface, arg5 versioned0.Interface, arg6 versioned1.Interface, arg7 versioned2.Interface) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Enrich", arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+	ret := m.ctrl.Call(m, "Enrich", arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Enrich indicates an expected call of Enrich.
-func (mr *MockClusterEnricherMockRecorder) Enrich(arg0, arg1, arg2, arg3, arg4, arg5, arg6 interface{}) *gomock.Call {
+func (mr *MockClusterEnricherMockRecorder) Enrich(arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enrich", reflect.TypeOf((*MockClusterEnricher)(nil).Enrich), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enrich", reflect.TypeOf((*MockClusterEnricher)(nil).Enrich), arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7)
 }
 
 // SetDefaults mocks base method.
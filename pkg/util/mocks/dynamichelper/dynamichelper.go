@@ -82,6 +82,21 @@ func (mr *MockInterfaceMockRecorder) EnsureDeletedGVR(arg0, arg1, arg2, arg3, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureDeletedGVR", reflect.TypeOf((*MockInterface)(nil).EnsureDeletedGVR), arg0, arg1, arg2, arg3, arg4)
 }
 
+// GetConstraintViolations mocks base method.
+func (m *MockInterface) GetConstraintViolations(arg0 context.Context, arg1, arg2, arg3 string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConstraintViolations", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConstraintViolations indicates an expected call of GetConstraintViolations.
+func (mr *MockInterfaceMockRecorder) GetConstraintViolations(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConstraintViolations", reflect.TypeOf((*MockInterface)(nil).GetConstraintViolations), arg0, arg1, arg2, arg3)
+}
+
 // IsConstraintTemplateReady mocks base method.
 func (m *MockInterface) IsConstraintTemplateReady(arg0 context.Context, arg1 string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -365,6 +365,20 @@ func (mr *MockInterfaceMockRecorder) GatewayResourceGroup() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GatewayResourceGroup", reflect.TypeOf((*MockInterface)(nil).GatewayResourceGroup))
 }
 
+// HealthGateClientAuthorizer mocks base method.
+func (m *MockInterface) HealthGateClientAuthorizer() clientauthorizer.ClientAuthorizer {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HealthGateClientAuthorizer")
+	ret0, _ := ret[0].(clientauthorizer.ClientAuthorizer)
+	return ret0
+}
+
+// HealthGateClientAuthorizer indicates an expected call of HealthGateClientAuthorizer.
+func (mr *MockInterfaceMockRecorder) HealthGateClientAuthorizer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HealthGateClientAuthorizer", reflect.TypeOf((*MockInterface)(nil).HealthGateClientAuthorizer))
+}
+
 // Hostname mocks base method.
 func (m *MockInterface) Hostname() string {
 	m.ctrl.T.Helper()
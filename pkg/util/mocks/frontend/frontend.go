@@ -102,6 +102,20 @@ func (mr *MockQuotaValidatorMockRecorder) ValidateQuota(arg0, arg1, arg2, arg3,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateQuota", reflect.TypeOf((*MockQuotaValidator)(nil).ValidateQuota), arg0, arg1, arg2, arg3, arg4, arg5)
 }
 
+// ValidateWorkerProfilesQuota mocks base method.
+func (m *MockQuotaValidator) ValidateWorkerProfilesQuota(arg0 context.Context, arg1 *azureclient.AROEnvironment, arg2 env.Interface, arg3, arg4 string, arg5 *api.OpenShiftCluster, arg6 []api.WorkerProfile) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateWorkerProfilesQuota", arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateWorkerProfilesQuota indicates an expected call of ValidateWorkerProfilesQuota.
+func (mr *MockQuotaValidatorMockRecorder) ValidateWorkerProfilesQuota(arg0, arg1, arg2, arg3, arg4, arg5, arg6 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateWorkerProfilesQuota", reflect.TypeOf((*MockQuotaValidator)(nil).ValidateWorkerProfilesQuota), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+}
+
 // MockSkuValidator is a mock of SkuValidator interface.
 type MockSkuValidator struct {
 	ctrl     *gomock.Controller
@@ -139,6 +153,20 @@ func (mr *MockSkuValidatorMockRecorder) ValidateVMSku(arg0, arg1, arg2, arg3, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateVMSku", reflect.TypeOf((*MockSkuValidator)(nil).ValidateVMSku), arg0, arg1, arg2, arg3, arg4, arg5)
 }
 
+// ValidateWorkerProfilesVMSku mocks base method.
+func (m *MockSkuValidator) ValidateWorkerProfilesVMSku(arg0 context.Context, arg1 *azureclient.AROEnvironment, arg2 env.Interface, arg3, arg4 string, arg5 *api.OpenShiftCluster, arg6 []api.WorkerProfile) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateWorkerProfilesVMSku", arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateWorkerProfilesVMSku indicates an expected call of ValidateWorkerProfilesVMSku.
+func (mr *MockSkuValidatorMockRecorder) ValidateWorkerProfilesVMSku(arg0, arg1, arg2, arg3, arg4, arg5, arg6 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateWorkerProfilesVMSku", reflect.TypeOf((*MockSkuValidator)(nil).ValidateWorkerProfilesVMSku), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+}
+
 // MockProvidersValidator is a mock of ProvidersValidator interface.
 type MockProvidersValidator struct {
 	ctrl     *gomock.Controller
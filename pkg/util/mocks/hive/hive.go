@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/Azure/ARO-RP/pkg/hive (interfaces: ClusterManager)
+// Source: github.com/Azure/ARO-RP/pkg/hive (interfaces: ClusterManager,ShardManager)
 
 // Package mock_hive is a generated GoMock package.
 package mock_hive
@@ -13,6 +13,7 @@ import (
 	v10 "k8s.io/api/core/v1"
 
 	api "github.com/Azure/ARO-RP/pkg/api"
+	hive "github.com/Azure/ARO-RP/pkg/hive"
 )
 
 // MockClusterManager is a mock of ClusterManager interface.
@@ -153,3 +154,71 @@ func (mr *MockClusterManagerMockRecorder) ResetCorrelationData(arg0, arg1 interf
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetCorrelationData", reflect.TypeOf((*MockClusterManager)(nil).ResetCorrelationData), arg0, arg1)
 }
+
+// MockShardManager is a mock of ShardManager interface.
+type MockShardManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockShardManagerMockRecorder
+}
+
+// MockShardManagerMockRecorder is the mock recorder for MockShardManager.
+type MockShardManagerMockRecorder struct {
+	mock *MockShardManager
+}
+
+// NewMockShardManager creates a new mock instance.
+func NewMockShardManager(ctrl *gomock.Controller) *MockShardManager {
+	mock := &MockShardManager{ctrl: ctrl}
+	mock.recorder = &MockShardManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockShardManager) EXPECT() *MockShardManagerMockRecorder {
+	return m.recorder
+}
+
+// ForCluster mocks base method.
+func (m *MockShardManager) ForCluster(arg0 context.Context, arg1 *api.OpenShiftClusterDocument) (hive.ClusterManager, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForCluster", arg0, arg1)
+	ret0, _ := ret[0].(hive.ClusterManager)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ForCluster indicates an expected call of ForCluster.
+func (mr *MockShardManagerMockRecorder) ForCluster(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForCluster", reflect.TypeOf((*MockShardManager)(nil).ForCluster), arg0, arg1)
+}
+
+// ForShard mocks base method.
+func (m *MockShardManager) ForShard(arg0 context.Context, arg1 int) (hive.ClusterManager, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForShard", arg0, arg1)
+	ret0, _ := ret[0].(hive.ClusterManager)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ForShard indicates an expected call of ForShard.
+func (mr *MockShardManagerMockRecorder) ForShard(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForShard", reflect.TypeOf((*MockShardManager)(nil).ForShard), arg0, arg1)
+}
+
+// ShardCount mocks base method.
+func (m *MockShardManager) ShardCount(arg0 context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShardCount", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShardCount indicates an expected call of ShardCount.
+func (mr *MockShardManagerMockRecorder) ShardCount(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShardCount", reflect.TypeOf((*MockShardManager)(nil).ShardCount), arg0)
+}
@@ -119,3 +119,31 @@ func (mr *MockOperatorMockRecorder) Restart(arg0, arg1 interface{}) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restart", reflect.TypeOf((*MockOperator)(nil).Restart), arg0, arg1)
 }
+
+// UpdateAutoscaler mocks base method.
+func (m *MockOperator) UpdateAutoscaler(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAutoscaler", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAutoscaler indicates an expected call of UpdateAutoscaler.
+func (mr *MockOperatorMockRecorder) UpdateAutoscaler(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAutoscaler", reflect.TypeOf((*MockOperator)(nil).UpdateAutoscaler), arg0)
+}
+
+// UpdateCertificates mocks base method.
+func (m *MockOperator) UpdateCertificates(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCertificates", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCertificates indicates an expected call of UpdateCertificates.
+func (mr *MockOperatorMockRecorder) UpdateCertificates(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCertificates", reflect.TypeOf((*MockOperator)(nil).UpdateCertificates), arg0)
+}
@@ -9,6 +9,8 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"net/url"
+	"strings"
 
 	machnet "k8s.io/apimachinery/pkg/util/net"
 	"k8s.io/client-go/rest"
@@ -42,6 +44,11 @@ func RestConfig(dialer proxy.Dialer, oc *api.OpenShiftCluster) (*rest.Config, er
 		return nil, err
 	}
 
+	restconfig.Host, err = internalHost(restconfig.Host)
+	if err != nil {
+		return nil, err
+	}
+
 	restconfig.Dial = DialContext(dialer, oc)
 
 	// https://github.com/kubernetes/kubernetes/issues/118703#issuecomment-1595072383
@@ -65,3 +72,22 @@ func DialContext(dialer proxy.Dialer, oc *api.OpenShiftCluster) func(ctx context
 		return dialer.DialContext(ctx, network, oc.Properties.NetworkProfile.APIServerPrivateEndpointIP+":"+port)
 	}
 }
+
+// internalHost returns the host of the API server with its api-int name.  The
+// kube-apiserver serves api-int with a certificate signed by the cluster's
+// internal CA, which is the only CA our kubeconfigs trust, whereas the api
+// name may serve a certificate provided by the customer (see the certificates
+// operator controller).  The host name only determines the SNI and the name
+// which is verified: DialContext always connects to the private endpoint.
+func internalHost(host string) (string, error) {
+	u, err := url.Parse(host)
+	if err != nil {
+		return "", err
+	}
+
+	if strings.HasPrefix(u.Hostname(), "api.") {
+		u.Host = strings.Replace(u.Host, "api.", "api-int.", 1)
+	}
+
+	return u.String(), nil
+}
@@ -70,3 +70,37 @@ func TestDialContext(t *testing.T) {
 		})
 	}
 }
+
+func TestInternalHost(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		host string
+		want string
+	}{
+		{
+			name: "api-int is kept",
+			host: "https://api-int.cluster.location.aroapp.io:6443",
+			want: "https://api-int.cluster.location.aroapp.io:6443",
+		},
+		{
+			name: "api is replaced by api-int",
+			host: "https://api.cluster.example.com:6443",
+			want: "https://api-int.cluster.example.com:6443",
+		},
+		{
+			name: "other hosts are kept",
+			host: "https://kubernetes:6443",
+			want: "https://kubernetes:6443",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := internalHost(tt.host)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("got %s, wanted %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -32,6 +32,7 @@ var timeoutConditionErrors = map[string]string{
 	"ingressControllerReady":                 "Ingress Cluster Operator has not started successfully.",
 	"aroDeploymentReady":                     "ARO Cluster Operator has failed to initialize successfully.",
 	"ensureAROOperatorRunningDesiredVersion": "ARO Cluster Operator is not running desired version.",
+	"aroOperatorHealthy":                     "ARO Cluster Operator is reporting unhealthy conditions.",
 	"hiveClusterDeploymentReady":             "Timed out waiting for the condition to be ready.",
 	"hiveClusterInstallationComplete":        "Timed out waiting for the condition to complete.",
 }
@@ -0,0 +1,57 @@
+package version
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"fmt"
+
+	configv1 "github.com/openshift/api/config/v1"
+)
+
+// ValidateUpgradeVersions returns an error if upgrading from one version to
+// another breaks the static ARO upgrade rules: the major version must not
+// change, the minor version may only advance by one at a time and downgrades
+// or re-applying the current version are not allowed.
+func ValidateUpgradeVersions(from, to *Version) error {
+	switch {
+	case !from.Lt(to):
+		return fmt.Errorf("cannot upgrade from %s to %s: target is not newer", from, to)
+	case from.V[0] != to.V[0]:
+		return fmt.Errorf("cannot upgrade from %s to %s: major version upgrades are not supported", from, to)
+	case to.V[1] > from.V[1]+1:
+		return fmt.Errorf("cannot upgrade from %s to %s: minor versions cannot be skipped", from, to)
+	}
+
+	return nil
+}
+
+// ValidateUpgrade returns an error if upgrading the cluster to the given
+// version is not allowed: on top of the static rules of
+// ValidateUpgradeVersions, the version must be an edge of the cluster's
+// upgrade graph, i.e. one of the available updates reported by the cluster
+// version operator.
+func ValidateUpgrade(cv *configv1.ClusterVersion, to *Version) error {
+	from, err := GetClusterVersion(cv)
+	if err != nil {
+		return err
+	}
+
+	err = ValidateUpgradeVersions(from, to)
+	if err != nil {
+		return err
+	}
+
+	for _, update := range cv.Status.AvailableUpdates {
+		v, err := ParseVersion(update.Version)
+		if err != nil {
+			continue
+		}
+
+		if v.Eq(to) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("cannot upgrade from %s to %s: target is not an available update of the cluster", from, to)
+}
@@ -0,0 +1,159 @@
+package version
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"testing"
+
+	configv1 "github.com/openshift/api/config/v1"
+
+	utilerror "github.com/Azure/ARO-RP/test/util/error"
+)
+
+func TestValidateUpgradeVersions(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		from    *Version
+		to      *Version
+		wantErr string
+	}{
+		{
+			name: "patch upgrade",
+			from: NewVersion(4, 12, 25),
+			to:   NewVersion(4, 12, 30),
+		},
+		{
+			name: "minor upgrade",
+			from: NewVersion(4, 12, 25),
+			to:   NewVersion(4, 13, 1),
+		},
+		{
+			name:    "same version",
+			from:    NewVersion(4, 12, 25),
+			to:      NewVersion(4, 12, 25),
+			wantErr: "cannot upgrade from 4.12.25 to 4.12.25: target is not newer",
+		},
+		{
+			name:    "downgrade",
+			from:    NewVersion(4, 13, 1),
+			to:      NewVersion(4, 12, 25),
+			wantErr: "cannot upgrade from 4.13.1 to 4.12.25: target is not newer",
+		},
+		{
+			name:    "skipped minor version",
+			from:    NewVersion(4, 11, 40),
+			to:      NewVersion(4, 13, 1),
+			wantErr: "cannot upgrade from 4.11.40 to 4.13.1: minor versions cannot be skipped",
+		},
+		{
+			name:    "major version",
+			from:    NewVersion(4, 14, 1),
+			to:      NewVersion(5, 0, 0),
+			wantErr: "cannot upgrade from 4.14.1 to 5.0.0: major version upgrades are not supported",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateUpgradeVersions(tt.from, tt.to)
+			utilerror.AssertErrorMessage(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestValidateUpgrade(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		cv      *configv1.ClusterVersion
+		to      *Version
+		wantErr string
+	}{
+		{
+			name: "available update",
+			cv: &configv1.ClusterVersion{
+				Status: configv1.ClusterVersionStatus{
+					History: []configv1.UpdateHistory{
+						{
+							State:   configv1.CompletedUpdate,
+							Version: "4.12.25",
+						},
+					},
+					AvailableUpdates: []configv1.Release{
+						{
+							Version: "4.12.30",
+						},
+						{
+							Version: "4.13.1",
+						},
+					},
+				},
+			},
+			to: NewVersion(4, 13, 1),
+		},
+		{
+			name: "not an available update",
+			cv: &configv1.ClusterVersion{
+				Status: configv1.ClusterVersionStatus{
+					History: []configv1.UpdateHistory{
+						{
+							State:   configv1.CompletedUpdate,
+							Version: "4.12.25",
+						},
+					},
+					AvailableUpdates: []configv1.Release{
+						{
+							Version: "4.12.30",
+						},
+					},
+				},
+			},
+			to:      NewVersion(4, 13, 1),
+			wantErr: "cannot upgrade from 4.12.25 to 4.13.1: target is not an available update of the cluster",
+		},
+		{
+			name: "no available updates",
+			cv: &configv1.ClusterVersion{
+				Status: configv1.ClusterVersionStatus{
+					History: []configv1.UpdateHistory{
+						{
+							State:   configv1.CompletedUpdate,
+							Version: "4.12.25",
+						},
+					},
+				},
+			},
+			to:      NewVersion(4, 12, 30),
+			wantErr: "cannot upgrade from 4.12.25 to 4.12.30: target is not an available update of the cluster",
+		},
+		{
+			name: "available update breaking the static rules",
+			cv: &configv1.ClusterVersion{
+				Status: configv1.ClusterVersionStatus{
+					History: []configv1.UpdateHistory{
+						{
+							State:   configv1.CompletedUpdate,
+							Version: "4.11.40",
+						},
+					},
+					AvailableUpdates: []configv1.Release{
+						{
+							Version: "4.13.1",
+						},
+					},
+				},
+			},
+			to:      NewVersion(4, 13, 1),
+			wantErr: "cannot upgrade from 4.11.40 to 4.13.1: minor versions cannot be skipped",
+		},
+		{
+			name:    "unknown cluster version",
+			cv:      &configv1.ClusterVersion{},
+			to:      NewVersion(4, 13, 1),
+			wantErr: "unknown cluster version",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateUpgrade(tt.cv, tt.to)
+			utilerror.AssertErrorMessage(t, err, tt.wantErr)
+		})
+	}
+}
@@ -13,11 +13,12 @@ import {
   IIconStyles,
 } from "@fluentui/react"
 import { AxiosResponse } from "axios"
-import { fetchClusterInfo } from "./Request"
+import { fetchClusterInfo, fetchDashboards } from "./Request"
 import { IClusterCoordinates, headerStyles } from "./App"
 import { Nav, INavLink, INavStyles } from "@fluentui/react/lib/Nav"
 import { ToolIcons } from "./ToolIcons"
 import { MemoisedClusterDetailListComponent } from "./ClusterDetailList"
+import { IDashboard } from "./ClusterDetailListComponents/Statistics/Statistics"
 import React from "react"
 import { useLinkClickHandler, useNavigate, useParams } from "react-router-dom"
 
@@ -70,11 +71,11 @@ export const overviewKey = "overview"
 export const nodesKey = "nodes"
 export const machinesKey = "machines"
 export const machineSetsKey = "machinesets"
-export const apiStatisticsKey = "apistatistics"
-export const kcmStatisticsKey = "kcmstatistics"
-export const dnsStatisticsKey = "dnsstatistics"
-export const ingressStatisticsKey = "ingressstatistics"
+export const dashboardsKey = "dashboards"
 export const clusterOperatorsKey = "clusteroperators"
+export const podsKey = "pods"
+export const eventsKey = "events"
+export const elevatedAccessKey = "elevatedaccess"
 
 const errorBarStyles: Partial<IMessageBarStyles> = { root: { marginBottom: 15 } }
 
@@ -89,6 +90,7 @@ export function ClusterDetailPanel(props: {
   const [fetching, setFetching] = useState("")
   const [isOpen, { setTrue: openPanel, setFalse: dismissPanel }] = useBoolean(false) // panel controls
   const [dataLoaded, setDataLoaded] = useState<boolean>(false)
+  const [dashboards, setDashboards] = useState<IDashboard[]>([])
   const [customPanelStyle, setcustomPanelStyle] = useState<Partial<IPanelStyles>>({
     root: { top: "40px", left: "225px" },
     content: { paddingLeft: 30, paddingRight: 5 },
@@ -152,40 +154,48 @@ export function ClusterDetailPanel(props: {
           url: machineSetsKey,
           icon: "BuildQueue",
         },
-        {
-          name: "APIStatistics",
-          key: apiStatisticsKey,
-          url: apiStatisticsKey,
+        ...dashboards.map((dashboard) => ({
+          name: dashboard.title,
+          key: dashboardsKey + "/" + dashboard.name,
+          url: dashboardsKey + "/" + dashboard.name,
           icon: "BIDashboard",
-        },
+        })),
         {
-          name: "KCMStatistics",
-          key: kcmStatisticsKey,
-          url: kcmStatisticsKey,
-          icon: "BIDashboard",
+          name: 'ClusterOperators',
+          key: clusterOperatorsKey,
+          url: clusterOperatorsKey,
+          icon: 'Shapes',
         },
         {
-          name: "DNSStatistics",
-          key: dnsStatisticsKey,
-          url: dnsStatisticsKey,
-          icon: "BIDashboard",
+          name: "Pods",
+          key: podsKey,
+          url: podsKey,
+          icon: "Package",
         },
         {
-          name: "IngressStatistics",
-          key: ingressStatisticsKey,
-          url: ingressStatisticsKey,
-          icon: "BIDashboard",
+          name: "Events",
+          key: eventsKey,
+          url: eventsKey,
+          icon: "Ringer",
         },
         {
-          name: 'ClusterOperators',
-          key: clusterOperatorsKey,
-          url: clusterOperatorsKey,
-          icon: 'Shapes',
+          name: "Elevated Access",
+          key: elevatedAccessKey,
+          url: elevatedAccessKey,
+          icon: "Permissions",
         },
       ],
     },
   ]
 
+  useEffect(() => {
+    fetchDashboards().then((result: AxiosResponse | null) => {
+      if (result?.status === 200) {
+        setDashboards(result.data)
+      }
+    })
+  }, [])
+
   // updateData - updates the state of the component
   // can be used if we want a refresh button.
   // api/clusterdetail returns a single item.
@@ -331,6 +341,8 @@ export function ClusterDetailPanel(props: {
               item={data}
               cluster={currentCluster}
               isDataLoaded={dataLoaded}
+              csrfToken={props.csrfToken}
+              dashboards={dashboards}
             />
           </Stack.Item>
         </Stack>
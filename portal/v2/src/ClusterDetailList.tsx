@@ -1,20 +1,25 @@
-import React from "react"
+import React, { MutableRefObject } from "react"
 import { Navigate, Route, Routes } from "react-router-dom"
 
 import { OverviewWrapper } from "./ClusterDetailListComponents/OverviewWrapper"
 import { NodesWrapper } from "./ClusterDetailListComponents/NodesWrapper"
 import { MachinesWrapper } from "./ClusterDetailListComponents/MachinesWrapper"
 import { MachineSetsWrapper } from "./ClusterDetailListComponents/MachineSetsWrapper"
-import { Statistics } from "./ClusterDetailListComponents/Statistics/Statistics"
+import { IDashboard, Statistics } from "./ClusterDetailListComponents/Statistics/Statistics"
 import { ClusterOperatorsWrapper } from "./ClusterDetailListComponents/ClusterOperatorsWrapper";
+import { PodsWrapper } from "./ClusterDetailListComponents/PodsWrapper"
+import { EventsWrapper } from "./ClusterDetailListComponents/EventsWrapper"
+import { ElevatedAccessWrapper } from "./ClusterDetailListComponents/ElevatedAccessWrapper"
 
 import { IClusterCoordinates } from "./App"
-import { apiStatisticsKey, clusterOperatorsKey, dnsStatisticsKey, ingressStatisticsKey, kcmStatisticsKey, machineSetsKey, machinesKey, nodesKey, overviewKey } from "./ClusterDetail"
+import { clusterOperatorsKey, dashboardsKey, elevatedAccessKey, eventsKey, machineSetsKey, machinesKey, nodesKey, overviewKey, podsKey } from "./ClusterDetail"
 
 interface ClusterDetailComponentProps {
   item: IClusterDetails
   cluster: IClusterCoordinates | null
   isDataLoaded: boolean
+  csrfToken: MutableRefObject<string>
+  dashboards: IDashboard[]
 }
 
 export interface IClusterDetails {
@@ -52,11 +57,17 @@ export function ClusterDetailComponent(props: ClusterDetailComponentProps) {
       <Route path="nodes" element={<NodesWrapper currentCluster={props.cluster!} detailPanelSelected={nodesKey} loaded={props.isDataLoaded} />} />
       <Route path="machines" element={<MachinesWrapper currentCluster={props.cluster!} detailPanelSelected={machinesKey} loaded={props.isDataLoaded} />} />
       <Route path="machinesets" element={<MachineSetsWrapper currentCluster={props.cluster!} detailPanelSelected={machineSetsKey} loaded={props.isDataLoaded} />} />
-      <Route path="apistatistics" element={<Statistics currentCluster={props.cluster!} detailPanelSelected={apiStatisticsKey} loaded={props.isDataLoaded} statisticsType="api" />} />
-      <Route path="kcmstatistics" element={<Statistics currentCluster={props.cluster!} detailPanelSelected={kcmStatisticsKey} loaded={props.isDataLoaded} statisticsType="kcm" />} />
-      <Route path="dnsstatistics" element={<Statistics currentCluster={props.cluster!} detailPanelSelected={dnsStatisticsKey} loaded={props.isDataLoaded} statisticsType="dns" />} />
-      <Route path="ingressstatistics" element={<Statistics currentCluster={props.cluster!} detailPanelSelected={ingressStatisticsKey} loaded={props.isDataLoaded} statisticsType="ingress" />} />
+      {props.dashboards.map((dashboard) => (
+        <Route key={dashboard.name} path={dashboardsKey + "/" + dashboard.name} element={<Statistics currentCluster={props.cluster!} detailPanelSelected={dashboardsKey} loaded={props.isDataLoaded} dashboard={dashboard} />} />
+      ))}
+      <Route path="apistatistics" element={<Navigate to={"../" + dashboardsKey + "/api"} />} />
+      <Route path="kcmstatistics" element={<Navigate to={"../" + dashboardsKey + "/kcm"} />} />
+      <Route path="dnsstatistics" element={<Navigate to={"../" + dashboardsKey + "/dns"} />} />
+      <Route path="ingressstatistics" element={<Navigate to={"../" + dashboardsKey + "/ingress"} />} />
       <Route path="clusteroperators" element={<ClusterOperatorsWrapper currentCluster={props.cluster!} detailPanelSelected={clusterOperatorsKey} loaded={props.isDataLoaded} />} />
+      <Route path="pods" element={<PodsWrapper currentCluster={props.cluster!} detailPanelSelected={podsKey} loaded={props.isDataLoaded} />} />
+      <Route path="events" element={<EventsWrapper currentCluster={props.cluster!} detailPanelSelected={eventsKey} loaded={props.isDataLoaded} />} />
+      <Route path="elevatedaccess" element={<ElevatedAccessWrapper currentCluster={props.cluster!} detailPanelSelected={elevatedAccessKey} loaded={props.isDataLoaded} csrfToken={props.csrfToken} />} />
     </Routes>
   )
 }
@@ -0,0 +1,180 @@
+import { useState, useEffect, MutableRefObject } from "react"
+import { AxiosResponse } from "axios"
+import { ElevatedAccessAction, fetchElevatedAccess, RequestElevatedAccess } from "../Request"
+import {
+  IMessageBarStyles,
+  MessageBar,
+  MessageBarType,
+  Stack,
+  CommandBar,
+  ICommandBarItemProps,
+  TextField,
+  PrimaryButton,
+  DefaultButton,
+  SelectionMode,
+} from "@fluentui/react"
+import { DetailsList, IColumn } from "@fluentui/react/lib/DetailsList"
+import { elevatedAccessKey } from "../ClusterDetail"
+import { WrapperProps } from "../ClusterDetailList"
+
+export interface IElevatedAccess {
+  id: string
+  username: string
+  resourceId: string
+  active: boolean
+  justification: string
+  incidentId: string
+  duration: number
+  state: string
+  requestedAt: string
+  decidedBy?: string
+  expiresAt?: string
+  revokedBy?: string
+}
+
+interface ElevatedAccessWrapperProps extends WrapperProps {
+  csrfToken: MutableRefObject<string>
+}
+
+const defaultDuration = 240
+
+export function ElevatedAccessWrapper(props: ElevatedAccessWrapperProps) {
+  const [grants, setGrants] = useState<IElevatedAccess[]>([])
+  const [justification, setJustification] = useState<string>("")
+  const [incidentId, setIncidentId] = useState<string>("")
+  const [duration, setDuration] = useState<string>(String(defaultDuration))
+  const [refresh, setRefresh] = useState<number>(0)
+  const [error, setError] = useState<AxiosResponse | null>(null)
+
+  const errorBarStyles: Partial<IMessageBarStyles> = { root: { marginBottom: 15 } }
+
+  const errorBar = (): any => {
+    return (
+      <MessageBar
+        messageBarType={MessageBarType.error}
+        isMultiline={true}
+        onDismiss={() => setError(null)}
+        dismissButtonAriaLabel="Close"
+        styles={errorBarStyles}>
+        {typeof error?.data === "string" && error.data ? error.data : error?.statusText}
+      </MessageBar>
+    )
+  }
+
+  const onResult = (result: AxiosResponse | null) => {
+    if (result && result.status >= 400) {
+      setError(result)
+    }
+    setRefresh(refresh + 1)
+  }
+
+  const action = (grant: IElevatedAccess, action: "approve" | "deny" | "revoke") => {
+    ElevatedAccessAction(props.csrfToken.current, grant.id, action).then(onResult)
+  }
+
+  const columns: IColumn[] = [
+    { key: "requestedAt", name: "Requested", fieldName: "requestedAt", minWidth: 140, maxWidth: 160, isResizable: true },
+    { key: "username", name: "Requester", fieldName: "username", minWidth: 120, maxWidth: 200, isResizable: true },
+    { key: "incidentId", name: "Incident", fieldName: "incidentId", minWidth: 80, maxWidth: 120, isResizable: true },
+    { key: "justification", name: "Justification", fieldName: "justification", minWidth: 200, isResizable: true, isMultiline: true },
+    {
+      key: "state",
+      name: "State",
+      minWidth: 120,
+      maxWidth: 200,
+      isResizable: true,
+      onRender: (item: IElevatedAccess) => (
+        <span>
+          {item.active ? "Active until " + item.expiresAt : item.state}
+          {item.decidedBy ? " (" + item.decidedBy + ")" : ""}
+        </span>
+      ),
+    },
+    {
+      key: "actions",
+      name: "",
+      minWidth: 170,
+      onRender: (item: IElevatedAccess) => (
+        <Stack horizontal tokens={{ childrenGap: 5 }}>
+          {item.state === "Requested" && <DefaultButton text="Approve" onClick={() => action(item, "approve")} />}
+          {item.state === "Requested" && <DefaultButton text="Deny" onClick={() => action(item, "deny")} />}
+          {item.active && <DefaultButton text="Revoke" onClick={() => action(item, "revoke")} />}
+        </Stack>
+      ),
+    },
+  ]
+
+  const controlStyles = {
+    root: {
+      paddingLeft: 0,
+      float: "right",
+    },
+  }
+
+  const _items: ICommandBarItemProps[] = [
+    {
+      key: "refresh",
+      text: "Refresh",
+      iconProps: { iconName: "Refresh" },
+      onClick: () => setRefresh(refresh + 1),
+    },
+  ]
+
+  useEffect(() => {
+    const onData = (result: AxiosResponse | null) => {
+      if (result?.status === 200) {
+        setGrants(
+          result.data.filter(
+            (grant: IElevatedAccess) =>
+              grant.resourceId.toLowerCase() === props.currentCluster?.resourceId.toLowerCase()
+          )
+        )
+      } else {
+        setError(result)
+      }
+    }
+
+    if (props.detailPanelSelected.toLowerCase() == elevatedAccessKey && props.loaded && props.currentCluster) {
+      fetchElevatedAccess().then(onData)
+    }
+  }, [refresh, props.loaded, props.detailPanelSelected])
+
+  return (
+    <Stack>
+      <Stack.Item grow>{error && errorBar()}</Stack.Item>
+      <Stack horizontal verticalAlign="end" tokens={{ childrenGap: 10 }}>
+        <TextField label="Incident ID" value={incidentId} onChange={(_, v) => setIncidentId(v || "")} />
+        <TextField
+          label="Justification"
+          value={justification}
+          onChange={(_, v) => setJustification(v || "")}
+          styles={{ root: { width: 400 } }}
+        />
+        <TextField label="Duration (minutes)" value={duration} onChange={(_, v) => setDuration(v || "")} />
+        <PrimaryButton
+          text="Request"
+          disabled={!incidentId || !justification || !props.currentCluster}
+          onClick={() =>
+            RequestElevatedAccess(
+              props.csrfToken.current,
+              props.currentCluster!.resourceId,
+              justification,
+              incidentId,
+              Number(duration)
+            ).then(onResult)
+          }
+        />
+        <Stack.Item grow>
+          <CommandBar items={_items} ariaLabel="Refresh" styles={controlStyles} />
+        </Stack.Item>
+      </Stack>
+      <DetailsList
+        setKey="none"
+        items={grants}
+        columns={columns}
+        selectionMode={SelectionMode.none}
+        ariaLabelForGrid="Elevated access"
+      />
+    </Stack>
+  )
+}
@@ -0,0 +1,163 @@
+import { useState, useEffect } from "react"
+import { AxiosResponse } from "axios"
+import { eventsSource, fetchNamespaces } from "../Request"
+import {
+  IMessageBarStyles,
+  MessageBar,
+  MessageBarType,
+  Stack,
+  CommandBar,
+  ICommandBarItemProps,
+  Dropdown,
+  IDropdownOption,
+  SelectionMode,
+} from "@fluentui/react"
+import { DetailsList, IColumn } from "@fluentui/react/lib/DetailsList"
+import { eventsKey } from "../ClusterDetail"
+import { WrapperProps } from "../ClusterDetailList"
+
+export interface IEvent {
+  namespace: string
+  name: string
+  type: string
+  reason: string
+  message: string
+  objectKind: string
+  objectName: string
+  count: number
+  lastTimestamp: string
+}
+
+const allNamespaces = ""
+
+// maxEvents is the number of most recent events kept on screen
+const maxEvents = 500
+
+export function EventsWrapper(props: WrapperProps) {
+  const [events, setEvents] = useState<IEvent[]>([])
+  const [namespaces, setNamespaces] = useState<IDropdownOption[]>([])
+  const [namespace, setNamespace] = useState<string>(allNamespaces)
+  const [paused, setPaused] = useState<boolean>(false)
+  const [error, setError] = useState<AxiosResponse | null>(null)
+
+  const errorBarStyles: Partial<IMessageBarStyles> = { root: { marginBottom: 15 } }
+
+  const errorBar = (): any => {
+    return (
+      <MessageBar
+        messageBarType={MessageBarType.error}
+        isMultiline={false}
+        onDismiss={() => setError(null)}
+        dismissButtonAriaLabel="Close"
+        styles={errorBarStyles}>
+        {error?.statusText}
+      </MessageBar>
+    )
+  }
+
+  const columns: IColumn[] = [
+    { key: "lastTimestamp", name: "Last Seen", fieldName: "lastTimestamp", minWidth: 140, maxWidth: 160, isResizable: true },
+    { key: "type", name: "Type", fieldName: "type", minWidth: 60, maxWidth: 70, isResizable: true },
+    { key: "namespace", name: "Namespace", fieldName: "namespace", minWidth: 100, maxWidth: 200, isResizable: true },
+    {
+      key: "object",
+      name: "Object",
+      minWidth: 150,
+      maxWidth: 300,
+      isResizable: true,
+      onRender: (item: IEvent) => <span>{item.objectKind + "/" + item.objectName}</span>,
+    },
+    { key: "reason", name: "Reason", fieldName: "reason", minWidth: 100, maxWidth: 150, isResizable: true },
+    { key: "count", name: "Count", fieldName: "count", minWidth: 40, maxWidth: 50, isResizable: true },
+    { key: "message", name: "Message", fieldName: "message", minWidth: 200, isResizable: true, isMultiline: true },
+  ]
+
+  const controlStyles = {
+    root: {
+      paddingLeft: 0,
+      float: "right",
+    },
+  }
+
+  const _items: ICommandBarItemProps[] = [
+    {
+      key: "pause",
+      text: paused ? "Resume" : "Pause",
+      iconProps: { iconName: paused ? "Play" : "Pause" },
+      onClick: () => setPaused(!paused),
+    },
+    {
+      key: "clear",
+      text: "Clear",
+      iconProps: { iconName: "Clear" },
+      onClick: () => setEvents([]),
+    },
+  ]
+
+  useEffect(() => {
+    const onData = (result: AxiosResponse | null) => {
+      if (result?.status === 200) {
+        setNamespaces(
+          [{ key: allNamespaces, text: "All namespaces" }].concat(
+            result.data.namespaces.map((element: { name: string }) => {
+              return { key: element.name, text: element.name }
+            })
+          )
+        )
+      } else {
+        setError(result)
+      }
+    }
+
+    if (props.detailPanelSelected.toLowerCase() == eventsKey && props.loaded && props.currentCluster) {
+      fetchNamespaces(props.currentCluster).then(onData)
+    }
+  }, [props.loaded, props.detailPanelSelected])
+
+  useEffect(() => {
+    if (props.detailPanelSelected.toLowerCase() != eventsKey ||
+        !props.loaded ||
+        !props.currentCluster ||
+        paused) {
+      return
+    }
+
+    const source = eventsSource(props.currentCluster, namespace)
+    source.onmessage = (message: MessageEvent) => {
+      const event: IEvent = JSON.parse(message.data)
+      setEvents((events) =>
+        [event]
+          .concat(events.filter((e) => e.namespace !== event.namespace || e.name !== event.name))
+          .slice(0, maxEvents)
+      )
+    }
+
+    return () => source.close()
+  }, [namespace, paused, props.loaded, props.detailPanelSelected])
+
+  return (
+    <Stack>
+      <Stack.Item grow>{error && errorBar()}</Stack.Item>
+      <Stack horizontal horizontalAlign="space-between">
+        <Dropdown
+          placeholder="All namespaces"
+          options={namespaces}
+          selectedKey={namespace}
+          onChange={(_, option) => {
+            setEvents([])
+            setNamespace(option?.key as string)
+          }}
+          styles={{ dropdown: { width: 300 } }}
+        />
+        <CommandBar items={_items} ariaLabel="Events" styles={controlStyles} />
+      </Stack>
+      <DetailsList
+        setKey="none"
+        items={events}
+        columns={columns}
+        selectionMode={SelectionMode.none}
+        ariaLabelForGrid="Events"
+      />
+    </Stack>
+  )
+}
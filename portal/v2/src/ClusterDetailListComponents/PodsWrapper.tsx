@@ -0,0 +1,249 @@
+import { useState, useEffect } from "react"
+import { AxiosResponse } from "axios"
+import { fetchContainerLogs, fetchNamespaces, fetchPods } from "../Request"
+import {
+  IMessageBarStyles,
+  MessageBar,
+  MessageBarType,
+  Stack,
+  CommandBar,
+  ICommandBarItemProps,
+  Dropdown,
+  IDropdownOption,
+  Link,
+  Panel,
+  PanelType,
+  SelectionMode,
+} from "@fluentui/react"
+import { IColumn } from "@fluentui/react/lib/DetailsList"
+import { ShimmeredDetailsList } from "@fluentui/react/lib/ShimmeredDetailsList"
+import { podsKey } from "../ClusterDetail"
+import { WrapperProps } from "../ClusterDetailList"
+
+export interface IContainer {
+  name: string
+  image: string
+  ready: boolean
+  restartCount: number
+  state: string
+  reason?: string
+}
+
+export interface IPod {
+  namespace: string
+  name: string
+  nodeName: string
+  phase: string
+  ready: string
+  restartCount: number
+  createdTime: string
+  containers: IContainer[]
+}
+
+const allNamespaces = ""
+const logTailLines = 500
+
+export function PodsWrapper(props: WrapperProps) {
+  const [pods, setPods] = useState<IPod[]>([])
+  const [namespaces, setNamespaces] = useState<IDropdownOption[]>([])
+  const [namespace, setNamespace] = useState<string>(allNamespaces)
+  const [error, setError] = useState<AxiosResponse | null>(null)
+  const [fetching, setFetching] = useState("")
+  const [currentPod, setCurrentPod] = useState<IPod | null>(null)
+  const [container, setContainer] = useState<string>("")
+  const [logs, setLogs] = useState<string>("")
+
+  const errorBarStyles: Partial<IMessageBarStyles> = { root: { marginBottom: 15 } }
+
+  const errorBar = (): any => {
+    return (
+      <MessageBar
+        messageBarType={MessageBarType.error}
+        isMultiline={false}
+        onDismiss={() => setError(null)}
+        dismissButtonAriaLabel="Close"
+        styles={errorBarStyles}>
+        {error?.statusText}
+      </MessageBar>
+    )
+  }
+
+  const columns: IColumn[] = [
+    {
+      key: "namespace",
+      name: "Namespace",
+      fieldName: "namespace",
+      minWidth: 120,
+      maxWidth: 250,
+      isResizable: true,
+    },
+    {
+      key: "name",
+      name: "Name",
+      fieldName: "name",
+      minWidth: 150,
+      maxWidth: 350,
+      isResizable: true,
+      onRender: (item: IPod) => <Link onClick={() => _onPodLinkClick(item)}>{item.name}</Link>,
+    },
+    {
+      key: "phase",
+      name: "Phase",
+      fieldName: "phase",
+      minWidth: 70,
+      maxWidth: 100,
+      isResizable: true,
+    },
+    {
+      key: "ready",
+      name: "Ready",
+      fieldName: "ready",
+      minWidth: 50,
+      maxWidth: 60,
+      isResizable: true,
+    },
+    {
+      key: "restartCount",
+      name: "Restarts",
+      fieldName: "restartCount",
+      minWidth: 60,
+      maxWidth: 70,
+      isResizable: true,
+    },
+    {
+      key: "nodeName",
+      name: "Node",
+      fieldName: "nodeName",
+      minWidth: 150,
+      maxWidth: 300,
+      isResizable: true,
+    },
+  ]
+
+  const controlStyles = {
+    root: {
+      paddingLeft: 0,
+      float: "right",
+    },
+  }
+
+  const _items: ICommandBarItemProps[] = [
+    {
+      key: "refresh",
+      text: "Refresh",
+      iconProps: { iconName: "Refresh" },
+      onClick: () => {
+        setPods([])
+        setFetching("")
+      },
+    },
+  ]
+
+  function _onPodLinkClick(pod: IPod) {
+    setCurrentPod(pod)
+    setLogs("")
+    setContainer(pod.containers.length > 0 ? pod.containers[0].name : "")
+  }
+
+  useEffect(() => {
+    const onData = (result: AxiosResponse | null) => {
+      if (result?.status === 200) {
+        setNamespaces(
+          [{ key: allNamespaces, text: "All namespaces" }].concat(
+            result.data.namespaces.map((element: { name: string }) => {
+              return { key: element.name, text: element.name }
+            })
+          )
+        )
+      } else {
+        setError(result)
+      }
+    }
+
+    if (props.detailPanelSelected.toLowerCase() == podsKey && props.loaded && props.currentCluster) {
+      fetchNamespaces(props.currentCluster).then(onData)
+    }
+  }, [props.loaded, props.detailPanelSelected])
+
+  useEffect(() => {
+    const onData = (result: AxiosResponse | null) => {
+      if (result?.status === 200) {
+        setPods(result.data.pods)
+      } else {
+        setError(result)
+      }
+      if (props.currentCluster) {
+        setFetching(props.currentCluster.name)
+      }
+    }
+
+    if (props.detailPanelSelected.toLowerCase() == podsKey &&
+        fetching === "" &&
+        props.loaded &&
+        props.currentCluster) {
+      setFetching("FETCHING")
+      fetchPods(props.currentCluster, namespace).then(onData)
+    }
+  }, [pods, namespace, fetching, props.loaded, props.detailPanelSelected])
+
+  useEffect(() => {
+    const onData = (result: AxiosResponse | null) => {
+      if (result?.status === 200) {
+        setLogs(result.data)
+      } else {
+        setError(result)
+      }
+    }
+
+    if (currentPod && container && props.currentCluster) {
+      fetchContainerLogs(props.currentCluster, currentPod.namespace, currentPod.name, container, logTailLines).then(onData)
+    }
+  }, [currentPod, container])
+
+  return (
+    <Stack>
+      <Stack.Item grow>{error && errorBar()}</Stack.Item>
+      <Stack horizontal horizontalAlign="space-between">
+        <Dropdown
+          placeholder="All namespaces"
+          options={namespaces}
+          selectedKey={namespace}
+          onChange={(_, option) => {
+            setNamespace(option?.key as string)
+            setPods([])
+            setFetching("")
+          }}
+          styles={{ dropdown: { width: 300 } }}
+        />
+        <CommandBar items={_items} ariaLabel="Refresh" styles={controlStyles} />
+      </Stack>
+      <ShimmeredDetailsList
+        setKey="none"
+        items={pods}
+        columns={columns}
+        selectionMode={SelectionMode.none}
+        enableShimmer={fetching === "FETCHING"}
+        ariaLabelForShimmer="Content is being fetched"
+        ariaLabelForGrid="Item details"
+      />
+      <Panel
+        isOpen={currentPod !== null}
+        onDismiss={() => setCurrentPod(null)}
+        type={PanelType.large}
+        headerText={currentPod ? currentPod.namespace + "/" + currentPod.name : ""}>
+        <Dropdown
+          label="Container"
+          options={(currentPod?.containers || []).map((c) => {
+            return { key: c.name, text: `${c.name} (${c.state}${c.reason ? ": " + c.reason : ""}, ${c.restartCount} restarts)` }
+          })}
+          selectedKey={container}
+          onChange={(_, option) => {
+            setLogs("")
+            setContainer(option?.key as string)
+          }}
+        />
+        <pre style={{ whiteSpace: "pre-wrap", wordBreak: "break-all", fontSize: 12 }}>{logs}</pre>
+      </Panel>
+    </Stack>
+  )
+}
@@ -32,12 +32,30 @@ export const iconButtonStyles = mergeStyleSets({
   },
 })
 
+export interface IThreshold {
+  value: number
+  severity: string
+}
+
+export interface IPanel {
+  name: string
+  title: string
+  unit?: string
+  thresholds?: IThreshold[]
+}
+
+export interface IDashboard {
+  name: string
+  title: string
+  panels: IPanel[]
+}
+
 const global = new Date()
 export function Statistics(props: {
   currentCluster: IClusterCoordinates
   detailPanelSelected: string
   loaded: boolean
-  statisticsType: string
+  dashboard: IDashboard
 }) {
   const [globalDuration, setGlobalDuration] = useState<string>("1h")
   const [globalEndDate, setGlobalEndDate] = useState<Date>(global)
@@ -97,7 +115,7 @@ export function Statistics(props: {
   }
   const theme = getTheme()
 
-  function GraphWrapper(lprops: { heading: string; statisticsName: string }) {
+  function GraphWrapper(lprops: { heading: string; panel: IPanel }) {
     const [isModalOpen, { setTrue: showModal, setFalse: hideModal }] = useBoolean(false)
     const [duration, setDuration] = useState<string>(globalDuration)
     const [endDate, setEndDate] = useState<Date>(globalEndDate)
@@ -141,7 +159,8 @@ export function Statistics(props: {
               currentCluster={props.currentCluster}
               detailPanelSelected={props.detailPanelSelected}
               loaded={props.loaded}
-              statisticsName={lprops.statisticsName}
+              dashboard={props.dashboard.name}
+              panel={lprops.panel}
               duration={duration}
               endDate={endDate}
               graphHeight={500}
@@ -176,7 +195,8 @@ export function Statistics(props: {
             currentCluster={props.currentCluster}
             detailPanelSelected={props.detailPanelSelected}
             loaded={props.loaded}
-            statisticsName={lprops.statisticsName}
+            dashboard={props.dashboard.name}
+            panel={lprops.panel}
             duration={duration}
             endDate={endDate}
             graphHeight={200}
@@ -187,84 +207,23 @@ export function Statistics(props: {
     )
   }
 
-  interface Map {
-    [key: string]: {
-      stasticsName: string
-      heading: string
-    }[]
+  const heading = (panel: IPanel): string => {
+    return panel.unit ? `${panel.title} (${panel.unit})` : panel.title
   }
 
-  let statisticsDataMap: Map = {
-    api: [
-      { stasticsName: "kubeapicodes", heading: "KubeAPI Server response sizes by code and verb" },
-      { stasticsName: "kubeapicpu", heading: "KubeAPI CPU per instance" },
-      { stasticsName: "kubeapimemory", heading: "KubeAPI Memory per instance" },
-    ],
-    kcm: [
-      {
-        stasticsName: "kubecontrollermanagercodes",
-        heading: "Kube Controller Manager Server response sizes by code and verb",
-      },
-      {
-        stasticsName: "kubecontrollermanagercpu",
-        heading: "Kube Controller Manager CPU per instance",
-      },
-      {
-        stasticsName: "kubecontrollermanagermemory",
-        heading: "Kube Controller Manager Memory per instance",
-      },
-    ],
-    dns: [
-      {
-        stasticsName: "dnsresponsecodes",
-        heading: "Response Codes",
-      },
-      {
-        stasticsName: "dnsalltraffic",
-        heading: "All Traffic",
-      },
-      {
-        stasticsName: "dnserrorrate",
-        heading: "Error Rate",
-      },
-      {
-        stasticsName: "dnshealthcheck",
-        heading: "Health Check",
-      },
-      {
-        stasticsName: "dnsforwardedtraffic",
-        heading: "Forwarded Traffic",
-      },
-    ],
-    ingress: [
-      {
-        stasticsName: "ingresscontrollercondition",
-        heading: "Ingress Controller Condition",
-      },
-    ],
-  }
-
-  const statisticsJSX = (sDataMap: Map): JSX.Element[] => {
-    let sDataArray = sDataMap[props.statisticsType]
-    let sDataArrayLength = sDataArray.length
+  const statisticsJSX = (panels: IPanel[]): JSX.Element[] => {
     let stackItems: JSX.Element[] = []
     let stacks: JSX.Element[] = []
-    sDataArray.map((sData, i) => {
-      if (i % 2 != 0 || i === sDataArrayLength - 1) {
-        stackItems.push(
-          <Stack.Item>
-            <GraphWrapper statisticsName={sData.stasticsName} heading={sData.heading} />
-          </Stack.Item>
-        )
-        stacks.push(<Stack horizontal>{stackItems}</Stack>)
-        stackItems = []
-        return
-      }
+    panels.forEach((panel, i) => {
       stackItems.push(
-        <Stack.Item>
-          <GraphWrapper statisticsName={sData.stasticsName} heading={sData.heading} />
+        <Stack.Item key={panel.name}>
+          <GraphWrapper panel={panel} heading={heading(panel)} />
         </Stack.Item>
       )
+      if (i % 2 != 0 || i === panels.length - 1) {
+        stacks.push(<Stack horizontal key={panel.name}>{stackItems}</Stack>)
+        stackItems = []
+      }
     })
     return stacks
   }
@@ -272,7 +231,7 @@ export function Statistics(props: {
   return (
     <>
       <GlobalGraphOptionsBar />
-      {statisticsJSX(statisticsDataMap)}
+      {statisticsJSX(props.dashboard.panels)}
     </>
   )
 }
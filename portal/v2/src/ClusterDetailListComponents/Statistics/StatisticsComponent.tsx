@@ -11,10 +11,12 @@ import {
 } from "@fluentui/react-charting"
 import { DefaultPalette } from "@fluentui/react/lib/Styling"
 import { IMetrics } from "./StatisticsWrapper"
+import { IThreshold } from "./Statistics"
 import { convertToUTC } from "./GraphOptionsComponent"
 
 export function StatisticsComponent(props: {
   metrics: IMetrics[]
+  thresholds: IThreshold[]
   clusterName: any
   duration: string
   height: number
@@ -97,8 +99,26 @@ export function StatisticsComponent(props: {
         }
         newPoints.push(lineChartPoint)
       })
+
+      // draw each threshold as a flat line across the time range of the data
+      const times = newPoints.flatMap((p) => p.data.map((d) => d.x as Date))
+      if (times.length > 0) {
+        const start = new Date(Math.min(...times.map((t) => t.getTime())))
+        const end = new Date(Math.max(...times.map((t) => t.getTime())))
+        props.thresholds.forEach((threshold) => {
+          newPoints.push({
+            legend: `${threshold.severity} (${threshold.value})`,
+            data: [
+              { x: start, y: threshold.value },
+              { x: end, y: threshold.value },
+            ],
+            color: threshold.severity === "critical" ? DefaultPalette.redDark : DefaultPalette.orange,
+            lineOptions: { strokeDasharray: "5" },
+          })
+        })
+      }
       setPoints(newPoints)
-    }, [props.metrics, props.fetchStatus])
+    }, [props.metrics, props.thresholds, props.fetchStatus])
 
     useEffect(() => {
       setData({
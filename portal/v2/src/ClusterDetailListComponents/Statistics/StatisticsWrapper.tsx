@@ -3,12 +3,8 @@ import { AxiosResponse } from "axios"
 import { IClusterCoordinates } from "../../App"
 import { StatisticsComponent } from "./StatisticsComponent"
 import { fetchStatistics } from "../../Request"
-import {
-  apiStatisticsKey,
-  dnsStatisticsKey,
-  ingressStatisticsKey,
-  kcmStatisticsKey,
-} from "../../ClusterDetail"
+import { dashboardsKey } from "../../ClusterDetail"
+import { IPanel } from "./Statistics"
 import {
   IMessageBarStyles,
   MessageBar,
@@ -32,7 +28,8 @@ export function StatisticsWrapper(props: {
   currentCluster: IClusterCoordinates
   detailPanelSelected: string
   loaded: boolean
-  statisticsName: string
+  dashboard: string
+  panel: IPanel
   duration: string
   endDate: Date
   graphHeight: number
@@ -44,12 +41,6 @@ export function StatisticsWrapper(props: {
   const [localDuration, setLocalDuration] = useState(props.duration)
   const [localEndDate, setLocalEndDate] = useState(props.endDate)
   const errorBarStyles: Partial<IMessageBarStyles> = { root: { marginBottom: 15 } }
-  const statisticsKeys = [
-    apiStatisticsKey,
-    dnsStatisticsKey,
-    ingressStatisticsKey,
-    kcmStatisticsKey,
-  ]
 
   const errorBar = (): any => {
     return (
@@ -111,7 +102,7 @@ export function StatisticsWrapper(props: {
     }
 
     if (
-      statisticsKeys.includes(props.detailPanelSelected.toLowerCase()) &&
+      props.detailPanelSelected.toLowerCase() == dashboardsKey &&
       (fetching === "" || localDuration != props.duration || localEndDate != props.endDate) &&
       props.loaded &&
       props.currentCluster.name != ""
@@ -121,7 +112,8 @@ export function StatisticsWrapper(props: {
       setFetching("FETCHING")
       fetchStatistics(
         props.currentCluster,
-        props.statisticsName,
+        props.dashboard,
+        props.panel.name,
         props.duration,
         props.endDate
       ).then(onData)
@@ -139,6 +131,7 @@ export function StatisticsWrapper(props: {
         />
         <StatisticsComponent
           metrics={metrics}
+          thresholds={props.panel.thresholds || []}
           fetchStatus={fetching}
           duration={props.duration}
           clusterName={props.currentCluster != null ? props.currentCluster.name : ""}
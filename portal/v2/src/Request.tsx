@@ -146,9 +146,20 @@ export const RequestKubeconfig = async (
   }
 }
 
+export const fetchDashboards = async (): Promise<AxiosResponse | null> => {
+  try {
+    const result = await axios("/api/dashboards")
+    return result
+  } catch (e: any) {
+    const err = e.response as AxiosResponse
+    return OnError(err)
+  }
+}
+
 export const fetchStatistics = async (
   cluster: IClusterCoordinates,
-  statisticsName: string,
+  dashboard: string,
+  panel: string,
   duration: string,
   endDate: Date
 ): Promise<AxiosResponse | null> => {
@@ -156,7 +167,7 @@ export const fetchStatistics = async (
   let endDateJSON = endDate.toJSON()
   try {
     const result = await axios(
-      `/api/${cluster.subscription}/${cluster.resourceGroup}/${cluster.name}/statistics/${statisticsName}?duration=${duration}&endtime=${endDateJSON}`
+      `/api/${cluster.subscription}/${cluster.resourceGroup}/${cluster.name}/dashboards/${dashboard}/panels/${panel}?duration=${duration}&endtime=${endDateJSON}`
     )
     return result
   } catch (e: any) {
@@ -164,3 +175,110 @@ export const fetchStatistics = async (
     return OnError(err)
   }
 }
+
+export const fetchNamespaces = async (cluster: IClusterCoordinates): Promise<AxiosResponse | null> => {
+  try {
+    const result = await axios(
+      ["/api", cluster.subscription, cluster.resourceGroup, cluster.name, "namespaces"].join("/"))
+    return result
+  } catch (e: any) {
+    const err = e.response as AxiosResponse
+    return OnError(err)
+  }
+}
+
+export const fetchPods = async (cluster: IClusterCoordinates, namespace: string): Promise<AxiosResponse | null> => {
+  try {
+    const result = await axios(
+      ["/api", cluster.subscription, cluster.resourceGroup, cluster.name, "pods"].join("/") +
+        (namespace ? "?namespace=" + encodeURIComponent(namespace) : "")
+    )
+    return result
+  } catch (e: any) {
+    const err = e.response as AxiosResponse
+    return OnError(err)
+  }
+}
+
+export const fetchContainerLogs = async (
+  cluster: IClusterCoordinates,
+  namespace: string,
+  pod: string,
+  container: string,
+  tailLines: number
+): Promise<AxiosResponse | null> => {
+  try {
+    const result = await axios(
+      ["/api", cluster.subscription, cluster.resourceGroup, cluster.name, "namespaces", namespace, "pods", pod, "logs"].join("/") +
+        `?container=${encodeURIComponent(container)}&tailLines=${tailLines}`,
+      { responseType: "text" }
+    )
+    return result
+  } catch (e: any) {
+    const err = e.response as AxiosResponse
+    return OnError(err)
+  }
+}
+
+// eventsSource returns an EventSource streaming the events of the cluster.
+// EventSource reconnects by itself whenever the stream ends.
+export const eventsSource = (cluster: IClusterCoordinates, namespace: string): EventSource => {
+  return new EventSource(
+    ["/api", cluster.subscription, cluster.resourceGroup, cluster.name, "events"].join("/") +
+      (namespace ? "?namespace=" + encodeURIComponent(namespace) : "")
+  )
+}
+
+export const fetchElevatedAccess = async (): Promise<AxiosResponse | null> => {
+  try {
+    const result = await axios("/api/elevatedaccess")
+    return result
+  } catch (e: any) {
+    const err = e.response as AxiosResponse
+    return OnError(err)
+  }
+}
+
+export const RequestElevatedAccess = async (
+  csrfToken: string,
+  resourceID: string,
+  justification: string,
+  incidentId: string,
+  duration: number
+): Promise<AxiosResponse | null> => {
+  try {
+    const result = await axios({
+      method: "POST",
+      url: resourceID + "/elevatedaccess/new",
+      data: { justification, incidentId, duration },
+      headers: {
+        "X-CSRF-Token": csrfToken,
+      },
+    })
+    return result
+  } catch (e: any) {
+    const err = e.response as AxiosResponse
+    return OnError(err)
+  }
+}
+
+// ElevatedAccessAction approves, denies or revokes an elevated access grant
+export const ElevatedAccessAction = async (
+  csrfToken: string,
+  id: string,
+  action: "approve" | "deny" | "revoke"
+): Promise<AxiosResponse | null> => {
+  try {
+    const result = await axios({
+      method: "POST",
+      url: "/api/elevatedaccess/" + id + "/" + action,
+      headers: {
+        "X-CSRF-Token": csrfToken,
+      },
+    })
+    return result
+  } catch (e: any) {
+    const err = e.response as AxiosResponse
+    return OnError(err)
+  }
+}
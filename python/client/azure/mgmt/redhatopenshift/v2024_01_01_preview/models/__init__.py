@@ -0,0 +1,179 @@
+# coding=utf-8
+# --------------------------------------------------------------------------
+# Copyright (c) Microsoft Corporation. All rights reserved.
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#   http://www.apache.org/licenses/LICENSE-2.0
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+# 
+# Code generated by Microsoft (R) AutoRest Code Generator.Changes may cause incorrect behavior and will be lost if the code is regenerated.
+# --------------------------------------------------------------------------
+
+try:
+    from ._models_py3 import ACMEIssuer
+    from ._models_py3 import APIServerProfile
+    from ._models_py3 import AutoscalerProfile
+    from ._models_py3 import AutoscalerProfileStatus
+    from ._models_py3 import AutoscalerScaleDown
+    from ._models_py3 import AutoscalerWorkerProfile
+    from ._models_py3 import CertificateProfile
+    from ._models_py3 import CertificateSource
+    from ._models_py3 import CloudErrorBody
+    from ._models_py3 import ClusterProfile
+    from ._models_py3 import ConsoleProfile
+    from ._models_py3 import Display
+    from ._models_py3 import EffectiveOutboundIP
+    from ._models_py3 import IngressProfile
+    from ._models_py3 import LoadBalancerProfile
+    from ._models_py3 import MachinePool
+    from ._models_py3 import MachinePoolList
+    from ._models_py3 import MachinePoolUpdate
+    from ._models_py3 import ManagedOutboundIPs
+    from ._models_py3 import MasterProfile
+    from ._models_py3 import NetworkProfile
+    from ._models_py3 import OpenShiftCluster
+    from ._models_py3 import OpenShiftClusterAdminKubeconfig
+    from ._models_py3 import OpenShiftClusterCredentials
+    from ._models_py3 import OpenShiftClusterList
+    from ._models_py3 import OpenShiftClusterUpdate
+    from ._models_py3 import OpenShiftVersion
+    from ._models_py3 import OpenShiftVersionList
+    from ._models_py3 import Operation
+    from ._models_py3 import OperationList
+    from ._models_py3 import ProxyResource
+    from ._models_py3 import Resource
+    from ._models_py3 import Secret
+    from ._models_py3 import SecretList
+    from ._models_py3 import SecretUpdate
+    from ._models_py3 import ServicePrincipalProfile
+    from ._models_py3 import SyncIdentityProvider
+    from ._models_py3 import SyncIdentityProviderList
+    from ._models_py3 import SyncIdentityProviderUpdate
+    from ._models_py3 import SyncSet
+    from ._models_py3 import SyncSetList
+    from ._models_py3 import SyncSetUpdate
+    from ._models_py3 import SystemData
+    from ._models_py3 import TrackedResource
+    from ._models_py3 import WorkerProfile
+except (SyntaxError, ImportError):
+    from ._models import ACMEIssuer  # type: ignore
+    from ._models import APIServerProfile  # type: ignore
+    from ._models import AutoscalerProfile  # type: ignore
+    from ._models import AutoscalerProfileStatus  # type: ignore
+    from ._models import AutoscalerScaleDown  # type: ignore
+    from ._models import AutoscalerWorkerProfile  # type: ignore
+    from ._models import CertificateProfile  # type: ignore
+    from ._models import CertificateSource  # type: ignore
+    from ._models import CloudErrorBody  # type: ignore
+    from ._models import ClusterProfile  # type: ignore
+    from ._models import ConsoleProfile  # type: ignore
+    from ._models import Display  # type: ignore
+    from ._models import EffectiveOutboundIP  # type: ignore
+    from ._models import IngressProfile  # type: ignore
+    from ._models import LoadBalancerProfile  # type: ignore
+    from ._models import MachinePool  # type: ignore
+    from ._models import MachinePoolList  # type: ignore
+    from ._models import MachinePoolUpdate  # type: ignore
+    from ._models import ManagedOutboundIPs  # type: ignore
+    from ._models import MasterProfile  # type: ignore
+    from ._models import NetworkProfile  # type: ignore
+    from ._models import OpenShiftCluster  # type: ignore
+    from ._models import OpenShiftClusterAdminKubeconfig  # type: ignore
+    from ._models import OpenShiftClusterCredentials  # type: ignore
+    from ._models import OpenShiftClusterList  # type: ignore
+    from ._models import OpenShiftClusterUpdate  # type: ignore
+    from ._models import OpenShiftVersion  # type: ignore
+    from ._models import OpenShiftVersionList  # type: ignore
+    from ._models import Operation  # type: ignore
+    from ._models import OperationList  # type: ignore
+    from ._models import ProxyResource  # type: ignore
+    from ._models import Resource  # type: ignore
+    from ._models import Secret  # type: ignore
+    from ._models import SecretList  # type: ignore
+    from ._models import SecretUpdate  # type: ignore
+    from ._models import ServicePrincipalProfile  # type: ignore
+    from ._models import SyncIdentityProvider  # type: ignore
+    from ._models import SyncIdentityProviderList  # type: ignore
+    from ._models import SyncIdentityProviderUpdate  # type: ignore
+    from ._models import SyncSet  # type: ignore
+    from ._models import SyncSetList  # type: ignore
+    from ._models import SyncSetUpdate  # type: ignore
+    from ._models import SystemData  # type: ignore
+    from ._models import TrackedResource  # type: ignore
+    from ._models import WorkerProfile  # type: ignore
+
+from ._azure_red_hat_open_shift_client_enums import (
+    AutoscalerState,
+    CertificateSourceType,
+    CreatedByType,
+    EncryptionAtHost,
+    FipsValidatedModules,
+    OutboundType,
+    PreconfiguredNSG,
+    ProvisioningState,
+    ScaleDownState,
+    Visibility,
+)
+
+__all__ = [
+    'ACMEIssuer',
+    'APIServerProfile',
+    'AutoscalerProfile',
+    'AutoscalerProfileStatus',
+    'AutoscalerScaleDown',
+    'AutoscalerWorkerProfile',
+    'CertificateProfile',
+    'CertificateSource',
+    'CloudErrorBody',
+    'ClusterProfile',
+    'ConsoleProfile',
+    'Display',
+    'EffectiveOutboundIP',
+    'IngressProfile',
+    'LoadBalancerProfile',
+    'MachinePool',
+    'MachinePoolList',
+    'MachinePoolUpdate',
+    'ManagedOutboundIPs',
+    'MasterProfile',
+    'NetworkProfile',
+    'OpenShiftCluster',
+    'OpenShiftClusterAdminKubeconfig',
+    'OpenShiftClusterCredentials',
+    'OpenShiftClusterList',
+    'OpenShiftClusterUpdate',
+    'OpenShiftVersion',
+    'OpenShiftVersionList',
+    'Operation',
+    'OperationList',
+    'ProxyResource',
+    'Resource',
+    'Secret',
+    'SecretList',
+    'SecretUpdate',
+    'ServicePrincipalProfile',
+    'SyncIdentityProvider',
+    'SyncIdentityProviderList',
+    'SyncIdentityProviderUpdate',
+    'SyncSet',
+    'SyncSetList',
+    'SyncSetUpdate',
+    'SystemData',
+    'TrackedResource',
+    'WorkerProfile',
+    'AutoscalerState',
+    'CertificateSourceType',
+    'CreatedByType',
+    'EncryptionAtHost',
+    'FipsValidatedModules',
+    'OutboundType',
+    'PreconfiguredNSG',
+    'ProvisioningState',
+    'ScaleDownState',
+    'Visibility',
+]
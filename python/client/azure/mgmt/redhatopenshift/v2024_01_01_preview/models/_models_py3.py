@@ -0,0 +1,2089 @@
+# coding=utf-8
+# --------------------------------------------------------------------------
+# Copyright (c) Microsoft Corporation. All rights reserved.
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#   http://www.apache.org/licenses/LICENSE-2.0
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+# 
+# Code generated by Microsoft (R) AutoRest Code Generator.Changes may cause incorrect behavior and will be lost if the code is regenerated.
+# --------------------------------------------------------------------------
+
+import datetime
+from typing import Dict, List, Optional, Union
+
+import msrest.serialization
+
+from ._azure_red_hat_open_shift_client_enums import *
+
+
+class ACMEIssuer(msrest.serialization.Model):
+    """ACMEIssuer represents an ACME issuer which validates the cluster domain with DNS-01
+    challenges.
+
+    :ivar directory_url: The ACME directory URL, e.g.
+     https://acme-v02.api.letsencrypt.org/directory.
+    :vartype directory_url: str
+    :ivar email: The contact email address of the ACME account.
+    :vartype email: str
+    :ivar dns_zone_id: The resource ID of the Azure DNS zone of the cluster domain, in
+     which the challenge records are created.
+    :vartype dns_zone_id: str
+    """
+
+    _attribute_map = {
+        'directory_url': {'key': 'directoryUrl', 'type': 'str'},
+        'email': {'key': 'email', 'type': 'str'},
+        'dns_zone_id': {'key': 'dnsZoneId', 'type': 'str'},
+    }
+
+    def __init__(
+        self,
+        *,
+        directory_url: Optional[str] = None,
+        email: Optional[str] = None,
+        dns_zone_id: Optional[str] = None,
+        **kwargs
+    ):
+        """
+        :keyword directory_url: The ACME directory URL, e.g.
+         https://acme-v02.api.letsencrypt.org/directory.
+        :paramtype directory_url: str
+        :keyword email: The contact email address of the ACME account.
+        :paramtype email: str
+        :keyword dns_zone_id: The resource ID of the Azure DNS zone of the cluster domain, in
+         which the challenge records are created.
+        :paramtype dns_zone_id: str
+        """
+        super(ACMEIssuer, self).__init__(**kwargs)
+        self.directory_url = directory_url
+        self.email = email
+        self.dns_zone_id = dns_zone_id
+
+
+class APIServerProfile(msrest.serialization.Model):
+    """APIServerProfile represents an API server profile.
+
+    Variables are only populated by the server, and will be ignored when sending a request.
+
+    :ivar visibility: API server visibility. Possible values include: "Private", "Public".
+    :vartype visibility: str or ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.Visibility
+    :ivar url: The URL to access the cluster API server.
+    :vartype url: str
+    :ivar ip: The IP of the cluster API server.
+    :vartype ip: str
+    """
+
+    _validation = {
+        'url': {'readonly': True},
+        'ip': {'readonly': True},
+    }
+
+    _attribute_map = {
+        'visibility': {'key': 'visibility', 'type': 'str'},
+        'url': {'key': 'url', 'type': 'str'},
+        'ip': {'key': 'ip', 'type': 'str'},
+    }
+
+    def __init__(
+        self,
+        *,
+        visibility: Optional[Union[str, "Visibility"]] = None,
+        **kwargs
+    ):
+        """
+        :keyword visibility: API server visibility. Possible values include: "Private", "Public".
+        :paramtype visibility: str or ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.Visibility
+        """
+        super(APIServerProfile, self).__init__(**kwargs)
+        self.visibility = visibility
+        self.url = None
+        self.ip = None
+
+
+class AutoscalerProfile(msrest.serialization.Model):
+    """AutoscalerProfile represents the cluster autoscaler configuration.
+
+    :ivar scale_down: The scale down settings of the cluster autoscaler.
+    :vartype scale_down: ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.AutoscalerScaleDown
+    :ivar worker_profiles: The worker profiles to autoscale.
+    :vartype worker_profiles: list[~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.AutoscalerWorkerProfile]
+    """
+
+    _attribute_map = {
+        'scale_down': {'key': 'scaleDown', 'type': 'AutoscalerScaleDown'},
+        'worker_profiles': {'key': 'workerProfiles', 'type': '[AutoscalerWorkerProfile]'},
+    }
+
+    def __init__(
+        self,
+        *,
+        scale_down: Optional["AutoscalerScaleDown"] = None,
+        worker_profiles: Optional[List["AutoscalerWorkerProfile"]] = None,
+        **kwargs
+    ):
+        """
+        :keyword scale_down: The scale down settings of the cluster autoscaler.
+        :paramtype scale_down: ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.AutoscalerScaleDown
+        :keyword worker_profiles: The worker profiles to autoscale.
+        :paramtype worker_profiles: list[~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.AutoscalerWorkerProfile]
+        """
+        super(AutoscalerProfile, self).__init__(**kwargs)
+        self.scale_down = scale_down
+        self.worker_profiles = worker_profiles
+
+
+class AutoscalerProfileStatus(msrest.serialization.Model):
+    """AutoscalerProfileStatus represents the status of the autoscaler configuration on the
+    cluster.
+
+    :ivar state: The state of the autoscaler configuration. Possible values include:
+     "Failed", "Succeeded".
+    :vartype state: str or ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.AutoscalerState
+    :ivar message: Details of the failure to apply the autoscaler configuration, if any.
+    :vartype message: str
+    """
+
+    _attribute_map = {
+        'state': {'key': 'state', 'type': 'str'},
+        'message': {'key': 'message', 'type': 'str'},
+    }
+
+    def __init__(
+        self,
+        *,
+        state: Optional[Union[str, "AutoscalerState"]] = None,
+        message: Optional[str] = None,
+        **kwargs
+    ):
+        """
+        :keyword state: The state of the autoscaler configuration. Possible values include:
+         "Failed", "Succeeded".
+        :paramtype state: str or ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.AutoscalerState
+        :keyword message: Details of the failure to apply the autoscaler configuration, if any.
+        :paramtype message: str
+        """
+        super(AutoscalerProfileStatus, self).__init__(**kwargs)
+        self.state = state
+        self.message = message
+
+
+class AutoscalerScaleDown(msrest.serialization.Model):
+    """AutoscalerScaleDown represents the scale down settings of the cluster autoscaler.
+
+    :ivar state: Whether the cluster autoscaler removes unneeded nodes.  The default value is
+     Enabled. Possible values include: "Disabled", "Enabled".
+    :vartype state: str or ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.ScaleDownState
+    :ivar delay_after_add: How long after a scale up scale down evaluation resumes, e.g. 10m.
+    :vartype delay_after_add: str
+    :ivar unneeded_time: How long a node must be unneeded before it is removed, e.g. 10m.
+    :vartype unneeded_time: str
+    :ivar utilization_threshold: The node utilization level, between 0 and 1, below which a
+     node may be removed, e.g. 0.5.
+    :vartype utilization_threshold: str
+    """
+
+    _attribute_map = {
+        'state': {'key': 'state', 'type': 'str'},
+        'delay_after_add': {'key': 'delayAfterAdd', 'type': 'str'},
+        'unneeded_time': {'key': 'unneededTime', 'type': 'str'},
+        'utilization_threshold': {'key': 'utilizationThreshold', 'type': 'str'},
+    }
+
+    def __init__(
+        self,
+        *,
+        state: Optional[Union[str, "ScaleDownState"]] = None,
+        delay_after_add: Optional[str] = None,
+        unneeded_time: Optional[str] = None,
+        utilization_threshold: Optional[str] = None,
+        **kwargs
+    ):
+        """
+        :keyword state: Whether the cluster autoscaler removes unneeded nodes.  The default value is
+         Enabled. Possible values include: "Disabled", "Enabled".
+        :paramtype state: str or ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.ScaleDownState
+        :keyword delay_after_add: How long after a scale up scale down evaluation resumes, e.g. 10m.
+        :paramtype delay_after_add: str
+        :keyword unneeded_time: How long a node must be unneeded before it is removed, e.g. 10m.
+        :paramtype unneeded_time: str
+        :keyword utilization_threshold: The node utilization level, between 0 and 1, below which a
+         node may be removed, e.g. 0.5.
+        :paramtype utilization_threshold: str
+        """
+        super(AutoscalerScaleDown, self).__init__(**kwargs)
+        self.state = state
+        self.delay_after_add = delay_after_add
+        self.unneeded_time = unneeded_time
+        self.utilization_threshold = utilization_threshold
+
+
+class AutoscalerWorkerProfile(msrest.serialization.Model):
+    """AutoscalerWorkerProfile represents the autoscaling limits of a worker profile.
+
+    :ivar name: The name of the worker profile, as listed in workerProfilesStatus.
+    :vartype name: str
+    :ivar min_count: The minimum number of worker VMs.
+    :vartype min_count: int
+    :ivar max_count: The maximum number of worker VMs.  Allowed values are in the range
+     of 1 - 50.
+    :vartype max_count: int
+    """
+
+    _attribute_map = {
+        'name': {'key': 'name', 'type': 'str'},
+        'min_count': {'key': 'minCount', 'type': 'int'},
+        'max_count': {'key': 'maxCount', 'type': 'int'},
+    }
+
+    def __init__(
+        self,
+        *,
+        name: Optional[str] = None,
+        min_count: Optional[int] = None,
+        max_count: Optional[int] = None,
+        **kwargs
+    ):
+        """
+        :keyword name: The name of the worker profile, as listed in workerProfilesStatus.
+        :paramtype name: str
+        :keyword min_count: The minimum number of worker VMs.
+        :paramtype min_count: int
+        :keyword max_count: The maximum number of worker VMs.  Allowed values are in the range
+         of 1 - 50.
+        :paramtype max_count: int
+        """
+        super(AutoscalerWorkerProfile, self).__init__(**kwargs)
+        self.name = name
+        self.min_count = min_count
+        self.max_count = max_count
+
+
+class CertificateProfile(msrest.serialization.Model):
+    """CertificateProfile represents the customer provided certificates of a cluster with a custom
+    domain.
+
+    :ivar api_server: The certificate served by the API server.
+    :vartype api_server: ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.CertificateSource
+    :ivar ingress: The default certificate served by the ingress controller.
+    :vartype ingress: ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.CertificateSource
+    """
+
+    _attribute_map = {
+        'api_server': {'key': 'apiServer', 'type': 'CertificateSource'},
+        'ingress': {'key': 'ingress', 'type': 'CertificateSource'},
+    }
+
+    def __init__(
+        self,
+        *,
+        api_server: Optional["CertificateSource"] = None,
+        ingress: Optional["CertificateSource"] = None,
+        **kwargs
+    ):
+        """
+        :keyword api_server: The certificate served by the API server.
+        :paramtype api_server: ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.CertificateSource
+        :keyword ingress: The default certificate served by the ingress controller.
+        :paramtype ingress: ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.CertificateSource
+        """
+        super(CertificateProfile, self).__init__(**kwargs)
+        self.api_server = api_server
+        self.ingress = ingress
+
+
+class CertificateSource(msrest.serialization.Model):
+    """CertificateSource represents a customer provided certificate.
+
+    :ivar type: Where the certificate comes from. Possible values include: "ACME",
+     "KeyVault".
+    :vartype type: str or ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.CertificateSourceType
+    :ivar key_vault_secret_id: The ID of the key vault secret holding the certificate and
+     private key, for the KeyVault type.  The secret may be versionless to follow renewals in the key
+     vault.
+    :vartype key_vault_secret_id: str
+    :ivar acme: The ACME issuer, for the ACME type.
+    :vartype acme: ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.ACMEIssuer
+    """
+
+    _attribute_map = {
+        'type': {'key': 'type', 'type': 'str'},
+        'key_vault_secret_id': {'key': 'keyVaultSecretId', 'type': 'str'},
+        'acme': {'key': 'acme', 'type': 'ACMEIssuer'},
+    }
+
+    def __init__(
+        self,
+        *,
+        type: Optional[Union[str, "CertificateSourceType"]] = None,
+        key_vault_secret_id: Optional[str] = None,
+        acme: Optional["ACMEIssuer"] = None,
+        **kwargs
+    ):
+        """
+        :keyword type: Where the certificate comes from. Possible values include: "ACME",
+         "KeyVault".
+        :paramtype type: str or ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.CertificateSourceType
+        :keyword key_vault_secret_id: The ID of the key vault secret holding the certificate and
+         private key, for the KeyVault type.  The secret may be versionless to follow renewals in the key
+         vault.
+        :paramtype key_vault_secret_id: str
+        :keyword acme: The ACME issuer, for the ACME type.
+        :paramtype acme: ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.ACMEIssuer
+        """
+        super(CertificateSource, self).__init__(**kwargs)
+        self.type = type
+        self.key_vault_secret_id = key_vault_secret_id
+        self.acme = acme
+
+
+class CloudErrorBody(msrest.serialization.Model):
+    """CloudErrorBody represents the body of a cloud error.
+
+    :ivar code: An identifier for the error. Codes are invariant and are intended to be consumed
+     programmatically.
+    :vartype code: str
+    :ivar message: A message describing the error, intended to be suitable for display in a user
+     interface.
+    :vartype message: str
+    :ivar target: The target of the particular error. For example, the name of the property in
+     error.
+    :vartype target: str
+    :ivar details: A list of additional details about the error.
+    :vartype details: list[~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.CloudErrorBody]
+    """
+
+    _attribute_map = {
+        'code': {'key': 'code', 'type': 'str'},
+        'message': {'key': 'message', 'type': 'str'},
+        'target': {'key': 'target', 'type': 'str'},
+        'details': {'key': 'details', 'type': '[CloudErrorBody]'},
+    }
+
+    def __init__(
+        self,
+        *,
+        code: Optional[str] = None,
+        message: Optional[str] = None,
+        target: Optional[str] = None,
+        details: Optional[List["CloudErrorBody"]] = None,
+        **kwargs
+    ):
+        """
+        :keyword code: An identifier for the error. Codes are invariant and are intended to be consumed
+         programmatically.
+        :paramtype code: str
+        :keyword message: A message describing the error, intended to be suitable for display in a user
+         interface.
+        :paramtype message: str
+        :keyword target: The target of the particular error. For example, the name of the property in
+         error.
+        :paramtype target: str
+        :keyword details: A list of additional details about the error.
+        :paramtype details: list[~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.CloudErrorBody]
+        """
+        super(CloudErrorBody, self).__init__(**kwargs)
+        self.code = code
+        self.message = message
+        self.target = target
+        self.details = details
+
+
+class ClusterProfile(msrest.serialization.Model):
+    """ClusterProfile represents a cluster profile.
+
+    :ivar pull_secret: The pull secret for the cluster.
+    :vartype pull_secret: str
+    :ivar domain: The domain for the cluster.
+    :vartype domain: str
+    :ivar version: The version of the cluster.
+    :vartype version: str
+    :ivar resource_group_id: The ID of the cluster resource group.
+    :vartype resource_group_id: str
+    :ivar fips_validated_modules: If FIPS validated crypto modules are used. Possible values
+     include: "Disabled", "Enabled".
+    :vartype fips_validated_modules: str or
+     ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.FipsValidatedModules
+    """
+
+    _attribute_map = {
+        'pull_secret': {'key': 'pullSecret', 'type': 'str'},
+        'domain': {'key': 'domain', 'type': 'str'},
+        'version': {'key': 'version', 'type': 'str'},
+        'resource_group_id': {'key': 'resourceGroupId', 'type': 'str'},
+        'fips_validated_modules': {'key': 'fipsValidatedModules', 'type': 'str'},
+    }
+
+    def __init__(
+        self,
+        *,
+        pull_secret: Optional[str] = None,
+        domain: Optional[str] = None,
+        version: Optional[str] = None,
+        resource_group_id: Optional[str] = None,
+        fips_validated_modules: Optional[Union[str, "FipsValidatedModules"]] = None,
+        **kwargs
+    ):
+        """
+        :keyword pull_secret: The pull secret for the cluster.
+        :paramtype pull_secret: str
+        :keyword domain: The domain for the cluster.
+        :paramtype domain: str
+        :keyword version: The version of the cluster.
+        :paramtype version: str
+        :keyword resource_group_id: The ID of the cluster resource group.
+        :paramtype resource_group_id: str
+        :keyword fips_validated_modules: If FIPS validated crypto modules are used. Possible values
+         include: "Disabled", "Enabled".
+        :paramtype fips_validated_modules: str or
+         ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.FipsValidatedModules
+        """
+        super(ClusterProfile, self).__init__(**kwargs)
+        self.pull_secret = pull_secret
+        self.domain = domain
+        self.version = version
+        self.resource_group_id = resource_group_id
+        self.fips_validated_modules = fips_validated_modules
+
+
+class ConsoleProfile(msrest.serialization.Model):
+    """ConsoleProfile represents a console profile.
+
+    Variables are only populated by the server, and will be ignored when sending a request.
+
+    :ivar url: The URL to access the cluster console.
+    :vartype url: str
+    """
+
+    _validation = {
+        'url': {'readonly': True},
+    }
+
+    _attribute_map = {
+        'url': {'key': 'url', 'type': 'str'},
+    }
+
+    def __init__(
+        self,
+        **kwargs
+    ):
+        """
+        """
+        super(ConsoleProfile, self).__init__(**kwargs)
+        self.url = None
+
+
+class Display(msrest.serialization.Model):
+    """Display represents the display details of an operation.
+
+    :ivar provider: Friendly name of the resource provider.
+    :vartype provider: str
+    :ivar resource: Resource type on which the operation is performed.
+    :vartype resource: str
+    :ivar operation: Operation type: read, write, delete, listKeys/action, etc.
+    :vartype operation: str
+    :ivar description: Friendly name of the operation.
+    :vartype description: str
+    """
+
+    _attribute_map = {
+        'provider': {'key': 'provider', 'type': 'str'},
+        'resource': {'key': 'resource', 'type': 'str'},
+        'operation': {'key': 'operation', 'type': 'str'},
+        'description': {'key': 'description', 'type': 'str'},
+    }
+
+    def __init__(
+        self,
+        *,
+        provider: Optional[str] = None,
+        resource: Optional[str] = None,
+        operation: Optional[str] = None,
+        description: Optional[str] = None,
+        **kwargs
+    ):
+        """
+        :keyword provider: Friendly name of the resource provider.
+        :paramtype provider: str
+        :keyword resource: Resource type on which the operation is performed.
+        :paramtype resource: str
+        :keyword operation: Operation type: read, write, delete, listKeys/action, etc.
+        :paramtype operation: str
+        :keyword description: Friendly name of the operation.
+        :paramtype description: str
+        """
+        super(Display, self).__init__(**kwargs)
+        self.provider = provider
+        self.resource = resource
+        self.operation = operation
+        self.description = description
+
+
+class EffectiveOutboundIP(msrest.serialization.Model):
+    """EffectiveOutboundIP represents an effective outbound IP resource of the cluster public load balancer.
+
+    :ivar id: The fully qualified Azure resource id of an IP address resource.
+    :vartype id: str
+    """
+
+    _attribute_map = {
+        'id': {'key': 'id', 'type': 'str'},
+    }
+
+    def __init__(
+        self,
+        *,
+        id: Optional[str] = None,
+        **kwargs
+    ):
+        """
+        :keyword id: The fully qualified Azure resource id of an IP address resource.
+        :paramtype id: str
+        """
+        super(EffectiveOutboundIP, self).__init__(**kwargs)
+        self.id = id
+
+
+class IngressProfile(msrest.serialization.Model):
+    """IngressProfile represents an ingress profile.
+
+    Variables are only populated by the server, and will be ignored when sending a request.
+
+    :ivar name: The ingress profile name.
+    :vartype name: str
+    :ivar visibility: Ingress visibility. Possible values include: "Private", "Public".
+    :vartype visibility: str or ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.Visibility
+    :ivar ip: The IP of the ingress.
+    :vartype ip: str
+    """
+
+    _validation = {
+        'ip': {'readonly': True},
+    }
+
+    _attribute_map = {
+        'name': {'key': 'name', 'type': 'str'},
+        'visibility': {'key': 'visibility', 'type': 'str'},
+        'ip': {'key': 'ip', 'type': 'str'},
+    }
+
+    def __init__(
+        self,
+        *,
+        name: Optional[str] = None,
+        visibility: Optional[Union[str, "Visibility"]] = None,
+        **kwargs
+    ):
+        """
+        :keyword name: The ingress profile name.
+        :paramtype name: str
+        :keyword visibility: Ingress visibility. Possible values include: "Private", "Public".
+        :paramtype visibility: str or ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.Visibility
+        """
+        super(IngressProfile, self).__init__(**kwargs)
+        self.name = name
+        self.visibility = visibility
+        self.ip = None
+
+
+class LoadBalancerProfile(msrest.serialization.Model):
+    """LoadBalancerProfile represents the profile of the cluster public load balancer.
+
+    Variables are only populated by the server, and will be ignored when sending a request.
+
+    :ivar managed_outbound_ips: The desired managed outbound IPs for the cluster public load
+     balancer.
+    :vartype managed_outbound_ips:
+     ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.ManagedOutboundIPs
+    :ivar effective_outbound_ips: The list of effective outbound IP addresses of the public load
+     balancer.
+    :vartype effective_outbound_ips:
+     list[~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.EffectiveOutboundIP]
+    """
+
+    _validation = {
+        'effective_outbound_ips': {'readonly': True},
+    }
+
+    _attribute_map = {
+        'managed_outbound_ips': {'key': 'managedOutboundIps', 'type': 'ManagedOutboundIPs'},
+        'effective_outbound_ips': {'key': 'effectiveOutboundIps', 'type': '[EffectiveOutboundIP]'},
+    }
+
+    def __init__(
+        self,
+        *,
+        managed_outbound_ips: Optional["ManagedOutboundIPs"] = None,
+        **kwargs
+    ):
+        """
+        :keyword managed_outbound_ips: The desired managed outbound IPs for the cluster public load
+         balancer.
+        :paramtype managed_outbound_ips:
+         ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.ManagedOutboundIPs
+        """
+        super(LoadBalancerProfile, self).__init__(**kwargs)
+        self.managed_outbound_ips = managed_outbound_ips
+        self.effective_outbound_ips = None
+
+
+class Resource(msrest.serialization.Model):
+    """Common fields that are returned in the response for all Azure Resource Manager resources.
+
+    Variables are only populated by the server, and will be ignored when sending a request.
+
+    :ivar id: Fully qualified resource ID for the resource. Ex -
+     /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}.
+    :vartype id: str
+    :ivar name: The name of the resource.
+    :vartype name: str
+    :ivar type: The type of the resource. E.g. "Microsoft.Compute/virtualMachines" or
+     "Microsoft.Storage/storageAccounts".
+    :vartype type: str
+    :ivar system_data: Azure Resource Manager metadata containing createdBy and modifiedBy
+     information.
+    :vartype system_data: ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.SystemData
+    """
+
+    _validation = {
+        'id': {'readonly': True},
+        'name': {'readonly': True},
+        'type': {'readonly': True},
+        'system_data': {'readonly': True},
+    }
+
+    _attribute_map = {
+        'id': {'key': 'id', 'type': 'str'},
+        'name': {'key': 'name', 'type': 'str'},
+        'type': {'key': 'type', 'type': 'str'},
+        'system_data': {'key': 'systemData', 'type': 'SystemData'},
+    }
+
+    def __init__(
+        self,
+        **kwargs
+    ):
+        """
+        """
+        super(Resource, self).__init__(**kwargs)
+        self.id = None
+        self.name = None
+        self.type = None
+        self.system_data = None
+
+
+class ProxyResource(Resource):
+    """The resource model definition for a Azure Resource Manager proxy resource. It will not have tags and a location.
+
+    Variables are only populated by the server, and will be ignored when sending a request.
+
+    :ivar id: Fully qualified resource ID for the resource. Ex -
+     /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}.
+    :vartype id: str
+    :ivar name: The name of the resource.
+    :vartype name: str
+    :ivar type: The type of the resource. E.g. "Microsoft.Compute/virtualMachines" or
+     "Microsoft.Storage/storageAccounts".
+    :vartype type: str
+    :ivar system_data: Azure Resource Manager metadata containing createdBy and modifiedBy
+     information.
+    :vartype system_data: ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.SystemData
+    """
+
+    _validation = {
+        'id': {'readonly': True},
+        'name': {'readonly': True},
+        'type': {'readonly': True},
+        'system_data': {'readonly': True},
+    }
+
+    _attribute_map = {
+        'id': {'key': 'id', 'type': 'str'},
+        'name': {'key': 'name', 'type': 'str'},
+        'type': {'key': 'type', 'type': 'str'},
+        'system_data': {'key': 'systemData', 'type': 'SystemData'},
+    }
+
+    def __init__(
+        self,
+        **kwargs
+    ):
+        """
+        """
+        super(ProxyResource, self).__init__(**kwargs)
+
+
+class MachinePool(ProxyResource):
+    """MachinePool represents a MachinePool.
+
+    Variables are only populated by the server, and will be ignored when sending a request.
+
+    :ivar id: Fully qualified resource ID for the resource. Ex -
+     /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}.
+    :vartype id: str
+    :ivar name: The name of the resource.
+    :vartype name: str
+    :ivar type: The type of the resource. E.g. "Microsoft.Compute/virtualMachines" or
+     "Microsoft.Storage/storageAccounts".
+    :vartype type: str
+    :ivar system_data: Azure Resource Manager metadata containing createdBy and modifiedBy
+     information.
+    :vartype system_data: ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.SystemData
+    :ivar resources:
+    :vartype resources: str
+    """
+
+    _validation = {
+        'id': {'readonly': True},
+        'name': {'readonly': True},
+        'type': {'readonly': True},
+        'system_data': {'readonly': True},
+    }
+
+    _attribute_map = {
+        'id': {'key': 'id', 'type': 'str'},
+        'name': {'key': 'name', 'type': 'str'},
+        'type': {'key': 'type', 'type': 'str'},
+        'system_data': {'key': 'systemData', 'type': 'SystemData'},
+        'resources': {'key': 'properties.resources', 'type': 'str'},
+    }
+
+    def __init__(
+        self,
+        *,
+        resources: Optional[str] = None,
+        **kwargs
+    ):
+        """
+        :keyword resources:
+        :paramtype resources: str
+        """
+        super(MachinePool, self).__init__(**kwargs)
+        self.resources = resources
+
+
+class MachinePoolList(msrest.serialization.Model):
+    """MachinePoolList represents a list of MachinePools.
+
+    :ivar value: The list of Machine Pools.
+    :vartype value: list[~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.MachinePool]
+    :ivar next_link: The link used to get the next page of operations.
+    :vartype next_link: str
+    """
+
+    _attribute_map = {
+        'value': {'key': 'value', 'type': '[MachinePool]'},
+        'next_link': {'key': 'nextLink', 'type': 'str'},
+    }
+
+    def __init__(
+        self,
+        *,
+        value: Optional[List["MachinePool"]] = None,
+        next_link: Optional[str] = None,
+        **kwargs
+    ):
+        """
+        :keyword value: The list of Machine Pools.
+        :paramtype value: list[~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.MachinePool]
+        :keyword next_link: The link used to get the next page of operations.
+        :paramtype next_link: str
+        """
+        super(MachinePoolList, self).__init__(**kwargs)
+        self.value = value
+        self.next_link = next_link
+
+
+class MachinePoolUpdate(msrest.serialization.Model):
+    """MachinePool represents a MachinePool.
+
+    Variables are only populated by the server, and will be ignored when sending a request.
+
+    :ivar system_data: The system meta data relating to this resource.
+    :vartype system_data: ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.SystemData
+    :ivar resources:
+    :vartype resources: str
+    """
+
+    _validation = {
+        'system_data': {'readonly': True},
+    }
+
+    _attribute_map = {
+        'system_data': {'key': 'systemData', 'type': 'SystemData'},
+        'resources': {'key': 'properties.resources', 'type': 'str'},
+    }
+
+    def __init__(
+        self,
+        *,
+        resources: Optional[str] = None,
+        **kwargs
+    ):
+        """
+        :keyword resources:
+        :paramtype resources: str
+        """
+        super(MachinePoolUpdate, self).__init__(**kwargs)
+        self.system_data = None
+        self.resources = resources
+
+
+class ManagedOutboundIPs(msrest.serialization.Model):
+    """ManagedOutboundIPs represents the desired managed outbound IPs for the cluster public load balancer.
+
+    :ivar count: Count represents the desired number of IPv4 outbound IPs created and managed by
+     Azure for the cluster public load balancer.  Allowed values are in the range of 1 - 20.  The
+     default value is 1.
+    :vartype count: int
+    """
+
+    _attribute_map = {
+        'count': {'key': 'count', 'type': 'int'},
+    }
+
+    def __init__(
+        self,
+        *,
+        count: Optional[int] = None,
+        **kwargs
+    ):
+        """
+        :keyword count: Count represents the desired number of IPv4 outbound IPs created and managed by
+         Azure for the cluster public load balancer.  Allowed values are in the range of 1 - 20.  The
+         default value is 1.
+        :paramtype count: int
+        """
+        super(ManagedOutboundIPs, self).__init__(**kwargs)
+        self.count = count
+
+
+class MasterProfile(msrest.serialization.Model):
+    """MasterProfile represents a master profile.
+
+    :ivar vm_size: The size of the master VMs.
+    :vartype vm_size: str
+    :ivar subnet_id: The Azure resource ID of the master subnet.
+    :vartype subnet_id: str
+    :ivar encryption_at_host: Whether master virtual machines are encrypted at host. Possible
+     values include: "Disabled", "Enabled".
+    :vartype encryption_at_host: str or
+     ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.EncryptionAtHost
+    :ivar disk_encryption_set_id: The resource ID of an associated DiskEncryptionSet, if
+     applicable.
+    :vartype disk_encryption_set_id: str
+    """
+
+    _attribute_map = {
+        'vm_size': {'key': 'vmSize', 'type': 'str'},
+        'subnet_id': {'key': 'subnetId', 'type': 'str'},
+        'encryption_at_host': {'key': 'encryptionAtHost', 'type': 'str'},
+        'disk_encryption_set_id': {'key': 'diskEncryptionSetId', 'type': 'str'},
+    }
+
+    def __init__(
+        self,
+        *,
+        vm_size: Optional[str] = None,
+        subnet_id: Optional[str] = None,
+        encryption_at_host: Optional[Union[str, "EncryptionAtHost"]] = None,
+        disk_encryption_set_id: Optional[str] = None,
+        **kwargs
+    ):
+        """
+        :keyword vm_size: The size of the master VMs.
+        :paramtype vm_size: str
+        :keyword subnet_id: The Azure resource ID of the master subnet.
+        :paramtype subnet_id: str
+        :keyword encryption_at_host: Whether master virtual machines are encrypted at host. Possible
+         values include: "Disabled", "Enabled".
+        :paramtype encryption_at_host: str or
+         ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.EncryptionAtHost
+        :keyword disk_encryption_set_id: The resource ID of an associated DiskEncryptionSet, if
+         applicable.
+        :paramtype disk_encryption_set_id: str
+        """
+        super(MasterProfile, self).__init__(**kwargs)
+        self.vm_size = vm_size
+        self.subnet_id = subnet_id
+        self.encryption_at_host = encryption_at_host
+        self.disk_encryption_set_id = disk_encryption_set_id
+
+
+class NetworkProfile(msrest.serialization.Model):
+    """NetworkProfile represents a network profile.
+
+    :ivar pod_cidr: The CIDR used for OpenShift/Kubernetes Pods.
+    :vartype pod_cidr: str
+    :ivar service_cidr: The CIDR used for OpenShift/Kubernetes Services.
+    :vartype service_cidr: str
+    :ivar outbound_type: The OutboundType used for egress traffic. Possible values include:
+     "Loadbalancer", "UserDefinedRouting".
+    :vartype outbound_type: str or ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.OutboundType
+    :ivar load_balancer_profile: The cluster load balancer profile.
+    :vartype load_balancer_profile:
+     ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.LoadBalancerProfile
+    :ivar preconfigured_nsg: Specifies whether subnets are pre-attached with an NSG. Possible
+     values include: "Disabled", "Enabled".
+    :vartype preconfigured_nsg: str or
+     ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.PreconfiguredNSG
+    """
+
+    _attribute_map = {
+        'pod_cidr': {'key': 'podCidr', 'type': 'str'},
+        'service_cidr': {'key': 'serviceCidr', 'type': 'str'},
+        'outbound_type': {'key': 'outboundType', 'type': 'str'},
+        'load_balancer_profile': {'key': 'loadBalancerProfile', 'type': 'LoadBalancerProfile'},
+        'preconfigured_nsg': {'key': 'preconfiguredNSG', 'type': 'str'},
+    }
+
+    def __init__(
+        self,
+        *,
+        pod_cidr: Optional[str] = None,
+        service_cidr: Optional[str] = None,
+        outbound_type: Optional[Union[str, "OutboundType"]] = None,
+        load_balancer_profile: Optional["LoadBalancerProfile"] = None,
+        preconfigured_nsg: Optional[Union[str, "PreconfiguredNSG"]] = None,
+        **kwargs
+    ):
+        """
+        :keyword pod_cidr: The CIDR used for OpenShift/Kubernetes Pods.
+        :paramtype pod_cidr: str
+        :keyword service_cidr: The CIDR used for OpenShift/Kubernetes Services.
+        :paramtype service_cidr: str
+        :keyword outbound_type: The OutboundType used for egress traffic. Possible values include:
+         "Loadbalancer", "UserDefinedRouting".
+        :paramtype outbound_type: str or ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.OutboundType
+        :keyword load_balancer_profile: The cluster load balancer profile.
+        :paramtype load_balancer_profile:
+         ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.LoadBalancerProfile
+        :keyword preconfigured_nsg: Specifies whether subnets are pre-attached with an NSG. Possible
+         values include: "Disabled", "Enabled".
+        :paramtype preconfigured_nsg: str or
+         ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.PreconfiguredNSG
+        """
+        super(NetworkProfile, self).__init__(**kwargs)
+        self.pod_cidr = pod_cidr
+        self.service_cidr = service_cidr
+        self.outbound_type = outbound_type
+        self.load_balancer_profile = load_balancer_profile
+        self.preconfigured_nsg = preconfigured_nsg
+
+
+class TrackedResource(Resource):
+    """The resource model definition for an Azure Resource Manager tracked top level resource which has 'tags' and a 'location'.
+
+    Variables are only populated by the server, and will be ignored when sending a request.
+
+    All required parameters must be populated in order to send to Azure.
+
+    :ivar id: Fully qualified resource ID for the resource. Ex -
+     /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}.
+    :vartype id: str
+    :ivar name: The name of the resource.
+    :vartype name: str
+    :ivar type: The type of the resource. E.g. "Microsoft.Compute/virtualMachines" or
+     "Microsoft.Storage/storageAccounts".
+    :vartype type: str
+    :ivar system_data: Azure Resource Manager metadata containing createdBy and modifiedBy
+     information.
+    :vartype system_data: ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.SystemData
+    :ivar tags: A set of tags. Resource tags.
+    :vartype tags: dict[str, str]
+    :ivar location: Required. The geo-location where the resource lives.
+    :vartype location: str
+    """
+
+    _validation = {
+        'id': {'readonly': True},
+        'name': {'readonly': True},
+        'type': {'readonly': True},
+        'system_data': {'readonly': True},
+        'location': {'required': True},
+    }
+
+    _attribute_map = {
+        'id': {'key': 'id', 'type': 'str'},
+        'name': {'key': 'name', 'type': 'str'},
+        'type': {'key': 'type', 'type': 'str'},
+        'system_data': {'key': 'systemData', 'type': 'SystemData'},
+        'tags': {'key': 'tags', 'type': '{str}'},
+        'location': {'key': 'location', 'type': 'str'},
+    }
+
+    def __init__(
+        self,
+        *,
+        location: str,
+        tags: Optional[Dict[str, str]] = None,
+        **kwargs
+    ):
+        """
+        :keyword tags: A set of tags. Resource tags.
+        :paramtype tags: dict[str, str]
+        :keyword location: Required. The geo-location where the resource lives.
+        :paramtype location: str
+        """
+        super(TrackedResource, self).__init__(**kwargs)
+        self.tags = tags
+        self.location = location
+
+
+class OpenShiftCluster(TrackedResource):
+    """OpenShiftCluster represents an Azure Red Hat OpenShift cluster.
+
+    Variables are only populated by the server, and will be ignored when sending a request.
+
+    All required parameters must be populated in order to send to Azure.
+
+    :ivar id: Fully qualified resource ID for the resource. Ex -
+     /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}.
+    :vartype id: str
+    :ivar name: The name of the resource.
+    :vartype name: str
+    :ivar type: The type of the resource. E.g. "Microsoft.Compute/virtualMachines" or
+     "Microsoft.Storage/storageAccounts".
+    :vartype type: str
+    :ivar system_data: Azure Resource Manager metadata containing createdBy and modifiedBy
+     information.
+    :vartype system_data: ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.SystemData
+    :ivar tags: A set of tags. Resource tags.
+    :vartype tags: dict[str, str]
+    :ivar location: Required. The geo-location where the resource lives.
+    :vartype location: str
+    :ivar provisioning_state: The cluster provisioning state. Possible values include:
+     "AdminUpdating", "Cancelled", "Creating", "Deleting", "Failed", "Succeeded", "Updating".
+    :vartype provisioning_state: str or
+     ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.ProvisioningState
+    :ivar cluster_profile: The cluster profile.
+    :vartype cluster_profile: ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.ClusterProfile
+    :ivar console_profile: The console profile.
+    :vartype console_profile: ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.ConsoleProfile
+    :ivar service_principal_profile: The cluster service principal profile.
+    :vartype service_principal_profile:
+     ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.ServicePrincipalProfile
+    :ivar network_profile: The cluster network profile.
+    :vartype network_profile: ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.NetworkProfile
+    :ivar master_profile: The cluster master profile.
+    :vartype master_profile: ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.MasterProfile
+    :ivar worker_profiles: The cluster worker profiles.
+    :vartype worker_profiles: list[~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.WorkerProfile]
+    :ivar worker_profiles_status: The cluster worker profiles status.
+    :vartype worker_profiles_status:
+     list[~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.WorkerProfile]
+    :ivar autoscaler_profile: The cluster autoscaler profile.
+    :vartype autoscaler_profile:
+     ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.AutoscalerProfile
+    :ivar autoscaler_profile_status: The cluster autoscaler profile status.
+    :vartype autoscaler_profile_status:
+     ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.AutoscalerProfileStatus
+    :ivar certificate_profile: The cluster certificate profile.
+    :vartype certificate_profile:
+     ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.CertificateProfile
+    :ivar apiserver_profile: The cluster API server profile.
+    :vartype apiserver_profile: ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.APIServerProfile
+    :ivar ingress_profiles: The cluster ingress profiles.
+    :vartype ingress_profiles: list[~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.IngressProfile]
+    """
+
+    _validation = {
+        'id': {'readonly': True},
+        'name': {'readonly': True},
+        'type': {'readonly': True},
+        'system_data': {'readonly': True},
+        'location': {'required': True},
+        'worker_profiles_status': {'readonly': True},
+        'autoscaler_profile_status': {'readonly': True},
+    }
+
+    _attribute_map = {
+        'id': {'key': 'id', 'type': 'str'},
+        'name': {'key': 'name', 'type': 'str'},
+        'type': {'key': 'type', 'type': 'str'},
+        'system_data': {'key': 'systemData', 'type': 'SystemData'},
+        'tags': {'key': 'tags', 'type': '{str}'},
+        'location': {'key': 'location', 'type': 'str'},
+        'provisioning_state': {'key': 'properties.provisioningState', 'type': 'str'},
+        'cluster_profile': {'key': 'properties.clusterProfile', 'type': 'ClusterProfile'},
+        'console_profile': {'key': 'properties.consoleProfile', 'type': 'ConsoleProfile'},
+        'service_principal_profile': {'key': 'properties.servicePrincipalProfile', 'type': 'ServicePrincipalProfile'},
+        'network_profile': {'key': 'properties.networkProfile', 'type': 'NetworkProfile'},
+        'master_profile': {'key': 'properties.masterProfile', 'type': 'MasterProfile'},
+        'worker_profiles': {'key': 'properties.workerProfiles', 'type': '[WorkerProfile]'},
+        'worker_profiles_status': {'key': 'properties.workerProfilesStatus', 'type': '[WorkerProfile]'},
+        'autoscaler_profile': {'key': 'properties.autoscalerProfile', 'type': 'AutoscalerProfile'},
+        'autoscaler_profile_status': {'key': 'properties.autoscalerProfileStatus', 'type': 'AutoscalerProfileStatus'},
+        'certificate_profile': {'key': 'properties.certificateProfile', 'type': 'CertificateProfile'},
+        'apiserver_profile': {'key': 'properties.apiserverProfile', 'type': 'APIServerProfile'},
+        'ingress_profiles': {'key': 'properties.ingressProfiles', 'type': '[IngressProfile]'},
+    }
+
+    def __init__(
+        self,
+        *,
+        location: str,
+        tags: Optional[Dict[str, str]] = None,
+        provisioning_state: Optional[Union[str, "ProvisioningState"]] = None,
+        cluster_profile: Optional["ClusterProfile"] = None,
+        console_profile: Optional["ConsoleProfile"] = None,
+        service_principal_profile: Optional["ServicePrincipalProfile"] = None,
+        network_profile: Optional["NetworkProfile"] = None,
+        master_profile: Optional["MasterProfile"] = None,
+        worker_profiles: Optional[List["WorkerProfile"]] = None,
+        autoscaler_profile: Optional["AutoscalerProfile"] = None,
+        certificate_profile: Optional["CertificateProfile"] = None,
+        apiserver_profile: Optional["APIServerProfile"] = None,
+        ingress_profiles: Optional[List["IngressProfile"]] = None,
+        **kwargs
+    ):
+        """
+        :keyword tags: A set of tags. Resource tags.
+        :paramtype tags: dict[str, str]
+        :keyword location: Required. The geo-location where the resource lives.
+        :paramtype location: str
+        :keyword provisioning_state: The cluster provisioning state. Possible values include:
+         "AdminUpdating", "Cancelled", "Creating", "Deleting", "Failed", "Succeeded", "Updating".
+        :paramtype provisioning_state: str or
+         ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.ProvisioningState
+        :keyword cluster_profile: The cluster profile.
+        :paramtype cluster_profile: ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.ClusterProfile
+        :keyword console_profile: The console profile.
+        :paramtype console_profile: ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.ConsoleProfile
+        :keyword service_principal_profile: The cluster service principal profile.
+        :paramtype service_principal_profile:
+         ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.ServicePrincipalProfile
+        :keyword network_profile: The cluster network profile.
+        :paramtype network_profile: ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.NetworkProfile
+        :keyword master_profile: The cluster master profile.
+        :paramtype master_profile: ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.MasterProfile
+        :keyword worker_profiles: The cluster worker profiles.
+        :paramtype worker_profiles: list[~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.WorkerProfile]
+        :keyword autoscaler_profile: The cluster autoscaler profile.
+        :paramtype autoscaler_profile:
+         ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.AutoscalerProfile
+        :keyword certificate_profile: The cluster certificate profile.
+        :paramtype certificate_profile:
+         ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.CertificateProfile
+        :keyword apiserver_profile: The cluster API server profile.
+        :paramtype apiserver_profile: ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.APIServerProfile
+        :keyword ingress_profiles: The cluster ingress profiles.
+        :paramtype ingress_profiles:
+         list[~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.IngressProfile]
+        """
+        super(OpenShiftCluster, self).__init__(tags=tags, location=location, **kwargs)
+        self.provisioning_state = provisioning_state
+        self.cluster_profile = cluster_profile
+        self.console_profile = console_profile
+        self.service_principal_profile = service_principal_profile
+        self.network_profile = network_profile
+        self.master_profile = master_profile
+        self.worker_profiles = worker_profiles
+        self.worker_profiles_status = None
+        self.autoscaler_profile = autoscaler_profile
+        self.autoscaler_profile_status = None
+        self.certificate_profile = certificate_profile
+        self.apiserver_profile = apiserver_profile
+        self.ingress_profiles = ingress_profiles
+
+
+class OpenShiftClusterAdminKubeconfig(msrest.serialization.Model):
+    """OpenShiftClusterAdminKubeconfig represents an OpenShift cluster's admin kubeconfig.
+
+    :ivar kubeconfig: The base64-encoded kubeconfig file.
+    :vartype kubeconfig: str
+    """
+
+    _attribute_map = {
+        'kubeconfig': {'key': 'kubeconfig', 'type': 'str'},
+    }
+
+    def __init__(
+        self,
+        *,
+        kubeconfig: Optional[str] = None,
+        **kwargs
+    ):
+        """
+        :keyword kubeconfig: The base64-encoded kubeconfig file.
+        :paramtype kubeconfig: str
+        """
+        super(OpenShiftClusterAdminKubeconfig, self).__init__(**kwargs)
+        self.kubeconfig = kubeconfig
+
+
+class OpenShiftClusterCredentials(msrest.serialization.Model):
+    """OpenShiftClusterCredentials represents an OpenShift cluster's credentials.
+
+    :ivar kubeadmin_username: The username for the kubeadmin user.
+    :vartype kubeadmin_username: str
+    :ivar kubeadmin_password: The password for the kubeadmin user.
+    :vartype kubeadmin_password: str
+    """
+
+    _attribute_map = {
+        'kubeadmin_username': {'key': 'kubeadminUsername', 'type': 'str'},
+        'kubeadmin_password': {'key': 'kubeadminPassword', 'type': 'str'},
+    }
+
+    def __init__(
+        self,
+        *,
+        kubeadmin_username: Optional[str] = None,
+        kubeadmin_password: Optional[str] = None,
+        **kwargs
+    ):
+        """
+        :keyword kubeadmin_username: The username for the kubeadmin user.
+        :paramtype kubeadmin_username: str
+        :keyword kubeadmin_password: The password for the kubeadmin user.
+        :paramtype kubeadmin_password: str
+        """
+        super(OpenShiftClusterCredentials, self).__init__(**kwargs)
+        self.kubeadmin_username = kubeadmin_username
+        self.kubeadmin_password = kubeadmin_password
+
+
+class OpenShiftClusterList(msrest.serialization.Model):
+    """OpenShiftClusterList represents a list of OpenShift clusters.
+
+    :ivar value: The list of OpenShift clusters.
+    :vartype value: list[~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.OpenShiftCluster]
+    :ivar next_link: The link used to get the next page of operations.
+    :vartype next_link: str
+    """
+
+    _attribute_map = {
+        'value': {'key': 'value', 'type': '[OpenShiftCluster]'},
+        'next_link': {'key': 'nextLink', 'type': 'str'},
+    }
+
+    def __init__(
+        self,
+        *,
+        value: Optional[List["OpenShiftCluster"]] = None,
+        next_link: Optional[str] = None,
+        **kwargs
+    ):
+        """
+        :keyword value: The list of OpenShift clusters.
+        :paramtype value: list[~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.OpenShiftCluster]
+        :keyword next_link: The link used to get the next page of operations.
+        :paramtype next_link: str
+        """
+        super(OpenShiftClusterList, self).__init__(**kwargs)
+        self.value = value
+        self.next_link = next_link
+
+
+class OpenShiftClusterUpdate(msrest.serialization.Model):
+    """OpenShiftCluster represents an Azure Red Hat OpenShift cluster.
+
+    Variables are only populated by the server, and will be ignored when sending a request.
+
+    :ivar tags: A set of tags. The resource tags.
+    :vartype tags: dict[str, str]
+    :ivar system_data: The system meta data relating to this resource.
+    :vartype system_data: ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.SystemData
+    :ivar provisioning_state: The cluster provisioning state. Possible values include:
+     "AdminUpdating", "Cancelled", "Creating", "Deleting", "Failed", "Succeeded", "Updating".
+    :vartype provisioning_state: str or
+     ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.ProvisioningState
+    :ivar cluster_profile: The cluster profile.
+    :vartype cluster_profile: ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.ClusterProfile
+    :ivar console_profile: The console profile.
+    :vartype console_profile: ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.ConsoleProfile
+    :ivar service_principal_profile: The cluster service principal profile.
+    :vartype service_principal_profile:
+     ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.ServicePrincipalProfile
+    :ivar network_profile: The cluster network profile.
+    :vartype network_profile: ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.NetworkProfile
+    :ivar master_profile: The cluster master profile.
+    :vartype master_profile: ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.MasterProfile
+    :ivar worker_profiles: The cluster worker profiles.
+    :vartype worker_profiles: list[~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.WorkerProfile]
+    :ivar worker_profiles_status: The cluster worker profiles status.
+    :vartype worker_profiles_status:
+     list[~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.WorkerProfile]
+    :ivar autoscaler_profile: The cluster autoscaler profile.
+    :vartype autoscaler_profile:
+     ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.AutoscalerProfile
+    :ivar autoscaler_profile_status: The cluster autoscaler profile status.
+    :vartype autoscaler_profile_status:
+     ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.AutoscalerProfileStatus
+    :ivar certificate_profile: The cluster certificate profile.
+    :vartype certificate_profile:
+     ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.CertificateProfile
+    :ivar apiserver_profile: The cluster API server profile.
+    :vartype apiserver_profile: ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.APIServerProfile
+    :ivar ingress_profiles: The cluster ingress profiles.
+    :vartype ingress_profiles: list[~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.IngressProfile]
+    """
+
+    _validation = {
+        'system_data': {'readonly': True},
+        'worker_profiles_status': {'readonly': True},
+        'autoscaler_profile_status': {'readonly': True},
+    }
+
+    _attribute_map = {
+        'tags': {'key': 'tags', 'type': '{str}'},
+        'system_data': {'key': 'systemData', 'type': 'SystemData'},
+        'provisioning_state': {'key': 'properties.provisioningState', 'type': 'str'},
+        'cluster_profile': {'key': 'properties.clusterProfile', 'type': 'ClusterProfile'},
+        'console_profile': {'key': 'properties.consoleProfile', 'type': 'ConsoleProfile'},
+        'service_principal_profile': {'key': 'properties.servicePrincipalProfile', 'type': 'ServicePrincipalProfile'},
+        'network_profile': {'key': 'properties.networkProfile', 'type': 'NetworkProfile'},
+        'master_profile': {'key': 'properties.masterProfile', 'type': 'MasterProfile'},
+        'worker_profiles': {'key': 'properties.workerProfiles', 'type': '[WorkerProfile]'},
+        'worker_profiles_status': {'key': 'properties.workerProfilesStatus', 'type': '[WorkerProfile]'},
+        'autoscaler_profile': {'key': 'properties.autoscalerProfile', 'type': 'AutoscalerProfile'},
+        'autoscaler_profile_status': {'key': 'properties.autoscalerProfileStatus', 'type': 'AutoscalerProfileStatus'},
+        'certificate_profile': {'key': 'properties.certificateProfile', 'type': 'CertificateProfile'},
+        'apiserver_profile': {'key': 'properties.apiserverProfile', 'type': 'APIServerProfile'},
+        'ingress_profiles': {'key': 'properties.ingressProfiles', 'type': '[IngressProfile]'},
+    }
+
+    def __init__(
+        self,
+        *,
+        tags: Optional[Dict[str, str]] = None,
+        provisioning_state: Optional[Union[str, "ProvisioningState"]] = None,
+        cluster_profile: Optional["ClusterProfile"] = None,
+        console_profile: Optional["ConsoleProfile"] = None,
+        service_principal_profile: Optional["ServicePrincipalProfile"] = None,
+        network_profile: Optional["NetworkProfile"] = None,
+        master_profile: Optional["MasterProfile"] = None,
+        worker_profiles: Optional[List["WorkerProfile"]] = None,
+        autoscaler_profile: Optional["AutoscalerProfile"] = None,
+        certificate_profile: Optional["CertificateProfile"] = None,
+        apiserver_profile: Optional["APIServerProfile"] = None,
+        ingress_profiles: Optional[List["IngressProfile"]] = None,
+        **kwargs
+    ):
+        """
+        :keyword tags: A set of tags. The resource tags.
+        :paramtype tags: dict[str, str]
+        :keyword provisioning_state: The cluster provisioning state. Possible values include:
+         "AdminUpdating", "Cancelled", "Creating", "Deleting", "Failed", "Succeeded", "Updating".
+        :paramtype provisioning_state: str or
+         ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.ProvisioningState
+        :keyword cluster_profile: The cluster profile.
+        :paramtype cluster_profile: ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.ClusterProfile
+        :keyword console_profile: The console profile.
+        :paramtype console_profile: ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.ConsoleProfile
+        :keyword service_principal_profile: The cluster service principal profile.
+        :paramtype service_principal_profile:
+         ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.ServicePrincipalProfile
+        :keyword network_profile: The cluster network profile.
+        :paramtype network_profile: ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.NetworkProfile
+        :keyword master_profile: The cluster master profile.
+        :paramtype master_profile: ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.MasterProfile
+        :keyword worker_profiles: The cluster worker profiles.
+        :paramtype worker_profiles: list[~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.WorkerProfile]
+        :keyword autoscaler_profile: The cluster autoscaler profile.
+        :paramtype autoscaler_profile:
+         ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.AutoscalerProfile
+        :keyword certificate_profile: The cluster certificate profile.
+        :paramtype certificate_profile:
+         ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.CertificateProfile
+        :keyword apiserver_profile: The cluster API server profile.
+        :paramtype apiserver_profile: ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.APIServerProfile
+        :keyword ingress_profiles: The cluster ingress profiles.
+        :paramtype ingress_profiles:
+         list[~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.IngressProfile]
+        """
+        super(OpenShiftClusterUpdate, self).__init__(**kwargs)
+        self.tags = tags
+        self.system_data = None
+        self.provisioning_state = provisioning_state
+        self.cluster_profile = cluster_profile
+        self.console_profile = console_profile
+        self.service_principal_profile = service_principal_profile
+        self.network_profile = network_profile
+        self.master_profile = master_profile
+        self.worker_profiles = worker_profiles
+        self.worker_profiles_status = None
+        self.autoscaler_profile = autoscaler_profile
+        self.autoscaler_profile_status = None
+        self.certificate_profile = certificate_profile
+        self.apiserver_profile = apiserver_profile
+        self.ingress_profiles = ingress_profiles
+
+
+class OpenShiftVersion(ProxyResource):
+    """OpenShiftVersion represents an OpenShift version that can be installed.
+
+    Variables are only populated by the server, and will be ignored when sending a request.
+
+    :ivar id: Fully qualified resource ID for the resource. Ex -
+     /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}.
+    :vartype id: str
+    :ivar name: The name of the resource.
+    :vartype name: str
+    :ivar type: The type of the resource. E.g. "Microsoft.Compute/virtualMachines" or
+     "Microsoft.Storage/storageAccounts".
+    :vartype type: str
+    :ivar system_data: Azure Resource Manager metadata containing createdBy and modifiedBy
+     information.
+    :vartype system_data: ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.SystemData
+    :ivar version: Version represents the version to create the cluster at.
+    :vartype version: str
+    """
+
+    _validation = {
+        'id': {'readonly': True},
+        'name': {'readonly': True},
+        'type': {'readonly': True},
+        'system_data': {'readonly': True},
+    }
+
+    _attribute_map = {
+        'id': {'key': 'id', 'type': 'str'},
+        'name': {'key': 'name', 'type': 'str'},
+        'type': {'key': 'type', 'type': 'str'},
+        'system_data': {'key': 'systemData', 'type': 'SystemData'},
+        'version': {'key': 'properties.version', 'type': 'str'},
+    }
+
+    def __init__(
+        self,
+        *,
+        version: Optional[str] = None,
+        **kwargs
+    ):
+        """
+        :keyword version: Version represents the version to create the cluster at.
+        :paramtype version: str
+        """
+        super(OpenShiftVersion, self).__init__(**kwargs)
+        self.version = version
+
+
+class OpenShiftVersionList(msrest.serialization.Model):
+    """OpenShiftVersionList represents a List of available versions.
+
+    :ivar value: The List of available versions.
+    :vartype value: list[~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.OpenShiftVersion]
+    :ivar next_link: Next Link to next operation.
+    :vartype next_link: str
+    """
+
+    _attribute_map = {
+        'value': {'key': 'value', 'type': '[OpenShiftVersion]'},
+        'next_link': {'key': 'nextLink', 'type': 'str'},
+    }
+
+    def __init__(
+        self,
+        *,
+        value: Optional[List["OpenShiftVersion"]] = None,
+        next_link: Optional[str] = None,
+        **kwargs
+    ):
+        """
+        :keyword value: The List of available versions.
+        :paramtype value: list[~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.OpenShiftVersion]
+        :keyword next_link: Next Link to next operation.
+        :paramtype next_link: str
+        """
+        super(OpenShiftVersionList, self).__init__(**kwargs)
+        self.value = value
+        self.next_link = next_link
+
+
+class Operation(msrest.serialization.Model):
+    """Operation represents an RP operation.
+
+    :ivar name: Operation name: {provider}/{resource}/{operation}.
+    :vartype name: str
+    :ivar display: The object that describes the operation.
+    :vartype display: ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.Display
+    :ivar origin: Sources of requests to this operation.  Comma separated list with valid values
+     user or system, e.g. "user,system".
+    :vartype origin: str
+    """
+
+    _attribute_map = {
+        'name': {'key': 'name', 'type': 'str'},
+        'display': {'key': 'display', 'type': 'Display'},
+        'origin': {'key': 'origin', 'type': 'str'},
+    }
+
+    def __init__(
+        self,
+        *,
+        name: Optional[str] = None,
+        display: Optional["Display"] = None,
+        origin: Optional[str] = None,
+        **kwargs
+    ):
+        """
+        :keyword name: Operation name: {provider}/{resource}/{operation}.
+        :paramtype name: str
+        :keyword display: The object that describes the operation.
+        :paramtype display: ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.Display
+        :keyword origin: Sources of requests to this operation.  Comma separated list with valid values
+         user or system, e.g. "user,system".
+        :paramtype origin: str
+        """
+        super(Operation, self).__init__(**kwargs)
+        self.name = name
+        self.display = display
+        self.origin = origin
+
+
+class OperationList(msrest.serialization.Model):
+    """OperationList represents an RP operation list.
+
+    :ivar value: List of operations supported by the resource provider.
+    :vartype value: list[~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.Operation]
+    :ivar next_link: The link used to get the next page of operations.
+    :vartype next_link: str
+    """
+
+    _attribute_map = {
+        'value': {'key': 'value', 'type': '[Operation]'},
+        'next_link': {'key': 'nextLink', 'type': 'str'},
+    }
+
+    def __init__(
+        self,
+        *,
+        value: Optional[List["Operation"]] = None,
+        next_link: Optional[str] = None,
+        **kwargs
+    ):
+        """
+        :keyword value: List of operations supported by the resource provider.
+        :paramtype value: list[~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.Operation]
+        :keyword next_link: The link used to get the next page of operations.
+        :paramtype next_link: str
+        """
+        super(OperationList, self).__init__(**kwargs)
+        self.value = value
+        self.next_link = next_link
+
+
+class Secret(ProxyResource):
+    """Secret represents a secret.
+
+    Variables are only populated by the server, and will be ignored when sending a request.
+
+    :ivar id: Fully qualified resource ID for the resource. Ex -
+     /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}.
+    :vartype id: str
+    :ivar name: The name of the resource.
+    :vartype name: str
+    :ivar type: The type of the resource. E.g. "Microsoft.Compute/virtualMachines" or
+     "Microsoft.Storage/storageAccounts".
+    :vartype type: str
+    :ivar system_data: Azure Resource Manager metadata containing createdBy and modifiedBy
+     information.
+    :vartype system_data: ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.SystemData
+    :ivar secret_resources: The Secrets Resources.
+    :vartype secret_resources: str
+    """
+
+    _validation = {
+        'id': {'readonly': True},
+        'name': {'readonly': True},
+        'type': {'readonly': True},
+        'system_data': {'readonly': True},
+    }
+
+    _attribute_map = {
+        'id': {'key': 'id', 'type': 'str'},
+        'name': {'key': 'name', 'type': 'str'},
+        'type': {'key': 'type', 'type': 'str'},
+        'system_data': {'key': 'systemData', 'type': 'SystemData'},
+        'secret_resources': {'key': 'properties.secretResources', 'type': 'str'},
+    }
+
+    def __init__(
+        self,
+        *,
+        secret_resources: Optional[str] = None,
+        **kwargs
+    ):
+        """
+        :keyword secret_resources: The Secrets Resources.
+        :paramtype secret_resources: str
+        """
+        super(Secret, self).__init__(**kwargs)
+        self.secret_resources = secret_resources
+
+
+class SecretList(msrest.serialization.Model):
+    """SecretList represents a list of Secrets.
+
+    :ivar value: The list of secrets.
+    :vartype value: list[~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.Secret]
+    :ivar next_link: The link used to get the next page of operations.
+    :vartype next_link: str
+    """
+
+    _attribute_map = {
+        'value': {'key': 'value', 'type': '[Secret]'},
+        'next_link': {'key': 'nextLink', 'type': 'str'},
+    }
+
+    def __init__(
+        self,
+        *,
+        value: Optional[List["Secret"]] = None,
+        next_link: Optional[str] = None,
+        **kwargs
+    ):
+        """
+        :keyword value: The list of secrets.
+        :paramtype value: list[~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.Secret]
+        :keyword next_link: The link used to get the next page of operations.
+        :paramtype next_link: str
+        """
+        super(SecretList, self).__init__(**kwargs)
+        self.value = value
+        self.next_link = next_link
+
+
+class SecretUpdate(msrest.serialization.Model):
+    """Secret represents a secret.
+
+    Variables are only populated by the server, and will be ignored when sending a request.
+
+    :ivar system_data: The system meta data relating to this resource.
+    :vartype system_data: ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.SystemData
+    :ivar secret_resources: The Secrets Resources.
+    :vartype secret_resources: str
+    """
+
+    _validation = {
+        'system_data': {'readonly': True},
+    }
+
+    _attribute_map = {
+        'system_data': {'key': 'systemData', 'type': 'SystemData'},
+        'secret_resources': {'key': 'properties.secretResources', 'type': 'str'},
+    }
+
+    def __init__(
+        self,
+        *,
+        secret_resources: Optional[str] = None,
+        **kwargs
+    ):
+        """
+        :keyword secret_resources: The Secrets Resources.
+        :paramtype secret_resources: str
+        """
+        super(SecretUpdate, self).__init__(**kwargs)
+        self.system_data = None
+        self.secret_resources = secret_resources
+
+
+class ServicePrincipalProfile(msrest.serialization.Model):
+    """ServicePrincipalProfile represents a service principal profile.
+
+    :ivar client_id: The client ID used for the cluster.
+    :vartype client_id: str
+    :ivar client_secret: The client secret used for the cluster.
+    :vartype client_secret: str
+    """
+
+    _attribute_map = {
+        'client_id': {'key': 'clientId', 'type': 'str'},
+        'client_secret': {'key': 'clientSecret', 'type': 'str'},
+    }
+
+    def __init__(
+        self,
+        *,
+        client_id: Optional[str] = None,
+        client_secret: Optional[str] = None,
+        **kwargs
+    ):
+        """
+        :keyword client_id: The client ID used for the cluster.
+        :paramtype client_id: str
+        :keyword client_secret: The client secret used for the cluster.
+        :paramtype client_secret: str
+        """
+        super(ServicePrincipalProfile, self).__init__(**kwargs)
+        self.client_id = client_id
+        self.client_secret = client_secret
+
+
+class SyncIdentityProvider(ProxyResource):
+    """SyncIdentityProvider represents a SyncIdentityProvider.
+
+    Variables are only populated by the server, and will be ignored when sending a request.
+
+    :ivar id: Fully qualified resource ID for the resource. Ex -
+     /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}.
+    :vartype id: str
+    :ivar name: The name of the resource.
+    :vartype name: str
+    :ivar type: The type of the resource. E.g. "Microsoft.Compute/virtualMachines" or
+     "Microsoft.Storage/storageAccounts".
+    :vartype type: str
+    :ivar system_data: Azure Resource Manager metadata containing createdBy and modifiedBy
+     information.
+    :vartype system_data: ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.SystemData
+    :ivar resources:
+    :vartype resources: str
+    """
+
+    _validation = {
+        'id': {'readonly': True},
+        'name': {'readonly': True},
+        'type': {'readonly': True},
+        'system_data': {'readonly': True},
+    }
+
+    _attribute_map = {
+        'id': {'key': 'id', 'type': 'str'},
+        'name': {'key': 'name', 'type': 'str'},
+        'type': {'key': 'type', 'type': 'str'},
+        'system_data': {'key': 'systemData', 'type': 'SystemData'},
+        'resources': {'key': 'properties.resources', 'type': 'str'},
+    }
+
+    def __init__(
+        self,
+        *,
+        resources: Optional[str] = None,
+        **kwargs
+    ):
+        """
+        :keyword resources:
+        :paramtype resources: str
+        """
+        super(SyncIdentityProvider, self).__init__(**kwargs)
+        self.resources = resources
+
+
+class SyncIdentityProviderList(msrest.serialization.Model):
+    """SyncSetList represents a list of SyncSets.
+
+    :ivar value: The list of sync identity providers.
+    :vartype value: list[~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.SyncIdentityProvider]
+    :ivar next_link: The link used to get the next page of operations.
+    :vartype next_link: str
+    """
+
+    _attribute_map = {
+        'value': {'key': 'value', 'type': '[SyncIdentityProvider]'},
+        'next_link': {'key': 'nextLink', 'type': 'str'},
+    }
+
+    def __init__(
+        self,
+        *,
+        value: Optional[List["SyncIdentityProvider"]] = None,
+        next_link: Optional[str] = None,
+        **kwargs
+    ):
+        """
+        :keyword value: The list of sync identity providers.
+        :paramtype value: list[~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.SyncIdentityProvider]
+        :keyword next_link: The link used to get the next page of operations.
+        :paramtype next_link: str
+        """
+        super(SyncIdentityProviderList, self).__init__(**kwargs)
+        self.value = value
+        self.next_link = next_link
+
+
+class SyncIdentityProviderUpdate(msrest.serialization.Model):
+    """SyncIdentityProvider represents a SyncIdentityProvider.
+
+    Variables are only populated by the server, and will be ignored when sending a request.
+
+    :ivar system_data: The system meta data relating to this resource.
+    :vartype system_data: ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.SystemData
+    :ivar resources:
+    :vartype resources: str
+    """
+
+    _validation = {
+        'system_data': {'readonly': True},
+    }
+
+    _attribute_map = {
+        'system_data': {'key': 'systemData', 'type': 'SystemData'},
+        'resources': {'key': 'properties.resources', 'type': 'str'},
+    }
+
+    def __init__(
+        self,
+        *,
+        resources: Optional[str] = None,
+        **kwargs
+    ):
+        """
+        :keyword resources:
+        :paramtype resources: str
+        """
+        super(SyncIdentityProviderUpdate, self).__init__(**kwargs)
+        self.system_data = None
+        self.resources = resources
+
+
+class SyncSet(ProxyResource):
+    """SyncSet represents a SyncSet for an Azure Red Hat OpenShift Cluster.
+
+    Variables are only populated by the server, and will be ignored when sending a request.
+
+    :ivar id: Fully qualified resource ID for the resource. Ex -
+     /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}.
+    :vartype id: str
+    :ivar name: The name of the resource.
+    :vartype name: str
+    :ivar type: The type of the resource. E.g. "Microsoft.Compute/virtualMachines" or
+     "Microsoft.Storage/storageAccounts".
+    :vartype type: str
+    :ivar system_data: Azure Resource Manager metadata containing createdBy and modifiedBy
+     information.
+    :vartype system_data: ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.SystemData
+    :ivar resources: Resources represents the SyncSets configuration.
+    :vartype resources: str
+    """
+
+    _validation = {
+        'id': {'readonly': True},
+        'name': {'readonly': True},
+        'type': {'readonly': True},
+        'system_data': {'readonly': True},
+    }
+
+    _attribute_map = {
+        'id': {'key': 'id', 'type': 'str'},
+        'name': {'key': 'name', 'type': 'str'},
+        'type': {'key': 'type', 'type': 'str'},
+        'system_data': {'key': 'systemData', 'type': 'SystemData'},
+        'resources': {'key': 'properties.resources', 'type': 'str'},
+    }
+
+    def __init__(
+        self,
+        *,
+        resources: Optional[str] = None,
+        **kwargs
+    ):
+        """
+        :keyword resources: Resources represents the SyncSets configuration.
+        :paramtype resources: str
+        """
+        super(SyncSet, self).__init__(**kwargs)
+        self.resources = resources
+
+
+class SyncSetList(msrest.serialization.Model):
+    """SyncSetList represents a list of SyncSets.
+
+    :ivar value: The list of syncsets.
+    :vartype value: list[~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.SyncSet]
+    :ivar next_link: The link used to get the next page of operations.
+    :vartype next_link: str
+    """
+
+    _attribute_map = {
+        'value': {'key': 'value', 'type': '[SyncSet]'},
+        'next_link': {'key': 'nextLink', 'type': 'str'},
+    }
+
+    def __init__(
+        self,
+        *,
+        value: Optional[List["SyncSet"]] = None,
+        next_link: Optional[str] = None,
+        **kwargs
+    ):
+        """
+        :keyword value: The list of syncsets.
+        :paramtype value: list[~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.SyncSet]
+        :keyword next_link: The link used to get the next page of operations.
+        :paramtype next_link: str
+        """
+        super(SyncSetList, self).__init__(**kwargs)
+        self.value = value
+        self.next_link = next_link
+
+
+class SyncSetUpdate(msrest.serialization.Model):
+    """SyncSet represents a SyncSet for an Azure Red Hat OpenShift Cluster.
+
+    Variables are only populated by the server, and will be ignored when sending a request.
+
+    :ivar system_data: The system meta data relating to this resource.
+    :vartype system_data: ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.SystemData
+    :ivar resources: Resources represents the SyncSets configuration.
+    :vartype resources: str
+    """
+
+    _validation = {
+        'system_data': {'readonly': True},
+    }
+
+    _attribute_map = {
+        'system_data': {'key': 'systemData', 'type': 'SystemData'},
+        'resources': {'key': 'properties.resources', 'type': 'str'},
+    }
+
+    def __init__(
+        self,
+        *,
+        resources: Optional[str] = None,
+        **kwargs
+    ):
+        """
+        :keyword resources: Resources represents the SyncSets configuration.
+        :paramtype resources: str
+        """
+        super(SyncSetUpdate, self).__init__(**kwargs)
+        self.system_data = None
+        self.resources = resources
+
+
+class SystemData(msrest.serialization.Model):
+    """Metadata pertaining to creation and last modification of the resource.
+
+    :ivar created_by: The identity that created the resource.
+    :vartype created_by: str
+    :ivar created_by_type: The type of identity that created the resource. Possible values include:
+     "User", "Application", "ManagedIdentity", "Key".
+    :vartype created_by_type: str or ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.CreatedByType
+    :ivar created_at: The timestamp of resource creation (UTC).
+    :vartype created_at: ~datetime.datetime
+    :ivar last_modified_by: The identity that last modified the resource.
+    :vartype last_modified_by: str
+    :ivar last_modified_by_type: The type of identity that last modified the resource. Possible
+     values include: "User", "Application", "ManagedIdentity", "Key".
+    :vartype last_modified_by_type: str or
+     ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.CreatedByType
+    :ivar last_modified_at: The timestamp of resource last modification (UTC).
+    :vartype last_modified_at: ~datetime.datetime
+    """
+
+    _attribute_map = {
+        'created_by': {'key': 'createdBy', 'type': 'str'},
+        'created_by_type': {'key': 'createdByType', 'type': 'str'},
+        'created_at': {'key': 'createdAt', 'type': 'iso-8601'},
+        'last_modified_by': {'key': 'lastModifiedBy', 'type': 'str'},
+        'last_modified_by_type': {'key': 'lastModifiedByType', 'type': 'str'},
+        'last_modified_at': {'key': 'lastModifiedAt', 'type': 'iso-8601'},
+    }
+
+    def __init__(
+        self,
+        *,
+        created_by: Optional[str] = None,
+        created_by_type: Optional[Union[str, "CreatedByType"]] = None,
+        created_at: Optional[datetime.datetime] = None,
+        last_modified_by: Optional[str] = None,
+        last_modified_by_type: Optional[Union[str, "CreatedByType"]] = None,
+        last_modified_at: Optional[datetime.datetime] = None,
+        **kwargs
+    ):
+        """
+        :keyword created_by: The identity that created the resource.
+        :paramtype created_by: str
+        :keyword created_by_type: The type of identity that created the resource. Possible values
+         include: "User", "Application", "ManagedIdentity", "Key".
+        :paramtype created_by_type: str or ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.CreatedByType
+        :keyword created_at: The timestamp of resource creation (UTC).
+        :paramtype created_at: ~datetime.datetime
+        :keyword last_modified_by: The identity that last modified the resource.
+        :paramtype last_modified_by: str
+        :keyword last_modified_by_type: The type of identity that last modified the resource. Possible
+         values include: "User", "Application", "ManagedIdentity", "Key".
+        :paramtype last_modified_by_type: str or
+         ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.CreatedByType
+        :keyword last_modified_at: The timestamp of resource last modification (UTC).
+        :paramtype last_modified_at: ~datetime.datetime
+        """
+        super(SystemData, self).__init__(**kwargs)
+        self.created_by = created_by
+        self.created_by_type = created_by_type
+        self.created_at = created_at
+        self.last_modified_by = last_modified_by
+        self.last_modified_by_type = last_modified_by_type
+        self.last_modified_at = last_modified_at
+
+
+class WorkerProfile(msrest.serialization.Model):
+    """WorkerProfile represents a worker profile.
+
+    :ivar name: The worker profile name.
+    :vartype name: str
+    :ivar vm_size: The size of the worker VMs.
+    :vartype vm_size: str
+    :ivar disk_size_gb: The disk size of the worker VMs.
+    :vartype disk_size_gb: int
+    :ivar subnet_id: The Azure resource ID of the worker subnet.
+    :vartype subnet_id: str
+    :ivar count: The number of worker VMs.
+    :vartype count: int
+    :ivar encryption_at_host: Whether master virtual machines are encrypted at host. Possible
+     values include: "Disabled", "Enabled".
+    :vartype encryption_at_host: str or
+     ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.EncryptionAtHost
+    :ivar disk_encryption_set_id: The resource ID of an associated DiskEncryptionSet, if
+     applicable.
+    :vartype disk_encryption_set_id: str
+    """
+
+    _attribute_map = {
+        'name': {'key': 'name', 'type': 'str'},
+        'vm_size': {'key': 'vmSize', 'type': 'str'},
+        'disk_size_gb': {'key': 'diskSizeGB', 'type': 'int'},
+        'subnet_id': {'key': 'subnetId', 'type': 'str'},
+        'count': {'key': 'count', 'type': 'int'},
+        'encryption_at_host': {'key': 'encryptionAtHost', 'type': 'str'},
+        'disk_encryption_set_id': {'key': 'diskEncryptionSetId', 'type': 'str'},
+    }
+
+    def __init__(
+        self,
+        *,
+        name: Optional[str] = None,
+        vm_size: Optional[str] = None,
+        disk_size_gb: Optional[int] = None,
+        subnet_id: Optional[str] = None,
+        count: Optional[int] = None,
+        encryption_at_host: Optional[Union[str, "EncryptionAtHost"]] = None,
+        disk_encryption_set_id: Optional[str] = None,
+        **kwargs
+    ):
+        """
+        :keyword name: The worker profile name.
+        :paramtype name: str
+        :keyword vm_size: The size of the worker VMs.
+        :paramtype vm_size: str
+        :keyword disk_size_gb: The disk size of the worker VMs.
+        :paramtype disk_size_gb: int
+        :keyword subnet_id: The Azure resource ID of the worker subnet.
+        :paramtype subnet_id: str
+        :keyword count: The number of worker VMs.
+        :paramtype count: int
+        :keyword encryption_at_host: Whether master virtual machines are encrypted at host. Possible
+         values include: "Disabled", "Enabled".
+        :paramtype encryption_at_host: str or
+         ~azure.mgmt.redhatopenshift.v2024_01_01_preview.models.EncryptionAtHost
+        :keyword disk_encryption_set_id: The resource ID of an associated DiskEncryptionSet, if
+         applicable.
+        :paramtype disk_encryption_set_id: str
+        """
+        super(WorkerProfile, self).__init__(**kwargs)
+        self.name = name
+        self.vm_size = vm_size
+        self.disk_size_gb = disk_size_gb
+        self.subnet_id = subnet_id
+        self.count = count
+        self.encryption_at_host = encryption_at_host
+        self.disk_encryption_set_id = disk_encryption_set_id
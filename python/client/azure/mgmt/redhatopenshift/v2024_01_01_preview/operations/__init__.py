@@ -0,0 +1,33 @@
+# coding=utf-8
+# --------------------------------------------------------------------------
+# Copyright (c) Microsoft Corporation. All rights reserved.
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#   http://www.apache.org/licenses/LICENSE-2.0
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+# 
+# Code generated by Microsoft (R) AutoRest Code Generator.Changes may cause incorrect behavior and will be lost if the code is regenerated.
+# --------------------------------------------------------------------------
+
+from ._operations import Operations
+from ._open_shift_versions_operations import OpenShiftVersionsOperations
+from ._open_shift_clusters_operations import OpenShiftClustersOperations
+from ._machine_pools_operations import MachinePoolsOperations
+from ._secrets_operations import SecretsOperations
+from ._sync_identity_providers_operations import SyncIdentityProvidersOperations
+from ._sync_sets_operations import SyncSetsOperations
+
+__all__ = [
+    'Operations',
+    'OpenShiftVersionsOperations',
+    'OpenShiftClustersOperations',
+    'MachinePoolsOperations',
+    'SecretsOperations',
+    'SyncIdentityProvidersOperations',
+    'SyncSetsOperations',
+]
@@ -5,6 +5,7 @@ package database
 
 import (
 	"context"
+	"sort"
 	"time"
 
 	"github.com/Azure/ARO-RP/pkg/api"
@@ -24,4 +25,8 @@ func fakeBillingDeletionTimestampTrigger(ctx context.Context, doc *api.BillingDo
 func injectBilling(c *cosmosdb.FakeBillingDocumentClient) {
 	c.SetTriggerHandler("setCreationBillingTimeStamp", fakeBillingCreationTimestampTrigger)
 	c.SetTriggerHandler("setDeletionBillingTimeStamp", fakeBillingDeletionTimestampTrigger)
+
+	c.SetSorter(func(in []*api.BillingDocument) {
+		sort.Slice(in, func(i, j int) bool { return in[i].ID < in[j].ID })
+	})
 }
@@ -55,6 +55,7 @@ func NewFakeAsyncOperations() (db database.AsyncOperations, client *cosmosdb.Fak
 func NewFakePortal() (db database.Portal, client *cosmosdb.FakePortalDocumentClient) {
 	uuid := deterministicuuid.NewTestUUIDGenerator(deterministicuuid.PORTAL)
 	client = cosmosdb.NewFakePortalDocumentClient(jsonHandle)
+	injectPortal(client)
 	db = database.NewPortalWithProvidedClient(client, uuid)
 	return db, client
 }
@@ -72,6 +73,12 @@ func NewFakeOpenShiftVersions(uuid uuid.Generator) (db database.OpenShiftVersion
 	return db, client
 }
 
+func NewFakeOperatorRollouts(uuid uuid.Generator) (db database.OperatorRollouts, client *cosmosdb.FakeOperatorRolloutDocumentClient) {
+	client = cosmosdb.NewFakeOperatorRolloutDocumentClient(jsonHandle)
+	db = database.NewOperatorRolloutsWithProvidedClient(client, uuid)
+	return db, client
+}
+
 func NewFakeClusterManager() (db database.ClusterManagerConfigurations, client *cosmosdb.FakeClusterManagerConfigurationDocumentClient) {
 	uuid := deterministicuuid.NewTestUUIDGenerator(deterministicuuid.CLUSTERMANAGER)
 	client = cosmosdb.NewFakeClusterManagerConfigurationDocumentClient(jsonHandle)
@@ -60,6 +60,47 @@ func fakeOpenShiftClustersQueueLengthQuery(client cosmosdb.OpenShiftClusterDocum
 	return &fakeOpenShiftClustersQueueLengthIterator{resultCount: len(results)}
 }
 
+func fakeOpenShiftClustersHiveShardQuery(client cosmosdb.OpenShiftClusterDocumentClient, query *cosmosdb.Query, options *cosmosdb.Options) cosmosdb.OpenShiftClusterDocumentRawIterator {
+	docs, err := fakeOpenShiftClustersGetAllDocuments(client)
+	if err != nil {
+		return cosmosdb.NewFakeOpenShiftClusterDocumentErroringRawIterator(err)
+	}
+
+	var count int
+	for _, r := range docs {
+		shard := r.OpenShiftCluster.Properties.HiveProfile.ShardIndex
+		if shard == 0 {
+			shard = 1
+		}
+		if strconv.Itoa(shard) == query.Parameters[0].Value {
+			count++
+		}
+	}
+	return &fakeOpenShiftClustersQueueLengthIterator{resultCount: count}
+}
+
+func fakeOpenShiftClustersCertificateRenewalDueQuery(client cosmosdb.OpenShiftClusterDocumentClient, query *cosmosdb.Query, options *cosmosdb.Options) cosmosdb.OpenShiftClusterDocumentRawIterator {
+	now, err := time.Parse(time.RFC3339, query.Parameters[0].Value)
+	if err != nil {
+		return cosmosdb.NewFakeOpenShiftClusterDocumentErroringRawIterator(err)
+	}
+
+	docs, err := fakeOpenShiftClustersGetAllDocuments(client)
+	if err != nil {
+		return cosmosdb.NewFakeOpenShiftClusterDocumentErroringRawIterator(err)
+	}
+
+	var results []*api.OpenShiftClusterDocument
+	for _, r := range docs {
+		cr := r.OpenShiftCluster.Properties.CertificateRenewal
+		if r.OpenShiftCluster.Properties.ProvisioningState == api.ProvisioningStateSucceeded &&
+			cr != nil && cr.DueTime != nil && !cr.DueTime.After(now) {
+			results = append(results, r)
+		}
+	}
+	return cosmosdb.NewFakeOpenShiftClusterDocumentIterator(results, 0)
+}
+
 func fakeOpenShiftClustersDequeueQuery(client cosmosdb.OpenShiftClusterDocumentClient, query *cosmosdb.Query, options *cosmosdb.Options) cosmosdb.OpenShiftClusterDocumentRawIterator {
 	docs, err := getQueuedOpenShiftDocuments(client)
 	if err != nil {
@@ -154,6 +195,8 @@ func openShiftClusterConflictChecker(one *api.OpenShiftClusterDocument, two *api
 func injectOpenShiftClusters(c *cosmosdb.FakeOpenShiftClusterDocumentClient) {
 	c.SetQueryHandler(database.OpenShiftClustersDequeueQuery, fakeOpenShiftClustersDequeueQuery)
 	c.SetQueryHandler(database.OpenShiftClustersQueueLengthQuery, fakeOpenShiftClustersQueueLengthQuery)
+	c.SetQueryHandler(database.OpenShiftClustersHiveShardQuery, fakeOpenShiftClustersHiveShardQuery)
+	c.SetQueryHandler(database.OpenShiftClustersCertificateRenewalDueQuery, fakeOpenShiftClustersCertificateRenewalDueQuery)
 	c.SetQueryHandler(database.OpenShiftClustersGetQuery, fakeOpenshiftClustersMatchQuery)
 	c.SetQueryHandler(database.OpenshiftClustersClientIdQuery, fakeOpenshiftClustersMatchQuery)
 	c.SetQueryHandler(database.OpenshiftClustersResourceGroupQuery, fakeOpenshiftClustersMatchQuery)
@@ -166,7 +209,8 @@ func injectOpenShiftClusters(c *cosmosdb.FakeOpenShiftClusterDocumentClient) {
 }
 
 // fakeOpenShiftClustersQueueLengthIterator is a RawIterator that will produce a
-// document containing a list of a single integer when NextRaw is called.
+// document containing a list of a single integer when NextRaw is called. It is
+// used for all of the aggregate COUNT queries.
 type fakeOpenShiftClustersQueueLengthIterator struct {
 	called      bool
 	resultCount int
@@ -0,0 +1,37 @@
+package database
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"sort"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/database"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+)
+
+func injectPortal(c *cosmosdb.FakePortalDocumentClient) {
+	c.SetQueryHandler(database.PortalElevatedAccessQuery, fakePortalElevatedAccessQuery)
+
+	c.SetSorter(func(in []*api.PortalDocument) {
+		sort.Slice(in, func(i, j int) bool { return in[i].ID < in[j].ID })
+	})
+}
+
+func fakePortalElevatedAccessQuery(client cosmosdb.PortalDocumentClient, query *cosmosdb.Query, options *cosmosdb.Options) cosmosdb.PortalDocumentRawIterator {
+	input, err := client.ListAll(context.Background(), nil)
+	if err != nil {
+		return cosmosdb.NewFakePortalDocumentErroringRawIterator(err)
+	}
+
+	var docs []*api.PortalDocument
+	for _, doc := range input.PortalDocuments {
+		if doc.Portal != nil && doc.Portal.ElevatedAccess != nil {
+			docs = append(docs, doc)
+		}
+	}
+
+	return cosmosdb.NewFakePortalDocumentIterator(docs, 0)
+}
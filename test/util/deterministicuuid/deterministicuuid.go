@@ -19,6 +19,7 @@ const (
 	GATEWAY
 	OPENSHIFT_VERSIONS
 	CLUSTERMANAGER
+	OPERATOR_ROLLOUTS
 )
 
 type gen struct {
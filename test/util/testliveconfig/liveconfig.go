@@ -25,6 +25,13 @@ func (t *testLiveConfig) HiveRestConfig(ctx context.Context, shard int) (*rest.C
 	return nil, errors.New("testLiveConfig does not have a Hive")
 }
 
+func (t *testLiveConfig) HiveShardCount(ctx context.Context) (int, error) {
+	if t.adoptByHive || t.installViaHive {
+		return 1, nil
+	}
+	return 0, errors.New("testLiveConfig does not have a Hive")
+}
+
 func (t *testLiveConfig) InstallViaHive(ctx context.Context) (bool, error) {
 	return t.installViaHive, nil
 }